@@ -56,7 +56,7 @@ func (s *Service) fetchAndStoreDeposits(
 	blockNum math.U64,
 ) {
 	blockNumStr := strconv.FormatUint(blockNum.Unwrap(), 10)
-	deposits, err := s.depositContract.ReadDeposits(ctx, blockNum, blockNum)
+	deposits, locations, err := s.depositContract.ReadDeposits(ctx, blockNum, blockNum)
 	if err != nil {
 		s.logger.Error("Failed to read deposits", "error", err)
 		s.metrics.sink.IncrementCounter(
@@ -89,6 +89,19 @@ func (s *Service) fetchAndStoreDeposits(
 		s.failedBlocksMu.Unlock()
 		return
 	}
+
+	if err = s.storageBackend.DepositStore().EnqueueDepositLocations(ctx, locations); err != nil {
+		s.logger.Error("Failed to store deposit locations", "error", err)
+		s.metrics.sink.IncrementCounter(
+			"beacon_kit.execution.deposit.failed_to_enqueue_deposit_locations",
+			"block_num",
+			blockNumStr,
+		)
+		s.failedBlocksMu.Lock()
+		s.failedBlocks[blockNum] = struct{}{}
+		s.failedBlocksMu.Unlock()
+		return
+	}
 	s.failedBlocksMu.Lock()
 	delete(s.failedBlocks, blockNum)
 	s.failedBlocksMu.Unlock()
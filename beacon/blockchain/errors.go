@@ -38,6 +38,8 @@ var (
 	ErrDataNotAvailable = errors.New("data not available")
 	// ErrSidecarCommitmentMismatch indicates that the BeaconBlockBody commitments do not match the sidecars.
 	ErrSidecarCommitmentMismatch = errors.New("sidecars commitments mismatch")
+	// ErrNotUnjailTx is an error for consensus txs not carrying an unjail.
+	ErrNotUnjailTx = errors.New("not an unjail tx")
 	// ErrSidecarSignatureMismatch indicates that the sidecar signature is invalid.
 	ErrSidecarSignatureMismatch = errors.New("sidecar signature mismatch")
 )
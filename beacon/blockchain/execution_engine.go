@@ -24,7 +24,6 @@ import (
 	"context"
 	"fmt"
 
-	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
@@ -44,13 +43,13 @@ func (s *Service) sendPostBlockFCU(
 	}
 
 	// Send a forkchoice update without payload attributes to notify EL of the new head.
-	req := ctypes.BuildForkchoiceUpdateRequestNoAttrs(
+	req := s.attributesFactory.HeadForkchoiceUpdate(
 		&engineprimitives.ForkchoiceStateV1{
 			HeadBlockHash:      lph.GetBlockHash(),
 			SafeBlockHash:      lph.GetParentHash(),
 			FinalizedBlockHash: lph.GetParentHash(),
 		},
-		s.chainSpec.ActiveForkVersionForTimestamp(lph.GetTimestamp()),
+		lph.GetTimestamp(),
 	)
 	if _, err = s.executionEngine.NotifyForkchoiceUpdate(ctx, req); err != nil {
 		return fmt.Errorf("failed forkchoice update, head %s: %w",
@@ -27,6 +27,7 @@ import (
 
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -47,9 +48,13 @@ func (s *Service) FinalizeBlock(
 		// While req.GetTime() and blk.GetTimestamp() may be different, they are guaranteed
 		// to map to the same forkVersion due to checks during ProcessProposal.
 		currentForkVersion,
+		s.metrics.ssz,
 	)
 	if err != nil {
-		s.logger.Error("Failed to decode block and blobs", "error", err)
+		s.logger.Error(
+			"Failed to decode block and blobs",
+			"trace_id", tracing.TraceID(ctx), "error", err,
+		)
 		return nil, fmt.Errorf("failed to decode block and blobs: %w", err)
 	}
 	s.logger.Debug(
@@ -79,7 +84,10 @@ func (s *Service) FinalizeBlock(
 			blobs,
 		)
 		if err != nil {
-			s.logger.Error("Failed to process blob sidecars", "error", err)
+			s.logger.Error(
+				"Failed to process blob sidecars",
+				"trace_id", tracing.TraceID(ctx), "error", err,
+			)
 			return nil, fmt.Errorf("failed to process blob sidecars: %w", err)
 		}
 
@@ -98,19 +106,29 @@ func (s *Service) FinalizeBlock(
 
 	// STEP 3: Finalize the block.
 	consensusBlk := types.NewConsensusBlock(blk, req.GetProposerAddress(), req.GetTime())
+	consensusBlk.SetMisbehaviors(DuplicateVoteMisbehaviors(req.GetMisbehavior()))
 	st := s.storageBackend.StateFromContext(ctx)
 	valUpdates, err := s.finalizeBeaconBlock(ctx, st, consensusBlk)
 	if err != nil {
 		s.logger.Error("Failed to process verified beacon block",
+			"trace_id", tracing.TraceID(ctx),
 			"error", err,
 		)
 		return nil, err
 	}
 
+	// Jailing updates come last, so that they take precedence over the
+	// epoch updates of the same validators.
+	jailUpdates, err := s.processJailing(st, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed processing jailing: %w", err)
+	}
+	valUpdates = append(valUpdates, jailUpdates...).CanonicalSort()
+
 	// STEP 4: Post Finalizations cleanups.
 
 	// Fetch and store the deposit for the block.
-	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
+	blockNum := blk.GetBody().GetImmutableExecutionPayload().GetNumber()
 	s.depositFetcher(ctx, blockNum)
 
 	// Store the finalized block in the KVStore.
@@ -123,6 +141,7 @@ func (s *Service) FinalizeBlock(
 		)
 		return nil, err
 	}
+	s.updateHead(blk)
 
 	// Prune the availability and deposit store.
 	err = s.processPruning(ctx, blk)
@@ -167,6 +186,9 @@ func (s *Service) executeStateTransition(
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
 
+	ctx, span := tracing.StartSpan(ctx, "StateTransition")
+	defer span.End()
+
 	// Notes about context attributes:
 	// - VerifyPayload: set to true. When we are NOT synced to the tip,
 	// process proposal does NOT get called and thus we must ensure that
@@ -183,14 +205,19 @@ func (s *Service) executeStateTransition(
 	//   1. we validated it during ProcessProposal at the head of the chain OR
 	//   2. we are bootstrapping and implicitly trust that the randao was validated by
 	//    the super majority during ProcessProposal of the given block height.
+	// - OptimisticPayload: set to true. For the same reason, the payload is
+	// imported optimistically if the execution client is syncing, instead of
+	// waiting for it to catch up.
 	txCtx := transition.NewTransitionCtx(
 		ctx,
 		blk.GetConsensusTime(),
 		blk.GetProposerAddress(),
 	).
+		WithMisbehaviors(blk.GetMisbehaviors()).
 		WithVerifyPayload(true).
 		WithVerifyRandao(false).
 		WithVerifyResult(false).
+		WithOptimisticPayload(true).
 		WithMeterGas(true)
 
 	return s.stateProcessor.Transition(
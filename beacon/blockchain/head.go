@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"github.com/berachain/beacon-kit/beacon/events"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// headInfo identifies the head of the chain.
+type headInfo struct {
+	slot      math.Slot
+	blockRoot common.Root
+	stateRoot common.Root
+	// optimistic is true if the execution payload of the block was imported
+	// optimistically.
+	optimistic bool
+}
+
+// updateHead makes the finalized block the new head of the chain, publishing
+// a head event along with a reorg event if the block does not descend from
+// the previous head.
+//
+// NOTE: CometBFT finalizes every block it commits, so a reorg is never
+// expected and signals that the node state has been rolled back and a
+// different chain has been followed since.
+func (s *Service) updateHead(blk *ctypes.BeaconBlock) {
+	newHead := &headInfo{
+		slot:      blk.GetSlot(),
+		blockRoot: blk.HashTreeRoot(),
+		stateRoot: blk.GetStateRoot(),
+		optimistic: s.optimistic.IsOptimistic(
+			blk.GetBody().GetExecutionPayload().GetNumber(),
+		),
+	}
+	oldHead := s.head
+	s.head = newHead
+
+	if oldHead != nil && blk.GetParentBlockRoot() != oldHead.blockRoot {
+		depth := s.reorgDepth(oldHead, blk)
+		s.metrics.markReorg(depth)
+		s.logger.Warn(
+			"Chain reorg detected",
+			"slot", newHead.slot.Base10(),
+			"depth", depth,
+			"old_head", oldHead.blockRoot,
+			"new_head", newHead.blockRoot,
+		)
+		s.eventBus.Publish(events.Event{
+			Topic: events.TopicChainReorg,
+			Data: &events.ChainReorg{
+				Slot:                newHead.slot,
+				Depth:               depth,
+				OldHeadBlock:        oldHead.blockRoot,
+				NewHeadBlock:        newHead.blockRoot,
+				OldHeadState:        oldHead.stateRoot,
+				NewHeadState:        newHead.stateRoot,
+				Epoch:               s.chainSpec.SlotToEpoch(newHead.slot),
+				ExecutionOptimistic: newHead.optimistic,
+			},
+		})
+	}
+
+	s.eventBus.Publish(events.Event{
+		Topic: events.TopicHead,
+		Data: &events.Head{
+			Slot:                newHead.slot,
+			Block:               newHead.blockRoot,
+			State:               newHead.stateRoot,
+			EpochTransition:     newHead.slot.Unwrap()%s.chainSpec.SlotsPerEpoch() == 0,
+			ExecutionOptimistic: newHead.optimistic,
+		},
+	})
+}
+
+// reorgDepth returns the number of slots between the old head and the last
+// block it has in common with the new head. The parent of the new head is
+// taken as the common block, assuming it directly preceded the new head if it
+// is not in the block store anymore.
+func (s *Service) reorgDepth(oldHead *headInfo, blk *ctypes.BeaconBlock) uint64 {
+	ancestor, err := s.storageBackend.BlockStore().GetSlotByBlockRoot(blk.GetParentBlockRoot())
+	if err != nil && blk.GetSlot() > 0 {
+		ancestor = blk.GetSlot() - 1
+	}
+	if oldHead.slot <= ancestor {
+		// The old head is not an ancestor of the new head at a lower slot,
+		// so it has been replaced by a sibling.
+		return 1
+	}
+	return (oldHead.slot - ancestor).Unwrap()
+}
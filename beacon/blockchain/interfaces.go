@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/beacon/events"
 	"github.com/berachain/beacon-kit/chain"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	dastore "github.com/berachain/beacon-kit/da/store"
@@ -59,6 +60,33 @@ type ExecutionEngine interface {
 	) (*engineprimitives.PayloadID, error)
 }
 
+// AttributesFactory produces the forkchoice updates sent to the execution
+// client.
+type AttributesFactory interface {
+	// HeadForkchoiceUpdate returns a forkchoice update that only moves the
+	// head of the execution client, without triggering a payload build.
+	HeadForkchoiceUpdate(
+		state *engineprimitives.ForkchoiceStateV1,
+		headTimestamp math.U64,
+	) *ctypes.ForkchoiceUpdateRequest
+}
+
+// OptimisticTracker tracks the execution blocks imported optimistically while
+// the execution client is syncing.
+type OptimisticTracker interface {
+	// IsOptimistic returns true if the execution block with the given
+	// number is imported optimistically and not validated yet.
+	IsOptimistic(number math.U64) bool
+}
+
+// ProposerSchedule predicts the proposers of the upcoming heights.
+type ProposerSchedule interface {
+	// ExpectedProposerAddresses returns the CometBFT addresses of the
+	// validators expected to propose the next count heights, starting at
+	// the height being decided.
+	ExpectedProposerAddresses(count int) ([][]byte, error)
+}
+
 // LocalBuilder is the interface for the builder service.
 type LocalBuilder interface {
 	// Enabled returns true if the local builder is enabled.
@@ -95,6 +123,13 @@ type StateProcessor interface {
 		*statedb.StateDB,
 		*ctypes.BeaconBlock,
 	) (transition.ValidatorUpdates, error)
+	// ProcessJailing jails the validators missing too many blocks and
+	// releases the ones unjailing.
+	ProcessJailing(
+		*statedb.StateDB,
+		[]core.CommitVote,
+		[]*ctypes.SignedUnjail,
+	) (transition.ValidatorUpdates, error)
 	GetSignatureVerifierFn(*statedb.StateDB) (
 		func(
 			blk *ctypes.BeaconBlock,
@@ -116,6 +151,12 @@ type StorageBackend interface {
 	BlockStore() *block.KVStore[*ctypes.BeaconBlock]
 }
 
+// EventPublisher publishes chain events.
+type EventPublisher interface {
+	// Publish sends the event to its subscribers without blocking.
+	Publish(event events.Event)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments the counter identified by
@@ -125,6 +166,10 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+
+	// AddSample adds a sample to the histogram identified by the provided
+	// key.
+	AddSample(key string, value float64, args ...string)
 }
 
 //nolint:revive // its ok
@@ -139,6 +184,9 @@ type BlockchainI interface {
 		sdk.Context,
 		*cmtabci.FinalizeBlockRequest,
 	) (transition.ValidatorUpdates, error)
+	// RollbackSlot discards the off-state side effects of a slot that was
+	// finalized but never committed.
+	RollbackSlot(context.Context, math.Slot) error
 }
 
 // BlobProcessor is the interface for the blobs processor.
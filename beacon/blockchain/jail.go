@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// unjailTxPrefix prefixes the consensus transactions carrying a signed
+// unjail, which proposal mutators inject after the beacon block, blob
+// sidecars and vote extensions.
+var unjailTxPrefix = []byte("unjail")
+
+// EncodeUnjailTx encodes the signed unjail into a consensus transaction.
+func EncodeUnjailTx(unjail *ctypes.SignedUnjail) ([]byte, error) {
+	bz, err := unjail.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(bytes.Clone(unjailTxPrefix), bz...), nil
+}
+
+// DecodeUnjailTx decodes the signed unjail carried by the consensus
+// transaction, returning ErrNotUnjailTx if it does not carry one.
+func DecodeUnjailTx(tx []byte) (*ctypes.SignedUnjail, error) {
+	bz, found := bytes.CutPrefix(tx, unjailTxPrefix)
+	if !found {
+		return nil, ErrNotUnjailTx
+	}
+	unjail := ctypes.NewEmptySignedUnjail()
+	if err := unjail.UnmarshalSSZ(bz); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotUnjailTx, err)
+	}
+	return unjail, nil
+}
+
+// processJailing jails the validators which missed too many blocks, as told
+// by the votes of the previous block commit, and releases the validators
+// whose unjail transactions are included in the block.
+func (s *Service) processJailing(
+	st *statedb.StateDB, req *cmtabci.FinalizeBlockRequest,
+) (transition.ValidatorUpdates, error) {
+	var unjails []*ctypes.SignedUnjail
+	for _, tx := range req.Txs[min(len(req.Txs), MaxConsensusTxsCount):] {
+		unjail, err := DecodeUnjailTx(tx)
+		if err != nil {
+			continue
+		}
+		unjails = append(unjails, unjail)
+	}
+
+	votes := make([]core.CommitVote, len(req.DecidedLastCommit.Votes))
+	for i, vote := range req.DecidedLastCommit.Votes {
+		votes[i] = core.CommitVote{
+			ValidatorAddress: vote.Validator.Address,
+			Absent:           cmttypes.BlockIDFlag(vote.BlockIdFlag) == cmttypes.BlockIDFlagAbsent,
+		}
+	}
+	return s.stateProcessor.ProcessJailing(st, votes, unjails)
+}
@@ -23,6 +23,7 @@ package blockchain
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -30,6 +31,8 @@ import (
 type chainMetrics struct {
 	// sink is the sink for the metrics.
 	sink TelemetrySink
+	// ssz records the duration and size of decoded blocks.
+	ssz *ssz.Metrics
 }
 
 // newChainMetrics creates a new chainMetrics.
@@ -38,6 +41,7 @@ func newChainMetrics(
 ) *chainMetrics {
 	return &chainMetrics{
 		sink: sink,
+		ssz:  ssz.NewMetrics(sink),
 	}
 }
 
@@ -52,6 +56,13 @@ func (cm *chainMetrics) measureStateTransitionDuration(
 	)
 }
 
+// markReorg increments the counter for the number of reorgs and records
+// their depth.
+func (cm *chainMetrics) markReorg(depth uint64) {
+	cm.sink.IncrementCounter("beacon_kit.blockchain.reorg")
+	cm.sink.AddSample("beacon_kit.blockchain.reorg_depth", float64(depth))
+}
+
 // markRebuildPayloadForRejectedBlockSuccess increments the counter for the
 // number of times
 // the validator successfully rebuilt the payload for a rejected block.
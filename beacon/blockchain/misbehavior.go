@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// DuplicateVoteMisbehaviors returns the duplicate vote evidence among the
+// misbehaviors reported by CometBFT along with a block. Light client attacks
+// are not punished, since the attacking validators cannot be told apart from
+// the ones merely being part of a forked validator set.
+func DuplicateVoteMisbehaviors(misbehaviors []cmtabci.Misbehavior) []transition.Misbehavior {
+	var res []transition.Misbehavior
+	for _, m := range misbehaviors {
+		if m.Type != cmtabci.MISBEHAVIOR_TYPE_DUPLICATE_VOTE {
+			continue
+		}
+		res = append(res, transition.Misbehavior{
+			ValidatorAddress: m.Validator.Address,
+			Height:           m.Height,
+		})
+	}
+	return res
+}
@@ -57,13 +57,13 @@ func (s *Service) forceSyncUponProcess(
 	)
 
 	// Submit the forkchoice update to the execution client.
-	req := ctypes.BuildForkchoiceUpdateRequestNoAttrs(
+	req := s.attributesFactory.HeadForkchoiceUpdate(
 		&engineprimitives.ForkchoiceStateV1{
 			HeadBlockHash:      lph.GetBlockHash(),
 			SafeBlockHash:      lph.GetParentHash(),
 			FinalizedBlockHash: lph.GetParentHash(),
 		},
-		s.chainSpec.ActiveForkVersionForTimestamp(lph.GetTimestamp()),
+		lph.GetTimestamp(),
 	)
 	if _, err = s.executionEngine.NotifyForkchoiceUpdate(ctx, req); err != nil {
 		s.logger.Error(
@@ -81,7 +81,7 @@ func (s *Service) forceSyncUponFinalize(
 	beaconBlock *ctypes.BeaconBlock,
 ) error {
 	// NewPayload call first to load payload into EL client.
-	executionPayload := beaconBlock.GetBody().GetExecutionPayload()
+	executionPayload := beaconBlock.GetBody().GetImmutableExecutionPayload()
 	payloadReq, err := ctypes.BuildNewPayloadRequestFromFork(beaconBlock)
 	if err != nil {
 		return err
@@ -91,8 +91,8 @@ func (s *Service) forceSyncUponFinalize(
 		return err
 	}
 
-	// We set retryOnSyncingStatus to false here. We can ignore SYNCING status and proceed
-	// to the FCU.
+	// We set retryOnSyncingStatus to false here. On SYNCING status the payload is imported
+	// optimistically and we proceed to the FCU.
 	err = s.executionEngine.NotifyNewPayload(ctx, payloadReq, false)
 	if err != nil {
 		return fmt.Errorf("startSyncUponFinalize NotifyNewPayload failed: %w", err)
@@ -100,22 +100,20 @@ func (s *Service) forceSyncUponFinalize(
 
 	// Submit the forkchoice update to the EL client. This will ensure that it is either synced or
 	// starts up a sync.
-	req := ctypes.BuildForkchoiceUpdateRequestNoAttrs(
+	req := s.attributesFactory.HeadForkchoiceUpdate(
 		&engineprimitives.ForkchoiceStateV1{
 			HeadBlockHash:      executionPayload.GetBlockHash(),
 			SafeBlockHash:      executionPayload.GetParentHash(),
 			FinalizedBlockHash: executionPayload.GetParentHash(),
 		},
-		s.chainSpec.ActiveForkVersionForTimestamp(executionPayload.GetTimestamp()),
+		executionPayload.GetTimestamp(),
 	)
 
 	switch _, err = s.executionEngine.NotifyForkchoiceUpdate(ctx, req); {
 	case err == nil:
 		return nil
 
-	case errors.IsAny(err,
-		engineerrors.ErrSyncingPayloadStatus,
-		engineerrors.ErrAcceptedPayloadStatus):
+	case errors.Is(err, engineerrors.ErrSyncingEL):
 		s.logger.Warn(
 			//nolint:lll // long message on one line for readability.
 			`Your execution client is syncing. It should be downloading eth blocks from its peers. Restart the beacon node once the execution client is caught up.`,
@@ -184,6 +182,7 @@ func (s *Service) preFetchBuildData(st *statedb.StateDB, currentTime math.U64) (
 		PayloadWithdrawals: payloadWithdrawals,
 		PrevRandao:         prevRandao,
 		ParentBlockRoot:    latestHeader.HashTreeRoot(),
+		ParentTimestamp:    lph.GetTimestamp(),
 
 		// We set the head of our chain to the latest verified block (whether it is final or not)
 		HeadEth1BlockHash: lph.GetBlockHash(),
@@ -31,14 +31,17 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	bcmocks "github.com/berachain/beacon-kit/beacon/blockchain/mocks"
+	"github.com/berachain/beacon-kit/beacon/events"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config/spec"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/engine"
 	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
 	bemocks "github.com/berachain/beacon-kit/node-api/backend/mocks"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/payload/attributes"
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
@@ -136,6 +139,7 @@ func TestOptimisticBlockBuildingRejectedBlockStateChecks(t *testing.T) {
 		invalidBlk,
 		consensusTime,
 		proposerAddress,
+		nil,
 	)
 	require.ErrorIs(t, err, core.ErrProposerMismatch)
 
@@ -161,55 +165,15 @@ func TestOptimisticBlockBuildingVerifiedBlockStateChecks(t *testing.T) {
 	sb.EXPECT().DepositStore().RunAndReturn(func() deposit.StoreManager { return depStore })
 	b.EXPECT().Enabled().Return(optimisticPayloadBuilds)
 
-	// Before processing any block it is mandatory to handle genesis
-	genesisData := testProcessGenesis(t, cs, chain, ctx)
-
-	// write genesis changes to make them available for next block
-	//nolint:errcheck // false positive as this has no return value
-	ctx.ConsensusCtx().(sdk.Context).MultiStore().(storetypes.CacheMultiStore).Write()
-
-	// Finally create a block that will be rejected and
-	// verify the state on top of which is next payload built
-	var (
-		consensusTime = math.U64(time.Now().Unix())
-		proposer      = ctx.ProposerAddress()
-	)
+	// The local validator proposes the height after the verified block.
+	chain.AttachProposerSchedule(stubProposerSchedule{testOtherAddress, testLocalAddress})
 
 	// Since this is the first block called post genesis
 	// forceSyncUponProcess will be called.
 	dummyPayloadID := &engineprimitives.PayloadID{1, 2, 3}
 	eng.EXPECT().NotifyForkchoiceUpdate(mock.Anything, mock.Anything).Return(dummyPayloadID, nil)
 
-	// BUILD A VALID BLOCK (without polluting state st)
-	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
-	buildState := state.NewBeaconStateFromDB(
-		st.KVStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
-	)
-
-	nextBlkTimestamp := math.U64(cs.GenesisTime() + 1)
-	_, err = sp.ProcessSlots(buildState, constants.GenesisSlot+1)
-	require.NoError(t, err)
-
-	depositsRoot := ctypes.Deposits(genesisData.Deposits).HashTreeRoot()
-
-	validBlk := buildNextBlock(
-		t,
-		cs,
-		buildState,
-		ctypes.NewEth1Data(depositsRoot),
-		nextBlkTimestamp,
-	)
-	stateRoot, err := computeStateRoot( // fix state root in block
-		ctx.ConsensusCtx(),
-		proposer,
-		consensusTime,
-		sp,
-		buildState,
-		validBlk,
-	)
-	require.NoError(t, err)
-	validBlk.SetStateRoot(stateRoot)
-	// end of BUILD A VALID BLOCK
+	genesisData, validBlk, consensusTime := buildValidBlockOnGenesis(t, cs, chain, st, cms, ctx, sp)
 
 	// register async call to block building
 	var wg sync.WaitGroup // useful to make test wait on async checks
@@ -245,6 +209,7 @@ func TestOptimisticBlockBuildingVerifiedBlockStateChecks(t *testing.T) {
 		validBlk,
 		consensusTime,
 		ctx.ProposerAddress(),
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -257,6 +222,111 @@ func TestOptimisticBlockBuildingVerifiedBlockStateChecks(t *testing.T) {
 	require.Equal(t, validBlk.GetSlot(), slot)
 }
 
+// When the local validator is not the next proposer, the payload built
+// optimistically would never be used, so it must not be requested.
+func TestOptimisticBlockBuildingSkippedForOtherProposer(t *testing.T) {
+	t.Parallel()
+
+	optimisticPayloadBuilds := true
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	chain, st, cms, ctx, sp, b, sb, eng, depStore := setupOptimisticPayloadTests(t, cs, optimisticPayloadBuilds)
+	sb.EXPECT().StateFromContext(mock.Anything).Return(st).Times(2)
+	sb.EXPECT().DepositStore().RunAndReturn(func() deposit.StoreManager { return depStore })
+	b.EXPECT().Enabled().Return(optimisticPayloadBuilds)
+
+	// Another validator proposes the height after the verified block. The
+	// builder mock fails the test if RequestPayloadAsync is called.
+	chain.AttachProposerSchedule(stubProposerSchedule{testLocalAddress, testOtherAddress})
+
+	dummyPayloadID := &engineprimitives.PayloadID{1, 2, 3}
+	eng.EXPECT().NotifyForkchoiceUpdate(mock.Anything, mock.Anything).Return(dummyPayloadID, nil)
+	eng.EXPECT().NotifyNewPayload(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, validBlk, consensusTime := buildValidBlockOnGenesis(t, cs, chain, st, cms, ctx, sp)
+	err = chain.VerifyIncomingBlock(
+		ctx.ConsensusCtx(),
+		validBlk,
+		consensusTime,
+		ctx.ProposerAddress(),
+		nil,
+	)
+	require.NoError(t, err)
+}
+
+var (
+	testLocalAddress = []byte("local validator")
+	testOtherAddress = []byte("other validator")
+)
+
+// stubProposerSchedule predicts the given proposers.
+type stubProposerSchedule [][]byte
+
+func (s stubProposerSchedule) ExpectedProposerAddresses(count int) ([][]byte, error) {
+	return s[:min(count, len(s))], nil
+}
+
+// buildValidBlockOnGenesis processes the genesis and builds a valid block on
+// top of it, without changing st. It returns the genesis, the block and its
+// consensus time.
+func buildValidBlockOnGenesis(
+	t *testing.T,
+	cs chain.Spec,
+	chain *blockchain.Service,
+	st *statetransition.TestBeaconStateT,
+	cms storetypes.CommitMultiStore,
+	ctx core.ReadOnlyContext,
+	sp *statetransition.TestStateProcessorT,
+) (*ctypes.Genesis, *ctypes.BeaconBlock, math.U64) {
+	t.Helper()
+
+	// Before processing any block it is mandatory to handle genesis
+	genesisData := testProcessGenesis(t, cs, chain, ctx)
+
+	// write genesis changes to make them available for next block
+	//nolint:errcheck // false positive as this has no return value
+	ctx.ConsensusCtx().(sdk.Context).MultiStore().(storetypes.CacheMultiStore).Write()
+
+	var (
+		consensusTime = math.U64(time.Now().Unix())
+		proposer      = ctx.ProposerAddress()
+	)
+
+	// BUILD A VALID BLOCK (without polluting state st)
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	buildState := state.NewBeaconStateFromDB(
+		st.KVStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	nextBlkTimestamp := math.U64(cs.GenesisTime() + 1)
+	_, err := sp.ProcessSlots(buildState, constants.GenesisSlot+1)
+	require.NoError(t, err)
+
+	depositsRoot := ctypes.Deposits(genesisData.Deposits).HashTreeRoot()
+
+	validBlk := buildNextBlock(
+		t,
+		cs,
+		buildState,
+		ctypes.NewEth1Data(depositsRoot),
+		nextBlkTimestamp,
+	)
+	stateRoot, err := computeStateRoot( // fix state root in block
+		ctx.ConsensusCtx(),
+		proposer,
+		consensusTime,
+		sp,
+		buildState,
+		validBlk,
+	)
+	require.NoError(t, err)
+	validBlk.SetStateRoot(stateRoot)
+	// end of BUILD A VALID BLOCK
+
+	return genesisData, validBlk, consensusTime
+}
+
 func setupOptimisticPayloadTests(t *testing.T, cs chain.Spec, optimisticPayloadBuilds bool) (
 	*blockchain.Service,
 	*statetransition.TestBeaconStateT,
@@ -283,10 +353,15 @@ func setupOptimisticPayloadTests(t *testing.T, cs chain.Spec, optimisticPayloadB
 		logger,
 		cs,
 		eng,
+		engine.NewOptimisticTracker(),
 		b,
+		attributes.NewAttributesFactory(cs, logger, common.ExecutionAddress{}, ts),
 		sp,
 		ts,
 		optimisticPayloadBuilds,
+		testLocalAddress,
+		cs.MinEpochsForBlobsSidecarsRequest().Unwrap()*cs.SlotsPerEpoch(),
+		events.NewBus(),
 	)
 	return chain, st, cms, ctx, sp, b, sb, eng, depStore
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/consensus/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -51,9 +52,14 @@ const (
 	// BlobSidecarsTxIndex represents the index of the blob sidecar transaction.
 	// It follows the beacon block transaction in the tx list.
 	BlobSidecarsTxIndex
-
-	// A Consensus block has at most two transactions (block and blob).
-	MaxConsensusTxsCount = 2
+	// VoteExtensionsTxIndex represents the index of the optional transaction
+	// carrying the vote extensions of the last commit. It follows the blob
+	// sidecar transaction in the tx list.
+	VoteExtensionsTxIndex
+
+	// A Consensus block has at most three transactions (block, blob and
+	// vote extensions).
+	MaxConsensusTxsCount = 3
 )
 
 //nolint:funlen // not an issue
@@ -75,6 +81,7 @@ func (s *Service) ProcessProposal(
 		BeaconBlockTxIndex,
 		BlobSidecarsTxIndex,
 		forkVersion,
+		s.metrics.ssz,
 	)
 	if err != nil {
 		return err
@@ -156,7 +163,10 @@ func (s *Service) ProcessProposal(
 		// VerifyIncomingBlock).
 		err = s.VerifyIncomingBlobSidecars(ctx, sidecars, blk.GetHeader(), blobKzgCommitments)
 		if err != nil {
-			s.logger.Error("failed to verify incoming blob sidecars", "error", err)
+			s.logger.Error(
+				"failed to verify incoming blob sidecars",
+				"trace_id", tracing.TraceID(ctx), "error", err,
+			)
 			return err
 		}
 	}
@@ -167,14 +177,19 @@ func (s *Service) ProcessProposal(
 		req.GetProposerAddress(),
 		req.GetTime(),
 	)
+	consensusBlk.SetMisbehaviors(DuplicateVoteMisbehaviors(req.GetMisbehavior()))
 	err = s.VerifyIncomingBlock(
 		ctx,
 		consensusBlk.GetBeaconBlock(),
 		consensusBlk.GetConsensusTime(),
 		consensusBlk.GetProposerAddress(),
+		consensusBlk.GetMisbehaviors(),
 	)
 	if err != nil {
-		s.logger.Error("failed to verify incoming block", "error", err)
+		s.logger.Error(
+			"failed to verify incoming block",
+			"trace_id", tracing.TraceID(ctx), "error", err,
+		)
 		return err
 	}
 
@@ -234,6 +249,7 @@ func (s *Service) VerifyIncomingBlock(
 	beaconBlk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
+	misbehaviors []transition.Misbehavior,
 ) error {
 	state := s.storageBackend.StateFromContext(ctx)
 
@@ -250,6 +266,7 @@ func (s *Service) VerifyIncomingBlock(
 		"Received incoming beacon block",
 		"state_root", beaconBlk.GetStateRoot(),
 		"slot", beaconBlk.GetSlot(),
+		"trace_id", tracing.TraceID(ctx),
 	)
 
 	// verify block slot
@@ -280,7 +297,7 @@ func (s *Service) VerifyIncomingBlock(
 
 	if s.shouldBuildOptimisticPayloads() {
 		// state copy makes sure that preFetchBuildData does not affect state
-		copiedState := state.Copy(ctx)
+		copiedState := state.Copy()
 		nextBlockData, errFetch = s.preFetchBuildData(copiedState, consensusTime)
 		if errFetch != nil {
 			// We don't return with err if pre-fetch fails. Instead we log the issue
@@ -300,7 +317,9 @@ func (s *Service) VerifyIncomingBlock(
 		state,
 		beaconBlk,
 		consensusTime,
-		proposerAddress)
+		proposerAddress,
+		misbehaviors,
+	)
 	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
@@ -324,9 +343,9 @@ func (s *Service) VerifyIncomingBlock(
 		"state_root", beaconBlk.GetStateRoot(),
 	)
 
-	if s.shouldBuildOptimisticPayloads() {
+	if s.shouldBuildOptimisticPayloads() && s.isNextProposer() {
 		// state copy makes sure that preFetchBuildDataForSuccess does not affect state
-		copiedState := state.Copy(ctx)
+		copiedState := state.Copy()
 		nextBlockData, errFetch = s.preFetchBuildData(copiedState, consensusTime)
 		if errFetch != nil {
 			// We don't mark the block as rejected if it is valid but pre-fetch fails.
@@ -352,21 +371,29 @@ func (s *Service) verifyStateRoot(
 	blk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
+	misbehaviors []transition.Misbehavior,
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
 
+	ctx, span := tracing.StartSpan(ctx, "VerifyStateRoot")
+	defer span.End()
+
 	txCtx := transition.NewTransitionCtx(
 		ctx,
 		consensusTime,
 		proposerAddress,
 	).
+		WithMisbehaviors(misbehaviors).
 		WithVerifyPayload(true).
 		WithVerifyRandao(true).
 		WithVerifyResult(true).
 		WithMeterGas(false)
 
 	_, err := s.stateProcessor.Transition(txCtx, st, blk)
+	if err != nil {
+		span.RecordError(err)
+	}
 	return err
 }
 
@@ -375,3 +402,25 @@ func (s *Service) verifyStateRoot(
 func (s *Service) shouldBuildOptimisticPayloads() bool {
 	return s.optimisticPayloadBuilds && s.localBuilder.Enabled()
 }
+
+// isNextProposer returns false if the local validator is not expected to
+// propose the height after the one being decided, in which case the payload
+// built optimistically for it would never be used. If the next proposer
+// cannot be predicted, the local validator is assumed to be it.
+//
+// Rebuilds for rejected blocks are not gated: the next round of the same
+// height is proposed by a validator the schedule does not predict.
+func (s *Service) isNextProposer() bool {
+	if s.proposers == nil || len(s.localAddress) == 0 {
+		return true
+	}
+	proposers, err := s.proposers.ExpectedProposerAddresses(2)
+	if err != nil || len(proposers) < 2 {
+		s.logger.Warn(
+			"Failed predicting the next proposer, building optimistically",
+			"err", err,
+		)
+		return true
+	}
+	return bytes.Equal(proposers[1], s.localAddress)
+}
@@ -28,7 +28,7 @@ import (
 
 func (s *Service) processPruning(ctx context.Context, beaconBlk *ctypes.BeaconBlock) error {
 	// prune availability store
-	start, end := availabilityPruneRangeFn(beaconBlk.GetSlot().Unwrap(), s.chainSpec)
+	start, end := availabilityPruneRangeFn(beaconBlk.GetSlot().Unwrap(), s.blobRetentionSlots)
 	err := s.storageBackend.AvailabilityStore().Prune(start, end)
 	if err != nil {
 		return err
@@ -52,8 +52,7 @@ func depositPruneRangeFn([]*ctypes.Deposit, PruningChainSpec) (uint64, uint64) {
 }
 
 //nolint:unparam // this is ok
-func availabilityPruneRangeFn(slot uint64, cs PruningChainSpec) (uint64, uint64) {
-	window := cs.MinEpochsForBlobsSidecarsRequest().Unwrap() * cs.SlotsPerEpoch()
+func availabilityPruneRangeFn(slot uint64, window uint64) (uint64, uint64) {
 	if slot < window {
 		return 0, 0
 	}
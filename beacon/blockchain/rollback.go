@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// RollbackSlot discards the side effects FinalizeBlock left in stores outside
+// of the committed state for a slot whose commit never completed, so that the
+// slot can be replayed from scratch.
+//
+// Only blob sidecars need removing. The other stores written around a slot
+// may still hold data of the uncommitted slot, which replay tolerates:
+//   - deposits are keyed by their index and are overwritten on replay;
+//   - the block store is rebuilt in memory;
+//   - the proposals log may keep the record of a block this node proposed
+//     for the slot, as it does for proposals of failed rounds, and a
+//     proposal built again for the slot replaces it;
+//   - the performance tracker only records blocks once CometBFT publishes
+//     them as committed, so it never sees the uncommitted slot;
+//   - the execution client may already have the payload of the slot as its
+//     head, and CometBFT replays the same decided block, so the payload and
+//     forkchoice sent on replay are the ones it already has.
+func (s *Service) RollbackSlot(_ context.Context, slot math.Slot) error {
+	if err := s.storageBackend.AvailabilityStore().DeleteSlot(slot); err != nil {
+		return err
+	}
+	s.logger.Warn("Rolled back uncommitted slot", "slot", slot.Base10())
+	return nil
+}
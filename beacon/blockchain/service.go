@@ -40,6 +40,8 @@ type Service struct {
 	depositContract deposit.Contract
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
 	eth1FollowDistance math.U64
+	// blobRetentionSlots is the number of slots blob sidecars are retained for.
+	blobRetentionSlots uint64
 	// failedBlocksMu protects failedBlocks for concurrent access.
 	failedBlocksMu sync.RWMutex
 	// failedBlocks is a map of blocks that failed to be processed
@@ -53,8 +55,14 @@ type Service struct {
 	//
 	// execution payloads.
 	executionEngine ExecutionEngine
+	// optimistic tracks the payloads imported while the execution client
+	// is syncing.
+	optimistic OptimisticTracker
 	// localBuilder is a local builder for constructing new beacon states.
 	localBuilder LocalBuilder
+	// attributesFactory produces the head-only forkchoice updates, which do
+	// not trigger payload builds on the execution client.
+	attributesFactory AttributesFactory
 	// stateProcessor is the state processor for beacon blocks and states.
 	stateProcessor StateProcessor
 	// metrics is the metrics for the service.
@@ -62,8 +70,16 @@ type Service struct {
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
+	// localAddress is the CometBFT address of the local validator.
+	localAddress []byte
+	// proposers predicts the next proposers, nil until attached.
+	proposers ProposerSchedule
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// eventBus is where head and reorg events are published.
+	eventBus EventPublisher
+	// head is the last finalized block, nil until the first one after start.
+	head *headInfo
 }
 
 // NewService creates a new validator service.
@@ -74,10 +90,15 @@ func NewService(
 	logger log.Logger,
 	chainSpec ServiceChainSpec,
 	executionEngine ExecutionEngine,
+	optimistic OptimisticTracker,
 	localBuilder LocalBuilder,
+	attributesFactory AttributesFactory,
 	stateProcessor StateProcessor,
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
+	localAddress []byte,
+	blobRetentionSlots uint64,
+	eventBus EventPublisher,
 ) *Service {
 	return &Service{
 		storageBackend:          storageBackend,
@@ -88,14 +109,25 @@ func NewService(
 		logger:                  logger,
 		chainSpec:               chainSpec,
 		executionEngine:         executionEngine,
+		optimistic:              optimistic,
 		localBuilder:            localBuilder,
+		attributesFactory:       attributesFactory,
 		stateProcessor:          stateProcessor,
 		metrics:                 newChainMetrics(telemetrySink),
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
+		localAddress:            localAddress,
 		forceStartupSyncOnce:    new(sync.Once),
+		blobRetentionSlots:      blobRetentionSlots,
+		eventBus:                eventBus,
 	}
 }
 
+// AttachProposerSchedule sets the schedule used to skip optimistic payload
+// builds when the local validator is not the next proposer.
+func (s *Service) AttachProposerSchedule(proposers ProposerSchedule) {
+	s.proposers = proposers
+}
+
 // Name returns the name of the service.
 func (s *Service) Name() string {
 	return "blockchain"
@@ -125,3 +157,8 @@ func (s *Service) Stop() error {
 func (s *Service) StorageBackend() StorageBackend {
 	return s.storageBackend
 }
+
+// StateProcessor returns the state processor.
+func (s *Service) StateProcessor() StateProcessor {
+	return s.stateProcessor
+}
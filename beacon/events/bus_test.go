@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus(t *testing.T) {
+	t.Parallel()
+	bus := events.NewBus()
+	heads, cancelHeads := bus.Subscribe(events.TopicHead)
+	all, cancelAll := bus.Subscribe(events.TopicHead, events.TopicChainReorg)
+
+	bus.Publish(events.Event{Topic: events.TopicChainReorg, Data: &events.ChainReorg{Depth: 1}})
+	bus.Publish(events.Event{Topic: events.TopicHead, Data: &events.Head{Slot: 2}})
+
+	// Subscribers only receive the events of their topics, in order.
+	require.Equal(t, events.TopicHead, (<-heads).Topic)
+	require.Equal(t, events.TopicChainReorg, (<-all).Topic)
+	require.Equal(t, events.TopicHead, (<-all).Topic)
+
+	// Cancelling closes the channel and stops delivery.
+	cancelHeads()
+	cancelHeads()
+	_, ok := <-heads
+	require.False(t, ok)
+	bus.Publish(events.Event{Topic: events.TopicHead})
+	require.Len(t, all, 1)
+
+	// Publishing never blocks on a subscriber that fell behind.
+	for range 2 * cap(all) {
+		bus.Publish(events.Event{Topic: events.TopicHead})
+	}
+	require.Len(t, all, cap(all))
+	cancelAll()
+}
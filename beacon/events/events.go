@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package events is an in-process bus carrying chain events, such as head
+// updates and reorgs, from the blockchain service to their consumers.
+package events
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// TopicHead is the topic of Head events.
+	TopicHead = "head"
+	// TopicChainReorg is the topic of ChainReorg events.
+	TopicChainReorg = "chain_reorg"
+)
+
+// Event is an event published on the bus.
+type Event struct {
+	Topic string
+	Data  any
+}
+
+// Head is published whenever a block becomes the new head of the chain.
+type Head struct {
+	Slot            math.Slot
+	Block           common.Root
+	State           common.Root
+	EpochTransition bool
+	// ExecutionOptimistic is true if the execution payload of the block was
+	// imported optimistically, while the execution client was syncing.
+	ExecutionOptimistic bool
+}
+
+// ChainReorg is published when the new head does not descend from the
+// previous head. Depth is the number of slots between the previous head and
+// the last block the two heads have in common.
+type ChainReorg struct {
+	Slot         math.Slot
+	Depth        uint64
+	OldHeadBlock common.Root
+	NewHeadBlock common.Root
+	OldHeadState common.Root
+	NewHeadState common.Root
+	Epoch        math.Epoch
+	// ExecutionOptimistic is true if the execution payload of the new head
+	// was imported optimistically.
+	ExecutionOptimistic bool
+}
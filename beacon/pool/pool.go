@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package pool holds signed operations submitted through the node API until
+// they can be included in a block.
+package pool
+
+import (
+	"encoding/binary"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// keyLength is the length of the keys of persisted operations, i.e. the big
+// endian encoded validator index.
+const keyLength = 8
+
+var (
+	// ErrPoolFull is returned when inserting into a pool that reached its limit.
+	ErrPoolFull = errors.New("operation pool is full")
+
+	// ErrCorruptedEntry is returned when a persisted operation cannot be loaded.
+	ErrCorruptedEntry = errors.New("corrupted operation pool entry")
+)
+
+// Pool is a bounded, thread-safe set of signed operations keyed by the index of
+// the validator they apply to. Every supported operation (BLS to execution
+// changes, voluntary exits) may be applied at most once per validator, so the
+// first operation seen for a validator is the one retained.
+type Pool[T any] struct {
+	mu    sync.RWMutex
+	items map[math.ValidatorIndex]T
+	limit int
+
+	// db persists the pooled operations across restarts, if set.
+	db    dbm.DB
+	codec Codec[T]
+}
+
+// Codec encodes pooled operations for persistence.
+type Codec[T any] struct {
+	Encode func(T) ([]byte, error)
+	Decode func([]byte) (T, error)
+}
+
+// New creates a new in-memory pool holding at most limit operations.
+func New[T any](limit int) *Pool[T] {
+	return &Pool[T]{
+		items: make(map[math.ValidatorIndex]T),
+		limit: limit,
+	}
+}
+
+// NewPersistent creates a new pool holding at most limit operations, which are
+// also written to db. The operations persisted by a previous run are loaded.
+func NewPersistent[T any](limit int, db dbm.DB, codec Codec[T]) (*Pool[T], error) {
+	p := New[T](limit)
+	p.db = db
+	p.codec = codec
+
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if len(it.Key()) != keyLength {
+			return nil, errors.Wrapf(ErrCorruptedEntry, "key %x", it.Key())
+		}
+		var op T
+		op, err = codec.Decode(it.Value())
+		if err != nil {
+			return nil, errors.Join(ErrCorruptedEntry, err)
+		}
+		p.items[math.ValidatorIndex(binary.BigEndian.Uint64(it.Key()))] = op
+	}
+	return p, it.Error()
+}
+
+// Insert adds the operation for the given validator. It returns false without
+// error if an operation for the validator is already pooled.
+func (p *Pool[T]) Insert(idx math.ValidatorIndex, op T) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.items[idx]; ok {
+		return false, nil
+	}
+	if len(p.items) >= p.limit {
+		return false, ErrPoolFull
+	}
+	if p.db != nil {
+		bz, err := p.codec.Encode(op)
+		if err != nil {
+			return false, err
+		}
+		if err = p.db.Set(key(idx), bz); err != nil {
+			return false, err
+		}
+	}
+	p.items[idx] = op
+	return true, nil
+}
+
+// Has returns whether an operation for the given validator is pooled.
+func (p *Pool[T]) Has(idx math.ValidatorIndex) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.items[idx]
+	return ok
+}
+
+// Remove drops the operation of the given validator, if any.
+func (p *Pool[T]) Remove(idx math.ValidatorIndex) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.remove(idx)
+}
+
+// Prune drops the operations for which keep returns false, e.g. because they
+// were applied to the state in the meantime.
+func (p *Pool[T]) Prune(keep func(idx math.ValidatorIndex, op T) bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for idx, op := range p.items {
+		if keep(idx, op) {
+			continue
+		}
+		if err := p.remove(idx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Pool[T]) remove(idx math.ValidatorIndex) error {
+	if p.db != nil {
+		if err := p.db.Delete(key(idx)); err != nil {
+			return err
+		}
+	}
+	delete(p.items, idx)
+	return nil
+}
+
+// Len returns the number of pooled operations.
+func (p *Pool[T]) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.items)
+}
+
+// All returns the pooled operations ordered by validator index.
+func (p *Pool[T]) All() []T {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	indices := make([]math.ValidatorIndex, 0, len(p.items))
+	for idx := range p.items {
+		indices = append(indices, idx)
+	}
+	slices.Sort(indices)
+	ops := make([]T, 0, len(indices))
+	for _, idx := range indices {
+		ops = append(ops, p.items[idx])
+	}
+	return ops
+}
+
+// key returns the database key of the operation of the given validator.
+func key(idx math.ValidatorIndex) []byte {
+	bz := make([]byte, keyLength)
+	binary.BigEndian.PutUint64(bz, idx.Unwrap())
+	return bz
+}
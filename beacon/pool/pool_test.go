@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/pool"
+	"github.com/berachain/beacon-kit/primitives/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+	p := pool.New[string](2)
+
+	added, err := p.Insert(7, "seven")
+	require.NoError(t, err)
+	require.True(t, added)
+
+	// The first operation seen for a validator is retained.
+	added, err = p.Insert(7, "other")
+	require.NoError(t, err)
+	require.False(t, added)
+
+	added, err = p.Insert(3, "three")
+	require.NoError(t, err)
+	require.True(t, added)
+
+	_, err = p.Insert(9, "nine")
+	require.ErrorIs(t, err, pool.ErrPoolFull)
+
+	require.True(t, p.Has(7))
+	require.Equal(t, []string{"three", "seven"}, p.All())
+
+	require.NoError(t, p.Remove(7))
+	require.False(t, p.Has(7))
+	require.Equal(t, 1, p.Len())
+}
+
+func TestPool_Persistence(t *testing.T) {
+	t.Parallel()
+	db := dbm.NewMemDB()
+	codec := pool.Codec[uint64]{
+		Encode: func(op uint64) ([]byte, error) { return []byte(strconv.FormatUint(op, 10)), nil },
+		Decode: func(bz []byte) (uint64, error) { return strconv.ParseUint(string(bz), 10, 64) },
+	}
+
+	p, err := pool.NewPersistent(10, db, codec)
+	require.NoError(t, err)
+	for _, idx := range []math.ValidatorIndex{1, 2, 3} {
+		_, err = p.Insert(idx, idx.Unwrap()*100)
+		require.NoError(t, err)
+	}
+	require.NoError(t, p.Prune(func(idx math.ValidatorIndex, _ uint64) bool {
+		return idx != 2
+	}))
+
+	// A restarted pool loads the operations left by the previous one.
+	reloaded, err := pool.NewPersistent(10, db, codec)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{100, 300}, reloaded.All())
+}
@@ -29,15 +29,18 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/consensus/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/proposals"
 )
 
 // BuildBlockAndSidecars builds a new beacon block.
@@ -50,6 +53,9 @@ func (s *Service) BuildBlockAndSidecars(
 	startTime := time.Now()
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
 
+	ctx, span := tracing.StartSpan(ctx, "BuildBlockAndSidecars")
+	defer span.End()
+
 	if !s.localPayloadBuilder.Enabled() {
 		// node is not supposed to build blocks
 		return nil, nil, builder.ErrPayloadBuilderDisabled
@@ -64,6 +70,7 @@ func (s *Service) BuildBlockAndSidecars(
 
 	// blkSlot is the height for the next block, which consensus is requesting BeaconKit to build.
 	blkSlot := slotData.GetSlot()
+	s.timings.ProposalRequested(blkSlot)
 
 	// Prepare the state such that it is ready to build a block for the requested slot.
 	if _, err := s.stateProcessor.ProcessSlots(st, blkSlot); err != nil {
@@ -83,6 +90,9 @@ func (s *Service) BuildBlockAndSidecars(
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed retrieving execution payload: %w", err)
 	}
+	if err = s.checkBlobLimit(blkSlot, envelope); err != nil {
+		return nil, nil, err
+	}
 
 	// We introduce hard forks with the expectation that the first block proposed after the
 	// hard fork timestamp is when new rules apply. When building blocks, we provide the Execution
@@ -121,6 +131,7 @@ func (s *Service) BuildBlockAndSidecars(
 		ctx,
 		slotData.GetProposerAddress(),
 		slotData.GetConsensusTime(),
+		slotData.GetMisbehaviors(),
 		st,
 		blk,
 	); err != nil {
@@ -144,9 +155,11 @@ func (s *Service) BuildBlockAndSidecars(
 		"slot", blkSlot.Base10(),
 		"state_root", blk.GetStateRoot(),
 		"duration", time.Since(startTime).String(),
+		"trace_id", tracing.TraceID(ctx),
 	)
 
-	signedBlkBytes, bbErr := signedBlk.MarshalSSZ()
+	s.metrics.ssz.ObserveSize(ssz.TypeExecutionPayload, blk.GetBody().GetExecutionPayload())
+	signedBlkBytes, bbErr := s.metrics.ssz.Marshal(ssz.TypeBeaconBlock, signedBlk)
 	if bbErr != nil {
 		return nil, nil, bbErr
 	}
@@ -155,9 +168,26 @@ func (s *Service) BuildBlockAndSidecars(
 		return nil, nil, scErr
 	}
 
+	s.recordProposal(blkSlot, envelope.GetExecutionPayload())
+	s.timings.BlockBroadcast(blkSlot)
 	return signedBlkBytes, sidecarsBytes, nil
 }
 
+// recordProposal records the fee recipient of the built block. Failing to
+// record it does not fail the proposal.
+func (s *Service) recordProposal(slot math.Slot, payload *ctypes.ExecutionPayload) {
+	if err := s.proposals.Set(proposals.Record{
+		Slot:         slot,
+		FeeRecipient: payload.GetFeeRecipient(),
+		BlockHash:    payload.GetBlockHash(),
+	}); err != nil {
+		s.logger.Error(
+			"Failed to record proposal fee recipient",
+			"slot", slot.Base10(), "error", err,
+		)
+	}
+}
+
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service) getEmptyBeaconBlockForSlot(
 	st *statedb.StateDB, requestedSlot math.Slot,
@@ -218,9 +248,39 @@ func (s *Service) retrieveExecutionPayload(
 	//
 	// Get the payload for the block.
 	slot := slotData.GetSlot()
+	startTime := time.Now()
 	envelope, err := s.localPayloadBuilder.RetrievePayload(ctx, slot, parentBlockRoot)
-	if err == nil {
+	switch {
+	case err == nil && !s.isEarlyEmptyPayload(envelope, startTime):
 		return envelope, nil
+
+	case err == nil:
+		// An empty payload this early is likely the result of an execution
+		// client hiccup. Request a new one closer to the deadline instead.
+		s.logger.Info(
+			"Discarding empty payload, requesting a new one",
+			"slot", slot.Base10(),
+			"retry_window", s.cfg.EmptyPayloadRetryWindow,
+		)
+		s.metrics.discardedEmptyPayload()
+		err = ErrEarlyEmptyPayload
+		select {
+		case <-time.After(time.Until(startTime.Add(s.cfg.EmptyPayloadRetryWindow))):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+	default:
+		// The payload ID is evicted once retrieved, so a proposal retried in
+		// a later round of the same slot lands here. Reuse the payload built
+		// for the first attempt rather than issuing a fresh build, if still
+		// valid.
+		reused, reuseErr := s.reusablePayload(st, parentBlockRoot, slotData)
+		if reuseErr != nil {
+			s.logger.Info("Not reusing earlier payload", "slot", slot.Base10(), "reason", reuseErr)
+		} else if reused != nil {
+			return reused, nil
+		}
 	}
 
 	// If we failed to retrieve the payload, request a synchronous payload.
@@ -279,12 +339,49 @@ func (s *Service) retrieveExecutionPayload(
 		PayloadWithdrawals: payloadWithdrawals,
 		PrevRandao:         prevRandao,
 		ParentBlockRoot:    parentBlockRoot,
+		ParentTimestamp:    lph.GetTimestamp(),
 		HeadEth1BlockHash:  lph.GetBlockHash(),
 		FinalEth1BlockHash: lph.GetParentHash(),
 	}
 	return s.localPayloadBuilder.RequestPayloadSync(ctx, r)
 }
 
+// isEarlyEmptyPayload returns true if the payload has neither transactions
+// nor withdrawals and was retrieved within the empty payload retry window of
+// the given start time.
+func (s *Service) isEarlyEmptyPayload(
+	envelope ctypes.BuiltExecutionPayloadEnv, startTime time.Time,
+) bool {
+	if s.cfg.EmptyPayloadRetryWindow == 0 ||
+		time.Since(startTime) >= s.cfg.EmptyPayloadRetryWindow {
+		return false
+	}
+	payload := envelope.GetExecutionPayload()
+	return len(payload.GetTransactions()) == 0 && len(payload.GetWithdrawals()) == 0
+}
+
+// checkBlobLimit flags payloads carrying more blobs than the locally
+// configured limit. The execution client cannot be asked to build a payload
+// with fewer blobs, and stripping blob transactions would invalidate the
+// payload, so an oversized payload is still proposed rather than leaving the
+// slot to an empty proposal.
+func (s *Service) checkBlobLimit(slot math.Slot, envelope ctypes.BuiltExecutionPayloadEnv) error {
+	blobsBundle := envelope.GetBlobsBundle()
+	if blobsBundle == nil {
+		return ErrNilBlobsBundle
+	}
+	count := len(blobsBundle.GetCommitments())
+	exceeded := s.cfg.MaxBlobsPerBlock > 0 && uint64(count) > s.cfg.MaxBlobsPerBlock
+	s.metrics.observePayloadBlobs(count, exceeded)
+	if exceeded {
+		s.logger.Warn(
+			"Proposing payload over the local blob limit",
+			"slot", slot.Base10(), "blobs", count, "limit", s.cfg.MaxBlobsPerBlock,
+		)
+	}
+	return nil
+}
+
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service) buildBlockBody(
 	ctx context.Context,
@@ -355,6 +452,13 @@ func (s *Service) buildBlockBody(
 	// Set the execution payload on the block body.
 	body.SetExecutionPayload(envelope.GetExecutionPayload())
 
+	// Include the pooled voluntary exits and BLS to execution changes from
+	// Electra1 on.
+	if version.EqualsOrIsAfter(body.GetForkVersion(), version.Electra1()) {
+		body.SetVoluntaryExits(s.pooledVoluntaryExits(st))
+		body.SetBlsToExecutionChanges(s.pooledBLSToExecutionChanges(st))
+	}
+
 	if version.EqualsOrIsAfter(body.GetForkVersion(), version.Electra()) {
 		encodedReqs := envelope.GetEncodedExecutionRequests()
 		result := make([][]byte, len(encodedReqs))
@@ -374,12 +478,59 @@ func (s *Service) buildBlockBody(
 	return nil
 }
 
+// pooledVoluntaryExits returns up to MaxVoluntaryExits pooled exits which are
+// valid against the state the block is built on. Failing to read the pool does
+// not fail the proposal.
+func (s *Service) pooledVoluntaryExits(st *statedb.StateDB) ctypes.VoluntaryExits {
+	pooled, err := s.operations.VoluntaryExits()
+	if err != nil {
+		s.logger.Error("Failed to read pooled voluntary exits", "error", err)
+		return ctypes.VoluntaryExits{}
+	}
+	exits := make(ctypes.VoluntaryExits, 0, min(len(pooled), constants.MaxVoluntaryExits))
+	for _, exit := range pooled {
+		if len(exits) == constants.MaxVoluntaryExits {
+			break
+		}
+		if err = s.stateProcessor.ValidateVoluntaryExit(st, exit); err != nil {
+			s.logger.Warn("Skipping invalid pooled voluntary exit", "error", err)
+			continue
+		}
+		exits = append(exits, exit)
+	}
+	return exits
+}
+
+// pooledBLSToExecutionChanges returns up to MaxBlsToExecutionChanges pooled
+// changes which are valid against the state the block is built on. Failing to
+// read the pool does not fail the proposal.
+func (s *Service) pooledBLSToExecutionChanges(st *statedb.StateDB) ctypes.BlsToExecutionChanges {
+	pooled, err := s.operations.BLSToExecutionChanges()
+	if err != nil {
+		s.logger.Error("Failed to read pooled BLS to execution changes", "error", err)
+		return ctypes.BlsToExecutionChanges{}
+	}
+	changes := make(ctypes.BlsToExecutionChanges, 0, min(len(pooled), constants.MaxBlsToExecutionChanges))
+	for _, change := range pooled {
+		if len(changes) == constants.MaxBlsToExecutionChanges {
+			break
+		}
+		if err = s.stateProcessor.ValidateBLSToExecutionChange(st, change); err != nil {
+			s.logger.Warn("Skipping invalid pooled BLS to execution change", "error", err)
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service) computeAndSetStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	misbehaviors []transition.Misbehavior,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) error {
@@ -387,6 +538,7 @@ func (s *Service) computeAndSetStateRoot(
 		ctx,
 		proposerAddress,
 		consensusTime,
+		misbehaviors,
 		st,
 		blk,
 	)
@@ -407,6 +559,7 @@ func (s *Service) computeStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	misbehaviors []transition.Misbehavior,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) (common.Root, error) {
@@ -420,6 +573,7 @@ func (s *Service) computeStateRoot(
 		consensusTime,
 		proposerAddress,
 	).
+		WithMisbehaviors(misbehaviors).
 		WithVerifyPayload(false).
 		WithVerifyRandao(false).
 		WithVerifyResult(false).
@@ -431,3 +585,50 @@ func (s *Service) computeStateRoot(
 
 	return st.HashTreeRoot(), nil
 }
+
+// reusablePayload returns the payload already built for the slot and parent
+// block root, provided it still extends the latest execution payload and its
+// timestamp is valid for the current consensus time. It returns a nil payload
+// if none was built.
+func (s *Service) reusablePayload(
+	st *statedb.StateDB,
+	parentBlockRoot common.Root,
+	slotData *types.SlotData,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	slot := slotData.GetSlot()
+	envelope, found := s.localPayloadBuilder.RetrieveBuiltPayload(slot, parentBlockRoot)
+	if !found {
+		return nil, nil //nolint:nilnil // nothing to reuse.
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	payload := envelope.GetExecutionPayload()
+	if payload.GetParentHash() != lph.GetBlockHash() {
+		return nil, fmt.Errorf(
+			"%w: parent hash %s, expected %s",
+			ErrStalePayload, payload.GetParentHash(), lph.GetBlockHash(),
+		)
+	}
+	if payload.GetTimestamp() <= lph.GetTimestamp() {
+		return nil, fmt.Errorf(
+			"%w: timestamp %d not after parent timestamp %d",
+			ErrStalePayload, payload.GetTimestamp(), lph.GetTimestamp(),
+		)
+	}
+	if err = payloadtime.Verify(
+		slotData.GetConsensusTime(), lph.GetTimestamp(), payload.GetTimestamp(),
+	); err != nil {
+		return nil, errors.Join(ErrStalePayload, err)
+	}
+
+	s.logger.Info(
+		"Reusing payload built for an earlier proposal attempt",
+		"slot", slot.Base10(),
+		"payload_block_hash", payload.GetBlockHash(),
+	)
+	s.metrics.reusedPayload()
+	return envelope, nil
+}
@@ -0,0 +1,253 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/slottiming"
+	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cryptomocks "github.com/berachain/beacon-kit/primitives/crypto/mocks"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	sszutil "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/proposals"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const blobLimitExceededMetric = "beacon_kit.validator.payload_blob_limit_exceeded"
+
+type stubStorage struct {
+	st *statedb.StateDB
+	ds deposit.StoreManager
+}
+
+func (s stubStorage) DepositStore() deposit.StoreManager { return s.ds }
+
+func (s stubStorage) StateFromContext(context.Context) *statedb.StateDB { return s.st }
+
+// stubProcessor skips the state transition of the built block, which would
+// verify the payload against the execution client.
+type stubProcessor struct {
+	*core.StateProcessor
+}
+
+func (stubProcessor) Transition(
+	core.ReadOnlyContext, *statedb.StateDB, *ctypes.BeaconBlock,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+type stubEnvelope struct {
+	payload *ctypes.ExecutionPayload
+	blobs   *engineprimitives.BlobsBundleV1
+}
+
+func (e stubEnvelope) GetExecutionPayload() *ctypes.ExecutionPayload { return e.payload }
+
+func (stubEnvelope) GetBlockValue() *math.U256 { return math.NewU256(0) }
+
+func (e stubEnvelope) GetBlobsBundle() engineprimitives.BlobsBundle { return e.blobs }
+
+func (stubEnvelope) GetEncodedExecutionRequests() []ctypes.EncodedExecutionRequest { return nil }
+
+func (stubEnvelope) ShouldOverrideBuilder() bool { return false }
+
+type stubPayloadBuilder struct {
+	envelope ctypes.BuiltExecutionPayloadEnv
+}
+
+func (stubPayloadBuilder) Enabled() bool { return true }
+
+func (b stubPayloadBuilder) RetrievePayload(
+	context.Context, math.Slot, common.Root,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	return b.envelope, nil
+}
+
+func (stubPayloadBuilder) RetrieveBuiltPayload(
+	math.Slot, common.Root,
+) (ctypes.BuiltExecutionPayloadEnv, bool) {
+	return nil, false
+}
+
+func (stubPayloadBuilder) RequestPayloadSync(
+	context.Context, *builder.RequestPayloadData,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	return nil, errors.New("unexpected payload request")
+}
+
+type stubBlobFactory struct{}
+
+func (stubBlobFactory) BuildSidecars(
+	*ctypes.SignedBeaconBlock, engineprimitives.BlobsBundle,
+) (datypes.BlobSidecars, error) {
+	return datypes.BlobSidecars{}, nil
+}
+
+type stubProposals struct{}
+
+func (stubProposals) Set(proposals.Record) error { return nil }
+
+type stubOperations struct{}
+
+func (stubOperations) VoluntaryExits() ([]*ctypes.SignedVoluntaryExit, error) { return nil, nil }
+
+func (stubOperations) BLSToExecutionChanges() ([]*ctypes.SignedBLSToExecutionChange, error) {
+	return nil, nil
+}
+
+// countingSink counts the increments of each counter.
+type countingSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *countingSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+}
+
+func (*countingSink) MeasureSince(string, time.Time, ...string) {}
+
+func (*countingSink) AddSample(string, float64, ...string) {}
+
+func (s *countingSink) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key]
+}
+
+// TestBuildBlockAndSidecars_BlobLimit checks that payloads over the local blob
+// limit are still proposed, and counted.
+func TestBuildBlockAndSidecars_BlobLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		limit        uint64
+		blobs        int
+		wantExceeded int
+	}{
+		{name: "under limit", limit: 2, blobs: 2},
+		{name: "no limit", limit: 0, blobs: 3},
+		{name: "over limit", limit: 1, blobs: 3, wantExceeded: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cs, err := spec.DevnetChainSpec()
+			require.NoError(t, err)
+			sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+			proposer := crypto.BLSPubkey{0x01}
+			genDeposits := ctypes.Deposits{{
+				Pubkey:      proposer,
+				Credentials: ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+				Amount:      cs.MaxEffectiveBalance(),
+				Index:       0,
+			}}
+			require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+			_, err = sp.InitializeBeaconStateFromEth1(
+				st, genDeposits,
+				&ctypes.ExecutionPayloadHeader{Versionable: ctypes.NewVersionable(cs.GenesisForkVersion())},
+				cs.GenesisForkVersion(),
+			)
+			require.NoError(t, err)
+
+			signer := &cryptomocks.BLSSigner{}
+			signer.On("PublicKey").Return(proposer)
+			signer.On("Sign", mock.Anything).Return(crypto.BLSSignature{}, nil)
+
+			const timestamp = 10
+			forkVersion := cs.ActiveForkVersionForTimestamp(timestamp)
+			envelope := stubEnvelope{
+				payload: &ctypes.ExecutionPayload{
+					Versionable:   ctypes.NewVersionable(forkVersion),
+					Timestamp:     timestamp,
+					ExtraData:     []byte{},
+					Transactions:  [][]byte{},
+					Withdrawals:   []*engineprimitives.Withdrawal{},
+					BaseFeePerGas: math.NewU256(0),
+				},
+				blobs: &engineprimitives.BlobsBundleV1{
+					Commitments: make([]eip4844.KZGCommitment, tt.blobs),
+					Proofs:      make([]eip4844.KZGProof, tt.blobs),
+				},
+			}
+
+			sink := &countingSink{counters: make(map[string]int)}
+			timings, err := slottiming.NewRecorder(sink, 8)
+			require.NoError(t, err)
+			cfg := validator.DefaultConfig()
+			cfg.MaxBlobsPerBlock = tt.limit
+			svc := validator.NewService(
+				&cfg,
+				noop.NewLogger[any](),
+				cs,
+				stubStorage{st: st, ds: ds},
+				stubProcessor{StateProcessor: sp},
+				signer,
+				stubBlobFactory{},
+				stubPayloadBuilder{envelope: envelope},
+				sink,
+				timings,
+				stubProposals{},
+				stubOperations{},
+			)
+
+			blkBz, _, err := svc.BuildBlockAndSidecars(
+				ctx.ConsensusCtx(),
+				types.NewSlotData(1, nil, nil, statetransition.DummyProposerAddr, time.Unix(timestamp, 0)),
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantExceeded, sink.count(blobLimitExceededMetric))
+
+			signedBlk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(forkVersion)
+			require.NoError(t, err)
+			require.NoError(t, sszutil.Unmarshal(blkBz, signedBlk))
+			require.Len(t, signedBlk.GetBeaconBlock().GetBody().GetBlobKzgCommitments(), tt.blobs)
+		})
+	}
+}
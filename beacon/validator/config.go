@@ -20,6 +20,8 @@
 
 package validator
 
+import "time"
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -27,6 +29,14 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = false
+
+	// defaultMaxBlobsPerBlock is the default local blob limit, where zero
+	// defers to the limit of the chain spec.
+	defaultMaxBlobsPerBlock = 0
+
+	// defaultEmptyPayloadRetryWindow is the default window in which empty
+	// payloads are re-requested, where zero disables re-requesting.
+	defaultEmptyPayloadRetryWindow = 0
 )
 
 // Config is the validator configuration.
@@ -37,6 +47,19 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// MaxBlobsPerBlock is the number of blobs in blocks proposed by this
+	// node above which the proposal is logged and counted, e.g. to watch the
+	// resource pressure on the execution client. The execution client cannot
+	// be asked for fewer blobs, so such payloads are still proposed. Zero
+	// defers to the limit of the chain spec.
+	MaxBlobsPerBlock uint64 `mapstructure:"max-blobs-per-block"`
+
+	// EmptyPayloadRetryWindow is how long after a proposal starts a payload
+	// with neither transactions nor withdrawals is discarded and requested
+	// again once the window has passed, giving the execution client time to
+	// fill its mempool. Zero proposes empty payloads right away.
+	EmptyPayloadRetryWindow time.Duration `mapstructure:"empty-payload-retry-window"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -44,5 +67,7 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		MaxBlobsPerBlock:              defaultMaxBlobsPerBlock,
+		EmptyPayloadRetryWindow:       defaultEmptyPayloadRetryWindow,
 	}
 }
@@ -29,6 +29,14 @@ var (
 	// ErrNilBlobsBundle is an error for when the blobs bundle is nil.
 	ErrNilBlobsBundle = errors.New("nil blobs bundle")
 
+	// ErrStalePayload is an error for when a payload built for an earlier
+	// proposal attempt is no longer valid for the current one.
+	ErrStalePayload = errors.New("previously built payload is stale")
+
+	// ErrEarlyEmptyPayload is an error for when an empty payload was
+	// retrieved within the empty payload retry window.
+	ErrEarlyEmptyPayload = errors.New("empty payload retrieved early in the proposal")
+
 	// ErrDepositStoreIncomplete is an error for when the deposit store has not returned
 	// the expected amount of deposits. Could be due to pruning when it should not be enabled.
 	ErrDepositStoreIncomplete = errors.New("deposits from deposit store incomplete")
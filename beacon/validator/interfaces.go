@@ -35,8 +35,24 @@ import (
 	"github.com/berachain/beacon-kit/state-transition/core"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/proposals"
 )
 
+// ProposalStore records the blocks proposed by this node.
+type ProposalStore interface {
+	// Set records the proposal.
+	Set(r proposals.Record) error
+}
+
+// OperationPool holds the operations submitted through the node API, for this
+// node to include them in the blocks it proposes.
+type OperationPool interface {
+	// VoluntaryExits returns the pooled voluntary exits.
+	VoluntaryExits() ([]*ctypes.SignedVoluntaryExit, error)
+	// BLSToExecutionChanges returns the pooled BLS to execution changes.
+	BLSToExecutionChanges() ([]*ctypes.SignedBLSToExecutionChange, error)
+}
+
 // BlobFactory represents a blob factory interface.
 type BlobFactory interface {
 	// BuildSidecars builds sidecars for a given block and blobs bundle.
@@ -58,6 +74,12 @@ type PayloadBuilder interface {
 		slot math.Slot,
 		parentBlockRoot common.Root,
 	) (ctypes.BuiltExecutionPayloadEnv, error)
+	// RetrieveBuiltPayload returns the payload last built for the given slot
+	// and parent block root, if any.
+	RetrieveBuiltPayload(
+		slot math.Slot,
+		parentBlockRoot common.Root,
+	) (ctypes.BuiltExecutionPayloadEnv, bool)
 	// RequestPayloadSync requests a payload for the given slot and
 	// blocks until the payload is delivered.
 	RequestPayloadSync(
@@ -82,6 +104,11 @@ type StateProcessor interface {
 		st *statedb.StateDB,
 		blk *ctypes.BeaconBlock,
 	) (transition.ValidatorUpdates, error)
+	// ValidateVoluntaryExit checks that the signed exit can be applied to the state.
+	ValidateVoluntaryExit(st *statedb.StateDB, signed *ctypes.SignedVoluntaryExit) error
+	// ValidateBLSToExecutionChange checks that the signed change can be applied
+	// to the state.
+	ValidateBLSToExecutionChange(st *statedb.StateDB, signed *ctypes.SignedBLSToExecutionChange) error
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -100,6 +127,9 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// AddSample adds a sample to the histogram identified by the provided
+	// key.
+	AddSample(key string, value float64, args ...string)
 }
 
 type BlockBuilderI interface {
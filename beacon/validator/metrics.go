@@ -23,6 +23,7 @@ package validator
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -30,6 +31,8 @@ import (
 type validatorMetrics struct {
 	// sink is the sink for the metrics.
 	sink TelemetrySink
+	// ssz records the duration and size of encoded blocks.
+	ssz *ssz.Metrics
 }
 
 // newValidatorMetrics creates a new validatorMetrics.
@@ -38,6 +41,7 @@ func newValidatorMetrics(
 ) *validatorMetrics {
 	return &validatorMetrics{
 		sink: sink,
+		ssz:  ssz.NewMetrics(sink),
 	}
 }
 
@@ -61,6 +65,19 @@ func (cm *validatorMetrics) measureStateRootComputationTime(start time.Time) {
 	)
 }
 
+// observePayloadBlobs records the number of blobs in the payload retrieved
+// for a proposal, and whether it exceeds the local blob limit.
+func (cm *validatorMetrics) observePayloadBlobs(count int, exceeded bool) {
+	cm.sink.AddSample(
+		"beacon_kit.validator.payload_blob_count", float64(count),
+	)
+	if exceeded {
+		cm.sink.IncrementCounter(
+			"beacon_kit.validator.payload_blob_limit_exceeded",
+		)
+	}
+}
+
 // failedToRetrievePayload increments the counter for the number of
 // times the validator failed to retrieve payloads.
 func (cm *validatorMetrics) failedToRetrievePayload(
@@ -74,3 +91,15 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// reusedPayload increments the counter for the number of times a payload
+// built for an earlier proposal attempt was reused.
+func (cm *validatorMetrics) reusedPayload() {
+	cm.sink.IncrementCounter("beacon_kit.validator.reused_payload")
+}
+
+// discardedEmptyPayload increments the counter for the number of times an
+// empty payload was discarded to request a new one.
+func (cm *validatorMetrics) discardedEmptyPayload() {
+	cm.sink.IncrementCounter("beacon_kit.validator.discarded_empty_payload")
+}
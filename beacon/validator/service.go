@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/slottiming"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
@@ -50,6 +51,13 @@ type Service struct {
 	localPayloadBuilder PayloadBuilder
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
+	// timings records the proposer timeline of each slot.
+	timings *slottiming.Recorder
+	// proposals records the fee recipient of every block built, so that
+	// operators can prove which address captured the fees of each block.
+	proposals ProposalStore
+	// operations holds the operations to include in the blocks built.
+	operations OperationPool
 }
 
 // NewService creates a new validator service.
@@ -63,6 +71,9 @@ func NewService(
 	blobFactory BlobFactory,
 	localPayloadBuilder PayloadBuilder,
 	ts TelemetrySink,
+	timings *slottiming.Recorder,
+	proposals ProposalStore,
+	operations OperationPool,
 ) *Service {
 	return &Service{
 		cfg:                 cfg,
@@ -74,6 +85,9 @@ func NewService(
 		blobFactory:         blobFactory,
 		localPayloadBuilder: localPayloadBuilder,
 		metrics:             newValidatorMetrics(ts),
+		timings:             timings,
+		proposals:           proposals,
+		operations:          operations,
 	}
 }
 
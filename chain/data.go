@@ -82,7 +82,10 @@ type SpecData struct {
 	// DepositEth1ChainID is the chain ID of the execution client.
 	DepositEth1ChainID uint64 `mapstructure:"deposit-eth1-chain-id"`
 	// Eth1FollowDistance is the distance between the eth1 chain and the beacon
-	// chain with respect to reading deposits.
+	// chain with respect to reading deposits, i.e. the number of execution
+	// blocks a deposit must be confirmed by before it is read into the
+	// deposit store and becomes eligible for inclusion. All nodes must agree
+	// on it, as blocks are validated against the local deposit store.
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
@@ -97,6 +100,12 @@ type SpecData struct {
 	ElectraForkTime uint64 `mapstructure:"electra-fork-time"`
 	// Electra1ForkTime is the time at which the Electra1 fork is activated.
 	Electra1ForkTime uint64 `mapstructure:"electra-one-fork-time"`
+	// UpgradeName is the name of the next scheduled upgrade, empty if none is
+	// scheduled.
+	UpgradeName string `mapstructure:"upgrade-name"`
+	// UpgradeTime is the time at which the next scheduled upgrade activates.
+	// Binaries not activating a fork at that time halt before processing it.
+	UpgradeTime uint64 `mapstructure:"upgrade-time"`
 
 	// State list lengths
 	//
@@ -111,6 +120,17 @@ type SpecData struct {
 	// registry.
 	ValidatorRegistryLimit uint64 `mapstructure:"validator-registry-limit"`
 
+	// Bellatrix Values
+	//
+	// MaxTxsPerPayload is the maximum number of transactions accepted in a
+	// payload. It may be lower than the SSZ list limit, which is fixed, and
+	// defaults to it when omitted from a spec file.
+	MaxTxsPerPayload uint64 `mapstructure:"max-txs-per-payload"`
+	// MaxBytesPerTx is the maximum size of a transaction accepted in a
+	// payload. It may be lower than the SSZ byte list limit, which is fixed,
+	// and defaults to it when omitted from a spec file.
+	MaxBytesPerTx uint64 `mapstructure:"max-bytes-per-tx"`
+
 	// Capella Values
 	//
 	// MaxWithdrawalsPerPayload indicates the maximum number of withdrawal
@@ -142,6 +162,23 @@ type SpecData struct {
 	// for a given epoch
 	// Note: ValidatorSetCap must be smaller than ValidatorRegistryLimit.
 	ValidatorSetCap uint64 `mapstructure:"validator-set-cap"`
+	// MaxValidatorActivationsPerEpoch is the maximum number of validators
+	// activated at each epoch transition. Zero means no limit.
+	MaxValidatorActivationsPerEpoch uint64 `mapstructure:"max-validator-activations-per-epoch"`
+	// MaxValidatorExitsPerEpoch is the maximum number of validators whose exit
+	// is scheduled for the same epoch, starting from Electra. Ejections due to
+	// the validator set cap are not subject to this limit. Zero means no limit.
+	MaxValidatorExitsPerEpoch uint64 `mapstructure:"max-validator-exits-per-epoch"`
+	// MinSlashingPenaltyQuotient is the quotient of the effective balance of a
+	// validator burned when it is slashed for misbehaving. Zero disables the
+	// processing of misbehavior evidence altogether.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// DowntimeJailThreshold is the number of blocks in a row a validator can
+	// miss signing before being jailed, i.e. removed from the consensus
+	// validator set until it unjails. Zero disables jailing.
+	DowntimeJailThreshold uint64 `mapstructure:"downtime-jail-threshold"`
+	// MinJailDuration is the minimum number of slots a validator stays jailed.
+	MinJailDuration uint64 `mapstructure:"min-jail-duration"`
 	// EVMInflationAddressGenesis is the address on the EVM which will receive the
 	// inflation amount of native EVM balance through a withdrawal every block.
 	EVMInflationAddressGenesis common.ExecutionAddress `mapstructure:"evm-inflation-address"`
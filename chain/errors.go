@@ -30,9 +30,53 @@ var (
 	ErrInsufficientMaxWithdrawalsPerPayload = errors.New(
 		"max withdrawals per payload must be greater than 1")
 
+	// ErrExcessiveMaxWithdrawalsPerPayload is returned when the max
+	// withdrawals per payload exceeds the SSZ limit of the withdrawals list.
+	ErrExcessiveMaxWithdrawalsPerPayload = errors.New(
+		"max withdrawals per payload must not exceed the SSZ limit",
+	)
+
+	// ErrInvalidTransactionLimits is returned when the transaction limits
+	// are zero or exceed the SSZ limits of the execution payload.
+	ErrInvalidTransactionLimits = errors.New(
+		"max txs per payload and max bytes per tx must be non-zero and within the SSZ limits",
+	)
+
 	// ErrInvalidValidatorSetCap is returned when the validator set cap is
 	// greater than the validator registry limit.
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrZeroEffectiveBalanceIncrement is returned when the effective balance
+	// increment is zero.
+	ErrZeroEffectiveBalanceIncrement = errors.New(
+		"effective balance increment must be greater than 0",
+	)
+
+	// ErrZeroHysteresisQuotient is returned when the hysteresis quotient is
+	// zero.
+	ErrZeroHysteresisQuotient = errors.New(
+		"hysteresis quotient must be greater than 0",
+	)
+
+	// ErrZeroEpochsPerSlashingsVector is returned when slashing is enabled
+	// but the slashings vector is empty.
+	ErrZeroEpochsPerSlashingsVector = errors.New(
+		"epochs per slashings vector must be greater than 0 when slashing is enabled",
+	)
+
+	// ErrInvalidUpgrade is returned when a scheduled upgrade misses its name
+	// or time, or is scheduled no later than genesis.
+	ErrInvalidUpgrade = errors.New(
+		"scheduled upgrade must have both a name and a time after genesis",
+	)
+
+	// ErrInvalidMaxEffectiveBalance is returned when the max effective balance
+	// is not a multiple of the effective balance increment, or is lower than
+	// the min activation balance.
+	ErrInvalidMaxEffectiveBalance = errors.New(
+		"max effective balance must be a multiple of the effective balance " +
+			"increment and not lower than the min activation balance",
+	)
 )
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 )
@@ -106,6 +107,14 @@ type ForkSpec interface {
 
 	// Electra1ForkTime returns the time at which the Electra1 fork takes effect.
 	Electra1ForkTime() uint64
+
+	// UpgradeName returns the name of the next scheduled upgrade, empty if
+	// none is scheduled.
+	UpgradeName() string
+
+	// UpgradeTime returns the time at which the next scheduled upgrade takes
+	// effect.
+	UpgradeTime() uint64
 }
 
 type BlobSpec interface {
@@ -152,6 +161,36 @@ type BerachainSpec interface {
 
 	// ValidatorSetCap retrieves the maximum number of validators allowed in the active set.
 	ValidatorSetCap() uint64
+
+	// MaxValidatorActivationsPerEpoch returns the maximum number of validators
+	// activated per epoch, zero meaning no limit.
+	MaxValidatorActivationsPerEpoch() uint64
+
+	// MaxValidatorExitsPerEpoch returns the maximum number of validators
+	// exiting per epoch, zero meaning no limit.
+	MaxValidatorExitsPerEpoch() uint64
+
+	// MinSlashingPenaltyQuotient returns the quotient of the effective balance
+	// burned when slashing a validator, zero meaning slashing is disabled.
+	MinSlashingPenaltyQuotient() uint64
+
+	// DowntimeJailThreshold returns the number of blocks in a row a validator
+	// can miss before being jailed, zero meaning jailing is disabled.
+	DowntimeJailThreshold() uint64
+
+	// MinJailDuration returns the minimum number of slots a validator stays
+	// jailed.
+	MinJailDuration() uint64
+}
+
+type ExecutionPayloadSpec interface {
+	// MaxTxsPerPayload returns the maximum number of transactions accepted
+	// in a payload.
+	MaxTxsPerPayload() uint64
+
+	// MaxBytesPerTx returns the maximum size in bytes of a transaction
+	// accepted in a payload.
+	MaxBytesPerTx() uint64
 }
 
 type WithdrawalsSpec interface {
@@ -179,6 +218,7 @@ type Spec interface {
 	BlobSpec
 	ForkVersionSpec
 	BerachainSpec
+	ExecutionPayloadSpec
 	WithdrawalsSpec
 
 	// Time parameters constants.
@@ -242,11 +282,41 @@ func (s spec) validate() error {
 	if s.Data.MaxWithdrawalsPerPayload <= 1 {
 		return ErrInsufficientMaxWithdrawalsPerPayload
 	}
+	// The SSZ limit of the withdrawals list is fixed by the consensus specs,
+	// as it affects the payload root, so the spec can only tighten it.
+	if s.Data.MaxWithdrawalsPerPayload > constants.MaxWithdrawalsPerPayload {
+		return ErrExcessiveMaxWithdrawalsPerPayload
+	}
+
+	// The SSZ limits of transactions are fixed by the consensus specs, so
+	// the accepted limits can only tighten them.
+	if s.Data.MaxTxsPerPayload == 0 || s.Data.MaxTxsPerPayload > constants.MaxTxsPerPayload ||
+		s.Data.MaxBytesPerTx == 0 || s.Data.MaxBytesPerTx > constants.MaxBytesPerTx {
+		return ErrInvalidTransactionLimits
+	}
 
 	if s.Data.ValidatorSetCap > s.Data.ValidatorRegistryLimit {
 		return ErrInvalidValidatorSetCap
 	}
 
+	// Effective balances are computed by rounding balances down to a multiple
+	// of the increment and capping them at the max effective balance, with
+	// hysteresis thresholds being fractions of the increment.
+	if s.Data.EffectiveBalanceIncrement == 0 {
+		return ErrZeroEffectiveBalanceIncrement
+	}
+	if s.Data.HysteresisQuotient == 0 {
+		return ErrZeroHysteresisQuotient
+	}
+	if s.Data.MaxEffectiveBalance%s.Data.EffectiveBalanceIncrement != 0 ||
+		s.Data.MaxEffectiveBalance < s.Data.MinActivationBalance {
+		return ErrInvalidMaxEffectiveBalance
+	}
+
+	if s.Data.MinSlashingPenaltyQuotient != 0 && s.Data.EpochsPerSlashingsVector == 0 {
+		return ErrZeroEpochsPerSlashingsVector
+	}
+
 	// EVM Inflation values can be zero or non-zero, no validation needed.
 
 	// Enforce ordering of the forks. Like most chains, BeaconKit does not support arbitrary ordering of forks.
@@ -268,6 +338,12 @@ func (s spec) validate() error {
 		}
 	}
 
+	// A scheduled upgrade needs both a name and a time after genesis.
+	if (s.Data.UpgradeName == "") != (s.Data.UpgradeTime == 0) ||
+		(s.Data.UpgradeTime != 0 && s.Data.UpgradeTime <= s.Data.GenesisTime) {
+		return ErrInvalidUpgrade
+	}
+
 	// TODO: Add more validation rules here.
 	return nil
 }
@@ -402,6 +478,16 @@ func (s spec) Electra1ForkTime() uint64 {
 	return s.Data.Electra1ForkTime
 }
 
+// UpgradeName returns the name of the next scheduled upgrade.
+func (s spec) UpgradeName() string {
+	return s.Data.UpgradeName
+}
+
+// UpgradeTime returns the timestamp of the next scheduled upgrade.
+func (s spec) UpgradeTime() uint64 {
+	return s.Data.UpgradeTime
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (s spec) EpochsPerHistoricalVector() uint64 {
 	return s.Data.EpochsPerHistoricalVector
@@ -422,6 +508,18 @@ func (s spec) ValidatorRegistryLimit() uint64 {
 	return s.Data.ValidatorRegistryLimit
 }
 
+// MaxTxsPerPayload returns the maximum number of transactions accepted in a
+// payload.
+func (s spec) MaxTxsPerPayload() uint64 {
+	return s.Data.MaxTxsPerPayload
+}
+
+// MaxBytesPerTx returns the maximum size in bytes of a transaction accepted
+// in a payload.
+func (s spec) MaxBytesPerTx() uint64 {
+	return s.Data.MaxBytesPerTx
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (s spec) MaxWithdrawalsPerPayload() uint64 {
@@ -469,6 +567,36 @@ func (s spec) ValidatorSetCap() uint64 {
 	return s.Data.ValidatorSetCap
 }
 
+// MaxValidatorActivationsPerEpoch returns the maximum number of validators
+// activated per epoch, zero meaning no limit.
+func (s spec) MaxValidatorActivationsPerEpoch() uint64 {
+	return s.Data.MaxValidatorActivationsPerEpoch
+}
+
+// MaxValidatorExitsPerEpoch returns the maximum number of validators exiting
+// per epoch, zero meaning no limit.
+func (s spec) MaxValidatorExitsPerEpoch() uint64 {
+	return s.Data.MaxValidatorExitsPerEpoch
+}
+
+// MinSlashingPenaltyQuotient returns the quotient of the effective balance
+// burned when slashing a validator, zero meaning slashing is disabled.
+func (s spec) MinSlashingPenaltyQuotient() uint64 {
+	return s.Data.MinSlashingPenaltyQuotient
+}
+
+// DowntimeJailThreshold returns the number of blocks in a row a validator can
+// miss before being jailed, zero meaning jailing is disabled.
+func (s spec) DowntimeJailThreshold() uint64 {
+	return s.Data.DowntimeJailThreshold
+}
+
+// MinJailDuration returns the minimum number of slots a validator stays
+// jailed.
+func (s spec) MinJailDuration() uint64 {
+	return s.Data.MinJailDuration
+}
+
 // EVMInflationAddress returns the address on the EVM which will receive the
 // inflation amount of native EVM balance through a withdrawal every block.
 func (s spec) EVMInflationAddress(timestamp math.U64) common.ExecutionAddress {
@@ -24,15 +24,21 @@ import (
 	"testing"
 
 	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/stretchr/testify/require"
 )
 
 func baseSpecData() *chain.SpecData {
 	return &chain.SpecData{
 		// satisfy the pre-checks in validate()
-		MaxWithdrawalsPerPayload: 2,
-		ValidatorSetCap:          100,
-		ValidatorRegistryLimit:   100,
+		MaxWithdrawalsPerPayload:  2,
+		MaxTxsPerPayload:          1,
+		MaxBytesPerTx:             1,
+		ValidatorSetCap:           100,
+		ValidatorRegistryLimit:    100,
+		MaxEffectiveBalance:       32e9,
+		EffectiveBalanceIncrement: 1e9,
+		HysteresisQuotient:        4,
 	}
 }
 
@@ -85,3 +91,98 @@ func TestValidate_ForkOrder_AllForksAtGenesis(t *testing.T) {
 	_, err := chain.NewSpec(data)
 	require.NoError(t, err)
 }
+
+func TestValidate_EffectiveBalances(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		setup    func(*chain.SpecData)
+		expected error
+	}{
+		{
+			name:     "zero increment",
+			setup:    func(d *chain.SpecData) { d.EffectiveBalanceIncrement = 0 },
+			expected: chain.ErrZeroEffectiveBalanceIncrement,
+		},
+		{
+			name:     "zero hysteresis quotient",
+			setup:    func(d *chain.SpecData) { d.HysteresisQuotient = 0 },
+			expected: chain.ErrZeroHysteresisQuotient,
+		},
+		{
+			name:     "max balance not multiple of increment",
+			setup:    func(d *chain.SpecData) { d.MaxEffectiveBalance = 32e9 + 1 },
+			expected: chain.ErrInvalidMaxEffectiveBalance,
+		},
+		{
+			name:     "max balance below min activation balance",
+			setup:    func(d *chain.SpecData) { d.MinActivationBalance = 33e9 },
+			expected: chain.ErrInvalidMaxEffectiveBalance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			data := baseSpecData()
+			tt.setup(data)
+			_, err := chain.NewSpec(data)
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestValidate_Upgrade(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.GenesisTime = 10
+	data.UpgradeName = "electra2"
+	data.UpgradeTime = 20
+	_, err := chain.NewSpec(data)
+	require.NoError(t, err)
+
+	data.UpgradeTime = 10
+	_, err = chain.NewSpec(data)
+	require.ErrorIs(t, err, chain.ErrInvalidUpgrade)
+
+	data.UpgradeTime = 0
+	_, err = chain.NewSpec(data)
+	require.ErrorIs(t, err, chain.ErrInvalidUpgrade)
+
+	data.UpgradeName = ""
+	_, err = chain.NewSpec(data)
+	require.NoError(t, err)
+}
+
+func TestValidate_MaxWithdrawalsPerPayload(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.MaxWithdrawalsPerPayload = constants.MaxWithdrawalsPerPayload
+	_, err := chain.NewSpec(data)
+	require.NoError(t, err)
+
+	data.MaxWithdrawalsPerPayload = constants.MaxWithdrawalsPerPayload + 1
+	_, err = chain.NewSpec(data)
+	require.ErrorIs(t, err, chain.ErrExcessiveMaxWithdrawalsPerPayload)
+
+	data.MaxWithdrawalsPerPayload = 1
+	_, err = chain.NewSpec(data)
+	require.ErrorIs(t, err, chain.ErrInsufficientMaxWithdrawalsPerPayload)
+}
+
+func TestValidate_TransactionLimits(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.MaxTxsPerPayload = constants.MaxTxsPerPayload
+	data.MaxBytesPerTx = constants.MaxBytesPerTx
+	_, err := chain.NewSpec(data)
+	require.NoError(t, err)
+
+	data.MaxBytesPerTx = constants.MaxBytesPerTx + 1
+	_, err = chain.NewSpec(data)
+	require.ErrorIs(t, err, chain.ErrInvalidTransactionLimits)
+
+	data.MaxBytesPerTx = 1 << 17
+	data.MaxTxsPerPayload = 0
+	_, err = chain.NewSpec(data)
+	require.ErrorIs(t, err, chain.ErrInvalidTransactionLimits)
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/cobra"
+)
+
+// GetMigrateCmd returns a command converting the data directory to another
+// database backend. The node must be stopped while it runs.
+func GetMigrateCmd() *cobra.Command {
+	var target string
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Converts all databases in the data directory to another backend",
+		Long: `Converts the application, deposit, operation pool, proposal, performance and
+CometBFT databases to the backend given by --to, then updates db_backend in
+config.toml. The backend of each database is detected from its files, as the
+BeaconKit databases of older nodes use PebbleDB whatever db_backend is set to. The
+original databases are kept with a .<backend>.bak suffix and can be removed once the
+node runs correctly. The node must be stopped.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+
+			to, err := storagedb.ParseBackend(target)
+			if err != nil {
+				return err
+			}
+
+			dataDir := filepath.Join(cfg.RootDir, "data")
+			for _, name := range storagedb.DataDBNames {
+				from, detectErr := storagedb.DetectBackend(dataDir, name, to)
+				if detectErr != nil {
+					return detectErr
+				}
+				if from == to {
+					continue
+				}
+				count, found, migrateErr := storagedb.Migrate(dataDir, name, from, to)
+				if migrateErr != nil {
+					return migrateErr
+				}
+				if found {
+					logger.Info("Migrated database", "name", name, "from", from, "entries", count)
+				}
+			}
+
+			cfg.DBBackend = string(to)
+			cmtcfg.WriteConfigFile(filepath.Join(cfg.RootDir, "config", "config.toml"), cfg)
+			logger.Info("Migration complete", "to", to)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&target, "to", "", "database backend to convert to (pebbledb or goleveldb)")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
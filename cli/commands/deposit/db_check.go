@@ -26,7 +26,6 @@ import (
 	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/berachain/beacon-kit/storage/db"
-	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
@@ -42,7 +41,7 @@ func GetDBCheckCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			v := clicontext.GetViperFromCmd(cmd)
 			logger := clicontext.GetLoggerFromCmd(cmd)
 			cfg := clicontext.GetConfigFromCmd(cmd)
-			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			db, err := db.OpenAppDB(cfg.RootDir, cfg.DBBackend)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/cli/commands/genesis/types"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	libcommon "github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagValidators        = "validators"
+	FlagDepositAmount     = "deposit-amount"
+	FlagWithdrawalAddress = "withdrawal-address"
+	FlagPremine           = "premine"
+
+	defaultValidators        = 4
+	defaultDepositAmount     = "32000000000"
+	defaultWithdrawalAddress = "0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4"
+
+	// validatorsDir is the directory, relative to the node home, holding the
+	// keys of the generated validators other than the node itself.
+	validatorsDir = "validators"
+)
+
+// GenerateCmd returns the cobra command generating a complete devnet genesis.
+//
+//nolint:lll // reads better if long description is one line
+func GenerateCmd(chainSpecCreator servertypes.ChainSpecCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [eth/genesis/file.json]",
+		Short: "generates a devnet genesis with the given number of validators",
+		Long:  `Generates the validator keys and premined deposits for the given number of validators, collects them into the beacon genesis, and produces the matching EL genesis in the BEACOND_HOME directory, with premined balances, deposit contract storage and the genesis execution payload bound to the beacon genesis. The first validator uses the key of the node in BEACOND_HOME, the others are written to BEACOND_HOME/validators. Requires a genesis file created by 'beacond init'.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetConfigFromCmd(cmd)
+			appOpts := context.GetViperFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(appOpts)
+			if err != nil {
+				return err
+			}
+
+			numValidators, err := cmd.Flags().GetInt(FlagValidators)
+			if err != nil {
+				return err
+			}
+			amountStr, err := cmd.Flags().GetString(FlagDepositAmount)
+			if err != nil {
+				return err
+			}
+			depositAmount, err := parser.ConvertAmount(amountStr)
+			if err != nil {
+				return err
+			}
+			withdrawalStr, err := cmd.Flags().GetString(FlagWithdrawalAddress)
+			if err != nil {
+				return err
+			}
+			premineStrs, err := cmd.Flags().GetStringSlice(FlagPremine)
+			if err != nil {
+				return err
+			}
+			premines, err := parsePremines(premineStrs)
+			if err != nil {
+				return err
+			}
+
+			pubkeys, err := Generate(
+				chainSpec,
+				config,
+				args[0],
+				numValidators,
+				depositAmount,
+				libcommon.NewExecutionAddressFromHex(withdrawalStr),
+				premines,
+			)
+			if err != nil {
+				return err
+			}
+
+			for i, pubkey := range pubkeys {
+				cmd.Printf("validator %d: %s\n", i, pubkey)
+			}
+			cmd.Printf(
+				"Successfully generated genesis %s and EL genesis %s\n",
+				config.GenesisFile(),
+				filepath.Join(config.RootDir, filepath.Base(args[0])),
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagValidators, defaultValidators, "number of genesis validators")
+	cmd.Flags().String(FlagDepositAmount, defaultDepositAmount, "premined deposit amount of each validator, in Gwei")
+	cmd.Flags().String(FlagWithdrawalAddress, defaultWithdrawalAddress, "withdrawal address of the validators")
+	cmd.Flags().StringSlice(FlagPremine, nil, "EL premined balances as <address>=<amount in wei>, may be repeated")
+	return cmd
+}
+
+// Generate is the modularized version of GenerateCmd that can be properly
+// tested from within the runtime. It returns the pubkeys of the generated
+// validators.
+func Generate(
+	cs ChainSpec,
+	config *cmtcfg.Config,
+	elGenesisPath string,
+	numValidators int,
+	depositAmount math.Gwei,
+	withdrawalAddress libcommon.ExecutionAddress,
+	premines map[common.Address]*big.Int,
+) ([]crypto.BLSPubkey, error) {
+	if numValidators <= 0 {
+		return nil, fmt.Errorf("number of validators must be positive, got %d", numValidators)
+	}
+	if _, err := os.Stat(config.GenesisFile()); err != nil {
+		return nil, errors.Wrap(err, "genesis file not found, run 'beacond init' first")
+	}
+
+	// Create the keys and premined deposits of every validator.
+	pubkeys := make([]crypto.BLSPubkey, 0, numValidators)
+	for i := range numValidators {
+		valConfig := config
+		if i > 0 {
+			valConfig = cmtcfg.DefaultConfig()
+			valConfig.SetRoot(ValidatorHomeDir(config.RootDir, i))
+		}
+		if _, _, err := genutil.InitializeNodeValidatorFiles(
+			valConfig, crypto.CometBLSType,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to initialize validator %d files", i)
+		}
+		blsSigner := signer.NewBLSSigner(
+			valConfig.PrivValidatorKeyFile(), valConfig.PrivValidatorStateFile(),
+		)
+		pubkey := blsSigner.PublicKey()
+
+		outputDocument, err := makeOutputFilepath(config.RootDir, pubkey.String())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create output file path")
+		}
+		if err = AddGenesisDeposit(
+			cs, valConfig, blsSigner, depositAmount, withdrawalAddress, outputDocument,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to add deposit of validator %d", i)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	if err := CollectGenesisDeposits(config); err != nil {
+		return nil, err
+	}
+
+	// Write the EL genesis with the premined balances in the node home, then
+	// complete it with the deposit contract storage and bind it to the beacon
+	// genesis through the genesis execution payload.
+	homeELGenesisPath := filepath.Join(config.RootDir, filepath.Base(elGenesisPath))
+	if err := writePremineAllocs(elGenesisPath, homeELGenesisPath, premines); err != nil {
+		return nil, errors.Wrap(err, "failed to write premined balances")
+	}
+	if err := SetDepositStorage(cs, config, homeELGenesisPath); err != nil {
+		return nil, err
+	}
+	if err := AddExecutionPayload(cs, homeELGenesisPath, config); err != nil {
+		return nil, err
+	}
+	return pubkeys, nil
+}
+
+// ValidatorHomeDir returns the directory holding the keys of the i-th
+// validator generated by Generate in the given node home, for i > 0.
+func ValidatorHomeDir(rootDir string, i int) string {
+	return filepath.Join(rootDir, validatorsDir, "validator-"+strconv.Itoa(i))
+}
+
+// parsePremines parses premined balances given as <address>=<amount in wei>.
+// Amounts may be decimal or 0x-prefixed hexadecimal.
+func parsePremines(premines []string) (map[common.Address]*big.Int, error) {
+	res := make(map[common.Address]*big.Int, len(premines))
+	for _, premine := range premines {
+		addr, amount, found := strings.Cut(premine, "=")
+		if !found || !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid premine %q, expected <address>=<amount>", premine)
+		}
+		balance, ok := new(big.Int).SetString(amount, 0)
+		if !ok || balance.Sign() < 0 {
+			return nil, fmt.Errorf("invalid premine amount %q", amount)
+		}
+		res[common.HexToAddress(addr)] = balance
+	}
+	return res, nil
+}
+
+// writePremineAllocs copies the EL genesis file at inputDocument to
+// outputDocument, setting the balance of the premined accounts.
+func writePremineAllocs(
+	inputDocument string,
+	outputDocument string,
+	premines map[common.Address]*big.Int,
+) error {
+	existingBz, err := afero.ReadFile(afero.NewOsFs(), inputDocument)
+	if err != nil {
+		return err
+	}
+
+	// Unmarshal existing genesis using json.Number to preserve integer precision
+	var existingGenesis map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(existingBz))
+	decoder.UseNumber()
+	if err = decoder.Decode(&existingGenesis); err != nil {
+		return err
+	}
+	alloc, ok := existingGenesis[types.DefaultAllocsKey].(map[string]interface{})
+	if !ok {
+		return errors.New("invalid alloc format in genesis file")
+	}
+
+	for addr, balance := range premines {
+		// Allocs may be keyed with any casing, with or without 0x prefix.
+		key := addr.Hex()
+		for existing := range alloc {
+			if common.HexToAddress(existing) == addr {
+				key = existing
+				break
+			}
+		}
+		account, _ := alloc[key].(map[string]interface{})
+		if account == nil {
+			account = make(map[string]interface{})
+		}
+		account["balance"] = "0x" + balance.Text(16) //nolint:mnd // hex.
+		alloc[key] = account
+	}
+
+	bz, err := json.MarshalIndent(existingGenesis, "", "  ")
+	if err != nil {
+		return err
+	}
+	return afero.WriteFile(
+		afero.NewOsFs(),
+		outputDocument,
+		bz,
+		0o644, //nolint:mnd // file permissions.
+	)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	homeDir := t.TempDir()
+
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cometConfig := cmtcfg.DefaultConfig()
+	cometConfig.SetRoot(homeDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0o755))
+
+	// Write the genesis file as created by `beacond init`.
+	appState, err := json.Marshal(map[string]any{
+		"beacon": types.DefaultGenesis(chainSpec.GenesisForkVersion()),
+	})
+	require.NoError(t, err)
+	appGenesis := &genutiltypes.AppGenesis{
+		ChainID:       "beacond-test",
+		AppState:      appState,
+		InitialHeight: 1,
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: cometbft.DefaultConsensusParams(crypto.CometBLSType),
+		},
+	}
+	require.NoError(t, genutil.ExportGenesisFile(appGenesis, cometConfig.GenesisFile()))
+
+	premineAddr := gethcommon.HexToAddress("0x981114102592310C347E61368342DDA67017bf84")
+	premineAmount, _ := new(big.Int).SetString("1000000000000000000000", 10)
+
+	const numValidators = 3
+	pubkeys, err := genesis.Generate(
+		chainSpec,
+		cometConfig,
+		"../../../testing/files/eth-genesis.json",
+		numValidators,
+		chainSpec.MaxEffectiveBalance(),
+		common.NewExecutionAddressFromHex("0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4"),
+		map[gethcommon.Address]*big.Int{premineAddr: premineAmount},
+	)
+	require.NoError(t, err)
+	require.Len(t, pubkeys, numValidators)
+
+	// Every validator but the node itself has its own key directory.
+	require.FileExists(t, cometConfig.PrivValidatorKeyFile())
+	for i := 1; i < numValidators; i++ {
+		require.FileExists(t, filepath.Join(
+			homeDir, "validators", "validator-"+strconv.Itoa(i), "config", "priv_validator_key.json",
+		))
+	}
+
+	// The beacon genesis holds one deposit per validator and the EL genesis
+	// payload header.
+	appGenesis, err = genutiltypes.AppGenesisFromFile(cometConfig.GenesisFile())
+	require.NoError(t, err)
+	genesisState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	require.NoError(t, err)
+	beaconGenesis := &types.Genesis{}
+	require.NoError(t, json.Unmarshal(genesisState["beacon"], beaconGenesis))
+	require.Len(t, beaconGenesis.Deposits, numValidators)
+	for i, deposit := range beaconGenesis.Deposits {
+		require.Equal(t, uint64(i), deposit.Index)
+		require.Contains(t, pubkeys, deposit.Pubkey)
+	}
+	require.NotEqual(t, common.ExecutionHash{}, beaconGenesis.ExecutionPayloadHeader.BlockHash)
+
+	// The EL genesis holds the premined balance.
+	elGenesisBz, err := os.ReadFile(filepath.Join(homeDir, "eth-genesis.json"))
+	require.NoError(t, err)
+	var elGenesis struct {
+		Alloc map[gethcommon.Address]struct {
+			Balance string `json:"balance"`
+		} `json:"alloc"`
+	}
+	require.NoError(t, json.Unmarshal(elGenesisBz, &elGenesis))
+	require.Equal(t, "0x"+premineAmount.Text(16), elGenesis.Alloc[premineAddr].Balance)
+}
+
+func TestGenerate_NoGenesisFile(t *testing.T) {
+	t.Parallel()
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cometConfig := cmtcfg.DefaultConfig()
+	cometConfig.SetRoot(t.TempDir())
+
+	_, err = genesis.Generate(
+		chainSpec, cometConfig, "../../../testing/files/eth-genesis.json",
+		1, chainSpec.MaxEffectiveBalance(), common.ExecutionAddress{}, nil,
+	)
+	require.ErrorContains(t, err, "run 'beacond init' first")
+}
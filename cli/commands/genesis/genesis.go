@@ -45,8 +45,11 @@ func Commands(
 		AddGenesisDepositCmd(csc),
 		CollectGenesisDepositsCmd(),
 		AddExecutionPayloadCmd(csc),
+		GenerateCmd(csc),
 		GetGenesisValidatorRootCmd(csc),
 		SetDepositStorageCmd(csc),
+		SetGenesisTimeCmd(),
+		ImportDepositDataCmd(csc),
 	)
 
 	// Add additional commands
@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// depositDataPattern matches the files written by staking-deposit-cli.
+const depositDataPattern = "deposit_data-*.json"
+
+var (
+	// ErrNoDepositData is returned when a directory holds no deposit data.
+	ErrNoDepositData = errors.New("no deposit_data-*.json entries found")
+	// ErrDepositForkVersion is returned when a deposit was signed for a
+	// fork version other than the genesis one.
+	ErrDepositForkVersion = errors.New("deposit signed for another fork version")
+	// ErrDepositMessageRoot is returned when a deposit's message root does
+	// not match its contents.
+	ErrDepositMessageRoot = errors.New("deposit_message_root mismatch")
+	// ErrDuplicateDeposit is returned when a pubkey is already deposited in
+	// genesis.
+	ErrDuplicateDeposit = errors.New("duplicate genesis deposit")
+)
+
+// depositDataEntry is a single entry of a deposit_data-*.json file. All
+// byte fields are hex encoded without a 0x prefix.
+type depositDataEntry struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+// ImportDepositDataCmd returns the cobra command to import a directory of
+// deposit_data-*.json files into the genesis file.
+func ImportDepositDataCmd(
+	chainSpecCreator servertypes.ChainSpecCreator,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-deposit-data [dir]",
+		Short: "adds the validators of a deposit_data batch to the genesis file",
+		Long: `Reads every deposit_data-*.json file in the given directory, as ` +
+			`produced by staking-deposit-cli, verifies each deposit against ` +
+			`the genesis fork version and appends it to the genesis deposits.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainSpec, err := chainSpecCreator(context.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			config := context.GetConfigFromCmd(cmd)
+			deposits, err := ImportDepositData(chainSpec, config, args[0])
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Imported %d genesis deposits\n", len(deposits))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// ImportDepositData verifies every deposit in the deposit_data-*.json files
+// of dir and appends them to the genesis deposits, continuing the existing
+// deposit indexes. Nothing is written unless every deposit is valid.
+func ImportDepositData(
+	cs ChainSpec,
+	config *cmtcfg.Config,
+	dir string,
+) ([]*types.Deposit, error) {
+	deposits, err := ReadDepositDataFiles(cs, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read genesis doc from file")
+	}
+	appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		return nil, err
+	}
+	if appGenesisState == nil {
+		appGenesisState = make(map[string]json.RawMessage)
+	}
+
+	genesisInfo := &types.Genesis{}
+	if err = json.Unmarshal(appGenesisState["beacon"], genesisInfo); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal beacon genesis")
+	}
+
+	seen := make(map[crypto.BLSPubkey]struct{}, len(genesisInfo.Deposits))
+	for _, deposit := range genesisInfo.Deposits {
+		seen[deposit.Pubkey] = struct{}{}
+	}
+	for _, deposit := range deposits {
+		if _, ok := seen[deposit.Pubkey]; ok {
+			return nil, errors.Wrapf(
+				ErrDuplicateDeposit, "pubkey %s", deposit.Pubkey,
+			)
+		}
+		seen[deposit.Pubkey] = struct{}{}
+		// #nosec G115 -- won't realistically overflow.
+		deposit.Index = uint64(len(genesisInfo.Deposits))
+		genesisInfo.Deposits = append(genesisInfo.Deposits, deposit)
+	}
+
+	if appGenesisState["beacon"], err = json.Marshal(genesisInfo); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal beacon genesis")
+	}
+	if appGenesis.AppState, err = json.MarshalIndent(
+		appGenesisState, "", "  ",
+	); err != nil {
+		return nil, err
+	}
+
+	return deposits, genutil.ExportGenesisFile(appGenesis, config.GenesisFile())
+}
+
+// ReadDepositDataFiles reads and verifies the deposits of every
+// deposit_data-*.json file in dir, in file name order.
+func ReadDepositDataFiles(
+	cs ChainSpec,
+	dir string,
+) ([]*types.Deposit, error) {
+	fos, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]*types.Deposit, 0)
+	for _, fo := range fos {
+		if fo.IsDir() {
+			continue
+		}
+		if ok, _ := filepath.Match(depositDataPattern, fo.Name()); !ok {
+			continue
+		}
+
+		var bz []byte
+		bz, err = afero.ReadFile(afero.NewOsFs(), filepath.Join(dir, fo.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var entries []depositDataEntry
+		if err = json.Unmarshal(bz, &entries); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", fo.Name())
+		}
+		for i, entry := range entries {
+			var deposit *types.Deposit
+			if deposit, err = entry.toDeposit(cs); err != nil {
+				return nil, errors.Wrapf(err, "%s: entry %d", fo.Name(), i)
+			}
+			deposits = append(deposits, deposit)
+		}
+	}
+
+	if len(deposits) == 0 {
+		return nil, errors.Wrapf(ErrNoDepositData, "%s", dir)
+	}
+	return deposits, nil
+}
+
+// toDeposit decodes the entry and verifies its fork version, message root
+// and signature.
+func (e *depositDataEntry) toDeposit(cs ChainSpec) (*types.Deposit, error) {
+	forkVersion, err := decodeHex(e.ForkVersion, bytes.ToBytes4)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid fork_version")
+	}
+	genesisVersion := cs.GenesisForkVersion()
+	if forkVersion != genesisVersion {
+		return nil, errors.Wrapf(
+			ErrDepositForkVersion, "expected %s, got %s",
+			genesisVersion, forkVersion,
+		)
+	}
+
+	pubkey, err := decodeHex(e.Pubkey, bytes.ToBytes48)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pubkey")
+	}
+	credentials, err := decodeHex(e.WithdrawalCredentials, bytes.ToBytes32)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid withdrawal_credentials")
+	}
+	signature, err := decodeHex(e.Signature, bytes.ToBytes96)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+
+	depositMsg := &types.DepositMessage{
+		Pubkey:      pubkey,
+		Credentials: types.WithdrawalCredentials(credentials),
+		Amount:      math.Gwei(e.Amount),
+	}
+	if e.DepositMessageRoot != "" {
+		var root bytes.B32
+		if root, err = decodeHex(e.DepositMessageRoot, bytes.ToBytes32); err != nil {
+			return nil, errors.Wrap(err, "invalid deposit_message_root")
+		}
+		if common.Root(root) != depositMsg.HashTreeRoot() {
+			return nil, ErrDepositMessageRoot
+		}
+	}
+
+	if err = depositMsg.VerifyCreateValidator(
+		types.NewForkData(genesisVersion, common.Root{}),
+		signature,
+		cs.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.Deposit{
+		Pubkey:      depositMsg.Pubkey,
+		Credentials: depositMsg.Credentials,
+		Amount:      depositMsg.Amount,
+		Signature:   signature,
+	}, nil
+}
+
+// decodeHex decodes an unprefixed hex string into a fixed size byte array.
+func decodeHex[T any](s string, to func([]byte) (T, error)) (T, error) {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return to(bz)
+}
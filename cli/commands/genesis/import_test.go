@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportDepositData(t *testing.T) {
+	t.Parallel()
+	homeDir := t.TempDir()
+	depositDir := t.TempDir()
+
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cometConfig := cmtcfg.DefaultConfig()
+	cometConfig.SetRoot(homeDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0o755))
+
+	appState, err := json.Marshal(map[string]any{
+		"beacon": types.DefaultGenesis(chainSpec.GenesisForkVersion()),
+	})
+	require.NoError(t, err)
+	appGenesis := &genutiltypes.AppGenesis{
+		ChainID:       "beacond-test",
+		AppState:      appState,
+		InitialHeight: 1,
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: cometbft.DefaultConsensusParams(crypto.CometBLSType),
+		},
+	}
+	require.NoError(t, genutil.ExportGenesisFile(appGenesis, cometConfig.GenesisFile()))
+
+	// Sign two deposits the way staking-deposit-cli would.
+	entries := make([]map[string]any, 0, 2)
+	for range 2 {
+		blsSigner := signer.BLSSigner{
+			PrivValidator: cmttypes.NewMockPVWithKeyType(bls12381.KeyType),
+		}
+		msg, sig, signErr := types.CreateAndSignDepositMessage(
+			types.NewForkData(chainSpec.GenesisForkVersion(), common.Root{}),
+			chainSpec.DomainTypeDeposit(),
+			blsSigner,
+			types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01}),
+			chainSpec.MaxEffectiveBalance(),
+		)
+		require.NoError(t, signErr)
+		root := msg.HashTreeRoot()
+		version := chainSpec.GenesisForkVersion()
+		entries = append(entries, map[string]any{
+			"pubkey":                 hex.EncodeToString(msg.Pubkey[:]),
+			"withdrawal_credentials": hex.EncodeToString(msg.Credentials[:]),
+			"amount":                 uint64(msg.Amount),
+			"signature":              hex.EncodeToString(sig[:]),
+			"deposit_message_root":   hex.EncodeToString(root[:]),
+			"fork_version":           hex.EncodeToString(version[:]),
+			"network_name":           "devnet",
+		})
+	}
+	writeDepositData := func(name string, entries []map[string]any) {
+		bz, marshalErr := json.Marshal(entries)
+		require.NoError(t, marshalErr)
+		require.NoError(t, os.WriteFile(filepath.Join(depositDir, name), bz, 0o600))
+	}
+	writeDepositData("deposit_data-1.json", entries)
+
+	deposits, err := genesis.ImportDepositData(chainSpec, cometConfig, depositDir)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+
+	appGenesis, err = genutiltypes.AppGenesisFromFile(cometConfig.GenesisFile())
+	require.NoError(t, err)
+	appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	require.NoError(t, err)
+	beaconGenesis := &types.Genesis{}
+	require.NoError(t, json.Unmarshal(appGenesisState["beacon"], beaconGenesis))
+	require.Len(t, beaconGenesis.Deposits, 2)
+	for i, deposit := range beaconGenesis.Deposits {
+		require.Equal(t, uint64(i), deposit.Index)
+		require.True(t, deposits[i].Equals(deposit))
+	}
+
+	// Importing the same batch again duplicates the pubkeys.
+	_, err = genesis.ImportDepositData(chainSpec, cometConfig, depositDir)
+	require.ErrorIs(t, err, genesis.ErrDuplicateDeposit)
+
+	// Deposits signed for another fork version are rejected.
+	entries[0]["fork_version"] = "deadbeef"
+	writeDepositData("deposit_data-1.json", entries)
+	_, err = genesis.ImportDepositData(chainSpec, cometConfig, depositDir)
+	require.ErrorIs(t, err, genesis.ErrDepositForkVersion)
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+const FlagGenesisDelay = "delay"
+
+// SetGenesisTimeCmd returns the cobra command setting the time the chain
+// starts at.
+//
+//nolint:lll // reads better if long description is one line
+func SetGenesisTimeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-time [unix-timestamp]",
+		Short: "sets the time the chain starts at in the genesis file",
+		Long:  `Sets the genesis time of the genesis file, at which the chain starts producing blocks, either to the given unix timestamp or to the given --delay from now. This lets the participants of a launch agree on the start time once the genesis deposits are collected and the genesis execution payload is fixed. The genesis time must not be before the timestamp of the genesis execution payload.`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delay, err := cmd.Flags().GetDuration(FlagGenesisDelay)
+			if err != nil {
+				return err
+			}
+
+			var genesisTime time.Time
+			switch {
+			case len(args) == 1 && delay != 0:
+				return errors.New("either a timestamp or a delay must be given, not both")
+			case len(args) == 1:
+				var timestamp int64
+				timestamp, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return errors.Wrap(err, "invalid unix timestamp")
+				}
+				genesisTime = time.Unix(timestamp, 0)
+			case delay > 0:
+				genesisTime = time.Now().Add(delay).Truncate(time.Second)
+			default:
+				return errors.New("a timestamp or a positive delay must be given")
+			}
+
+			config := context.GetConfigFromCmd(cmd)
+			if err = SetGenesisTime(config, genesisTime); err != nil {
+				return err
+			}
+			cmd.Printf(
+				"Genesis time set to %d (%s)\n",
+				genesisTime.Unix(), genesisTime.UTC().Format(time.RFC3339),
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration(FlagGenesisDelay, 0, "delay from now to start the chain at, e.g. 24h")
+	return cmd
+}
+
+// SetGenesisTime sets the time the chain starts at in the genesis file.
+func SetGenesisTime(config *cmtcfg.Config, genesisTime time.Time) error {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+	if err != nil {
+		return errors.Wrap(err, "failed to read genesis doc from file")
+	}
+	appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		return err
+	}
+
+	// The first block must not be timestamped before the genesis execution
+	// payload it builds on.
+	if beaconGenesis, ok := appGenesisState["beacon"]; ok {
+		genesisInfo := &types.Genesis{}
+		if err = json.Unmarshal(beaconGenesis, genesisInfo); err != nil {
+			return errors.Wrap(err, "failed to unmarshal beacon state")
+		}
+		if eph := genesisInfo.GetExecutionPayloadHeader(); eph != nil &&
+			genesisTime.Unix() < int64(eph.GetTimestamp().Unwrap()) { //#nosec:G115 // timestamps fit an int64.
+			return fmt.Errorf(
+				"genesis time %d is before the genesis execution payload timestamp %d",
+				genesisTime.Unix(), eph.GetTimestamp().Unwrap(),
+			)
+		}
+	}
+
+	appGenesis.GenesisTime = genesisTime.UTC()
+	return genutil.ExportGenesisFile(appGenesis, config.GenesisFile())
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetGenesisTime(t *testing.T) {
+	t.Parallel()
+	homeDir := t.TempDir()
+
+	chainSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cometConfig := cmtcfg.DefaultConfig()
+	cometConfig.SetRoot(homeDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0o755))
+
+	// Write a genesis file whose execution payload is fixed.
+	const payloadTimestamp = 1_700_000_000
+	beaconGenesis := types.DefaultGenesis(chainSpec.GenesisForkVersion())
+	beaconGenesis.ExecutionPayloadHeader.Timestamp = payloadTimestamp
+	appState, err := json.Marshal(map[string]any{"beacon": beaconGenesis})
+	require.NoError(t, err)
+	appGenesis := &genutiltypes.AppGenesis{
+		ChainID:       "beacond-test",
+		AppState:      appState,
+		InitialHeight: 1,
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: cometbft.DefaultConsensusParams(crypto.CometBLSType),
+		},
+	}
+	require.NoError(t, genutil.ExportGenesisFile(appGenesis, cometConfig.GenesisFile()))
+
+	// The chain may start after the genesis execution payload.
+	startTime := time.Unix(payloadTimestamp+3600, 0)
+	require.NoError(t, genesis.SetGenesisTime(cometConfig, startTime))
+	appGenesis, err = genutiltypes.AppGenesisFromFile(cometConfig.GenesisFile())
+	require.NoError(t, err)
+	require.True(t, startTime.Equal(appGenesis.GenesisTime))
+
+	// But not before it.
+	err = genesis.SetGenesisTime(cometConfig, time.Unix(payloadTimestamp-1, 0))
+	require.ErrorContains(t, err, "before the genesis execution payload timestamp")
+	appGenesis, err = genutiltypes.AppGenesisFromFile(cometConfig.GenesisFile())
+	require.NoError(t, err)
+	require.True(t, startTime.Equal(appGenesis.GenesisTime))
+}
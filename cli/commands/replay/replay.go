@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/store"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstore "github.com/cometbft/cometbft/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagFromSlot = "from-slot"
+	FlagToSlot   = "to-slot"
+)
+
+// ErrStateRootMismatch is returned when a replayed block does not produce the
+// expected state root.
+var ErrStateRootMismatch = errors.New("state root mismatch")
+
+// NewReplayCmd creates a command re-executing stored blocks through the state
+// transition.
+//
+//nolint:lll // reads better if long description is one line
+func NewReplayCmd(
+	chainSpecCreator servertypes.ChainSpecCreator,
+	appCreator servertypes.AppCreator,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-executes stored blocks through the state transition",
+		Long:  `Loads the beacon state stored at from-slot - 1, then re-executes the blocks of the CometBFT block store from from-slot to to-slot through the state transition, without the execution client. After every block the replayed state root is checked against the state root of the block and the state stored by the node at that slot. On the first divergence the hash tree roots of all state fields and the first differing Merkle chunk are printed, a forensic dump is written to the data/debug directory and the command fails. The node must be stopped and the states must not have been pruned.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fromSlot, err := cmd.Flags().GetUint64(FlagFromSlot)
+			if err != nil {
+				return err
+			}
+			toSlot, err := cmd.Flags().GetUint64(FlagToSlot)
+			if err != nil {
+				return err
+			}
+			if fromSlot == 0 || toSlot < fromSlot {
+				return fmt.Errorf(
+					"invalid slot range [%d, %d], from-slot must be positive and not after to-slot",
+					fromSlot, toSlot,
+				)
+			}
+
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(v)
+			if err != nil {
+				return err
+			}
+
+			appDB, err := db.OpenAppDB(cfg.RootDir, cfg.DBBackend)
+			if err != nil {
+				return err
+			}
+			app := appCreator(logger, appDB, nil, cfg, v)
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(
+				&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to open CometBFT block store: %w", err)
+			}
+			blockStore := cmtstore.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			r := &replayer{
+				cmd:        cmd,
+				logger:     logger,
+				chainSpec:  chainSpec,
+				cms:        app.CommitMultiStore(),
+				storage:    app.StorageBackend(),
+				processor:  app.StateProcessor(),
+				blockStore: blockStore,
+				debugDir:   filepath.Join(cfg.RootDir, "data", "debug"),
+			}
+			return r.replay(math.Slot(fromSlot), math.Slot(toSlot))
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromSlot, 0, "first slot to replay")
+	cmd.Flags().Uint64(FlagToSlot, 0, "last slot to replay")
+	_ = cmd.MarkFlagRequired(FlagFromSlot)
+	_ = cmd.MarkFlagRequired(FlagToSlot)
+	return cmd
+}
+
+// ChainSpec is the chain spec used to decode the stored blocks.
+type ChainSpec interface {
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+}
+
+// replayer re-executes the blocks of the CometBFT block store. Slots map to
+// CometBFT heights and to multistore versions.
+type replayer struct {
+	cmd        *cobra.Command
+	logger     *phuslu.Logger
+	chainSpec  ChainSpec
+	cms        store.CommitMultiStore
+	storage    blockchain.StorageBackend
+	processor  blockchain.StateProcessor
+	blockStore *cmtstore.BlockStore
+	debugDir   string
+}
+
+func (r *replayer) replay(fromSlot, toSlot math.Slot) error {
+	st, err := r.stateAt(fromSlot - 1)
+	if err != nil {
+		return err
+	}
+
+	for slot := fromSlot; slot <= toSlot; slot++ {
+		//#nosec:G115 // slots are CometBFT heights.
+		cmtBlock, _ := r.blockStore.LoadBlock(int64(slot))
+		if cmtBlock == nil {
+			return fmt.Errorf("block at slot %d not found in the CometBFT block store", slot)
+		}
+		//#nosec:G115 // block times are after the unix epoch.
+		consensusTime := math.U64(cmtBlock.Time.Unix())
+		signedBlk, err := encoding.UnmarshalBeaconBlockFromABCIRequest(
+			cmtBlock.Txs.ToSliceOfBytes(),
+			blockchain.BeaconBlockTxIndex,
+			r.chainSpec.ActiveForkVersionForTimestamp(consensusTime),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to decode block at slot %d: %w", slot, err)
+		}
+		blk := signedBlk.GetBeaconBlock()
+
+		// Re-execute the block the same way FinalizeBlock does, except for
+		// the payload which cannot be verified without execution client.
+		txCtx := transition.NewTransitionCtx(
+			r.cmd.Context(),
+			consensusTime,
+			cmtBlock.ProposerAddress,
+		).
+			WithMisbehaviors(blockchain.DuplicateVoteMisbehaviors(cmtBlock.Evidence.Evidence.ToABCI())).
+			WithVerifyPayload(false).
+			WithVerifyRandao(false).
+			WithVerifyResult(false).
+			WithMeterGas(false)
+		if _, err = r.processor.Transition(txCtx, st, blk); err != nil {
+			return fmt.Errorf("state transition failed at slot %d: %w", slot, err)
+		}
+
+		if err = r.check(slot, st, blk.GetStateRoot()); err != nil {
+			return err
+		}
+	}
+	r.cmd.Printf("Replayed slots %d to %d without divergence\n", fromSlot, toSlot)
+	return nil
+}
+
+// check compares the replayed state of the given slot against the state
+// root of the block and the state stored by the node. On divergence it
+// prints the roots of all the state fields and writes a forensic dump.
+func (r *replayer) check(slot math.Slot, replayed *statedb.StateDB, blockRoot common.Root) error {
+	replayedRoot := replayed.HashTreeRoot()
+	stored, err := r.stateAt(slot)
+	if err != nil {
+		r.logger.Warn("Stored state unavailable, only checking the block state root",
+			"slot", slot, "error", err)
+	}
+	var storedRoot common.Root
+	if stored != nil {
+		storedRoot = stored.HashTreeRoot()
+	}
+
+	if replayedRoot == blockRoot && (stored == nil || storedRoot == replayedRoot) {
+		r.cmd.Printf("slot %d: state root %s\n", slot, replayedRoot)
+		return nil
+	}
+
+	r.cmd.Printf("slot %d: DIVERGENCE\n", slot)
+	r.cmd.Printf("  block state root:    %s\n", blockRoot)
+	r.cmd.Printf("  replayed state root: %s\n", replayedRoot)
+	if stored != nil {
+		r.cmd.Printf("  stored state root:   %s\n", storedRoot)
+	}
+
+	dump, err := core.NewStateRootMismatchDump(replayed, stored, blockRoot)
+	if err != nil {
+		return err
+	}
+	r.printFieldDiff(dump)
+	path, err := dump.Write(r.debugDir)
+	if err != nil {
+		return err
+	}
+	r.cmd.Printf("Wrote forensic dump to %s\n", path)
+	return fmt.Errorf("%w at slot %d", ErrStateRootMismatch, slot)
+}
+
+// printFieldDiff prints the hash tree roots of the fields of the replayed and
+// stored states, marking the ones which differ, and the first differing
+// chunk.
+func (r *replayer) printFieldDiff(dump *core.StateRootMismatchDump) {
+	if dump.ReferenceFields == nil {
+		return
+	}
+	r.cmd.Printf("  %-33s %-66s %-66s\n", "field", "replayed", "stored")
+	for i, field := range dump.ComputedFields {
+		var storedRoot common.Root
+		if i < len(dump.ReferenceFields) {
+			storedRoot = dump.ReferenceFields[i].Root
+		}
+		marker := " "
+		if field.Root != storedRoot {
+			marker = "*"
+		}
+		r.cmd.Printf("%s %-33s %-66s %-66s\n", marker, field.Name, field.Root, storedRoot)
+	}
+	if chunk := dump.FirstDifferingChunk; chunk != nil {
+		r.cmd.Printf("  first differing chunk: field %s, generalized index path %v\n",
+			chunk.Field, chunk.Path)
+	}
+}
+
+// stateAt returns the state stored by the node at the given slot, backed by
+// an in-memory cache so that it can be transitioned.
+func (r *replayer) stateAt(slot math.Slot) (*statedb.StateDB, error) {
+	//#nosec:G115 // slots are multistore versions.
+	ms, err := r.cms.CacheMultiStoreWithVersion(int64(slot))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state at slot %d, it may have been pruned: %w", slot, err)
+	}
+	ctx := sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(r.logger)).
+		WithContext(r.cmd.Context())
+	return r.storage.StateFromContext(ctx), nil
+}
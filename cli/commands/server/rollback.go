@@ -21,66 +21,159 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	types "github.com/berachain/beacon-kit/cli/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	nodetypes "github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
-	dbm "github.com/cosmos/cosmos-db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
-// NewRollbackCmd creates a command to rollback CometBFT and multistore state by
-// one height.
+// errInvalidRollbackSlots is returned when the number of slots to roll back
+// is zero or reaches past genesis.
+var errInvalidRollbackSlots = errors.New("invalid number of slots to roll back")
+
+// NewRollbackCmd creates a command to rollback CometBFT, multistore, blob and
+// deposit state by a number of heights.
 func NewRollbackCmd(
 	appCreator types.AppCreator,
 ) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		slots       uint64
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK and CometBFT state by one or more heights",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
-progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting CometBFT the transactions in block n will be re-executed against the
-application.
+progress, or from local corruption. Rollback overwrites a state at height n with
+the state at height n - slots. The application also rolls back to that height and
+blob sidecars above it are removed.
+
+Blocks above the target height + 1 are removed, as CometBFT can only roll back past
+a height once its block is gone. Block target + 1 is kept unless --hard is set, so
+upon restarting CometBFT the transactions in that block will be re-executed against
+the application.
+
+The deposit store is not rewound: deposits are read from the execution layer and
+keyed by index, so they remain valid. It is checked against the rolled back state.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			v := clicontext.GetViperFromCmd(cmd)
 			logger := clicontext.GetLoggerFromCmd(cmd)
 			cfg := clicontext.GetConfigFromCmd(cmd)
 
-			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			db, err := db.OpenAppDB(cfg.RootDir, cfg.DBBackend)
 			if err != nil {
 				return err
 			}
 			app := appCreator(logger, db, nil, cfg, v)
 
-			// rollback CometBFT state
-			height, hash, err := cmtcmd.RollbackState(cfg, removeBlock)
-			if err != nil {
-				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
-			}
-
-			// rollback the multistore
-			if err = app.CommitMultiStore().RollbackToVersion(height); err != nil {
-				return fmt.Errorf("failed to rollback to version: %w", err)
-			}
-
-			logger.Info(
-				"Rolled back state",
-				"height", height,
-				"hash", fmt.Sprintf("%X", hash),
-			)
-			return nil
+			_, _, err = Rollback(cmd.Context(), logger, cfg, app, slots, removeBlock)
+			return err
 		},
 	}
 
 	cmd.Flags().
 		BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().
+		Uint64Var(&slots, "slots", 1, "number of heights to roll back")
 	return cmd
 }
+
+// RollbackApp is the part of the application rolled back along with
+// CometBFT.
+type RollbackApp interface {
+	nodetypes.CommitMultistoreAccessor
+	nodetypes.StorageBackendAccessor
+}
+
+// Rollback rolls the CometBFT state, the multistore and the blob store back
+// by slots heights, then checks that the deposit store still backs the rolled
+// back state. Blocks above the target height + 1 are removed, and the one at
+// the target height + 1 as well if removeBlock is set. It returns the height
+// rolled back to and its app hash.
+func Rollback(
+	ctx context.Context,
+	logger *phuslu.Logger,
+	cfg *cmtcfg.Config,
+	app RollbackApp,
+	slots uint64,
+	removeBlock bool,
+) (int64, []byte, error) {
+	startHeight := app.CommitMultiStore().LastCommitID().Version
+	//#nosec:G115 // heights are never negative.
+	if slots == 0 || slots >= uint64(startHeight) {
+		return 0, nil, fmt.Errorf(
+			"%w: %d at height %d", errInvalidRollbackSlots, slots, startHeight,
+		)
+	}
+
+	// rollback CometBFT state, one height at a time
+	var (
+		height int64
+		hash   []byte
+		err    error
+	)
+	for i := range slots {
+		height, hash, err = cmtcmd.RollbackState(cfg, removeBlock || i < slots-1)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to rollback CometBFT state: %w", err)
+		}
+	}
+
+	// rollback the multistore
+	if err = app.CommitMultiStore().RollbackToVersion(height); err != nil {
+		return 0, nil, fmt.Errorf("failed to rollback to version: %w", err)
+	}
+
+	// remove blobs of the rolled back heights
+	storage := app.StorageBackend()
+	for slot := height + 1; slot <= startHeight; slot++ {
+		//#nosec:G115 // heights are never negative.
+		if err = storage.AvailabilityStore().DeleteSlot(math.Slot(slot)); err != nil {
+			return 0, nil, fmt.Errorf("failed to remove blobs at slot %d: %w", slot, err)
+		}
+	}
+
+	if err = cometbft.ResetCommitJournal(cfg.DBDir(), height); err != nil {
+		return 0, nil, fmt.Errorf("failed to reset commit journal: %w", err)
+	}
+
+	// check that the deposit store still backs the rolled back state
+	sdkCtx := sdk.NewContext(
+		app.CommitMultiStore().CacheMultiStore(), false, servercmtlog.WrapSDKLogger(logger),
+	).WithContext(ctx)
+	beaconState := storage.StateFromContext(sdkCtx)
+	eth1Data, err := beaconState.GetEth1Data()
+	if err != nil {
+		return 0, nil, err
+	}
+	if err = core.ValidateNonGenesisDeposits(
+		sdkCtx, beaconState, storage.DepositStore(), 0, nil, eth1Data.DepositRoot,
+	); err != nil {
+		return 0, nil, fmt.Errorf("deposit store out of sync after rollback: %w", err)
+	}
+
+	logger.Info(
+		"Rolled back state",
+		"from", startHeight,
+		"height", height,
+		"hash", fmt.Sprintf("%X", hash),
+	)
+	return height, hash, nil
+}
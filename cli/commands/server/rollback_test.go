@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/server"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	bemocks "github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// rollbackHeights is the number of heights committed before rolling back.
+const rollbackHeights = 6
+
+// rollbackApp is a committed chain whose state, blocks and blobs can be
+// rolled back.
+type rollbackApp struct {
+	cms     storetypes.CommitMultiStore
+	storage *bemocks.StorageBackend
+}
+
+func (a rollbackApp) CommitMultiStore() store.CommitMultiStore {
+	return a.cms
+}
+
+func (a rollbackApp) StorageBackend() blockchain.StorageBackend {
+	return a.storage
+}
+
+func TestRollback_SeveralHeights(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		removeBlock bool
+	}{
+		{name: "keep block", removeBlock: false},
+		{name: "hard", removeBlock: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := cometbft.DefaultConfig()
+			cfg.SetRoot(t.TempDir())
+			app, blobs, stateAt := setupRollbackChain(t, cs, cfg)
+
+			const slots = 3
+			logger := phuslu.NewLogger(io.Discard, nil)
+			height, hash, err := server.Rollback(
+				context.Background(), logger, cfg, app, slots, tt.removeBlock,
+			)
+			require.NoError(t, err)
+			target := int64(rollbackHeights - slots)
+			require.Equal(t, target, height)
+
+			// CometBFT is back at the target height, with the app hash of the
+			// state committed at that height.
+			blockStore, stateStore := openCometStores(t, cfg)
+			defer blockStore.Close()
+			defer stateStore.Close()
+			cmtState, err := stateStore.Load()
+			require.NoError(t, err)
+			require.Equal(t, target, cmtState.LastBlockHeight)
+			require.Equal(t, appHash(target), hash)
+			require.Equal(t, appHash(target), []byte(cmtState.AppHash))
+			if tt.removeBlock {
+				require.Equal(t, target, blockStore.Height())
+			} else {
+				// The next block is kept, to be executed again on restart.
+				require.Equal(t, target+1, blockStore.Height())
+			}
+
+			// The beacon state is at the target slot.
+			require.Equal(t, target, app.cms.LastCommitID().Version)
+			slot, err := stateAt(t).GetSlot()
+			require.NoError(t, err)
+			require.Equal(t, math.Slot(target), slot)
+
+			// Blobs are kept up to the target slot only.
+			for s := int64(1); s <= rollbackHeights; s++ {
+				sidecars, errBlobs := blobs.GetBlobSidecars(math.Slot(s))
+				require.NoError(t, errBlobs)
+				if s <= target {
+					require.Len(t, sidecars, 1, "slot %d", s)
+				} else {
+					require.Empty(t, sidecars, "slot %d", s)
+				}
+			}
+		})
+	}
+}
+
+// setupRollbackChain commits rollbackHeights heights to the CometBFT stores
+// under cfg, the beacon state and the blob store, one blob per height. It
+// returns the app and its blob store, along with a function loading the
+// latest committed beacon state.
+func setupRollbackChain(t *testing.T, cs chain.Spec, cfg *cmtcfg.Config) (
+	rollbackApp, *dastore.Store, func(t *testing.T) *statedb.StateDB,
+) {
+	t.Helper()
+	sp, st, ds, ctx, cms, _ := statetransition.SetupTestState(t, cs)
+	stateFromContext := func(ctx context.Context) *statedb.StateDB {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		return statedb.NewBeaconStateFromDB(
+			st.KVStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+		)
+	}
+	stateAt := func(t *testing.T) *statedb.StateDB {
+		t.Helper()
+		return stateFromContext(sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger()))
+	}
+
+	// Genesis is committed along with the first height.
+	var (
+		credentials = ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		deposits    = ctypes.Deposits{
+			{Pubkey: [48]byte{0x01}, Credentials: credentials, Amount: cs.MaxEffectiveBalance(), Index: 0},
+		}
+		header = &ctypes.ExecutionPayloadHeader{
+			Versionable: ctypes.NewVersionable(cs.GenesisForkVersion()),
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), deposits))
+	_, err := sp.InitializeBeaconStateFromEth1(st, deposits, header, cs.GenesisForkVersion())
+	require.NoError(t, err)
+
+	logger := log.NewNopLogger()
+	blobs := dastore.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(filedb.WithRootDirectory(filepath.Join(cfg.RootDir, "blobs")),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(0700),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger,
+	)
+
+	sdkCtx := ctx.ConsensusCtx().(sdk.Context)
+	for h := int64(1); h <= rollbackHeights; h++ {
+		if h > 1 {
+			sdkCtx = sdk.NewContext(cms.CacheMultiStore(), true, logger)
+			st = stateFromContext(sdkCtx)
+		}
+		require.NoError(t, st.SetSlot(math.Slot(h)))
+		//nolint:errcheck // false positive as this has no return value
+		sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+		cms.Commit()
+
+		require.NoError(t, blobs.Persist(datypes.BlobSidecars{{
+			SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+				Header: &ctypes.BeaconBlockHeader{Slot: math.Slot(h)},
+			},
+			InclusionProof: make([]common.Root, ctypes.KZGInclusionProofDepth),
+		}}))
+	}
+	commitCometBlocks(t, cfg)
+
+	storage := bemocks.NewStorageBackend(t)
+	storage.EXPECT().StateFromContext(mock.Anything).RunAndReturn(stateFromContext)
+	storage.EXPECT().AvailabilityStore().Return(blobs)
+	storage.EXPECT().DepositStore().Return(ds)
+	return rollbackApp{cms: cms, storage: storage}, blobs, stateAt
+}
+
+// commitCometBlocks saves rollbackHeights blocks and the CometBFT state after
+// each of them. The app hash after height h is appHash(h).
+func commitCometBlocks(t *testing.T, cfg *cmtcfg.Config) {
+	t.Helper()
+	blockStore, stateStore := openCometStores(t, cfg)
+	defer func() {
+		require.NoError(t, blockStore.Close())
+		require.NoError(t, stateStore.Close())
+	}()
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	state, err := sm.MakeGenesisState(&cmttypes.GenesisDoc{
+		ChainID:     "rollback-test",
+		GenesisTime: time.Unix(1, 0),
+		Validators:  []cmttypes.GenesisValidator{{PubKey: pubKey, Power: 1}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+
+	lastCommit := &cmttypes.Commit{}
+	for h := int64(1); h <= rollbackHeights; h++ {
+		block := state.MakeBlock(h, nil, lastCommit, nil, pubKey.Address())
+		parts, errParts := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, errParts)
+		blockID := cmttypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit = &cmttypes.Commit{
+			Height:  h,
+			BlockID: blockID,
+			Signatures: []cmttypes.CommitSig{{
+				BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+				ValidatorAddress: pubKey.Address(),
+				Timestamp:        block.Time,
+				Signature:        []byte{0x01},
+			}},
+		}
+		blockStore.SaveBlock(block, parts, lastCommit)
+
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastValidators = state.Validators.Copy()
+		state.AppHash = appHash(h)
+		require.NoError(t, stateStore.Save(state))
+	}
+}
+
+func openCometStores(t *testing.T, cfg *cmtcfg.Config) (*cmtstore.BlockStore, sm.Store) {
+	t.Helper()
+	blockDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	require.NoError(t, err)
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+	return cmtstore.NewBlockStore(blockDB, cmtstore.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout)),
+		sm.NewStore(stateDB, sm.StoreOptions{})
+}
+
+func appHash(height int64) []byte {
+	return []byte{byte(height)}
+}
@@ -27,7 +27,6 @@ import (
 	clicontext "github.com/berachain/beacon-kit/cli/context"
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
-	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 )
 
@@ -45,6 +44,9 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -90,7 +92,7 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 			}
 
 			// Open the Database
-			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			db, err := db.OpenAppDB(cfg.RootDir, cfg.DBBackend)
 			if err != nil {
 				return err
 			}
@@ -151,6 +153,16 @@ func addStartNodeFlags(
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		Uint64(
+			FlagStateSyncSnapshotInterval,
+			0,
+			"State sync snapshot interval (0 to disable)")
+	cmd.Flags().
+		Uint32(
+			FlagStateSyncSnapshotKeepRecent,
+			2, //nolint:mnd // default from the SDK.
+			"State sync snapshot to keep")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
@@ -21,12 +21,16 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/cli/commands/db"
 	"github.com/berachain/beacon-kit/cli/commands/deposit"
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/initialize"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
+	"github.com/berachain/beacon-kit/cli/commands/replay"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/spec"
+	"github.com/berachain/beacon-kit/cli/commands/verify"
 	"github.com/berachain/beacon-kit/cli/flags"
 	cmtcli "github.com/berachain/beacon-kit/consensus/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
@@ -48,18 +52,26 @@ func DefaultRootCommandSetup(
 		initialize.InitCmd(chainSpecCreator, mm),
 		// `genesis`
 		genesis.Commands(chainSpecCreator),
+		// `db`
+		db.Commands(),
 		// `deposit`
 		deposit.Commands(chainSpecCreator, appCreator),
 		// `jwt`
 		jwt.Commands(),
+		// `replay`
+		replay.NewReplayCmd(chainSpecCreator, appCreator),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions{
 			AddFlags: flags.AddBeaconKitFlags,
 		}),
+		// `spec`
+		spec.Commands(),
 		// `status`
 		cmtcli.StatusCommand(),
+		// `verify-chain`
+		verify.NewVerifyChainCmd(chainSpecCreator, appCreator),
 		// `version`
 		version.NewVersionCommand(),
 	)
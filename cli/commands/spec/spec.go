@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	configspec "github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for managing chain specs.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "spec",
+		Short:                      "Chain spec subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewValidateSpecCommand(),
+	)
+
+	return cmd
+}
+
+// NewValidateSpecCommand creates a new command for validating a chain spec
+// file.
+//
+//nolint:lll // reads better if long description is one line
+func NewValidateSpecCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [path]",
+		Short: "Validates a chain spec file",
+		Long:  `This command loads a TOML or YAML chain spec file the same way the node does with --beacon-kit.chain-spec=file, reporting missing, unknown or inconsistent parameters.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cs, err := configspec.LoadFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Successfully validated chain spec %s\n", args[0])
+			cmd.Printf("  deposit chain ID:     %d\n", cs.DepositEth1ChainID())
+			cmd.Printf("  deposit contract:     %s\n", cs.DepositContractAddress())
+			cmd.Printf("  genesis fork version: %s (%s)\n",
+				cs.GenesisForkVersion(), version.Name(cs.GenesisForkVersion()))
+			cmd.Printf("  genesis time:         %d\n", cs.GenesisTime())
+			cmd.Printf("  deneb1 fork time:     %d\n", cs.Deneb1ForkTime())
+			cmd.Printf("  electra fork time:    %d\n", cs.ElectraForkTime())
+			cmd.Printf("  electra1 fork time:   %d\n", cs.Electra1ForkTime())
+			if cs.UpgradeName() != "" {
+				cmd.Printf("  upgrade %q time: %d\n", cs.UpgradeName(), cs.UpgradeTime())
+			}
+			return nil
+		},
+	}
+}
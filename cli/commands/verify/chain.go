@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package verify provides commands auditing the integrity of the data stored
+// by the node.
+package verify
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstore "github.com/cometbft/cometbft/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagFrom = "from"
+	FlagTo   = "to"
+
+	// head is the value of the to flag selecting the latest stored block.
+	head = "head"
+
+	// progressInterval is the number of slots between progress logs.
+	progressInterval = 10_000
+)
+
+var (
+	// ErrParentRootMismatch is returned when the parent root of a block is
+	// not the root of the header of the previous block.
+	ErrParentRootMismatch = errors.New("parent root mismatch")
+
+	// ErrInvalidProposerSignature is returned when the signature of a block
+	// does not verify against the pubkey of its proposer.
+	ErrInvalidProposerSignature = errors.New("invalid proposer signature")
+
+	// ErrStateRootMismatch is returned when the state root of a block is not
+	// the root of the state stored at its slot.
+	ErrStateRootMismatch = errors.New("state root mismatch")
+)
+
+// NewVerifyChainCmd creates a command walking the stored blocks and checking
+// the links between them.
+//
+//nolint:lll // reads better if long description is one line
+func NewVerifyChainCmd(
+	chainSpecCreator servertypes.ChainSpecCreator,
+	appCreator servertypes.AppCreator,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-chain",
+		Short: "Verifies the integrity of the stored chain",
+		Long:  `Walks the blocks of the CometBFT block store from the from slot to the to slot, which may be "head" for the latest stored block. Each block must link to the header of the previous one through its parent root, carry a valid signature by its proposer and commit to the state root of the state stored by the node at its slot. The first broken link is reported and the command fails. Checks requiring a state which has been pruned are skipped and counted. The node must be stopped.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, err := cmd.Flags().GetUint64(FlagFrom)
+			if err != nil {
+				return err
+			}
+			toFlag, err := cmd.Flags().GetString(FlagTo)
+			if err != nil {
+				return err
+			}
+
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			chainSpec, err := chainSpecCreator(v)
+			if err != nil {
+				return err
+			}
+
+			appDB, err := db.OpenAppDB(cfg.RootDir, cfg.DBBackend)
+			if err != nil {
+				return err
+			}
+			app := appCreator(logger, appDB, nil, cfg, v)
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(
+				&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to open CometBFT block store: %w", err)
+			}
+			blockStore := cmtstore.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			//#nosec:G115 // CometBFT heights are not negative.
+			to := uint64(blockStore.Height())
+			if toFlag != head {
+				if to, err = strconv.ParseUint(toFlag, 10, 64); err != nil {
+					return fmt.Errorf("invalid to slot %q, must be a slot or %q", toFlag, head)
+				}
+			}
+			// There is no block at the genesis slot, and CometBFT may have
+			// pruned the oldest blocks.
+			//#nosec:G115 // CometBFT heights are not negative.
+			from = max(from, uint64(blockStore.Base()), 1)
+			if to < from {
+				return fmt.Errorf("invalid slot range [%d, %d], no stored block to verify", from, to)
+			}
+
+			c := &chainVerifier{
+				cmd:        cmd,
+				logger:     logger,
+				chainSpec:  chainSpec,
+				cms:        app.CommitMultiStore(),
+				storage:    app.StorageBackend(),
+				processor:  app.StateProcessor(),
+				blockStore: blockStore,
+			}
+			return c.verify(math.Slot(from), math.Slot(to))
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFrom, 0, "first slot to verify")
+	cmd.Flags().String(FlagTo, head, `last slot to verify, or "head" for the latest stored block`)
+	return cmd
+}
+
+// ChainSpec is the chain spec used to decode the stored blocks.
+type ChainSpec interface {
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+}
+
+// chainVerifier walks the blocks of the CometBFT block store. Slots map to
+// CometBFT heights and to multistore versions.
+type chainVerifier struct {
+	cmd        *cobra.Command
+	logger     *phuslu.Logger
+	chainSpec  ChainSpec
+	cms        store.CommitMultiStore
+	storage    blockchain.StorageBackend
+	processor  blockchain.StateProcessor
+	blockStore *cmtstore.BlockStore
+
+	// skippedSignatures and skippedStateRoots count the checks skipped
+	// because the state they need has been pruned.
+	skippedSignatures uint64
+	skippedStateRoots uint64
+}
+
+func (c *chainVerifier) verify(from, to math.Slot) error {
+	// The parent of the first block is the latest header of the state
+	// preceding it, if it is still stored.
+	var parentRoot *common.Root
+	parent := c.stateAt(from - 1)
+	if parent != nil {
+		root, err := latestHeaderRoot(parent)
+		if err != nil {
+			return err
+		}
+		parentRoot = &root
+	}
+
+	for slot := from; slot <= to; slot++ {
+		signedBlk, err := c.blockAt(slot)
+		if err != nil {
+			return err
+		}
+		blk := signedBlk.GetBeaconBlock()
+
+		if parentRoot != nil && blk.GetParentBlockRoot() != *parentRoot {
+			return fmt.Errorf(
+				"%w at slot %d: block parent root %s, previous header root %s",
+				ErrParentRootMismatch, slot, blk.GetParentBlockRoot(), *parentRoot,
+			)
+		}
+
+		// The proposer is looked up in the state the block was built on.
+		if parent != nil {
+			if err = c.verifySignature(parent, signedBlk); err != nil {
+				return fmt.Errorf("%w at slot %d: %w", ErrInvalidProposerSignature, slot, err)
+			}
+		} else {
+			c.skippedSignatures++
+		}
+
+		stored := c.stateAt(slot)
+		if stored != nil {
+			if storedRoot := stored.HashTreeRoot(); blk.GetStateRoot() != storedRoot {
+				return fmt.Errorf(
+					"%w at slot %d: block state root %s, stored state root %s",
+					ErrStateRootMismatch, slot, blk.GetStateRoot(), storedRoot,
+				)
+			}
+		} else {
+			c.skippedStateRoots++
+		}
+
+		root := blk.GetHeader().HashTreeRoot()
+		parentRoot, parent = &root, stored
+		if (slot-from+1)%progressInterval == 0 {
+			c.logger.Info("Verifying chain", "slot", slot, "to", to)
+		}
+	}
+
+	c.cmd.Printf("Verified slots %d to %d without broken link\n", from, to)
+	if c.skippedSignatures > 0 || c.skippedStateRoots > 0 {
+		c.cmd.Printf(
+			"Skipped %d proposer signature and %d state root checks, the states have been pruned\n",
+			c.skippedSignatures, c.skippedStateRoots,
+		)
+	}
+	return nil
+}
+
+// blockAt decodes the beacon block stored at the given slot.
+func (c *chainVerifier) blockAt(slot math.Slot) (*ctypes.SignedBeaconBlock, error) {
+	//#nosec:G115 // slots are CometBFT heights.
+	cmtBlock, _ := c.blockStore.LoadBlock(int64(slot))
+	if cmtBlock == nil {
+		return nil, fmt.Errorf("block at slot %d not found in the CometBFT block store", slot)
+	}
+	//#nosec:G115 // block times are after the unix epoch.
+	consensusTime := math.U64(cmtBlock.Time.Unix())
+	signedBlk, err := encoding.UnmarshalBeaconBlockFromABCIRequest(
+		cmtBlock.Txs.ToSliceOfBytes(),
+		blockchain.BeaconBlockTxIndex,
+		c.chainSpec.ActiveForkVersionForTimestamp(consensusTime),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode block at slot %d: %w", slot, err)
+	}
+	return signedBlk, nil
+}
+
+// verifySignature verifies the signature of the block against the pubkey of
+// its proposer in the given state.
+func (c *chainVerifier) verifySignature(
+	st *statedb.StateDB, signedBlk *ctypes.SignedBeaconBlock,
+) error {
+	verifierFn, err := c.processor.GetSignatureVerifierFn(st)
+	if err != nil {
+		return err
+	}
+	return verifierFn(signedBlk.GetBeaconBlock(), signedBlk.GetSignature())
+}
+
+// stateAt returns the state stored by the node at the given slot, or nil if
+// it has been pruned.
+func (c *chainVerifier) stateAt(slot math.Slot) *statedb.StateDB {
+	//#nosec:G115 // slots are multistore versions.
+	ms, err := c.cms.CacheMultiStoreWithVersion(int64(slot))
+	if err != nil {
+		return nil
+	}
+	ctx := sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(c.logger)).
+		WithContext(c.cmd.Context())
+	return c.storage.StateFromContext(ctx)
+}
+
+// latestHeaderRoot returns the root of the latest block header of the state,
+// with the state root filled in as the following slot processing does.
+func latestHeaderRoot(st *statedb.StateDB) (common.Root, error) {
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	if header.GetStateRoot() == (common.Root{}) {
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	return header.HashTreeRoot(), nil
+}
@@ -37,6 +37,8 @@ const (
 	SuggestedFeeRecipient = builderRoot + "suggested-fee-recipient"
 	BuilderEnabled        = builderRoot + "enabled"
 	BuildPayloadTimeout   = builderRoot + "payload-timeout"
+	AdaptivePayloadDelay  = builderRoot + "adaptive-payload-delay"
+	MinPayloadDelay       = builderRoot + "min-payload-delay"
 
 	// Validator Config.
 	validatorRoot = beaconKitRoot + "validator."
@@ -48,16 +50,32 @@ const (
 	RPCRetryInterval        = engineRoot + "rpc-retry-interval"
 	RPCMaxRetryInterval     = engineRoot + "rpc-max-retry-interval"
 	RPCTimeout              = engineRoot + "rpc-timeout"
+	RPCNewPayloadTimeout    = engineRoot + "rpc-new-payload-timeout"
+	RPCForkchoiceTimeout    = engineRoot + "rpc-forkchoice-updated-timeout"
+	RPCGetPayloadTimeout    = engineRoot + "rpc-get-payload-timeout"
+	RPCGetPayloadRetries    = engineRoot + "rpc-get-payload-retries"
+	RPCMaxIdleConns         = engineRoot + "rpc-max-idle-conns"
+	RPCIdleConnTimeout      = engineRoot + "rpc-idle-conn-timeout"
+	RPCKeepAlive            = engineRoot + "rpc-keep-alive"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	VerificationRPCDialURL  = engineRoot + "verification-rpc-dial-url"
+	VerificationJWTPath     = engineRoot + "verification-jwt-secret-path"
+	HaltOnVerifyMismatch    = engineRoot + "halt-on-verification-mismatch"
+	AllowedClientVersions   = engineRoot + "allowed-client-versions"
+	DeniedClientVersions    = engineRoot + "denied-client-versions"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
 	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
 	KZGImplementation   = kzgRoot + "implementation"
 
+	// DA Config.
+	daRoot          = beaconKitRoot + "da."
+	DABlobRetention = daRoot + "blob-retention"
+
 	// Logger Config.
 	loggerRoot = beaconKitRoot + "logger."
 	TimeFormat = loggerRoot + "time-format"
@@ -106,6 +124,41 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Duration(
 		RPCTimeout, defaultCfg.Engine.RPCTimeout, "rpc timeout",
 	)
+	startCmd.Flags().Duration(
+		RPCNewPayloadTimeout,
+		defaultCfg.Engine.RPCNewPayloadTimeout,
+		"engine_newPayload timeout, rpc timeout if zero",
+	)
+	startCmd.Flags().Duration(
+		RPCForkchoiceTimeout,
+		defaultCfg.Engine.RPCForkchoiceUpdatedTimeout,
+		"engine_forkchoiceUpdated timeout, rpc timeout if zero",
+	)
+	startCmd.Flags().Duration(
+		RPCGetPayloadTimeout,
+		defaultCfg.Engine.RPCGetPayloadTimeout,
+		"engine_getPayload timeout, rpc timeout if zero",
+	)
+	startCmd.Flags().Uint64(
+		RPCGetPayloadRetries,
+		defaultCfg.Engine.RPCGetPayloadRetries,
+		"number of retries of timed out engine_getPayload calls",
+	)
+	startCmd.Flags().Int(
+		RPCMaxIdleConns,
+		defaultCfg.Engine.RPCMaxIdleConns,
+		"idle connections kept open to the execution client",
+	)
+	startCmd.Flags().Duration(
+		RPCIdleConnTimeout,
+		defaultCfg.Engine.RPCIdleConnTimeout,
+		"how long idle connections to the execution client are kept open",
+	)
+	startCmd.Flags().Duration(
+		RPCKeepAlive,
+		defaultCfg.Engine.RPCKeepAlive,
+		"tcp keep-alive period of connections to the execution client",
+	)
 	startCmd.Flags().Duration(
 		RPCStartupCheckInterval,
 		defaultCfg.Engine.RPCStartupCheckInterval,
@@ -116,6 +169,31 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().String(
+		VerificationRPCDialURL,
+		defaultCfg.Engine.VerificationRPCDialURL.String(),
+		"rpc dial url of the execution client payloads are cross-validated against",
+	)
+	startCmd.Flags().String(
+		VerificationJWTPath,
+		defaultCfg.Engine.VerificationJWTSecretPath,
+		"path to the verification execution client secret",
+	)
+	startCmd.Flags().Bool(
+		HaltOnVerifyMismatch,
+		defaultCfg.Engine.HaltOnVerificationMismatch,
+		"stop proposing once the execution clients disagree on a payload",
+	)
+	startCmd.Flags().StringSlice(
+		AllowedClientVersions,
+		defaultCfg.Engine.AllowedClientVersions,
+		"execution client versions known to be compatible, as name/version[@fork]",
+	)
+	startCmd.Flags().StringSlice(
+		DeniedClientVersions,
+		defaultCfg.Engine.DeniedClientVersions,
+		"execution client versions to refuse proposing with, as name/version[@fork]",
+	)
 	startCmd.Flags().Bool(
 		BuilderEnabled,
 		defaultCfg.PayloadBuilder.Enabled,
@@ -126,6 +204,16 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.PayloadBuilder.PayloadTimeout,
 		"payload builder timeout",
 	)
+	startCmd.Flags().Bool(
+		AdaptivePayloadDelay,
+		defaultCfg.PayloadBuilder.AdaptivePayloadDelay,
+		"tune the payload builder delay from recent getPayload latencies",
+	)
+	startCmd.Flags().Duration(
+		MinPayloadDelay,
+		defaultCfg.PayloadBuilder.MinPayloadDelay,
+		"minimum adaptive payload builder delay",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
@@ -141,6 +229,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.KZG.Implementation,
 		"kzg implementation",
 	)
+	startCmd.Flags().String(
+		DABlobRetention,
+		defaultCfg.DA.BlobRetention,
+		"blob sidecar retention, e.g. 30d",
+	)
 	startCmd.Flags().String(
 		TimeFormat,
 		defaultCfg.Logger.TimeFormat,
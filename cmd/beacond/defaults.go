@@ -26,33 +26,47 @@ import (
 
 func DefaultComponents() []any {
 	c := []any{
+		components.ProvideAdminService,
+		components.ProvideWatchdogService,
 		components.ProvideAttributesFactory,
 		components.ProvideAvailabilityStore,
 		components.ProvideDepositContract,
+		components.ProvideDepositReconciler,
 		components.ProvideBlockStore,
 		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor,
 		components.ProvideBlobProofVerifier,
 		components.ProvideChainService,
+		components.ProvideEventBus,
 		components.ProvideNode,
 		components.ProvideConfig,
 		components.ProvideServerConfig,
+		components.ProvideDBRegistry,
 		components.ProvideDepositStore,
+		components.ProvideProposalStore,
+		components.ProvideDiskUsageService,
 		components.ProvideEngineClient,
 		components.ProvideExecutionEngine,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
+		components.ProvideReloadService,
 		components.ProvideReportingService,
 		components.ProvideCometBFTService,
 		components.ProvideServiceRegistry,
 		components.ProvideSidecarFactory,
+		components.ProvideSlotTimings,
+		components.ProvideValidatorPerformance,
 		components.ProvideStateProcessor,
 		components.ProvideKVStore,
 		components.ProvideStorageBackend,
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTracingService,
 		components.ProvideTrustedSetup,
 		components.ProvideValidatorService,
+		components.ProvideVerifier,
+		components.ProvideOptimisticTracker,
+		components.ProvideVoteExtensions,
 		components.ProvideShutDownService,
 	}
 	c = append(c,
@@ -64,12 +78,18 @@ func DefaultComponents() []any {
 	c = append(c,
 		components.ProvideNodeAPIHandlers,
 		components.ProvideNodeAPIBeaconHandler,
+		components.ProvideNodeAPIBlocksHandler,
 		components.ProvideNodeAPIBuilderHandler,
 		components.ProvideNodeAPIConfigHandler,
 		components.ProvideNodeAPIDebugHandler,
+		components.ProvideNodeAPIDepositsHandler,
 		components.ProvideNodeAPIEventsHandler,
+		components.ProvideNodeAPIFeesHandler,
+		components.ProvideNodeAPIHealthHandler,
 		components.ProvideNodeAPINodeHandler,
 		components.ProvideNodeAPIProofHandler,
+		components.ProvideNodeAPIStatsHandler,
+		components.ProvideNodeAPIValidatorHandler,
 	)
 
 	return c
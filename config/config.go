@@ -27,11 +27,17 @@ import (
 	"github.com/berachain/beacon-kit/config/template"
 	viperlib "github.com/berachain/beacon-kit/config/viper"
 	"github.com/berachain/beacon-kit/da/kzg"
+	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/errors"
 	engineclient "github.com/berachain/beacon-kit/execution/client"
 	log "github.com/berachain/beacon-kit/log/phuslu"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-core/services/admin"
+	"github.com/berachain/beacon-kit/node-core/services/depositreconciler"
+	"github.com/berachain/beacon-kit/node-core/services/diskusage"
+	"github.com/berachain/beacon-kit/node-core/services/watchdog"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
@@ -51,16 +57,22 @@ type AppOptions interface {
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
-		ChainSpec:         DefaultChainSpec,
-		ChainSpecFilePath: DefaultChainSpecFilePath,
-		ShutdownTimeout:   defaultShutdownTimeout,
-		Engine:            engineclient.DefaultConfig(),
-		Logger:            log.DefaultConfig(),
-		KZG:               kzg.DefaultConfig(),
-		PayloadBuilder:    builder.DefaultConfig(),
-		Validator:         validator.DefaultConfig(),
-		BlockStoreService: blockstore.DefaultConfig(),
-		NodeAPI:           server.DefaultConfig(),
+		ChainSpec:             DefaultChainSpec,
+		ChainSpecFilePath:     DefaultChainSpecFilePath,
+		ShutdownTimeout:       defaultShutdownTimeout,
+		Engine:                engineclient.DefaultConfig(),
+		Logger:                log.DefaultConfig(),
+		KZG:                   kzg.DefaultConfig(),
+		DA:                    dastore.DefaultConfig(),
+		PayloadBuilder:        builder.DefaultConfig(),
+		Validator:             validator.DefaultConfig(),
+		BlockStoreService:     blockstore.DefaultConfig(),
+		NodeAPI:               server.DefaultConfig(),
+		Tracing:               tracing.DefaultConfig(),
+		Admin:                 admin.DefaultConfig(),
+		Watchdog:              watchdog.DefaultConfig(),
+		DiskUsage:             diskusage.DefaultConfig(),
+		DepositReconciliation: depositreconciler.DefaultConfig(),
 	}
 }
 
@@ -79,6 +91,8 @@ type Config struct {
 	Logger log.Config `mapstructure:"logger"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// DA is the configuration for the blob sidecar store.
+	DA dastore.Config `mapstructure:"da"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
 	// Validator is the configuration for the validator client.
@@ -87,6 +101,17 @@ type Config struct {
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Tracing is the configuration for exporting block lifecycle traces.
+	Tracing tracing.Config `mapstructure:"tracing"`
+	// Admin is the configuration for the loopback-only admin server.
+	Admin admin.Config `mapstructure:"admin"`
+	// Watchdog is the configuration for the finality stall watchdog.
+	Watchdog watchdog.Config `mapstructure:"watchdog"`
+	// DiskUsage is the configuration for the disk usage monitor.
+	DiskUsage diskusage.Config `mapstructure:"disk-usage"`
+	// DepositReconciliation is the configuration for reconciling the deposit
+	// store against the deposit contract.
+	DepositReconciliation depositreconciler.Config `mapstructure:"deposit-reconciliation"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -114,6 +139,19 @@ func (c Config) Template() string {
 	return template.TomlTemplate
 }
 
+// ReloadConfigFromAppOpts re-reads the config file backing the given
+// application options and returns the resulting configuration.
+func ReloadConfigFromAppOpts(opts AppOptions) (*Config, error) {
+	v, ok := opts.(*viper.Viper)
+	if !ok {
+		return nil, errors.New("invalid application options type")
+	}
+	if err := v.MergeInConfig(); err != nil {
+		return nil, err
+	}
+	return ReadConfigFromAppOpts(v)
+}
+
 // ReadConfigFromAppOpts reads the configuration options from the given
 // application options.
 func ReadConfigFromAppOpts(opts AppOptions) (*Config, error) {
@@ -99,4 +99,20 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 
 # DatadogHostname defines the hostname to use when emitting metrics to
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
-datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
\ No newline at end of file
+datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
+###############################################################################
+###                         State Sync Configuration                        ###
+###############################################################################
+
+# State sync snapshots allow other nodes to rapidly join the network without
+# replaying historical blocks, instead downloading and applying a snapshot of
+# the beacon state and deposits at a given height.
+[state-sync]
+
+# snapshot-interval specifies the block interval at which local state sync
+# snapshots are taken (0 to disable).
+snapshot-interval = {{ .StateSync.SnapshotInterval }}
+
+# snapshot-keep-recent specifies the number of recent snapshots to keep and
+# serve (0 to keep all).
+snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
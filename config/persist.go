@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// ErrConfigKeyNotFound is returned when the key to update is not present in
+// the config file.
+var ErrConfigKeyNotFound = errors.New("key not found in config file")
+
+// AppConfigFile returns the path of the app.toml file of the node home.
+func AppConfigFile(homeDir string) string {
+	return filepath.Join(homeDir, "config", "app.toml")
+}
+
+// UpdateConfigFileValue sets key in the given section of the app.toml file of
+// the node home to the quoted value. The file is resolved explicitly, as on
+// the first run the config file known to viper is CometBFT's config.toml.
+// Only that line is rewritten, so comments and the other settings are left
+// untouched. The file is replaced atomically.
+func UpdateConfigFileValue(homeDir, section, key, value string) error {
+	path := AppConfigFile(homeDir)
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var (
+		out     bytes.Buffer
+		current string
+		found   bool
+		header  = "[" + section + "]"
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(bz))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			current = trimmed
+		}
+		if name, _, isKV := strings.Cut(trimmed, "="); isKV && !found &&
+			current == header && strings.TrimSpace(name) == key {
+			line = fmt.Sprintf("%s = %q", key, value)
+			found = true
+		}
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+	if !found {
+		return errors.Wrapf(ErrConfigKeyNotFound, "%s.%s in %s", section, key, path)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(out.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Chmod(info.Mode().Perm()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
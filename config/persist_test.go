@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/berachain/beacon-kit/config"
+	cfgtemplate "github.com/berachain/beacon-kit/config/template"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateConfigFileValue(t *testing.T) {
+	t.Parallel()
+	home := t.TempDir()
+	path := config.AppConfigFile(home)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(`[beacon-kit.engine]
+suggested-fee-recipient = "engine"
+
+[beacon-kit.payload-builder]
+# Receives the transaction fees.
+suggested-fee-recipient = "0x0000000000000000000000000000000000000000"
+`), 0o600))
+
+	require.NoError(t, config.UpdateConfigFileValue(
+		home, "beacon-kit.payload-builder", "suggested-fee-recipient", "0xaa",
+	))
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `[beacon-kit.engine]
+suggested-fee-recipient = "engine"
+
+[beacon-kit.payload-builder]
+# Receives the transaction fees.
+suggested-fee-recipient = "0xaa"
+`, string(bz))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	err = config.UpdateConfigFileValue(home, "beacon-kit.payload-builder", "missing", "x")
+	require.ErrorIs(t, err, config.ErrConfigKeyNotFound)
+}
+
+// TestUpdateConfigFileValue_FreshHome updates a home as left by the first
+// run of a node, where app.toml has just been written from the template and
+// the config file known to viper is CometBFT's config.toml.
+func TestUpdateConfigFileValue_FreshHome(t *testing.T) {
+	t.Parallel()
+	home := t.TempDir()
+	configDir := filepath.Join(home, "config")
+	require.NoError(t, os.MkdirAll(configDir, 0o755))
+	cometConfig := filepath.Join(configDir, "config.toml")
+	require.NoError(t, os.WriteFile(cometConfig, []byte("moniker = \"node\"\n"), 0o600))
+	v := viper.New()
+	v.SetConfigFile(cometConfig)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cometConfig, v.ConfigFileUsed())
+
+	tmpl, err := template.New("app").Parse(cfgtemplate.TomlTemplate)
+	require.NoError(t, err)
+	var app bytes.Buffer
+	require.NoError(t, tmpl.Execute(&app, struct{ BeaconKit *config.Config }{
+		BeaconKit: config.DefaultConfig(),
+	}))
+	require.NoError(t, os.WriteFile(config.AppConfigFile(home), app.Bytes(), 0o600))
+
+	const addr = "0x00000000000000000000000000000000000000Aa"
+	require.NoError(t, config.UpdateConfigFileValue(
+		home, "beacon-kit.payload-builder", "suggested-fee-recipient", addr,
+	))
+
+	updated := viper.New()
+	updated.SetConfigFile(config.AppConfigFile(home))
+	require.NoError(t, updated.ReadInConfig())
+	require.Equal(t, addr, updated.GetString("beacon-kit.payload-builder.suggested-fee-recipient"))
+
+	// CometBFT's config is left untouched.
+	bz, err := os.ReadFile(cometConfig)
+	require.NoError(t, err)
+	require.Equal(t, "moniker = \"node\"\n", string(bz))
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/net/url"
+)
+
+const (
+	// redactTag is the struct tag used to mark config fields whose values must
+	// never be exposed outside of the node, e.g. `redact:"true"`.
+	redactTag = "redact"
+	// RedactedValue is the placeholder returned in place of a secret value.
+	RedactedValue = "<redacted>"
+)
+
+// Redacted returns the effective configuration as a map keyed by the same names
+// used in app.toml, with any secret values replaced by RedactedValue. Fields
+// tagged with `redact:"true"` are always redacted, and credentials embedded in
+// URLs are masked.
+func (c Config) Redacted() map[string]any {
+	return redactStruct(reflect.ValueOf(c))
+}
+
+// redactStruct converts the given struct value into a map keyed by the
+// mapstructure tag of each field, redacting secrets along the way.
+func redactStruct(v reflect.Value) map[string]any {
+	t := v.Type()
+	out := make(map[string]any, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		key := field.Tag.Get("mapstructure")
+		if !field.IsExported() || key == "" || key == "-" {
+			continue
+		}
+		if field.Tag.Get(redactTag) == "true" {
+			out[key] = RedactedValue
+			continue
+		}
+		out[key] = redactValue(v.Field(i))
+	}
+	return out
+}
+
+// redactValue converts a single config value into its displayable form.
+func redactValue(v reflect.Value) any {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil
+	}
+	switch val := v.Interface().(type) {
+	case *url.ConnectionURL:
+		// Redacted masks any password present in the URL user info.
+		return val.Redacted()
+	case time.Duration:
+		return val.String()
+	case fmt.Stringer:
+		return val.String()
+	}
+	if v.Kind() == reflect.Struct {
+		return redactStruct(v)
+	}
+	return v.Interface()
+}
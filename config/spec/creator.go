@@ -23,12 +23,15 @@ package spec
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"reflect"
+	"strings"
 
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/cli/commands/server/types"
 	"github.com/berachain/beacon-kit/cli/flags"
 	viperlib "github.com/berachain/beacon-kit/config/viper"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
 	"github.com/spf13/viper"
@@ -41,6 +44,20 @@ const (
 	file    = "file"
 )
 
+// ErrUnsupportedSpecFileFormat is returned when the chain spec file extension
+// is neither TOML nor YAML.
+var ErrUnsupportedSpecFileFormat = errors.New("unsupported chain spec file format")
+
+// optionalSpecKeys are the keys spec files may omit, with their defaults.
+// They were added after chains wrote their spec files, and default to the
+// values those chains ran with.
+//
+//nolint:gochecknoglobals // read-only lookup table.
+var optionalSpecKeys = map[string]any{
+	"max-txs-per-payload": constants.MaxTxsPerPayload,
+	"max-bytes-per-tx":    constants.MaxBytesPerTx,
+}
+
 // Create creates a chain spec based on the app options config flag for "chain-spec".
 // If unset, the default of "mainnet" chain spec is used.
 func Create(appOpts types.AppOptions) (chain.Spec, error) {
@@ -75,20 +92,36 @@ func handleChainSpecFile(appOpts types.AppOptions) (chain.Spec, error) {
 	if specPath == "" {
 		return nil, fmt.Errorf("expected flag '%s' for chain spec", flags.ChainSpecFilePath)
 	}
-	specData, err := loadSpecData(specPath)
+	return LoadFromFile(specPath)
+}
+
+// LoadFromFile loads and validates the chain spec stored in the TOML or YAML
+// file at the given path.
+func LoadFromFile(path string) (chain.Spec, error) {
+	specData, err := loadSpecData(path)
 	if err != nil {
 		return nil, err
 	}
 	return chain.NewSpec(specData)
 }
 
-// loadSpecData reads the TOML chain-spec file from the given path using Viper,
-// unmarshals it into a SpecData, and validates that all required fields are set.
+// loadSpecData reads the TOML or YAML chain-spec file from the given path using
+// Viper, unmarshals it into a SpecData, and validates that all required fields
+// are set and that no unknown fields are present. Optional fields that are not
+// set take their defaults from optionalSpecKeys.
 func loadSpecData(path string) (*chain.SpecData, error) {
+	configType, err := specFileType(path)
+	if err != nil {
+		return nil, err
+	}
+
 	v := viper.New()
+	for key, value := range optionalSpecKeys {
+		v.SetDefault(key, value)
+	}
 	v.SetConfigFile(path)
-	v.SetConfigType("toml")
-	if err := v.ReadInConfig(); err != nil {
+	v.SetConfigType(configType)
+	if err = v.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config: %w", err)
 	}
 
@@ -107,9 +140,23 @@ func loadSpecData(path string) (*chain.SpecData, error) {
 		viperlib.StringToExecutionAddressFunc(),
 		viperlib.NumericToDomainTypeFunc(),
 	)
-	if err := v.Unmarshal(&specData, viper.DecodeHook(decodeHookFunc)); err != nil {
+	// Reject unknown keys, which are most likely misspelled parameters.
+	errorUnused := func(c *mapstructure.DecoderConfig) { c.ErrorUnused = true }
+	if err = v.Unmarshal(&specData, viper.DecodeHook(decodeHookFunc), errorUnused); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config into SpecData: %w", err)
 	}
 
 	return &specData, nil
 }
+
+// specFileType returns the Viper config type matching the file extension.
+func specFileType(path string) (string, error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".toml":
+		return "toml", nil
+	case ".yaml", ".yml":
+		return "yaml", nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedSpecFileFormat, ext)
+	}
+}
@@ -21,10 +21,14 @@
 package spec_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/berachain/beacon-kit/cli/flags"
 	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/stretchr/testify/require"
 )
 
@@ -127,3 +131,81 @@ func TestCreateChainSpec_File(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, devnetSpec, dcs, "the chain spec loaded from TOML does not match the devnet spec")
 }
+
+func TestLoadFromFile_YAML(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.LoadFromFile("../../testing/files/spec.yaml")
+	require.NoError(t, err)
+
+	devnetSpec, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	require.Equal(t, devnetSpec, cs, "the chain spec loaded from YAML does not match the devnet spec")
+}
+
+func TestLoadFromFile_DefaultTransactionLimits(t *testing.T) {
+	t.Parallel()
+
+	devnetTOML, err := os.ReadFile("../../testing/files/spec.toml")
+	require.NoError(t, err)
+	contents := strings.Replace(string(devnetTOML), "max-txs-per-payload = 1_048_576\n", "", 1)
+	contents = strings.Replace(contents, "max-bytes-per-tx = 1_073_741_824\n", "", 1)
+	require.NotContains(t, contents, "max-txs-per-payload")
+	require.NotContains(t, contents, "max-bytes-per-tx")
+	path := filepath.Join(t.TempDir(), "spec.toml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+	cs, err := spec.LoadFromFile(path)
+	require.NoError(t, err)
+	require.Equal(t, constants.MaxTxsPerPayload, cs.MaxTxsPerPayload())
+	require.Equal(t, constants.MaxBytesPerTx, cs.MaxBytesPerTx())
+}
+
+func TestLoadFromFile_Invalid(t *testing.T) {
+	t.Parallel()
+
+	devnetTOML, err := os.ReadFile("../../testing/files/spec.toml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		fileName string
+		contents string
+		errMsg   string
+	}{
+		{
+			name:     "unsupported extension",
+			fileName: "spec.json",
+			contents: "{}",
+			errMsg:   spec.ErrUnsupportedSpecFileFormat.Error(),
+		},
+		{
+			name:     "missing key",
+			fileName: "spec.toml",
+			contents: strings.Replace(string(devnetTOML), "slots-per-epoch = 32\n", "", 1),
+			errMsg:   "missing required configuration for key: slots-per-epoch",
+		},
+		{
+			name:     "unknown key",
+			fileName: "spec.toml",
+			contents: string(devnetTOML) + "slots-per-epoc = 32\n",
+			errMsg:   "slots-per-epoc",
+		},
+		{
+			name:     "unordered forks",
+			fileName: "spec.toml",
+			contents: strings.Replace(string(devnetTOML), "genesis-time = 0\n", "genesis-time = 10\n", 1),
+			errMsg:   "fork ordering violation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			require.NoError(t, os.WriteFile(path, []byte(tt.contents), 0o600))
+
+			_, err := spec.LoadFromFile(path)
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
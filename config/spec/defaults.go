@@ -61,6 +61,10 @@ const (
 	defaultHistoricalRootsLimit      = 8
 	defaultValidatorRegistryLimit    = 1099511627776
 
+	// Bellatrix values.
+	defaultMaxTxsPerPayload = 1 << 20
+	defaultMaxBytesPerTx    = 1 << 30
+
 	// Capella values.
 	defaultMaxWithdrawalsPerPayload         = 16
 	defaultMaxValidatorsPerWithdrawalsSweep = 1 << 14
@@ -73,9 +77,18 @@ const (
 	defaultBytesPerBlob                     = 131072
 
 	// Berachain values.
-	defaultValidatorSetCap      = 256
-	defaultEVMInflationAddress  = "0x0000000000000000000000000000000000000000"
-	defaultEVMInflationPerBlock = 0
+	defaultValidatorSetCap                 = 256
+	defaultEVMInflationAddress             = "0x0000000000000000000000000000000000000000"
+	defaultEVMInflationPerBlock            = 0
+	defaultMaxValidatorActivationsPerEpoch = 0
+	defaultMaxValidatorExitsPerEpoch       = 0
+	defaultMinSlashingPenaltyQuotient      = 0
+	defaultDowntimeJailThreshold           = 0
+	defaultMinJailDuration                 = 0
+
+	// Upgrade values, no upgrade is scheduled by default.
+	defaultUpgradeName = ""
+	defaultUpgradeTime = 0
 
 	// Electra values.
 	defaultMinValidatorWithdrawabilityDelay = 256
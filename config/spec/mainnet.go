@@ -139,6 +139,8 @@ func MainnetChainSpecData() *chain.SpecData {
 		Deneb1ForkTime:   mainnetDeneb1ForkTime,
 		ElectraForkTime:  mainnetElectraForkTime,
 		Electra1ForkTime: mainnetElectra1ForkTime,
+		UpgradeName:      defaultUpgradeName,
+		UpgradeTime:      defaultUpgradeTime,
 
 		// State list length constants.
 		EpochsPerHistoricalVector: defaultEpochsPerHistoricalVector,
@@ -146,6 +148,10 @@ func MainnetChainSpecData() *chain.SpecData {
 		HistoricalRootsLimit:      defaultHistoricalRootsLimit,
 		ValidatorRegistryLimit:    defaultValidatorRegistryLimit,
 
+		// Bellatrix values.
+		MaxTxsPerPayload: defaultMaxTxsPerPayload,
+		MaxBytesPerTx:    defaultMaxBytesPerTx,
+
 		// Capella values.
 		MaxWithdrawalsPerPayload:         defaultMaxWithdrawalsPerPayload,
 		MaxValidatorsPerWithdrawalsSweep: mainnetMaxValidatorsPerWithdrawalsSweep,
@@ -158,9 +164,14 @@ func MainnetChainSpecData() *chain.SpecData {
 		BytesPerBlob:                     defaultBytesPerBlob,
 
 		// Berachain values at genesis.
-		ValidatorSetCap:             mainnetValidatorSetCap,
-		EVMInflationAddressGenesis:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddress),
-		EVMInflationPerBlockGenesis: mainnetEVMInflationPerBlock,
+		ValidatorSetCap:                 mainnetValidatorSetCap,
+		MaxValidatorActivationsPerEpoch: defaultMaxValidatorActivationsPerEpoch,
+		MaxValidatorExitsPerEpoch:       defaultMaxValidatorExitsPerEpoch,
+		MinSlashingPenaltyQuotient:      defaultMinSlashingPenaltyQuotient,
+		DowntimeJailThreshold:           defaultDowntimeJailThreshold,
+		MinJailDuration:                 defaultMinJailDuration,
+		EVMInflationAddressGenesis:      common.NewExecutionAddressFromHex(mainnetEVMInflationAddress),
+		EVMInflationPerBlockGenesis:     mainnetEVMInflationPerBlock,
 
 		// Deneb1 values.
 		EVMInflationAddressDeneb1:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddressDeneb1),
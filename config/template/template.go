@@ -37,21 +37,62 @@ chain-spec-file = "{{ .BeaconKit.ChainSpecFilePath }}"
 shutdown-timeout = "{{ .BeaconKit.ShutdownTimeout }}"
 
 [beacon-kit.engine]
-# HTTP url of the execution client JSON-RPC endpoint.
+# HTTP url of the execution client JSON-RPC endpoint. An ipc:// url or an
+# absolute socket path, e.g. /var/run/geth.ipc, talks to the engine API over a
+# Unix socket instead, without HTTP and JWT authentication.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
 # RPC timeout for execution client requests.
 rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 
+# Timeouts of the individual engine API methods. A zero value uses rpc-timeout.
+# getPayload usually needs a much tighter deadline than newPayload, which may
+# take long while the execution client is syncing.
+rpc-new-payload-timeout = "{{ .BeaconKit.Engine.RPCNewPayloadTimeout }}"
+rpc-forkchoice-updated-timeout = "{{ .BeaconKit.Engine.RPCForkchoiceUpdatedTimeout }}"
+rpc-get-payload-timeout = "{{ .BeaconKit.Engine.RPCGetPayloadTimeout }}"
+
+# Number of times a timed out getPayload call is retried, with jittered backoff.
+rpc-get-payload-retries = {{ .BeaconKit.Engine.RPCGetPayloadRetries }}
+
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Idle connections kept open to the execution client, and for how long, so
+# that engine API calls do not pay for new TCP and TLS handshakes.
+rpc-max-idle-conns = {{ .BeaconKit.Engine.RPCMaxIdleConns }}
+rpc-idle-conn-timeout = "{{ .BeaconKit.Engine.RPCIdleConnTimeout }}"
+
+# TCP keep-alive period of the connections to the execution client.
+rpc-keep-alive = "{{ .BeaconKit.Engine.RPCKeepAlive }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Url of an optional second execution client, ideally a different
+# implementation, that every payload is also validated against. Disagreements
+# are logged as critical errors. Leave empty to disable cross-validation.
+verification-rpc-dial-url = "{{ .BeaconKit.Engine.VerificationRPCDialURL }}"
+
+# Path to the JWT-secret of the verification execution client. Empty uses
+# jwt-secret-path.
+verification-jwt-secret-path = "{{.BeaconKit.Engine.VerificationJWTSecretPath}}"
+
+# Stop proposing blocks once the execution clients disagree on a payload.
+halt-on-verification-mismatch = {{ .BeaconKit.Engine.HaltOnVerificationMismatch }}
+
+# Execution client versions, as reported by engine_getClientVersionV1, written
+# as "name/version[@fork]". A trailing '*' in the version matches a prefix, and
+# a fork restricts the entry to that fork, e.g. "reth/v1.3.*@electra".
+# Versions outside a non-empty allow list are warned about on connection.
+allowed-client-versions = [{{ range $i, $v := .BeaconKit.Engine.AllowedClientVersions }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+# Block proposals are refused while connected to a denied version at the
+# active fork.
+denied-client-versions = [{{ range $i, $v := .BeaconKit.Engine.DeniedClientVersions }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -60,9 +101,16 @@ time-format = "{{.BeaconKit.Logger.TimeFormat}}"
 # to LogLevel.
 log-level = "{{.BeaconKit.Logger.LogLevel}}"
 
-# Style is the style of the logger.
+# Style is the style of the logger. Options are "pretty", "json" and
+# "structured", the latter writing single-line JSON records with ts, level,
+# module, msg and fields keys.
 style = "{{.BeaconKit.Logger.Style}}"
 
+# ModuleLevels overrides log-level for named modules (engine-client, da,
+# blockchain, node-api), e.g. "engine-client=debug,da=warn". Reloaded on
+# SIGHUP.
+module-levels = "{{.BeaconKit.Logger.ModuleLevels}}"
+
 [beacon-kit.kzg]
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
@@ -71,6 +119,12 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+[beacon-kit.da]
+# BlobRetention is how long blob sidecars are retained, e.g. "30d" or "72h".
+# Blobs are never retained for less than MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS,
+# which is also what an empty value resolves to.
+blob-retention = "{{.BeaconKit.DA.BlobRetention}}"
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 # It should be enabled for validators, but it can be disabled
@@ -78,7 +132,8 @@ implementation = "{{.BeaconKit.KZG.Implementation}}"
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
 
 # Post bellatrix, this address will receive the transaction fees produced by any blocks
-# from this node.
+# from this node. It can be changed without a restart through PUT
+# /admin/fee-recipient on the admin server, which also rewrites this line.
 suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 
 # The timeout for local build payload. This should match, or be slightly less
@@ -86,14 +141,35 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+# Tune how long to wait for a locally built payload from the recent getPayload
+# latencies, leaving a safety margin, instead of always waiting payload-timeout.
+# When enabled, payload-timeout is the maximum delay.
+adaptive-payload-delay = {{ .BeaconKit.PayloadBuilder.AdaptivePayloadDelay }}
+
+# The minimum delay before fetching a locally built payload when
+# adaptive-payload-delay is enabled.
+min-payload-delay = "{{ .BeaconKit.PayloadBuilder.MinPayloadDelay }}"
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{ .BeaconKit.Validator.Graffiti }}"
 
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
+# The payload is only built if the node is the expected proposer of the next block.
 enable-optimistic-payload-builds = "{{ .BeaconKit.Validator.EnableOptimisticPayloadBuilds }}"
 
+# MaxBlobsPerBlock is the number of blobs in blocks proposed by this node above which the
+# proposal is logged and counted in beacon_kit.validator.payload_blob_limit_exceeded. Since the
+# execution client cannot be asked for fewer blobs, payloads over the limit are still proposed.
+# Zero defers to the limit of the chain spec.
+max-blobs-per-block = {{ .BeaconKit.Validator.MaxBlobsPerBlock }}
+
+# EmptyPayloadRetryWindow discards payloads with neither transactions nor withdrawals that are
+# retrieved within this long of the start of a proposal, and requests a new payload once the
+# window has passed. It must leave enough time to build before timeout_propose. Zero disables it.
+empty-payload-retry-window = "{{ .BeaconKit.Validator.EmptyPayloadRetryWindow }}"
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -110,4 +186,99 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# MaxSyncDistance is the number of blocks the node may lag behind its peers
+# and still report ready on /readyz.
+max-sync-distance = "{{ .BeaconKit.NodeAPI.MaxSyncDistance }}"
+
+# MaxFinalizedAge is the age of the latest finalized block past which the node
+# reports not ready on /readyz.
+max-finalized-age = "{{ .BeaconKit.NodeAPI.MaxFinalizedAge }}"
+
+# RateLimit is the number of requests per second allowed from each client IP.
+# Zero disables rate limiting.
+rate-limit = "{{ .BeaconKit.NodeAPI.RateLimit }}"
+
+# RateLimitBurst is the number of requests a client IP may send at once.
+rate-limit-burst = "{{ .BeaconKit.NodeAPI.RateLimitBurst }}"
+
+# AuthToken is the bearer token required on protected paths. Leave empty to
+# disable authentication.
+auth-token = "{{ .BeaconKit.NodeAPI.AuthToken }}"
+
+# ProtectedPaths is a comma separated list of path prefixes requiring the auth
+# token, e.g. "/eth/v1/debug,/eth/v2/debug". The signature verification
+# endpoint under /bkit/v1/crypto is only served when protected.
+protected-paths = "{{ .BeaconKit.NodeAPI.ProtectedPaths }}"
+
+# CORSAllowedOrigins is a comma separated list of origins browsers may call the
+# API from, "*" for any. Leave empty to disable CORS.
+cors-allowed-origins = "{{ .BeaconKit.NodeAPI.CORSAllowedOrigins }}"
+
+# Compression determines if responses are compressed for clients accepting
+# gzip or deflate.
+compression = "{{ .BeaconKit.NodeAPI.Compression }}"
+
+[beacon-kit.tracing]
+# Enabled determines if block lifecycle traces are exported.
+enabled = "{{ .BeaconKit.Tracing.Enabled }}"
+
+# Endpoint is the host:port of the OTLP gRPC collector.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS on the connection to the collector.
+insecure = "{{ .BeaconKit.Tracing.Insecure }}"
+
+# ServiceName is the name traces are reported under.
+service-name = "{{ .BeaconKit.Tracing.ServiceName }}"
+
+# SampleRatio is the fraction of blocks that are traced, between 0 and 1.
+# Block trace IDs are derived from the height, so all nodes sampling at the
+# same ratio trace the same blocks.
+sample-ratio = "{{ .BeaconKit.Tracing.SampleRatio }}"
+
+[beacon-kit.admin]
+# Enabled determines if the admin server is enabled. It serves pprof,
+# goroutine and heap dumps, online backups, and runtime log level and engine
+# capture toggles.
+#
+# Online backups through POST /admin/backup require the PebbleDB database
+# backend, db_backend = "pebbledb" in config.toml, and are refused on other
+# backends. Block processing is paused while the backup is written, so the
+# node falls behind consensus for its duration.
+enabled = "{{ .BeaconKit.Admin.Enabled }}"
+
+# Address is the address to bind the admin server to. It must be a loopback
+# address.
+address = "{{ .BeaconKit.Admin.Address }}"
+
+# AuthToken is the bearer token required to change the suggested fee recipient
+# through /admin/fee-recipient. Leave empty to disable fee recipient changes.
+auth-token = "{{ .BeaconKit.Admin.AuthToken }}"
+
+[beacon-kit.watchdog]
+# StallTimeout is how long the finalized slot may not advance before an alert
+# is logged, counted and POSTed to the webhook. Zero disables the watchdog.
+stall-timeout = "{{ .BeaconKit.Watchdog.StallTimeout }}"
+
+# WebhookURL is where finality stall and recovery alerts are POSTed as JSON.
+# Leave empty to only log and count them.
+webhook-url = "{{ .BeaconKit.Watchdog.WebhookURL }}"
+
+[beacon-kit.disk-usage]
+# Interval is how often the disk usage of each store in the data directory is
+# measured and reported. Zero disables the monitor, and with it the quota.
+interval = "{{ .BeaconKit.DiskUsage.Interval }}"
+
+# BlobQuotaGiB is a soft limit, in GiB, on the disk space taken by blob
+# sidecars. Once crossed, the oldest blob sidecars are pruned ahead of the
+# blob retention, though never within MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS of
+# the head. Zero disables the quota.
+blob-quota-gib = {{ .BeaconKit.DiskUsage.BlobQuotaGiB }}
+
+[beacon-kit.deposit-reconciliation]
+# Interval is how often the deposit store is reconciled against the deposit
+# contract. A divergence is logged as an error, counted, and reported at
+# bkit/v1/debug/deposit_reconciliation. Zero disables reconciliation.
+interval = "{{ .BeaconKit.DepositReconciliation.Interval }}"
 `
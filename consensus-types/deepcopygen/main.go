@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Command deepcopygen writes the DeepCopy and Equal methods of the consensus
+// containers. It runs through `go generate` in the consensus types package.
+//
+// The methods are derived from the struct definitions by reflection, so a
+// field removal may require deleting the generated file before running it.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+)
+
+// containers are the types the methods are generated for. Pointers to them
+// are deep copied and compared through the generated methods.
+//
+//nolint:gochecknoglobals // generator input.
+var containers = []any{
+	types.BeaconState{},
+	types.Eth1Data{},
+	types.ExecutionPayload{},
+	types.ExecutionPayloadHeader{},
+	types.Fork{},
+	types.PendingPartialWithdrawal{},
+	types.Validator{},
+}
+
+//nolint:gochecknoglobals // reflected once.
+var versionableType = reflect.TypeFor[constraints.Versionable]()
+
+// helpers are emitted once in the generated file.
+const helpers = `
+// copyPtr returns a pointer to a copy of the value p points to.
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	cpy := *p
+	return &cpy
+}
+
+// mapSlice returns a new slice with f applied to every element of s,
+// preserving nil.
+func mapSlice[S ~[]E, E any](s S, f func(E) E) S {
+	if s == nil {
+		return nil
+	}
+	out := make(S, len(s))
+	for i, e := range s {
+		out[i] = f(e)
+	}
+	return out
+}
+
+// cloneEach returns a deep copy of a slice of slices, preserving nil.
+func cloneEach[S ~[]E, E ~[]B, B any](s S) S {
+	return mapSlice(s, slices.Clone[E])
+}
+
+// equalPtr reports whether a and b are both nil or point to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// equalEach reports whether two slices of slices hold equal elements.
+func equalEach[S ~[]E, E ~[]B, B comparable](a, b S) bool {
+	return slices.EqualFunc(a, b, slices.Equal[E])
+}
+
+// equalVersionable reports whether a and b are both nil or have the same
+// fork version.
+func equalVersionable(a, b constraints.Versionable) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.GetForkVersion() == b.GetForkVersion()
+}
+`
+
+// generator accumulates the generated source.
+type generator struct {
+	buf   bytes.Buffer
+	names map[reflect.Type]bool
+}
+
+func main() {
+	out := flag.String("out", "", "file to write the methods to")
+	flag.Parse()
+
+	g := &generator{names: make(map[reflect.Type]bool)}
+	for _, c := range containers {
+		g.names[reflect.TypeOf(c)] = true
+	}
+
+	g.buf.WriteString(`// Code generated by deepcopygen. DO NOT EDIT.
+
+package types
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/primitives/constraints"
+)
+`)
+	for _, c := range containers {
+		if err := g.container(reflect.TypeOf(c)); err != nil {
+			log.Fatal(err)
+		}
+	}
+	g.buf.WriteString(helpers)
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	//nolint:gosec,mnd // generated source file.
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// container writes the DeepCopy and Equal methods of t.
+func (g *generator) container(t reflect.Type) error {
+	var copies, equals []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		cpy, err := g.copyExpr(f.Type, "x."+f.Name)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+		if cpy != "" {
+			copies = append(copies, fmt.Sprintf("cpy.%s = %s", f.Name, cpy))
+		}
+		eq, err := g.equalExpr(f.Type, "x."+f.Name, "other."+f.Name)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+		equals = append(equals, eq)
+	}
+
+	name := t.Name()
+	fmt.Fprintf(&g.buf, `
+// DeepCopy returns a copy of the %[1]s that shares no reference with it.
+func (x *%[1]s) DeepCopy() *%[1]s {
+	if x == nil {
+		return nil
+	}
+	cpy := *x
+`, name)
+	for _, c := range copies {
+		fmt.Fprintf(&g.buf, "\t%s\n", c)
+	}
+	fmt.Fprintf(&g.buf, `	return &cpy
+}
+
+// Equal reports whether x and other hold the same values.
+func (x *%[1]s) Equal(other *%[1]s) bool {
+	if x == nil || other == nil {
+		return x == other
+	}
+	return %[2]s
+}
+`, name, strings.Join(equals, " &&\n\t\t"))
+	return nil
+}
+
+// copyExpr returns the expression deep copying v of type t, or an empty
+// string if copying the struct already copies v.
+func (g *generator) copyExpr(t reflect.Type, v string) (string, error) {
+	switch {
+	case t == versionableType:
+		// Versionables are immutable and shared.
+		return "", nil
+	case !hasRefs(t):
+		return "", nil
+	case t.Kind() == reflect.Pointer && g.names[t.Elem()]:
+		return v + ".DeepCopy()", nil
+	case t.Kind() == reflect.Pointer && !hasRefs(t.Elem()):
+		return fmt.Sprintf("copyPtr(%s)", v), nil
+	case t.Kind() != reflect.Slice:
+		return "", fmt.Errorf("cannot deep copy %s", t)
+	}
+
+	elem := t.Elem()
+	switch {
+	case !hasRefs(elem):
+		return fmt.Sprintf("slices.Clone(%s)", v), nil
+	case elem.Kind() == reflect.Slice && !hasRefs(elem.Elem()):
+		return fmt.Sprintf("cloneEach(%s)", v), nil
+	case elem.Kind() == reflect.Pointer && g.names[elem.Elem()]:
+		return fmt.Sprintf("mapSlice(%s, (*%s).DeepCopy)", v, elem.Elem().Name()), nil
+	case elem.Kind() == reflect.Pointer && !hasRefs(elem.Elem()):
+		return fmt.Sprintf("mapSlice(%s, copyPtr)", v), nil
+	default:
+		return "", fmt.Errorf("cannot deep copy %s", t)
+	}
+}
+
+// equalExpr returns the expression comparing a and b of type t.
+func (g *generator) equalExpr(t reflect.Type, a, b string) (string, error) {
+	switch {
+	case t == versionableType:
+		return fmt.Sprintf("equalVersionable(%s, %s)", a, b), nil
+	case !hasRefs(t) && t.Comparable():
+		return fmt.Sprintf("%s == %s", a, b), nil
+	case t.Kind() == reflect.Pointer && g.names[t.Elem()]:
+		return fmt.Sprintf("%s.Equal(%s)", a, b), nil
+	case t.Kind() == reflect.Pointer && !hasRefs(t.Elem()):
+		return fmt.Sprintf("equalPtr(%s, %s)", a, b), nil
+	case t.Kind() != reflect.Slice:
+		return "", fmt.Errorf("cannot compare %s", t)
+	}
+
+	elem := t.Elem()
+	switch {
+	case !hasRefs(elem):
+		return fmt.Sprintf("slices.Equal(%s, %s)", a, b), nil
+	case elem.Kind() == reflect.Slice && !hasRefs(elem.Elem()):
+		return fmt.Sprintf("equalEach(%s, %s)", a, b), nil
+	case elem.Kind() == reflect.Pointer && g.names[elem.Elem()]:
+		return fmt.Sprintf("slices.EqualFunc(%s, %s, (*%s).Equal)", a, b, elem.Elem().Name()), nil
+	case elem.Kind() == reflect.Pointer && !hasRefs(elem.Elem()):
+		return fmt.Sprintf("slices.EqualFunc(%s, %s, equalPtr)", a, b), nil
+	default:
+		return "", fmt.Errorf("cannot compare %s", t)
+	}
+}
+
+// hasRefs reports whether values of t may share memory when copied.
+func hasRefs(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface,
+		reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return true
+	case reflect.Array:
+		return hasRefs(t.Elem())
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if hasRefs(t.Field(i).Type) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
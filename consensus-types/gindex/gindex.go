@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package gindex computes the generalized indices of the consensus types,
+// for a given fork version, from SSZ paths such as
+// `BeaconState.validators[123].pubkey`.
+package gindex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+)
+
+const (
+	// BeaconState is the path root addressing the beacon state.
+	BeaconState = "BeaconState"
+	// BeaconBlockHeader is the path root addressing the beacon block header,
+	// i.e. the beacon block root.
+	BeaconBlockHeader = "BeaconBlockHeader"
+)
+
+var (
+	// ErrInvalidPath is returned when a path cannot be parsed or does not
+	// address a node of its root type.
+	ErrInvalidPath = errors.New("invalid path")
+	// ErrUnsupportedFork is returned for fork versions without a schema.
+	ErrUnsupportedFork = errors.New("unsupported fork version")
+)
+
+// Compute returns the generalized index of the node addressed by the path,
+// for the given fork version. A path starts with its root type, BeaconState
+// or BeaconBlockHeader, followed by dot separated field names and bracketed
+// list indices, e.g. `BeaconBlockHeader.state_root.validators[123].pubkey`.
+// The length of a list is addressed with `__len__`.
+func Compute(forkVersion common.Version, path string) (uint64, error) {
+	root, objectPath, err := ParsePath(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var typ schema.SSZType
+	switch root {
+	case BeaconState:
+		typ, err = BeaconStateSchema(forkVersion)
+	case BeaconBlockHeader:
+		typ, err = BeaconBlockHeaderSchema(forkVersion)
+	default:
+		return 0, fmt.Errorf("%w: unknown root type %s", ErrInvalidPath, root)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	_, gIndex, _, err := objectPath.GetGeneralizedIndex(typ)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidPath, err)
+	}
+	return gIndex, nil
+}
+
+// ParsePath splits a path into its root type and the object path within it.
+func ParsePath(path string) (string, merkle.ObjectPath, error) {
+	segments := strings.Split(path, ".")
+	if len(segments) < 2 { //nolint:mnd // root and at least one field.
+		return "", "", fmt.Errorf("%w: %s addresses no field", ErrInvalidPath, path)
+	}
+
+	parts := make([]string, 0, len(segments))
+	for _, segment := range segments[1:] {
+		name, indices, _ := strings.Cut(segment, "[")
+		if name == "" {
+			return "", "", fmt.Errorf("%w: empty field name in %s", ErrInvalidPath, path)
+		}
+		parts = append(parts, name)
+		if indices == "" {
+			continue
+		}
+
+		// Every index must be closed, e.g. `[1][2]`.
+		if !strings.HasSuffix(indices, "]") {
+			return "", "", fmt.Errorf("%w: unclosed index in %s", ErrInvalidPath, path)
+		}
+		for _, index := range strings.Split(strings.TrimSuffix(indices, "]"), "][") {
+			if index == "" || strings.ContainsAny(index, "[]") {
+				return "", "", fmt.Errorf("%w: malformed index in %s", ErrInvalidPath, path)
+			}
+			parts = append(parts, index)
+		}
+	}
+	return segments[0], merkle.ObjectPath(strings.Join(parts, "/")), nil
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gindex_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/gindex"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name        string
+		forkVersion common.Version
+		path        string
+		expected    uint64
+		expectedErr error
+	}{
+		{
+			name:        "proposer index",
+			forkVersion: version.Deneb(),
+			path:        "BeaconBlockHeader.proposer_index",
+			expected:    9,
+		},
+		{
+			name:        "state in block",
+			forkVersion: version.Electra(),
+			path:        "BeaconBlockHeader.state_root",
+			expected:    11,
+		},
+		{
+			name:        "validator pubkey in state deneb",
+			forkVersion: version.Deneb1(),
+			path:        "BeaconState.validators[0].pubkey",
+			expected:    439804651110400,
+		},
+		{
+			name:        "validator pubkey in block electra",
+			forkVersion: version.Electra(),
+			path:        "BeaconBlockHeader.state_root.validators[0].pubkey",
+			expected:    6350779162034176,
+		},
+		{
+			name:        "validator credentials in block electra",
+			forkVersion: version.Electra1(),
+			path:        "BeaconBlockHeader.state_root.validators[1].withdrawal_credentials",
+			expected:    6350779162034177 + 8,
+		},
+		{
+			name:        "deposit in block deneb",
+			forkVersion: version.Deneb(),
+			path:        "BeaconBlockHeader.state_root.eth1_data.deposit_root[3]",
+			expected:    6253472382976 + 3,
+		},
+		{
+			name:        "validators length",
+			forkVersion: version.Deneb(),
+			path:        "BeaconState.validators.__len__",
+			expected:    51,
+		},
+		{
+			name:        "unknown field",
+			forkVersion: version.Electra(),
+			path:        "BeaconState.validator[0]",
+			expectedErr: gindex.ErrInvalidPath,
+		},
+		{
+			name:        "unknown root",
+			forkVersion: version.Electra(),
+			path:        "BeaconBlock.body",
+			expectedErr: gindex.ErrInvalidPath,
+		},
+		{
+			name:        "unclosed index",
+			forkVersion: version.Electra(),
+			path:        "BeaconState.validators[0.pubkey",
+			expectedErr: gindex.ErrInvalidPath,
+		},
+		{
+			name:        "no field",
+			forkVersion: version.Electra(),
+			path:        "BeaconState",
+			expectedErr: gindex.ErrInvalidPath,
+		},
+		{
+			name:        "unsupported fork",
+			forkVersion: version.Capella(),
+			path:        "BeaconState.slot",
+			expectedErr: gindex.ErrUnsupportedFork,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gIndex, err := gindex.Compute(tc.forkVersion, tc.path)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, gIndex)
+		})
+	}
+}
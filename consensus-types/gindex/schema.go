@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gindex
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// The field names follow the consensus specs, i.e. are snake cased, while
+// the list limits follow the SSZ definitions of the consensus types.
+const (
+	historicalRootsLimit = 8192
+	randaoMixesLimit     = 65536
+	maxExtraDataBytes    = 32
+)
+
+func forkSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("previous_version", schema.B4()),
+		schema.NewField("current_version", schema.B4()),
+		schema.NewField("epoch", schema.U64()),
+	)
+}
+
+func blockHeaderSchema(stateRoot schema.SSZType) schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("proposer_index", schema.U64()),
+		schema.NewField("parent_root", schema.B32()),
+		schema.NewField("state_root", stateRoot),
+		schema.NewField("body_root", schema.B32()),
+	)
+}
+
+func depositSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("pubkey", schema.B48()),
+		schema.NewField("credentials", schema.B32()),
+		schema.NewField("amount", schema.U64()),
+		schema.NewField("signature", schema.B96()),
+		schema.NewField("index", schema.U64()),
+	)
+}
+
+// eth1DataSchema expands the deposit root into the list of all deposits it is
+// the root of, so that deposits can be addressed through it.
+func eth1DataSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("deposit_root", schema.DefineList(depositSchema(), constants.MaxDeposits)),
+		schema.NewField("deposit_count", schema.U64()),
+		schema.NewField("block_hash", schema.B32()),
+	)
+}
+
+func executionPayloadHeaderSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("parent_hash", schema.B32()),
+		schema.NewField("fee_recipient", schema.B20()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField("receipts_root", schema.B32()),
+		schema.NewField("logs_bloom", schema.B256()),
+		schema.NewField("prev_randao", schema.B32()),
+		schema.NewField("block_number", schema.U64()),
+		schema.NewField("gas_limit", schema.U64()),
+		schema.NewField("gas_used", schema.U64()),
+		schema.NewField("timestamp", schema.U64()),
+		schema.NewField("extra_data", schema.DefineByteList(maxExtraDataBytes)),
+		schema.NewField("base_fee_per_gas", schema.U256()),
+		schema.NewField("block_hash", schema.B32()),
+		schema.NewField("transactions_root", schema.B32()),
+		schema.NewField("withdrawals_root", schema.B32()),
+		schema.NewField("blob_gas_used", schema.U64()),
+		schema.NewField("excess_blob_gas", schema.U64()),
+	)
+}
+
+func validatorSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("pubkey", schema.B48()),
+		schema.NewField("withdrawal_credentials", schema.B32()),
+		schema.NewField("effective_balance", schema.U64()),
+		schema.NewField("slashed", schema.Bool()),
+		schema.NewField("activation_eligibility_epoch", schema.U64()),
+		schema.NewField("activation_epoch", schema.U64()),
+		schema.NewField("exit_epoch", schema.U64()),
+		schema.NewField("withdrawable_epoch", schema.U64()),
+	)
+}
+
+func pendingPartialWithdrawalSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("validator_index", schema.U64()),
+		schema.NewField("amount", schema.U64()),
+		schema.NewField("withdrawable_epoch", schema.U64()),
+	)
+}
+
+// BeaconStateSchema returns the schema of the beacon state for the given fork
+// version.
+func BeaconStateSchema(forkVersion common.Version) (schema.SSZType, error) {
+	if version.IsBefore(forkVersion, version.Deneb()) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFork, forkVersion)
+	}
+
+	fields := []*schema.Field{
+		schema.NewField("genesis_validators_root", schema.B32()),
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("fork", forkSchema()),
+		schema.NewField("latest_block_header", blockHeaderSchema(schema.B32())),
+		schema.NewField("block_roots", schema.DefineList(schema.B32(), historicalRootsLimit)),
+		schema.NewField("state_roots", schema.DefineList(schema.B32(), historicalRootsLimit)),
+		schema.NewField("eth1_data", eth1DataSchema()),
+		schema.NewField("eth1_deposit_index", schema.U64()),
+		schema.NewField("latest_execution_payload_header", executionPayloadHeaderSchema()),
+		schema.NewField("validators", schema.DefineList(
+			validatorSchema(), constants.ValidatorsRegistryLimit,
+		)),
+		schema.NewField("balances", schema.DefineList(
+			schema.U64(), constants.ValidatorsRegistryLimit,
+		)),
+		schema.NewField("randao_mixes", schema.DefineList(
+			schema.B32(), randaoMixesLimit,
+		)),
+		schema.NewField("next_withdrawal_index", schema.U64()),
+		schema.NewField("next_withdrawal_validator_index", schema.U64()),
+		schema.NewField("slashings", schema.DefineList(
+			schema.U64(), constants.ValidatorsRegistryLimit,
+		)),
+		schema.NewField("total_slashing", schema.U64()),
+	}
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		fields = append(fields, schema.NewField(
+			"pending_partial_withdrawals", schema.DefineList(
+				pendingPartialWithdrawalSchema(), constants.PendingPartialWithdrawalsLimit,
+			),
+		))
+	}
+	return schema.DefineContainer(fields...), nil
+}
+
+// BeaconBlockHeaderSchema returns the schema of the beacon block header for
+// the given fork version. The state root is expanded into the beacon state it
+// is the root of, so that the state can be addressed through it.
+func BeaconBlockHeaderSchema(forkVersion common.Version) (schema.SSZType, error) {
+	state, err := BeaconStateSchema(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	return blockHeaderSchema(state), nil
+}
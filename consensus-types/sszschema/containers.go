@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sszschema
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// Sizes and list limits following the SSZ definitions of the consensus types.
+const (
+	versionSize          = 4
+	executionAddressSize = 20
+	logsBloomSize        = 256
+	historicalRootsLimit = 8192
+	randaoMixesLimit     = 65536
+	maxExtraDataBytes    = 32
+)
+
+// declare returns the containers of the fork version.
+//
+//nolint:funlen // declarations.
+func declare(forkVersion common.Version) []*Container {
+	electra := version.EqualsOrIsAfter(forkVersion, version.Electra())
+
+	fork := newContainer("Fork",
+		bytesN("previous_version", versionSize),
+		bytesN("current_version", versionSize),
+		uint64Field("epoch"),
+	)
+	header := newContainer("BeaconBlockHeader",
+		uint64Field("slot"),
+		uint64Field("proposer_index"),
+		bytesN("parent_root", common.RootSize),
+		bytesN("state_root", common.RootSize),
+		bytesN("body_root", common.RootSize),
+	)
+	eth1Data := newContainer("Eth1Data",
+		bytesN("deposit_root", common.RootSize),
+		uint64Field("deposit_count"),
+		bytesN("block_hash", common.RootSize),
+	)
+	deposit := newContainer("Deposit",
+		bytesN("pubkey", constants.BLSPubkeyLength),
+		bytesN("credentials", common.RootSize),
+		uint64Field("amount"),
+		bytesN("signature", constants.BLSSignatureLength),
+		uint64Field("index"),
+	)
+	validator := newContainer("Validator",
+		bytesN("pubkey", constants.BLSPubkeyLength),
+		bytesN("withdrawal_credentials", common.RootSize),
+		uint64Field("effective_balance"),
+		boolean("slashed"),
+		uint64Field("activation_eligibility_epoch"),
+		uint64Field("activation_epoch"),
+		uint64Field("exit_epoch"),
+		uint64Field("withdrawable_epoch"),
+	)
+	withdrawal := newContainer("Withdrawal",
+		uint64Field("index"),
+		uint64Field("validator_index"),
+		bytesN("address", executionAddressSize),
+		uint64Field("amount"),
+	)
+	attestationData := newContainer("AttestationData",
+		uint64Field("slot"),
+		uint64Field("index"),
+		bytesN("beacon_block_root", common.RootSize),
+	)
+	slashingInfo := newContainer("SlashingInfo",
+		uint64Field("slot"),
+		uint64Field("index"),
+	)
+
+	// The fields shared by the execution payload and its header.
+	payloadFields := func(txs, withdrawals Field) []Field {
+		return []Field{
+			bytesN("parent_hash", common.RootSize),
+			bytesN("fee_recipient", executionAddressSize),
+			bytesN("state_root", common.RootSize),
+			bytesN("receipts_root", common.RootSize),
+			bytesN("logs_bloom", logsBloomSize),
+			bytesN("prev_randao", common.RootSize),
+			uint64Field("block_number"),
+			uint64Field("gas_limit"),
+			uint64Field("gas_used"),
+			uint64Field("timestamp"),
+			byteList("extra_data", maxExtraDataBytes),
+			uint256Field("base_fee_per_gas"),
+			bytesN("block_hash", common.RootSize),
+			txs,
+			withdrawals,
+			uint64Field("blob_gas_used"),
+			uint64Field("excess_blob_gas"),
+		}
+	}
+	payload := newContainer("ExecutionPayload", payloadFields(
+		list(
+			"transactions", fmt.Sprintf("ByteList[%d]", constants.MaxBytesPerTx),
+			constants.MaxTxsPerPayload,
+		),
+		list("withdrawals", withdrawal.Name, constants.MaxWithdrawalsPerPayload),
+	)...)
+	payloadHeader := newContainer("ExecutionPayloadHeader", payloadFields(
+		bytesN("transactions_root", common.RootSize),
+		bytesN("withdrawals_root", common.RootSize),
+	)...)
+
+	stateFields := []Field{
+		bytesN("genesis_validators_root", common.RootSize),
+		uint64Field("slot"),
+		object("fork", fork),
+		object("latest_block_header", header),
+		list("block_roots", "Bytes32", historicalRootsLimit),
+		list("state_roots", "Bytes32", historicalRootsLimit),
+		object("eth1_data", eth1Data),
+		uint64Field("eth1_deposit_index"),
+		object("latest_execution_payload_header", payloadHeader),
+		list("validators", validator.Name, constants.ValidatorsRegistryLimit),
+		list("balances", "uint64", constants.ValidatorsRegistryLimit),
+		list("randao_mixes", "Bytes32", randaoMixesLimit),
+		uint64Field("next_withdrawal_index"),
+		uint64Field("next_withdrawal_validator_index"),
+		list("slashings", "uint64", constants.ValidatorsRegistryLimit),
+		uint64Field("total_slashing"),
+	}
+
+	containers := []*Container{
+		fork, header, eth1Data, deposit, validator, withdrawal,
+		attestationData, slashingInfo, payload, payloadHeader,
+	}
+	if !electra {
+		return append(containers, newContainer("BeaconState", stateFields...))
+	}
+
+	pendingPartialWithdrawal := newContainer("PendingPartialWithdrawal",
+		uint64Field("validator_index"),
+		uint64Field("amount"),
+		uint64Field("withdrawable_epoch"),
+	)
+	depositRequest := newContainer("DepositRequest", deposit.Fields...)
+	withdrawalRequest := newContainer("WithdrawalRequest",
+		bytesN("source_address", executionAddressSize),
+		bytesN("validator_pubkey", constants.BLSPubkeyLength),
+		uint64Field("amount"),
+	)
+	consolidationRequest := newContainer("ConsolidationRequest",
+		bytesN("source_address", executionAddressSize),
+		bytesN("source_pubkey", constants.BLSPubkeyLength),
+		bytesN("target_pubkey", constants.BLSPubkeyLength),
+	)
+	executionRequests := newContainer("ExecutionRequests",
+		list("deposits", depositRequest.Name, constants.MaxDepositRequestsPerPayload),
+		list("withdrawals", withdrawalRequest.Name, constants.MaxWithdrawalRequestsPerPayload),
+		list("consolidations", consolidationRequest.Name, constants.MaxConsolidationRequestsPerPayload),
+	)
+	stateFields = append(stateFields, list(
+		"pending_partial_withdrawals", pendingPartialWithdrawal.Name, constants.PendingPartialWithdrawalsLimit,
+	))
+
+	return append(containers,
+		pendingPartialWithdrawal, depositRequest, withdrawalRequest,
+		consolidationRequest, executionRequests,
+		newContainer("BeaconState", stateFields...),
+	)
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Command gen writes the compile-time assertions that the static size
+// constants of the consensus types match their schemas for the latest fork
+// version. It runs through `go generate` in the consensus types package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+
+	"github.com/berachain/beacon-kit/consensus-types/sszschema"
+)
+
+// assertions maps the containers to the constants holding their static size.
+//
+//nolint:gochecknoglobals // generator input.
+var assertions = []struct{ container, constant string }{
+	{"AttestationData", "AttestationDataSize"},
+	{"BeaconBlockHeader", "BeaconBlockHeaderSize"},
+	{"ConsolidationRequest", "sszConsolidationRequestSize"},
+	{"Deposit", "depositSize"},
+	{"Eth1Data", "Eth1DataSize"},
+	{"ExecutionPayload", "ExecutionPayloadStaticSize"},
+	{"ExecutionPayloadHeader", "ExecutionPayloadHeaderStaticSize"},
+	{"Fork", "ForkSize"},
+	{"PendingPartialWithdrawal", "sszPendingPartialWithdrawalSize"},
+	{"SlashingInfo", "SlashingInfoSize"},
+	{"Validator", "ValidatorSize"},
+	{"WithdrawalRequest", "sszWithdrawRequestSize"},
+}
+
+func main() {
+	out := flag.String("out", "", "file to write the assertions to")
+	pkg := flag.String("pkg", "types", "package of the assertions")
+	flag.Parse()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `// Code generated by sszschema/gen. DO NOT EDIT.
+
+package %s
+
+// Compile-time assertions that the static sizes of the consensus types match
+// their SSZ schemas for the latest fork version. A mismatch makes one of the
+// array lengths negative.
+var (
+`, *pkg)
+	for _, a := range assertions {
+		c, err := sszschema.Get(sszschema.Latest(), a.container)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(&buf, "\t_ [%s - %d]struct{}\n", a.constant, c.StaticSize)
+		fmt.Fprintf(&buf, "\t_ [%d - %s]struct{}\n", c.StaticSize, a.constant)
+	}
+	buf.WriteString(")\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	//#nosec G306 -- generated source file.
+	if err = os.WriteFile(*out, src, 0o644); err != nil { //nolint:mnd // file permissions.
+		log.Fatal(err)
+	}
+}
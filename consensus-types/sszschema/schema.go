@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package sszschema declares the SSZ layout of the consensus containers for
+// each fork version: the order, types and limits of their fields and their
+// static sizes. External tooling can rely on it to decode containers and
+// build proofs without mirroring the Go types.
+package sszschema
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+var (
+	// ErrUnsupportedFork is returned for fork versions without a schema.
+	ErrUnsupportedFork = errors.New("unsupported fork version")
+	// ErrUnknownContainer is returned for containers without a schema.
+	ErrUnknownContainer = errors.New("unknown container")
+)
+
+// Field is a field of a container.
+type Field struct {
+	// Name is the name of the field, as in the consensus specs.
+	Name string `json:"name"`
+	// Type is the SSZ type of the field, e.g. `uint64`, `Bytes32`,
+	// `List[Validator, 1099511627776]` or the name of a container.
+	Type string `json:"type"`
+	// Size is the number of bytes of the field in the fixed part of the
+	// container, i.e. the offset size for variable size fields.
+	Size uint32 `json:"size"`
+	// Variable is true if the field is encoded after the fixed part.
+	Variable bool `json:"variable,omitempty"`
+	// Limit is the maximum length of list fields.
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+// Container is the schema of a container for a fork version.
+type Container struct {
+	// Name is the name of the container, as in the consensus specs.
+	Name string `json:"name"`
+	// StaticSize is the size of the fixed part of the container, which is
+	// its full size if it has no variable size fields.
+	StaticSize uint32 `json:"static_size"`
+	// Variable is true if the container has variable size fields.
+	Variable bool `json:"variable,omitempty"`
+	// Fields are the fields of the container, in encoding order.
+	Fields []Field `json:"fields"`
+}
+
+// newContainer computes the sizes of the container from its fields.
+func newContainer(name string, fields ...Field) *Container {
+	c := &Container{Name: name, Fields: fields}
+	for _, f := range fields {
+		c.StaticSize += f.Size
+		c.Variable = c.Variable || f.Variable
+	}
+	return c
+}
+
+// Field returns the field of the container with the given name.
+func (c *Container) Field(name string) (Field, bool) {
+	i := slices.IndexFunc(c.Fields, func(f Field) bool { return f.Name == name })
+	if i < 0 {
+		return Field{}, false
+	}
+	return c.Fields[i], true
+}
+
+// Containers returns the schemas of the containers of the fork version,
+// sorted by name.
+func Containers(forkVersion common.Version) ([]*Container, error) {
+	if !slices.Contains(version.GetSupportedVersions(), forkVersion) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFork, forkVersion)
+	}
+	containers := declare(forkVersion)
+	slices.SortFunc(containers, func(a, b *Container) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return containers, nil
+}
+
+// Get returns the schema of the named container for the fork version.
+func Get(forkVersion common.Version, name string) (*Container, error) {
+	containers, err := Containers(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range containers {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s in %s", ErrUnknownContainer, name, version.Name(forkVersion))
+}
+
+// Latest returns the latest supported fork version.
+func Latest() common.Version {
+	versions := version.GetSupportedVersions()
+	return versions[len(versions)-1]
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Fields                                   */
+/* -------------------------------------------------------------------------- */
+
+func boolean(name string) Field {
+	return Field{Name: name, Type: "boolean", Size: 1}
+}
+
+func uint64Field(name string) Field {
+	return Field{Name: name, Type: "uint64", Size: 8} //nolint:mnd // 64 bits.
+}
+
+func uint256Field(name string) Field {
+	return Field{Name: name, Type: "uint256", Size: 32} //nolint:mnd // 256 bits.
+}
+
+func bytesN(name string, n uint32) Field {
+	return Field{Name: name, Type: fmt.Sprintf("Bytes%d", n), Size: n}
+}
+
+func byteList(name string, limit uint64) Field {
+	return Field{
+		Name:     name,
+		Type:     fmt.Sprintf("ByteList[%d]", limit),
+		Size:     constants.SSZOffsetSize,
+		Variable: true,
+		Limit:    limit,
+	}
+}
+
+func list(name, elem string, limit uint64) Field {
+	return Field{
+		Name:     name,
+		Type:     fmt.Sprintf("List[%s, %d]", elem, limit),
+		Size:     constants.SSZOffsetSize,
+		Variable: true,
+		Limit:    limit,
+	}
+}
+
+// object embeds a container, inline if it is fixed size.
+func object(name string, c *Container) Field {
+	if c.Variable {
+		return Field{Name: name, Type: c.Name, Size: constants.SSZOffsetSize, Variable: true}
+	}
+	return Field{Name: name, Type: c.Name, Size: c.StaticSize}
+}
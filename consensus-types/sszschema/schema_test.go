@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sszschema_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/sszschema"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticSizes(t *testing.T) {
+	t.Parallel()
+	for _, v := range version.GetSupportedVersions() {
+		t.Run(version.Name(v), func(t *testing.T) {
+			t.Parallel()
+			state, err := sszschema.Get(v, "BeaconState")
+			require.NoError(t, err)
+			require.True(t, state.Variable)
+			require.Equal(t,
+				types.NewEmptyBeaconStateWithVersion(v).SizeSSZ(nil, true),
+				state.StaticSize,
+			)
+
+			header, err := sszschema.Get(v, "ExecutionPayloadHeader")
+			require.NoError(t, err)
+			require.Equal(t,
+				types.NewEmptyExecutionPayloadHeaderWithVersion(v).SizeSSZ(nil, true),
+				header.StaticSize,
+			)
+
+			_, found := state.Field("pending_partial_withdrawals")
+			require.Equal(t, version.EqualsOrIsAfter(v, version.Electra()), found)
+			_, err = sszschema.Get(v, "ExecutionRequests")
+			if version.IsBefore(v, version.Electra()) {
+				require.ErrorIs(t, err, sszschema.ErrUnknownContainer)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	_, err := sszschema.Containers(common.Version{0xff})
+	require.ErrorIs(t, err, sszschema.ErrUnsupportedFork)
+}
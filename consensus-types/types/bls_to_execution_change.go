@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+var (
+	_ ssz.StaticObject                    = (*BLSToExecutionChange)(nil)
+	_ constraints.SSZMarshallableRootable = (*BLSToExecutionChange)(nil)
+	_ ssz.StaticObject                    = (*SignedBLSToExecutionChange)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedBLSToExecutionChange)(nil)
+)
+
+// BLSToExecutionChangeDomainType returns DOMAIN_BLS_TO_EXECUTION_CHANGE as
+// defined in the Capella specification. It is not part of the chain spec since
+// the value is fixed across all networks.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#domain-types
+func BLSToExecutionChangeDomainType() common.DomainType {
+	return common.DomainType{0x0a, 0x00, 0x00, 0x00}
+}
+
+// BLSToExecutionChange as defined in the Capella specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#blstoexecutionchange
+type BLSToExecutionChange struct {
+	// ValidatorIndex is the index of the validator rotating its credentials.
+	ValidatorIndex math.ValidatorIndex
+	// FromBLSPubkey is the BLS withdrawal pubkey committed to in the current
+	// withdrawal credentials.
+	FromBLSPubkey crypto.BLSPubkey
+	// ToExecutionAddress is the execution address to withdraw to.
+	ToExecutionAddress common.ExecutionAddress
+}
+
+// SignedBLSToExecutionChange as defined in the Capella specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#signedblstoexecutionchange
+type SignedBLSToExecutionChange struct {
+	// Message is the BLS to execution change being signed.
+	Message *BLSToExecutionChange
+	// Signature is the signature of the message by FromBLSPubkey.
+	Signature crypto.BLSSignature
+}
+
+// NewEmptySignedBLSToExecutionChange returns an empty signed change, ready to
+// be decoded into.
+func NewEmptySignedBLSToExecutionChange() *SignedBLSToExecutionChange {
+	return &SignedBLSToExecutionChange{Message: &BLSToExecutionChange{}}
+}
+
+// ValidateForValidator checks that the change applies to the given validator,
+// i.e. that the validator still has BLS withdrawal credentials and that those
+// credentials commit to FromBLSPubkey.
+func (c *BLSToExecutionChange) ValidateForValidator(validator *Validator) error {
+	creds := validator.GetWithdrawalCredentials()
+	if !creds.IsBLSWithdrawalCredentials() {
+		return errors.Wrapf(
+			ErrInvalidBLSToExecutionChange,
+			"validator %d does not have BLS withdrawal credentials", c.ValidatorIndex,
+		)
+	}
+	if !creds.MatchesBLSPubkey(c.FromBLSPubkey) {
+		return errors.Wrapf(
+			ErrInvalidBLSToExecutionChange,
+			"pubkey %s does not match withdrawal credentials of validator %d",
+			c.FromBLSPubkey, c.ValidatorIndex,
+		)
+	}
+	return nil
+}
+
+// VerifySignature verifies the signature of the change. Per specification the
+// domain is always computed over the genesis fork version, so that changes
+// signed once remain valid across forks.
+func (s *SignedBLSToExecutionChange) VerifySignature(
+	genesisForkData *ForkData,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		s.Message, genesisForkData.ComputeDomain(BLSToExecutionChangeDomainType()),
+	)
+	if err := signatureVerificationFn(
+		s.Message.FromBLSPubkey, signingRoot[:], s.Signature,
+	); err != nil {
+		return errors.Join(err, ErrBLSToExecutionChangeSignature)
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BLSToExecutionChange object in SSZ encoding.
+func (*BLSToExecutionChange) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 8 + 48 + 20 = 76.
+	return 76
+}
+
+// DefineSSZ defines the SSZ encoding for the BLSToExecutionChange object.
+func (c *BLSToExecutionChange) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &c.ValidatorIndex)
+	ssz.DefineStaticBytes(codec, &c.FromBLSPubkey)
+	ssz.DefineStaticBytes(codec, &c.ToExecutionAddress)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BLSToExecutionChange object.
+func (c *BLSToExecutionChange) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// MarshalSSZTo marshals the BLSToExecutionChange object to SSZ format into the
+// provided buffer.
+func (c *BLSToExecutionChange) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// MarshalSSZ marshals the BLSToExecutionChange object to SSZ format.
+func (c *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return c.MarshalSSZTo(buf)
+}
+
+func (*BLSToExecutionChange) ValidateAfterDecodingSSZ() error { return nil }
+
+// SizeSSZ returns the size of the SignedBLSToExecutionChange object in SSZ
+// encoding.
+func (*SignedBLSToExecutionChange) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 76 + 96 = 172.
+	return 172
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBLSToExecutionChange object.
+func (s *SignedBLSToExecutionChange) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.Message)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the
+// SignedBLSToExecutionChange object.
+func (s *SignedBLSToExecutionChange) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+// MarshalSSZTo marshals the SignedBLSToExecutionChange object to SSZ format into
+// the provided buffer.
+func (s *SignedBLSToExecutionChange) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// MarshalSSZ marshals the SignedBLSToExecutionChange object to SSZ format.
+func (s *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(s))
+	return s.MarshalSSZTo(buf)
+}
+
+// UnmarshalSSZ unmarshals the SignedBLSToExecutionChange object from SSZ format.
+func (s *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+func (*SignedBLSToExecutionChange) ValidateAfterDecodingSSZ() error { return nil }
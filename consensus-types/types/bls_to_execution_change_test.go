@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	types "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedBLSToExecutionChange_MarshalUnmarshalSSZ(t *testing.T) {
+	t.Parallel()
+	original := &types.SignedBLSToExecutionChange{
+		Message: &types.BLSToExecutionChange{
+			ValidatorIndex:     42,
+			FromBLSPubkey:      crypto.BLSPubkey{0x01},
+			ToExecutionAddress: common.ExecutionAddress{0x02},
+		},
+		Signature: crypto.BLSSignature{0x03},
+	}
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, 172)
+
+	decoded := types.NewEmptySignedBLSToExecutionChange()
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, original, decoded)
+	require.Equal(t, original.HashTreeRoot(), decoded.HashTreeRoot())
+}
+
+func TestBLSToExecutionChange_ValidateForValidator(t *testing.T) {
+	t.Parallel()
+	pubkey := crypto.BLSPubkey{0xaa}
+	hashed := sha256.Hash(pubkey[:])
+	blsCreds := types.WithdrawalCredentials(hashed)
+	blsCreds[0] = types.BLSWithdrawalPrefix
+
+	change := &types.BLSToExecutionChange{
+		ValidatorIndex:     1,
+		FromBLSPubkey:      pubkey,
+		ToExecutionAddress: common.ExecutionAddress{0x01},
+	}
+
+	tests := []struct {
+		name    string
+		creds   types.WithdrawalCredentials
+		pubkey  crypto.BLSPubkey
+		wantErr error
+	}{
+		{name: "matching BLS credentials", creds: blsCreds, pubkey: pubkey},
+		{
+			name:    "already rotated",
+			creds:   types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01}),
+			pubkey:  pubkey,
+			wantErr: types.ErrInvalidBLSToExecutionChange,
+		},
+		{
+			name:    "different pubkey",
+			creds:   blsCreds,
+			pubkey:  crypto.BLSPubkey{0xbb},
+			wantErr: types.ErrInvalidBLSToExecutionChange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := *change
+			c.FromBLSPubkey = tt.pubkey
+			err := c.ValidateForValidator(&types.Validator{WithdrawalCredentials: tt.creds})
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -18,28 +18,22 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-//nolint:dupl // separate file for ease of future implementation
 package types
 
 import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
-	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/karalabe/ssz"
 )
 
-// Compile-time assertions to ensure BlsToExecutionChange implements necessary interfaces.
-var (
-	_ ssz.StaticObject                    = (*BlsToExecutionChange)(nil)
-	_ constraints.SSZMarshallableRootable = (*BlsToExecutionChange)(nil)
-	_ common.UnusedEnforcer               = (*BlsToExecutionChanges)(nil)
-)
+// Compile-time assertions to ensure BlsToExecutionChanges implements necessary interfaces.
+var _ common.UnusedEnforcer = (*BlsToExecutionChanges)(nil)
 
-type (
-	BlsToExecutionChange  = common.UnusedType
-	BlsToExecutionChanges []*BlsToExecutionChange
-)
+// BlsToExecutionChanges is the bls_to_execution_changes field of the block body.
+// Changes may only be included from Electra1 on; the field must be left empty
+// before that.
+type BlsToExecutionChanges []*SignedBLSToExecutionChange
 
 // SizeSSZ returns the SSZ encoded size in bytes for the BlsToExecutionChanges.
 func (bs BlsToExecutionChanges) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
@@ -49,13 +43,13 @@ func (bs BlsToExecutionChanges) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
 // DefineSSZ defines the SSZ encoding for the BlsToExecutionChanges object.
 func (bs BlsToExecutionChanges) DefineSSZ(c *ssz.Codec) {
 	c.DefineDecoder(func(*ssz.Decoder) {
-		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*BlsToExecutionChange)(&bs), constants.MaxBlsToExecutionChanges)
+		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*SignedBLSToExecutionChange)(&bs), constants.MaxBlsToExecutionChanges)
 	})
 	c.DefineEncoder(func(*ssz.Encoder) {
-		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*BlsToExecutionChange)(&bs), constants.MaxBlsToExecutionChanges)
+		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*SignedBLSToExecutionChange)(&bs), constants.MaxBlsToExecutionChanges)
 	})
 	c.DefineHasher(func(*ssz.Hasher) {
-		ssz.DefineSliceOfStaticObjectsOffset(c, (*[]*BlsToExecutionChange)(&bs), constants.MaxBlsToExecutionChanges)
+		ssz.DefineSliceOfStaticObjectsOffset(c, (*[]*SignedBLSToExecutionChange)(&bs), constants.MaxBlsToExecutionChanges)
 	})
 }
 
@@ -65,8 +59,7 @@ func (bs BlsToExecutionChanges) HashTreeRoot() common.Root {
 }
 
 // EnforceUnused return true if the length of the BlsToExecutionChanges is 0.
-// As long as this type remains unimplemented and unvalidated by consensus,
-// we must enforce that it contains no data.
+// Blocks before Electra1 do not process changes, so they must not contain any.
 func (bs BlsToExecutionChanges) EnforceUnused() error {
 	if len(bs) != 0 {
 		return errors.New("BlsToExecutionChanges must be unused")
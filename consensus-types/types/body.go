@@ -86,14 +86,14 @@ type BeaconBlockBody struct {
 	attestations []*Attestation
 	// Deposits is the list of deposits included in the body.
 	Deposits []*Deposit
-	// voluntaryExits is unused but left for compatibility.
-	voluntaryExits []*VoluntaryExit
+	// voluntaryExits is introduced in Electra1 and must be empty before it.
+	voluntaryExits []*SignedVoluntaryExit
 	// syncAggregate is unused but left for compatibility.
 	syncAggregate *SyncAggregate
 	// ExecutionPayload is the execution payload of the body.
 	ExecutionPayload *ExecutionPayload
-	// blsToExecutionChanges is unused but left for compatibility.
-	blsToExecutionChanges []*BlsToExecutionChange
+	// blsToExecutionChanges is introduced in Electra1 and must be empty before it.
+	blsToExecutionChanges []*SignedBLSToExecutionChange
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
 	// executionRequests is introduced in Electra. We keep this private so that it must go through Getter/Setter
@@ -170,13 +170,14 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
 func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
-	err := common.EnforceAllUnused(
-		b.GetProposerSlashings(),
-		b.GetAttesterSlashings(),
-		b.GetAttestations(),
-		b.GetVoluntaryExits(),
-		b.GetSyncAggregate(),
-		b.GetBlsToExecutionChanges(),
+	err := errors.Join(
+		common.EnforceAllUnused(
+			b.GetProposerSlashings(),
+			b.GetAttesterSlashings(),
+			b.GetAttestations(),
+			b.GetSyncAggregate(),
+		),
+		b.enforceUnusedBeforeElectra1(),
 	)
 	if err != nil {
 		return []byte{}, err
@@ -199,16 +200,24 @@ func (b *BeaconBlockBody) ValidateAfterDecodingSSZ() error {
 		b.GetProposerSlashings(),
 		b.GetAttesterSlashings(),
 		b.GetAttestations(),
-		b.GetVoluntaryExits(),
 		b.GetSyncAggregate(),
-		b.GetBlsToExecutionChanges(),
 	)
 	return errors.Join(
 		b.ExecutionPayload.ValidateAfterDecodingSSZ(),
 		errUnused,
+		b.enforceUnusedBeforeElectra1(),
 	)
 }
 
+// enforceUnusedBeforeElectra1 enforces that the operations introduced in
+// Electra1 are left empty by blocks of earlier forks.
+func (b *BeaconBlockBody) enforceUnusedBeforeElectra1() error {
+	if version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra1()) {
+		return nil
+	}
+	return common.EnforceAllUnused(b.GetVoluntaryExits(), b.GetBlsToExecutionChanges())
+}
+
 // HashTreeRoot returns the SSZ hash tree root of the BeaconBlockBody.
 func (b *BeaconBlockBody) HashTreeRoot() common.Root {
 	return ssz.HashConcurrent(b)
@@ -339,6 +348,12 @@ func (b *BeaconBlockBody) GetExecutionPayload() *ExecutionPayload {
 	return b.ExecutionPayload
 }
 
+// GetImmutableExecutionPayload returns a read-only view of the execution
+// payload, for call sites that must not mutate the block.
+func (b *BeaconBlockBody) GetImmutableExecutionPayload() ImmutableExecutionPayload {
+	return NewImmutableExecutionPayload(b.ExecutionPayload)
+}
+
 func (b *BeaconBlockBody) SetExecutionPayload(executionData *ExecutionPayload) {
 	b.ExecutionPayload = executionData
 }
@@ -253,16 +253,30 @@ func TestBeaconBlockBody_UnusedAttestationsEnforcement(t *testing.T) {
 	})
 }
 
-// Ensure that the VoluntaryExits field cannot be unmarshaled with data in it,
-// enforcing that it's unused.
-func TestBeaconBlockBody_UnusedVoluntaryExitsEnforcement(t *testing.T) {
+// Ensure that the VoluntaryExits field cannot be unmarshaled with data in it
+// before Electra1, and that exits round trip from Electra1 on.
+func TestBeaconBlockBody_VoluntaryExitsEnforcement(t *testing.T) {
 	t.Parallel()
 	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
-		blockBody := types.BeaconBlockBody{
-			Versionable: types.NewVersionable(v),
+		blockBody := generateBeaconBlockBody(t, v)
+		blockBody.SetVoluntaryExits(types.VoluntaryExits{
+			{
+				Message:   &types.VoluntaryExitMessage{Epoch: 1, ValidatorIndex: 2},
+				Signature: crypto.BLSSignature{3},
+			},
+		})
+
+		if version.EqualsOrIsAfter(v, version.Electra1()) {
+			buf, err := blockBody.MarshalSSZ()
+			require.NoError(t, err)
+
+			unmarshalledBody := types.NewEmptyBeaconBlockBodyWithVersion(v)
+			require.NoError(t, sszutil.Unmarshal(buf, unmarshalledBody))
+			require.Equal(t, blockBody.GetVoluntaryExits(), unmarshalledBody.GetVoluntaryExits())
+			require.Equal(t, blockBody.HashTreeRoot(), unmarshalledBody.HashTreeRoot())
+			return
 		}
-		unused := common.UnusedType(1)
-		blockBody.SetVoluntaryExits(types.VoluntaryExits{&unused})
+
 		_, err := blockBody.MarshalSSZ()
 		require.Error(t, err)
 
@@ -276,16 +290,34 @@ func TestBeaconBlockBody_UnusedVoluntaryExitsEnforcement(t *testing.T) {
 	})
 }
 
-// Ensure that the BlsToExecutionChanges field cannot be unmarshaled with data in it,
-// enforcing that it's unused.
-func TestBeaconBlockBody_UnusedBlsToExecutionChangesEnforcement(t *testing.T) {
+// Ensure that the BlsToExecutionChanges field cannot be unmarshaled with data in
+// it before Electra1, and that changes round trip from Electra1 on.
+func TestBeaconBlockBody_BlsToExecutionChangesEnforcement(t *testing.T) {
 	t.Parallel()
 	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
-		blockBody := types.BeaconBlockBody{
-			Versionable: types.NewVersionable(v),
+		blockBody := generateBeaconBlockBody(t, v)
+		blockBody.SetBlsToExecutionChanges(types.BlsToExecutionChanges{
+			{
+				Message: &types.BLSToExecutionChange{
+					ValidatorIndex:     1,
+					FromBLSPubkey:      crypto.BLSPubkey{2},
+					ToExecutionAddress: common.ExecutionAddress{3},
+				},
+				Signature: crypto.BLSSignature{4},
+			},
+		})
+
+		if version.EqualsOrIsAfter(v, version.Electra1()) {
+			buf, err := blockBody.MarshalSSZ()
+			require.NoError(t, err)
+
+			unmarshalledBody := types.NewEmptyBeaconBlockBodyWithVersion(v)
+			require.NoError(t, sszutil.Unmarshal(buf, unmarshalledBody))
+			require.Equal(t, blockBody.GetBlsToExecutionChanges(), unmarshalledBody.GetBlsToExecutionChanges())
+			require.Equal(t, blockBody.HashTreeRoot(), unmarshalledBody.HashTreeRoot())
+			return
 		}
-		unused := common.UnusedType(1)
-		blockBody.SetBlsToExecutionChanges(types.BlsToExecutionChanges{&unused})
+
 		_, err := blockBody.MarshalSSZ()
 		require.Error(t, err)
 
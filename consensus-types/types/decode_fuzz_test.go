@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	sszutil "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// The decoders below are reachable from the network and the node API. Any
+// input they accept must re-encode to the same bytes, and none may panic.
+
+func FuzzExecutionPayload_UnmarshalSSZ(f *testing.F) {
+	for _, v := range version.GetSupportedVersions() {
+		payload := generateExecutionPayload()
+		payload.Versionable = types.NewVersionable(v)
+		data, err := payload.MarshalSSZ()
+		require.NoError(f, err)
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, v := range version.GetSupportedVersions() {
+			payload := types.NewEmptyExecutionPayloadWithVersion(v)
+			if err := sszutil.Unmarshal(data, payload); err != nil {
+				continue
+			}
+			encoded, err := payload.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, data, encoded)
+			_ = payload.HashTreeRoot()
+		}
+	})
+}
+
+func FuzzExecutionPayload_UnmarshalJSON(f *testing.F) {
+	data, err := json.Marshal(generateExecutionPayload())
+	require.NoError(f, err)
+	f.Add(data)
+	f.Add([]byte(`{}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		payload := types.NewEmptyExecutionPayloadWithVersion(version.Deneb1())
+		if err := payload.UnmarshalJSON(data); err != nil {
+			return
+		}
+		encoded, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		decoded := types.NewEmptyExecutionPayloadWithVersion(version.Deneb1())
+		require.NoError(t, decoded.UnmarshalJSON(encoded))
+		reencoded, err := json.Marshal(decoded)
+		require.NoError(t, err)
+		require.JSONEq(t, string(encoded), string(reencoded))
+	})
+}
+
+func FuzzExecutionPayloadHeader_UnmarshalSSZ(f *testing.F) {
+	for _, v := range version.GetSupportedVersions() {
+		payload := generateExecutionPayload()
+		payload.Versionable = types.NewVersionable(v)
+		header, err := payload.ToHeader()
+		require.NoError(f, err)
+		data, err := header.MarshalSSZ()
+		require.NoError(f, err)
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, v := range version.GetSupportedVersions() {
+			header := types.NewEmptyExecutionPayloadHeaderWithVersion(v)
+			if err := sszutil.Unmarshal(data, header); err != nil {
+				continue
+			}
+			encoded, err := header.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, data, encoded)
+			_ = header.HashTreeRoot()
+		}
+	})
+}
+
+func FuzzSignedBeaconBlock_UnmarshalSSZ(f *testing.F) {
+	for _, v := range version.GetSupportedVersions() {
+		blk := &types.SignedBeaconBlock{
+			BeaconBlock: types.NewEmptyBeaconBlockWithVersion(v),
+		}
+		data, err := blk.MarshalSSZ()
+		require.NoError(f, err)
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, v := range version.GetSupportedVersions() {
+			blk, err := types.NewEmptySignedBeaconBlockWithVersion(v)
+			require.NoError(t, err)
+			if err = sszutil.Unmarshal(data, blk); err != nil {
+				continue
+			}
+			var encoded []byte
+			encoded, err = blk.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, data, encoded)
+			require.NotEqual(t, common.Root{}, blk.HashTreeRoot())
+		}
+	})
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionPayload_DeepCopyEqual(t *testing.T) {
+	t.Parallel()
+	payload := generateExecutionPayload()
+	cpy := payload.DeepCopy()
+	require.True(t, payload.Equal(cpy))
+
+	cpy.Transactions[0][0] = 0xff
+	require.Equal(t, byte(0x07), payload.Transactions[0][0])
+	require.False(t, payload.Equal(cpy))
+
+	cpy = payload.DeepCopy()
+	cpy.Withdrawals[0].Amount = 1
+	require.Zero(t, payload.Withdrawals[0].Amount)
+	require.False(t, payload.Equal(cpy))
+}
+
+func TestBeaconState_DeepCopyEqual(t *testing.T) {
+	t.Parallel()
+	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
+		state := generateValidBeaconState(v)
+		cpy := state.DeepCopy()
+		require.True(t, state.Equal(cpy))
+
+		cpy.Validators[0].EffectiveBalance++
+		require.NotEqual(t,
+			cpy.Validators[0].EffectiveBalance,
+			state.Validators[0].EffectiveBalance,
+		)
+		require.False(t, state.Equal(cpy))
+	})
+}
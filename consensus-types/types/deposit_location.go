@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// depositLocationSize is the size of an encoded DepositLocation:
+// index (8) + block number (8) + log index (8) + tx hash (32).
+const depositLocationSize = 56
+
+// DepositLocation records where on the execution layer a deposit was read
+// from. It is node-local metadata and is never part of the consensus state.
+type DepositLocation struct {
+	// Index is the index of the deposit in the deposit contract.
+	Index math.U64 `json:"index"`
+	// BlockNumber is the execution block that emitted the deposit log.
+	BlockNumber math.U64 `json:"block_number"`
+	// LogIndex is the index of the deposit log within the block.
+	LogIndex math.U64 `json:"log_index"`
+	// TxHash is the hash of the transaction that emitted the deposit log.
+	TxHash common.ExecutionHash `json:"transaction_hash"`
+}
+
+// MarshalBinary encodes the DepositLocation into a fixed size byte slice.
+func (l *DepositLocation) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, depositLocationSize)
+	binary.BigEndian.PutUint64(buf[0:8], l.Index.Unwrap())
+	binary.BigEndian.PutUint64(buf[8:16], l.BlockNumber.Unwrap())
+	binary.BigEndian.PutUint64(buf[16:24], l.LogIndex.Unwrap())
+	copy(buf[24:], l.TxHash[:])
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a DepositLocation from a byte slice produced by
+// MarshalBinary.
+func (l *DepositLocation) UnmarshalBinary(buf []byte) error {
+	if len(buf) != depositLocationSize {
+		return fmt.Errorf(
+			"invalid deposit location size, expected %d, got %d",
+			depositLocationSize, len(buf),
+		)
+	}
+	l.Index = math.U64(binary.BigEndian.Uint64(buf[0:8]))
+	l.BlockNumber = math.U64(binary.BigEndian.Uint64(buf[8:16]))
+	l.LogIndex = math.U64(binary.BigEndian.Uint64(buf[16:24]))
+	copy(l.TxHash[:], buf[24:])
+	return nil
+}
@@ -32,6 +32,22 @@ var (
 		"invalid withdrawal credentials",
 	)
 
+	// ErrInvalidBLSToExecutionChange is an error for when a BLS to execution
+	// change does not apply to the validator it targets.
+	ErrInvalidBLSToExecutionChange = errors.New("invalid BLS to execution change")
+
+	// ErrBLSToExecutionChangeSignature is an error for when the signature of a
+	// BLS to execution change doesn't match.
+	ErrBLSToExecutionChangeSignature = errors.New("invalid BLS to execution change signature")
+
+	// ErrVoluntaryExitSignature is an error for when the signature of a
+	// voluntary exit doesn't match.
+	ErrVoluntaryExitSignature = errors.New("invalid voluntary exit signature")
+
+	// ErrUnjailSignature is an error for when the signature of an unjail
+	// doesn't match.
+	ErrUnjailSignature = errors.New("invalid unjail signature")
+
 	// ErrForkVersionNotSupported is an error for when the fork
 	// version is not supported.
 	ErrForkVersionNotSupported = errors.New("fork version not supported")
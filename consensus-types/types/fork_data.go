@@ -100,6 +100,13 @@ func (fd *ForkData) ComputeDomain(
 	)
 }
 
+// ComputeForkDigest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+func (fd *ForkData) ComputeForkDigest() common.ForkDigest {
+	forkDataRoot := fd.HashTreeRoot()
+	return common.ForkDigest(forkDataRoot[:4])
+}
+
 // ComputeRandaoSigningRoot computes the randao signing root.
 func (fd *ForkData) ComputeRandaoSigningRoot(
 	domainType common.DomainType,
@@ -93,6 +93,23 @@ func TestForkData_ComputeDomain(t *testing.T) {
 	})
 }
 
+func TestForkData_ComputeForkDigest(t *testing.T) {
+	t.Parallel()
+	// The fork digest and deposit domain of Ethereum mainnet at genesis.
+	genesisValidatorsRoot, err := common.NewRootFromHex(
+		"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	)
+	require.NoError(t, err)
+	forkData := types.NewForkData(common.Version{}, genesisValidatorsRoot)
+	require.Equal(t, "0xb5303f2a", forkData.ComputeForkDigest().String())
+
+	depositForkData := types.NewForkData(common.Version{}, common.Root{})
+	require.Equal(t,
+		"0x03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9",
+		depositForkData.ComputeDomain(common.DomainType{0x03}).String(),
+	)
+}
+
 func TestForkData_ComputeRandaoSigningRoot(t *testing.T) {
 	t.Parallel()
 	fd := &types.ForkData{
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// ErrMigrationDowngrade is returned when migrating an object to an earlier
+// fork version.
+var ErrMigrationDowngrade = errors.New("cannot migrate to an earlier fork version")
+
+// Migration translates obj, valid for fork.PreviousVersion, into an object
+// valid for fork.CurrentVersion. It may update obj in place.
+type Migration[T any] func(obj T, fork *Fork) (T, error)
+
+// Migrations is the registry of the per-fork migrations of a type, starting
+// from the earliest fork version the type supports.
+type Migrations[T any] struct {
+	base common.Version
+	// versions are the versions with a migration, in fork order.
+	versions []common.Version
+	steps    map[common.Version]Migration[T]
+}
+
+// NewMigrations creates a registry for a type supported since base.
+func NewMigrations[T any](base common.Version) *Migrations[T] {
+	return &Migrations[T]{
+		base:  base,
+		steps: make(map[common.Version]Migration[T]),
+	}
+}
+
+// Register sets the migration into the fork version to. Forks must be
+// registered in order.
+func (m *Migrations[T]) Register(to common.Version, fn Migration[T]) *Migrations[T] {
+	last := m.base
+	if len(m.versions) > 0 {
+		last = m.versions[len(m.versions)-1]
+	}
+	if !version.IsAfter(to, last) {
+		panic("migrations must be registered in fork order")
+	}
+	m.versions = append(m.versions, to)
+	m.steps[to] = fn
+	return m
+}
+
+// Supports returns true if the type exists in the given fork version.
+func (m *Migrations[T]) Supports(v common.Version) bool {
+	return v == m.base || slices.Contains(m.versions, v)
+}
+
+// Migrate translates obj from the fork version from to the fork version to,
+// applying in order the migration of every fork crossed. The forks crossed
+// are recorded as activating at epoch.
+func (m *Migrations[T]) Migrate(
+	obj T, from, to common.Version, epoch math.Epoch,
+) (T, error) {
+	switch {
+	case !m.Supports(from):
+		return obj, errors.Wrapf(ErrForkVersionNotSupported, "%s", from)
+	case !m.Supports(to):
+		return obj, errors.Wrapf(ErrForkVersionNotSupported, "%s", to)
+	case version.IsBefore(to, from):
+		return obj, errors.Wrapf(ErrMigrationDowngrade, "%s to %s", from, to)
+	}
+
+	var err error
+	previous := from
+	for _, v := range m.versions {
+		if !version.IsAfter(v, from) || version.IsAfter(v, to) {
+			continue
+		}
+		if obj, err = m.steps[v](obj, NewFork(previous, v, epoch)); err != nil {
+			return obj, errors.Wrapf(err, "failed migrating to %s", v)
+		}
+		previous = v
+	}
+	return obj, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Registries                                 */
+/* -------------------------------------------------------------------------- */
+
+// ExecutionPayloadHeaderMigrations migrates execution payload headers across
+// forks. The header layout is unchanged since Deneb.
+//
+//nolint:gochecknoglobals // registry of per-fork migrations.
+var ExecutionPayloadHeaderMigrations = NewMigrations[*ExecutionPayloadHeader](version.Deneb()).
+	Register(version.Deneb1(), migrateHeaderVersion).
+	Register(version.Electra(), migrateHeaderVersion).
+	Register(version.Electra1(), migrateHeaderVersion)
+
+// BeaconStateMigrations migrates beacon states across forks, mirroring the
+// state upgrades of the state processor.
+//
+//nolint:gochecknoglobals // registry of per-fork migrations.
+var BeaconStateMigrations = NewMigrations[*BeaconState](version.Deneb()).
+	Register(version.Deneb1(), migrateStateToDeneb1).
+	Register(version.Electra(), migrateStateToElectra).
+	Register(version.Electra1(), migrateStateToElectra1)
+
+// migrateHeaderVersion only updates the fork version of the header.
+func migrateHeaderVersion(
+	h *ExecutionPayloadHeader, fork *Fork,
+) (*ExecutionPayloadHeader, error) {
+	if h == nil {
+		return nil, ErrNilValue
+	}
+	h.Versionable = NewVersionable(fork.CurrentVersion)
+	return h, nil
+}
+
+// migrateStateVersion updates the fork version of the state and of its latest
+// execution payload header.
+func migrateStateVersion(st *BeaconState, fork *Fork) (*BeaconState, error) {
+	if st == nil {
+		return nil, ErrNilValue
+	}
+	st.Versionable = NewVersionable(fork.CurrentVersion)
+	if st.LatestExecutionPayloadHeader != nil {
+		if _, err := migrateHeaderVersion(st.LatestExecutionPayloadHeader, fork); err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
+
+// migrateStateToDeneb1 leaves the Fork of the state untouched, as Deneb1 did
+// not update it.
+func migrateStateToDeneb1(st *BeaconState, fork *Fork) (*BeaconState, error) {
+	return migrateStateVersion(st, fork)
+}
+
+// migrateStateToElectra sets the Fork of the state and initializes the pending
+// partial withdrawals.
+func migrateStateToElectra(st *BeaconState, fork *Fork) (*BeaconState, error) {
+	st, err := migrateStateVersion(st, fork)
+	if err != nil {
+		return nil, err
+	}
+	st.Fork = migratedFork(st.Fork, fork)
+	st.PendingPartialWithdrawals = []*PendingPartialWithdrawal{}
+	return st, nil
+}
+
+// migrateStateToElectra1 sets the Fork of the state and initializes the
+// pending partial withdrawals if missing.
+func migrateStateToElectra1(st *BeaconState, fork *Fork) (*BeaconState, error) {
+	st, err := migrateStateVersion(st, fork)
+	if err != nil {
+		return nil, err
+	}
+	st.Fork = migratedFork(st.Fork, fork)
+	if st.PendingPartialWithdrawals == nil {
+		st.PendingPartialWithdrawals = []*PendingPartialWithdrawal{}
+	}
+	return st, nil
+}
+
+// migratedFork returns the Fork of a state after fork. The previous version
+// is the current version of the state, which may predate fork.PreviousVersion
+// for forks like Deneb1 that left the Fork untouched.
+func migratedFork(current, fork *Fork) *Fork {
+	previous := fork.PreviousVersion
+	if current != nil {
+		previous = current.CurrentVersion
+	}
+	return NewFork(previous, fork.CurrentVersion, fork.Epoch)
+}
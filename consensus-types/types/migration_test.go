@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations(t *testing.T) {
+	t.Parallel()
+
+	var crossed []*types.Fork
+	step := func(obj int, fork *types.Fork) (int, error) {
+		crossed = append(crossed, fork)
+		return obj + 1, nil
+	}
+	m := types.NewMigrations[int](version.Deneb()).
+		Register(version.Deneb1(), step).
+		Register(version.Electra(), step)
+
+	require.True(t, m.Supports(version.Deneb()))
+	require.True(t, m.Supports(version.Electra()))
+	require.False(t, m.Supports(version.Electra1()))
+
+	// Every fork crossed is migrated, in order.
+	obj, err := m.Migrate(0, version.Deneb(), version.Electra(), 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, obj)
+	require.Equal(t, []*types.Fork{
+		types.NewFork(version.Deneb(), version.Deneb1(), 3),
+		types.NewFork(version.Deneb1(), version.Electra(), 3),
+	}, crossed)
+
+	// Migrating to the same version is a no-op.
+	obj, err = m.Migrate(0, version.Electra(), version.Electra(), 3)
+	require.NoError(t, err)
+	require.Zero(t, obj)
+
+	_, err = m.Migrate(0, version.Electra(), version.Deneb(), 3)
+	require.ErrorIs(t, err, types.ErrMigrationDowngrade)
+	_, err = m.Migrate(0, version.Deneb(), version.Electra1(), 3)
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+
+	require.Panics(t, func() {
+		types.NewMigrations[int](version.Electra()).Register(version.Deneb1(), step)
+	})
+}
+
+func TestBeaconStateMigrations(t *testing.T) {
+	t.Parallel()
+
+	st := types.NewEmptyBeaconStateWithVersion(version.Deneb())
+	st.Fork = types.NewFork(version.Deneb(), version.Deneb(), 0)
+	st.LatestExecutionPayloadHeader = types.NewEmptyExecutionPayloadHeaderWithVersion(version.Deneb())
+
+	// Deneb1 does not update the Fork.
+	st, err := types.BeaconStateMigrations.Migrate(st, version.Deneb(), version.Deneb1(), 5)
+	require.NoError(t, err)
+	require.Equal(t, version.Deneb1(), st.GetForkVersion())
+	require.Equal(t, version.Deneb1(), st.LatestExecutionPayloadHeader.GetForkVersion())
+	require.Equal(t, types.NewFork(version.Deneb(), version.Deneb(), 0), st.Fork)
+	require.Nil(t, st.PendingPartialWithdrawals)
+
+	st, err = types.BeaconStateMigrations.Migrate(st, version.Deneb1(), version.Electra1(), math.Epoch(7))
+	require.NoError(t, err)
+	require.Equal(t, version.Electra1(), st.GetForkVersion())
+	require.Equal(t, version.Electra1(), st.LatestExecutionPayloadHeader.GetForkVersion())
+	require.Equal(t, types.NewFork(version.Electra(), version.Electra1(), 7), st.Fork)
+	require.NotNil(t, st.PendingPartialWithdrawals)
+}
+
+func TestToHeaderUnsupportedVersion(t *testing.T) {
+	t.Parallel()
+	payload := types.NewEmptyExecutionPayloadWithVersion(common.Version{0xff})
+	_, err := payload.ToHeader()
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+}
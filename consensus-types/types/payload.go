@@ -34,6 +34,9 @@ import (
 	"github.com/karalabe/ssz"
 )
 
+// The static sizes of the consensus types are checked against their schemas.
+//go:generate go run ../sszschema/gen -out sizes.schemagen.go
+
 const (
 	// ExecutionPayloadStaticSize is the static size of the ExecutionPayload.
 	ExecutionPayloadStaticSize uint32 = 528
@@ -142,7 +145,7 @@ func (p *ExecutionPayload) DefineSSZ(codec *ssz.Codec) {
 		constants.MaxTxsPerPayload,
 		constants.MaxBytesPerTx,
 	)
-	ssz.DefineSliceOfStaticObjectsOffset(codec, &p.Withdrawals, 16)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &p.Withdrawals, constants.MaxWithdrawalsPerPayload)
 	ssz.DefineUint64(codec, &p.BlobGasUsed)
 	ssz.DefineUint64(codec, &p.ExcessBlobGas)
 
@@ -154,7 +157,7 @@ func (p *ExecutionPayload) DefineSSZ(codec *ssz.Codec) {
 		constants.MaxTxsPerPayload,
 		constants.MaxBytesPerTx,
 	)
-	ssz.DefineSliceOfStaticObjectsContent(codec, &p.Withdrawals, 16)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &p.Withdrawals, constants.MaxWithdrawalsPerPayload)
 
 	// Note that at this state we don't have any guarantee that
 	// p.Withdrawal is not nil, which we require Capella onwards
@@ -277,7 +280,7 @@ func (p *ExecutionPayload) HashTreeRootWith(hh fastssz.HashWalker) error {
 	{
 		subIndx := hh.Index()
 		num := uint64(len(p.Withdrawals))
-		if num > 16 {
+		if num > constants.MaxWithdrawalsPerPayload {
 			return fastssz.ErrIncorrectListSize
 		}
 		for _, elem := range p.Withdrawals {
@@ -285,7 +288,7 @@ func (p *ExecutionPayload) HashTreeRootWith(hh fastssz.HashWalker) error {
 				return err
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxWithdrawalsPerPayload)
 	}
 
 	// Field (15) 'BlobGasUsed'
@@ -574,29 +577,27 @@ func (p *ExecutionPayload) GetExcessBlobGas() math.U64 {
 
 // ToHeader converts the ExecutionPayload to an ExecutionPayloadHeader.
 func (p *ExecutionPayload) ToHeader() (*ExecutionPayloadHeader, error) {
-	switch p.GetForkVersion() {
-	case version.Deneb(), version.Deneb1(), version.Electra(), version.Electra1():
-		return &ExecutionPayloadHeader{
-			Versionable:      p.Versionable,
-			ParentHash:       p.GetParentHash(),
-			FeeRecipient:     p.GetFeeRecipient(),
-			StateRoot:        p.GetStateRoot(),
-			ReceiptsRoot:     p.GetReceiptsRoot(),
-			LogsBloom:        p.GetLogsBloom(),
-			Random:           p.GetPrevRandao(),
-			Number:           p.GetNumber(),
-			GasLimit:         p.GetGasLimit(),
-			GasUsed:          p.GetGasUsed(),
-			Timestamp:        p.GetTimestamp(),
-			ExtraData:        p.GetExtraData(),
-			BaseFeePerGas:    p.GetBaseFeePerGas(),
-			BlockHash:        p.GetBlockHash(),
-			TransactionsRoot: p.GetTransactions().HashTreeRoot(),
-			WithdrawalsRoot:  p.GetWithdrawals().HashTreeRoot(),
-			BlobGasUsed:      p.GetBlobGasUsed(),
-			ExcessBlobGas:    p.GetExcessBlobGas(),
-		}, nil
-	default:
-		return nil, errors.New("unknown fork version")
-	}
+	if !ExecutionPayloadHeaderMigrations.Supports(p.GetForkVersion()) {
+		return nil, errors.Wrapf(ErrForkVersionNotSupported, "%s", p.GetForkVersion())
+	}
+	return &ExecutionPayloadHeader{
+		Versionable:      p.Versionable,
+		ParentHash:       p.GetParentHash(),
+		FeeRecipient:     p.GetFeeRecipient(),
+		StateRoot:        p.GetStateRoot(),
+		ReceiptsRoot:     p.GetReceiptsRoot(),
+		LogsBloom:        p.GetLogsBloom(),
+		Random:           p.GetPrevRandao(),
+		Number:           p.GetNumber(),
+		GasLimit:         p.GetGasLimit(),
+		GasUsed:          p.GetGasUsed(),
+		Timestamp:        p.GetTimestamp(),
+		ExtraData:        p.GetExtraData(),
+		BaseFeePerGas:    p.GetBaseFeePerGas(),
+		BlockHash:        p.GetBlockHash(),
+		TransactionsRoot: p.GetTransactions().HashTreeRoot(),
+		WithdrawalsRoot:  p.GetWithdrawals().HashTreeRoot(),
+		BlobGasUsed:      p.GetBlobGasUsed(),
+		ExcessBlobGas:    p.GetExcessBlobGas(),
+	}, nil
 }
@@ -21,8 +21,12 @@
 package types
 
 import (
+	"fmt"
+
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -43,47 +47,102 @@ type BuiltExecutionPayloadEnv interface {
 }
 
 // executionPayloadEnvelope is a struct that holds the execution payload and
-// its associated data.
-type executionPayloadEnvelope[BlobsBundleT engineprimitives.BlobsBundle] struct {
-	ExecutionPayload  *ExecutionPayload         `json:"executionPayload"`
-	BlockValue        *math.U256                `json:"blockValue"`
-	BlobsBundle       BlobsBundleT              `json:"blobsBundle"`
-	ExecutionRequests []EncodedExecutionRequest `json:"executionRequests"`
-	Override          bool                      `json:"shouldOverrideBuilder"`
+// its associated data. It decodes the response of any engine_getPayload
+// version, since they only differ in which fields are set.
+type executionPayloadEnvelope struct {
+	ExecutionPayload  *ExecutionPayload               `json:"executionPayload"`
+	BlockValue        *math.U256                      `json:"blockValue"`
+	BlobsBundle       *engineprimitives.BlobsBundleV1 `json:"blobsBundle"`
+	ExecutionRequests []EncodedExecutionRequest       `json:"executionRequests"`
+	Override          bool                            `json:"shouldOverrideBuilder"`
+
+	// getPayloadVersion is the version of the engine_getPayload response the
+	// envelope is decoded from.
+	getPayloadVersion engineprimitives.GetPayloadVersion
 }
 
-// NewEmptyExecutionPayloadEnvelope returns an empty executionPayloadEnvelope
-// for the given fork version.
-func NewEmptyExecutionPayloadEnvelope[
-	BlobsBundleT engineprimitives.BlobsBundle,
-](forkVersion common.Version) BuiltExecutionPayloadEnv {
-	return &executionPayloadEnvelope[BlobsBundleT]{
-		ExecutionPayload: NewEmptyExecutionPayloadWithVersion(forkVersion),
+// NewExecutionPayloadEnvelope returns an empty executionPayloadEnvelope for
+// the given fork version, which decodes and validates the response of the
+// given engine_getPayload version.
+func NewExecutionPayloadEnvelope(
+	forkVersion common.Version,
+	getPayloadVersion engineprimitives.GetPayloadVersion,
+) BuiltExecutionPayloadEnv {
+	return &executionPayloadEnvelope{
+		ExecutionPayload:  NewEmptyExecutionPayloadWithVersion(forkVersion),
+		getPayloadVersion: getPayloadVersion,
+	}
+}
+
+// DecodeExecutionPayloadEnvelope decodes the JSON response of the
+// engine_getPayload version used by the given fork version. The execution
+// payload of the returned envelope carries the fork version.
+func DecodeExecutionPayloadEnvelope(
+	forkVersion common.Version, bz []byte,
+) (BuiltExecutionPayloadEnv, error) {
+	getPayloadVersion, err := engineprimitives.GetPayloadVersionForFork(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	env := NewExecutionPayloadEnvelope(forkVersion, getPayloadVersion)
+	if err = json.Unmarshal(bz, env); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// UnmarshalJSON decodes the envelope and checks that it has the shape of its
+// engine_getPayload version.
+func (e *executionPayloadEnvelope) UnmarshalJSON(bz []byte) error {
+	// envelope has the fields of executionPayloadEnvelope but not its methods,
+	// to avoid recursing into UnmarshalJSON.
+	type envelope executionPayloadEnvelope
+	dec := envelope(*e)
+	if err := json.Unmarshal(bz, &dec); err != nil {
+		return err
+	}
+	if dec.ExecutionPayload == nil {
+		return errors.New("missing required field 'executionPayload' for ExecutionPayloadEnvelope")
+	}
+	if dec.BlobsBundle == nil {
+		return errors.New("missing required field 'blobsBundle' for ExecutionPayloadEnvelope")
+	}
+	// Electra and later forks may return an empty list of requests, but
+	// never omit it.
+	if (dec.ExecutionRequests != nil) != e.getPayloadVersion.HasExecutionRequests() {
+		return fmt.Errorf(
+			"%w: getPayloadV%d", engineprimitives.ErrUnexpectedExecutionRequests, e.getPayloadVersion,
+		)
+	}
+	if err := e.getPayloadVersion.ValidateBlobsBundle(dec.BlobsBundle); err != nil {
+		return err
 	}
+	*e = executionPayloadEnvelope(dec)
+	return nil
 }
 
 // GetExecutionPayload returns the execution payload of the
 // executionPayloadEnvelope.
-func (e *executionPayloadEnvelope[BlobsBundleT]) GetExecutionPayload() *ExecutionPayload {
+func (e *executionPayloadEnvelope) GetExecutionPayload() *ExecutionPayload {
 	return e.ExecutionPayload
 }
 
 // GetBlockValue returns the block value of the executionPayloadEnvelope.
-func (e *executionPayloadEnvelope[BlobsBundleT]) GetBlockValue() *math.U256 {
+func (e *executionPayloadEnvelope) GetBlockValue() *math.U256 {
 	return e.BlockValue
 }
 
 // GetBlobsBundle returns the blobs bundle of the executionPayloadEnvelope.
-func (e *executionPayloadEnvelope[BlobsBundleT]) GetBlobsBundle() engineprimitives.BlobsBundle {
+func (e *executionPayloadEnvelope) GetBlobsBundle() engineprimitives.BlobsBundle {
 	return e.BlobsBundle
 }
 
 // GetEncodedExecutionRequests returns the encoded Execution Requests
-func (e *executionPayloadEnvelope[BlobsBundleT]) GetEncodedExecutionRequests() []EncodedExecutionRequest {
+func (e *executionPayloadEnvelope) GetEncodedExecutionRequests() []EncodedExecutionRequest {
 	return e.ExecutionRequests
 }
 
 // ShouldOverrideBuilder returns whether the builder should be overridden.
-func (e *executionPayloadEnvelope[BlobsBundleT]) ShouldOverrideBuilder() bool {
+func (e *executionPayloadEnvelope) ShouldOverrideBuilder() bool {
 	return e.Override
 }
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func getPayloadResponse(
+	t *testing.T,
+	forkVersion common.Version,
+	requests []types.EncodedExecutionRequest,
+	bundle *engineprimitives.BlobsBundleV1,
+) []byte {
+	t.Helper()
+	payload := types.NewEmptyExecutionPayloadWithVersion(forkVersion)
+	payload.Transactions = [][]byte{}
+	payload.ExtraData = []byte{}
+	payload.Number = 7
+	response := map[string]any{
+		"executionPayload":      payload,
+		"blockValue":            "0x0",
+		"blobsBundle":           bundle,
+		"shouldOverrideBuilder": false,
+	}
+	if requests != nil {
+		response["executionRequests"] = requests
+	}
+	bz, err := json.Marshal(response)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestDecodeExecutionPayloadEnvelope(t *testing.T) {
+	t.Parallel()
+	emptyBundle := &engineprimitives.BlobsBundleV1{}
+	tests := []struct {
+		name        string
+		forkVersion common.Version
+		requests    []types.EncodedExecutionRequest
+		bundle      *engineprimitives.BlobsBundleV1
+		expectedErr error
+	}{
+		{
+			name:        "deneb without requests",
+			forkVersion: version.Deneb1(),
+			bundle:      emptyBundle,
+		},
+		{
+			name:        "deneb with requests",
+			forkVersion: version.Deneb1(),
+			requests:    []types.EncodedExecutionRequest{},
+			bundle:      emptyBundle,
+			expectedErr: engineprimitives.ErrUnexpectedExecutionRequests,
+		},
+		{
+			name:        "electra with requests",
+			forkVersion: version.Electra(),
+			requests:    []types.EncodedExecutionRequest{{0x00, 0x01}},
+			bundle:      emptyBundle,
+		},
+		{
+			name:        "electra without requests",
+			forkVersion: version.Electra(),
+			bundle:      emptyBundle,
+			expectedErr: engineprimitives.ErrUnexpectedExecutionRequests,
+		},
+		{
+			name:        "mismatched blobs bundle",
+			forkVersion: version.Electra(),
+			requests:    []types.EncodedExecutionRequest{},
+			bundle: &engineprimitives.BlobsBundleV1{
+				Commitments: []eip4844.KZGCommitment{{}},
+				Proofs:      []eip4844.KZGProof{{}},
+			},
+			expectedErr: engineprimitives.ErrInvalidBlobsBundle,
+		},
+		{
+			name:        "unsupported fork",
+			forkVersion: version.Capella(),
+			bundle:      emptyBundle,
+			expectedErr: engineprimitives.ErrUnsupportedGetPayloadVersion,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			bz := getPayloadResponse(t, tc.forkVersion, tc.requests, tc.bundle)
+			env, err := types.DecodeExecutionPayloadEnvelope(tc.forkVersion, bz)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			payload := env.GetExecutionPayload()
+			require.Equal(t, tc.forkVersion, payload.GetForkVersion())
+			require.Equal(t, uint64(7), payload.Number.Unwrap())
+			require.Equal(t, tc.requests, env.GetEncodedExecutionRequests())
+		})
+	}
+}
+
+func TestGetPayloadVersionCellProofs(t *testing.T) {
+	t.Parallel()
+	bundle := &engineprimitives.BlobsBundleV1{
+		Commitments: []eip4844.KZGCommitment{{}},
+		Proofs:      make([]eip4844.KZGProof, engineprimitives.CellsPerExtBlob),
+		Blobs:       []*eip4844.Blob{{}},
+	}
+	require.NoError(t, engineprimitives.GetPayloadV5.ValidateBlobsBundle(bundle))
+	require.ErrorIs(
+		t,
+		engineprimitives.GetPayloadV4.ValidateBlobsBundle(bundle),
+		engineprimitives.ErrInvalidBlobsBundle,
+	)
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"slices"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Compile-time assertion to ensure immutableExecutionPayload implements
+// ImmutableExecutionPayload.
+var _ ImmutableExecutionPayload = (*immutableExecutionPayload)(nil)
+
+// ImmutableExecutionPayload is a read-only view of an ExecutionPayload that
+// can be handed out by stores and caches without letting subsystems mutate
+// the shared payload. Getters of reference types return copies.
+type ImmutableExecutionPayload interface {
+	constraints.Versionable
+	GetParentHash() common.ExecutionHash
+	GetFeeRecipient() common.ExecutionAddress
+	GetStateRoot() common.Bytes32
+	GetReceiptsRoot() common.Bytes32
+	GetLogsBloom() bytes.B256
+	GetPrevRandao() common.Bytes32
+	GetNumber() math.U64
+	GetGasLimit() math.U64
+	GetGasUsed() math.U64
+	GetTimestamp() math.U64
+	GetExtraData() []byte
+	GetBaseFeePerGas() *math.U256
+	GetBlockHash() common.ExecutionHash
+	GetTransactions() engineprimitives.Transactions
+	GetWithdrawals() engineprimitives.Withdrawals
+	GetBlobGasUsed() math.U64
+	GetExcessBlobGas() math.U64
+	HashTreeRoot() common.Root
+	ToHeader() (*ExecutionPayloadHeader, error)
+	// DeepCopy returns a mutable copy of the payload, for call sites that
+	// genuinely need to modify it.
+	DeepCopy() *ExecutionPayload
+}
+
+// immutableExecutionPayload wraps an ExecutionPayload and only exposes it
+// through copying getters.
+type immutableExecutionPayload struct {
+	payload *ExecutionPayload
+}
+
+// NewImmutableExecutionPayload returns a read-only view of the payload. The
+// view shares the payload, so the owner must not mutate it afterwards.
+func NewImmutableExecutionPayload(p *ExecutionPayload) ImmutableExecutionPayload {
+	return &immutableExecutionPayload{payload: p}
+}
+
+func (v *immutableExecutionPayload) GetForkVersion() common.Version {
+	return v.payload.GetForkVersion()
+}
+
+func (v *immutableExecutionPayload) GetParentHash() common.ExecutionHash {
+	return v.payload.GetParentHash()
+}
+
+func (v *immutableExecutionPayload) GetFeeRecipient() common.ExecutionAddress {
+	return v.payload.GetFeeRecipient()
+}
+
+func (v *immutableExecutionPayload) GetStateRoot() common.Bytes32 {
+	return v.payload.GetStateRoot()
+}
+
+func (v *immutableExecutionPayload) GetReceiptsRoot() common.Bytes32 {
+	return v.payload.GetReceiptsRoot()
+}
+
+func (v *immutableExecutionPayload) GetLogsBloom() bytes.B256 {
+	return v.payload.GetLogsBloom()
+}
+
+func (v *immutableExecutionPayload) GetPrevRandao() common.Bytes32 {
+	return v.payload.GetPrevRandao()
+}
+
+func (v *immutableExecutionPayload) GetNumber() math.U64 {
+	return v.payload.GetNumber()
+}
+
+func (v *immutableExecutionPayload) GetGasLimit() math.U64 {
+	return v.payload.GetGasLimit()
+}
+
+func (v *immutableExecutionPayload) GetGasUsed() math.U64 {
+	return v.payload.GetGasUsed()
+}
+
+func (v *immutableExecutionPayload) GetTimestamp() math.U64 {
+	return v.payload.GetTimestamp()
+}
+
+// GetExtraData returns a copy of the extra data.
+func (v *immutableExecutionPayload) GetExtraData() []byte {
+	return slices.Clone(v.payload.GetExtraData())
+}
+
+// GetBaseFeePerGas returns a copy of the base fee per gas.
+func (v *immutableExecutionPayload) GetBaseFeePerGas() *math.U256 {
+	if v.payload.BaseFeePerGas == nil {
+		return nil
+	}
+	return v.payload.BaseFeePerGas.Clone()
+}
+
+func (v *immutableExecutionPayload) GetBlockHash() common.ExecutionHash {
+	return v.payload.GetBlockHash()
+}
+
+// GetTransactions returns a deep copy of the transactions. Prefer
+// HashTreeRoot or ToHeader when only a commitment to them is needed.
+func (v *immutableExecutionPayload) GetTransactions() engineprimitives.Transactions {
+	return copyTransactions(v.payload.GetTransactions())
+}
+
+// GetWithdrawals returns a deep copy of the withdrawals.
+func (v *immutableExecutionPayload) GetWithdrawals() engineprimitives.Withdrawals {
+	return copyWithdrawals(v.payload.GetWithdrawals())
+}
+
+func (v *immutableExecutionPayload) GetBlobGasUsed() math.U64 {
+	return v.payload.GetBlobGasUsed()
+}
+
+func (v *immutableExecutionPayload) GetExcessBlobGas() math.U64 {
+	return v.payload.GetExcessBlobGas()
+}
+
+func (v *immutableExecutionPayload) HashTreeRoot() common.Root {
+	return v.payload.HashTreeRoot()
+}
+
+// ToHeader converts the payload to a header. The header does not share any
+// reference with the payload.
+func (v *immutableExecutionPayload) ToHeader() (*ExecutionPayloadHeader, error) {
+	return v.DeepCopy().ToHeader()
+}
+
+func (v *immutableExecutionPayload) DeepCopy() *ExecutionPayload {
+	return v.payload.DeepCopy()
+}
+
+// copyTransactions returns a deep copy of txs, preserving nil.
+func copyTransactions(txs engineprimitives.Transactions) engineprimitives.Transactions {
+	if txs == nil {
+		return nil
+	}
+	cpy := make(engineprimitives.Transactions, len(txs))
+	for i, tx := range txs {
+		cpy[i] = slices.Clone(tx)
+	}
+	return cpy
+}
+
+// copyWithdrawals returns a deep copy of withdrawals, preserving nil.
+func copyWithdrawals(withdrawals []*engineprimitives.Withdrawal) []*engineprimitives.Withdrawal {
+	if withdrawals == nil {
+		return nil
+	}
+	cpy := make([]*engineprimitives.Withdrawal, len(withdrawals))
+	for i, w := range withdrawals {
+		if w != nil {
+			wCopy := *w
+			cpy[i] = &wCopy
+		}
+	}
+	return cpy
+}
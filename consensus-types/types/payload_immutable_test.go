@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImmutableExecutionPayload(t *testing.T) {
+	t.Parallel()
+	payload := generateExecutionPayload()
+	payload.BaseFeePerGas = math.NewU256(7)
+	root := payload.HashTreeRoot()
+	view := types.NewImmutableExecutionPayload(payload)
+
+	// Mutating the values returned by the view must not reach the payload.
+	view.GetExtraData()[0] = 0xff
+	view.GetTransactions()[0][0] = 0xff
+	view.GetWithdrawals()[0].Amount = 1
+	view.GetBaseFeePerGas().SetUint64(1)
+	require.Equal(t, root, payload.HashTreeRoot())
+	require.Equal(t, root, view.HashTreeRoot())
+	require.Equal(t, payload.GetForkVersion(), view.GetForkVersion())
+
+	// Nor must mutating a deep copy.
+	cpy := view.DeepCopy()
+	require.Equal(t, payload, cpy)
+	cpy.ExtraData[0] = 0xff
+	cpy.Transactions[0][0] = 0xff
+	cpy.Withdrawals[0].Amount = 1
+	cpy.BaseFeePerGas.SetUint64(1)
+	require.Equal(t, root, payload.HashTreeRoot())
+	require.NotEqual(t, root, cpy.HashTreeRoot())
+
+	header, err := view.ToHeader()
+	require.NoError(t, err)
+	expected, err := payload.ToHeader()
+	require.NoError(t, err)
+	require.Equal(t, expected, header)
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+var (
+	_ ssz.StaticObject                    = (*VoluntaryExitMessage)(nil)
+	_ constraints.SSZMarshallableRootable = (*VoluntaryExitMessage)(nil)
+	_ ssz.StaticObject                    = (*SignedVoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedVoluntaryExit)(nil)
+)
+
+// VoluntaryExitMessage is the VoluntaryExit container as defined in the
+// Ethereum 2.0 specification, signed over by SignedVoluntaryExit.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntaryexit
+type VoluntaryExitMessage struct {
+	// Epoch is the earliest epoch the exit can be processed at.
+	Epoch math.Epoch
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex
+}
+
+// SignedVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedvoluntaryexit
+type SignedVoluntaryExit struct {
+	// Message is the voluntary exit being signed.
+	Message *VoluntaryExitMessage
+	// Signature is the signature of the message by the exiting validator.
+	Signature crypto.BLSSignature
+}
+
+// NewEmptySignedVoluntaryExit returns an empty signed exit, ready to be
+// decoded into.
+func NewEmptySignedVoluntaryExit() *SignedVoluntaryExit {
+	return &SignedVoluntaryExit{Message: &VoluntaryExitMessage{}}
+}
+
+// VerifySignature verifies the signature of the exit against the pubkey of the
+// exiting validator.
+func (s *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(s.Message, forkData.ComputeDomain(domainType))
+	if err := signatureVerificationFn(pubkey, signingRoot[:], s.Signature); err != nil {
+		return errors.Join(err, ErrVoluntaryExitSignature)
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the VoluntaryExitMessage object in SSZ encoding.
+func (*VoluntaryExitMessage) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 8 + 8 = 16.
+	return 16
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExitMessage object.
+func (e *VoluntaryExitMessage) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &e.Epoch)
+	ssz.DefineUint64(codec, &e.ValidatorIndex)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the VoluntaryExitMessage object.
+func (e *VoluntaryExitMessage) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZTo marshals the VoluntaryExitMessage object to SSZ format into the
+// provided buffer.
+func (e *VoluntaryExitMessage) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// MarshalSSZ marshals the VoluntaryExitMessage object to SSZ format.
+func (e *VoluntaryExitMessage) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(e))
+	return e.MarshalSSZTo(buf)
+}
+
+func (*VoluntaryExitMessage) ValidateAfterDecodingSSZ() error { return nil }
+
+// SizeSSZ returns the size of the SignedVoluntaryExit object in SSZ encoding.
+func (*SignedVoluntaryExit) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 16 + 96 = 112.
+	return 112
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedVoluntaryExit object.
+func (s *SignedVoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.Message)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedVoluntaryExit object.
+func (s *SignedVoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+// MarshalSSZTo marshals the SignedVoluntaryExit object to SSZ format into the
+// provided buffer.
+func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// MarshalSSZ marshals the SignedVoluntaryExit object to SSZ format.
+func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(s))
+	return s.MarshalSSZTo(buf)
+}
+
+// UnmarshalSSZ unmarshals the SignedVoluntaryExit object from SSZ format.
+func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+func (*SignedVoluntaryExit) ValidateAfterDecodingSSZ() error { return nil }
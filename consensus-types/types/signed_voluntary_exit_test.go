@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	types "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedVoluntaryExit_MarshalUnmarshalSSZ(t *testing.T) {
+	t.Parallel()
+	original := &types.SignedVoluntaryExit{
+		Message:   &types.VoluntaryExitMessage{Epoch: 5, ValidatorIndex: 9},
+		Signature: crypto.BLSSignature{0x01},
+	}
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, 112)
+
+	decoded := types.NewEmptySignedVoluntaryExit()
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, original, decoded)
+}
+
+func TestSignedVoluntaryExit_VerifySignature(t *testing.T) {
+	t.Parallel()
+	exit := &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExitMessage{Epoch: 5, ValidatorIndex: 9},
+	}
+	forkData := types.NewForkData(common.Version{}, common.Root{})
+	domainType := common.DomainType{0x04}
+
+	signer := &mocks.BLSSigner{}
+	signer.On("VerifySignature", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("bad signature"))
+	err := exit.VerifySignature(forkData, domainType, crypto.BLSPubkey{}, signer.VerifySignature)
+	require.ErrorIs(t, err, types.ErrVoluntaryExitSignature)
+}
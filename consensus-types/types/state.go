@@ -30,6 +30,10 @@ import (
 	"github.com/karalabe/ssz"
 )
 
+// DeepCopy and Equal of the consensus containers are generated from their
+// struct definitions.
+//go:generate go run ../deepcopygen -out deepcopy.gen.go
+
 // BeaconState represents the entire state of the beacon chain.
 type BeaconState struct {
 	constraints.Versionable `json:"-"`
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math/pow"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// beaconStateFieldNames are the names of the BeaconState fields, in SSZ order.
+//
+//nolint:gochecknoglobals // read-only.
+var beaconStateFieldNames = []string{
+	"genesis_validators_root",
+	"slot",
+	"fork",
+	"latest_block_header",
+	"block_roots",
+	"state_roots",
+	"eth1_data",
+	"eth1_deposit_index",
+	"latest_execution_payload_header",
+	"validators",
+	"balances",
+	"randao_mixes",
+	"next_withdrawal_index",
+	"next_withdrawal_validator_index",
+	"slashings",
+	"total_slashing",
+	"pending_partial_withdrawals",
+}
+
+// StateFieldRoot is the hash tree root of a field of the BeaconState.
+type StateFieldRoot struct {
+	// Name is the JSON name of the field.
+	Name string `json:"name"`
+	// GeneralizedIndex is the generalized index of the field in the state tree.
+	GeneralizedIndex int `json:"generalized_index"`
+	// Root is the hash tree root of the field.
+	Root common.Root `json:"root"`
+}
+
+// StateChunkDiff locates the first Merkle chunk differing between two
+// BeaconStates.
+type StateChunkDiff struct {
+	// Field is the JSON name of the field holding the chunk.
+	Field string `json:"field"`
+	// Path holds the generalized indices of the nodes from the state root
+	// down to the chunk, which always take the left-most differing branch.
+	Path []int `json:"path"`
+}
+
+// numFields returns the number of fields of the BeaconState, and the
+// generalized index of the first of them.
+func (st *BeaconState) numFields() (int, int) {
+	numFields := len(beaconStateFieldNames)
+	if version.IsBefore(st.GetForkVersion(), version.Electra()) {
+		// PendingPartialWithdrawals is introduced in Electra.
+		numFields--
+	}
+	// The fields are the leaves of the container tree, padded up to the next
+	// power of two.
+	return numFields, int(pow.NextPowerOfTwo(uint64(numFields)))
+}
+
+// FieldRoots returns the hash tree roots of the fields of the BeaconState,
+// in SSZ order.
+func (st *BeaconState) FieldRoots() ([]StateFieldRoot, error) {
+	numFields, firstLeaf := st.numFields()
+	tree, err := st.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]StateFieldRoot, numFields)
+	for i := range numFields {
+		node, errGet := tree.Get(firstLeaf + i)
+		if errGet != nil {
+			return nil, errGet
+		}
+		roots[i] = StateFieldRoot{
+			Name:             beaconStateFieldNames[i],
+			GeneralizedIndex: firstLeaf + i,
+			Root:             common.NewRootFromBytes(node.Hash()),
+		}
+	}
+	return roots, nil
+}
+
+// FirstDifferingStateChunk walks the trees of the given BeaconStates down the
+// left-most differing branch and returns the first differing chunk, or nil if
+// the states have the same root.
+func FirstDifferingStateChunk(a, b *BeaconState) (*StateChunkDiff, error) {
+	treeA, err := a.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	treeB, err := b.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(treeA.Hash(), treeB.Hash()) {
+		return nil, nil //nolint:nilnil // nil diff means equal states.
+	}
+
+	numFields, firstLeaf := a.numFields()
+	diff := &StateChunkDiff{Path: []int{1}}
+	for gindex := 1; ; {
+		// Stop at the first leaf of either tree, as lists of different
+		// lengths can be padded with zero hashes at different depths.
+		leftA, errA := treeA.Get(2 * gindex)
+		leftB, errB := treeB.Get(2 * gindex)
+		if errA != nil || errB != nil {
+			break
+		}
+		gindex *= 2
+		if bytes.Equal(leftA.Hash(), leftB.Hash()) {
+			gindex++
+		}
+		diff.Path = append(diff.Path, gindex)
+	}
+
+	// The field is the ancestor of the chunk at the depth of the fields.
+	for _, gindex := range diff.Path {
+		if gindex >= firstLeaf && gindex < firstLeaf+numFields {
+			diff.Field = beaconStateFieldNames[gindex-firstLeaf]
+		}
+	}
+	return diff, nil
+}
@@ -222,3 +222,53 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 		)
 	})
 }
+
+func TestBeaconState_FieldRoots(t *testing.T) {
+	t.Parallel()
+	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
+		state := generateValidBeaconState(v)
+		roots, err := state.FieldRoots()
+		require.NoError(t, err)
+		if version.IsBefore(v, version.Electra()) {
+			require.Len(t, roots, 16)
+		} else {
+			require.Len(t, roots, 17)
+			require.Equal(t, "pending_partial_withdrawals", roots[16].Name)
+		}
+		require.Equal(t, "genesis_validators_root", roots[0].Name)
+		require.Equal(t, state.GenesisValidatorsRoot, roots[0].Root)
+
+		// Changing a field changes its root only.
+		state.Slot++
+		newRoots, err := state.FieldRoots()
+		require.NoError(t, err)
+		for i := range roots {
+			if roots[i].Name == "slot" {
+				require.NotEqual(t, roots[i].Root, newRoots[i].Root)
+				continue
+			}
+			require.Equal(t, roots[i], newRoots[i])
+		}
+	})
+}
+
+func TestFirstDifferingStateChunk(t *testing.T) {
+	t.Parallel()
+	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
+		a := generateValidBeaconState(v)
+		b := generateValidBeaconState(v)
+
+		diff, err := types.FirstDifferingStateChunk(a, b)
+		require.NoError(t, err)
+		require.Nil(t, diff)
+
+		b.Validators[1].EffectiveBalance++
+		diff, err = types.FirstDifferingStateChunk(a, b)
+		require.NoError(t, err)
+		require.NotNil(t, diff)
+		require.Equal(t, "validators", diff.Field)
+		require.Equal(t, 1, diff.Path[0])
+		// The chunk lies below the validators field.
+		require.Greater(t, len(diff.Path), 6)
+	})
+}
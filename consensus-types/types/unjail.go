@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+var (
+	_ ssz.StaticObject                    = (*UnjailMessage)(nil)
+	_ constraints.SSZMarshallableRootable = (*UnjailMessage)(nil)
+	_ ssz.StaticObject                    = (*SignedUnjail)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedUnjail)(nil)
+)
+
+// UnjailDomainType returns the domain type of unjail messages. Unjailing is
+// specific to beacon-kit, hence the domain type lies in the application
+// range. It is not part of the chain spec since the value is fixed across
+// all networks.
+func UnjailDomainType() common.DomainType {
+	return common.DomainType{0x01, 0x00, 0x00, 0x01}
+}
+
+// UnjailMessage is the request of a jailed validator to rejoin the consensus
+// validator set once its jail time is served.
+type UnjailMessage struct {
+	// ValidatorIndex is the index of the jailed validator.
+	ValidatorIndex math.ValidatorIndex
+	// JailedUntil is the slot the validator is jailed until. It binds the
+	// message to a single jailing, so that it cannot be replayed.
+	JailedUntil math.Slot
+}
+
+// SignedUnjail is an UnjailMessage signed by the jailed validator.
+type SignedUnjail struct {
+	// Message is the unjail being signed.
+	Message *UnjailMessage
+	// Signature is the signature of the message by the jailed validator.
+	Signature crypto.BLSSignature
+}
+
+// NewEmptySignedUnjail returns an empty signed unjail, ready to be decoded
+// into.
+func NewEmptySignedUnjail() *SignedUnjail {
+	return &SignedUnjail{Message: &UnjailMessage{}}
+}
+
+// VerifySignature verifies the signature of the unjail against the pubkey of
+// the jailed validator. Like BLS to execution changes, unjails are signed
+// over the genesis fork version so that they remain valid across forks.
+func (s *SignedUnjail) VerifySignature(
+	genesisForkData *ForkData,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		s.Message, genesisForkData.ComputeDomain(UnjailDomainType()),
+	)
+	if err := signatureVerificationFn(pubkey, signingRoot[:], s.Signature); err != nil {
+		return errors.Join(err, ErrUnjailSignature)
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the UnjailMessage object in SSZ encoding.
+func (*UnjailMessage) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 8 + 8 = 16.
+	return 16
+}
+
+// DefineSSZ defines the SSZ encoding for the UnjailMessage object.
+func (u *UnjailMessage) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &u.ValidatorIndex)
+	ssz.DefineUint64(codec, &u.JailedUntil)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the UnjailMessage object.
+func (u *UnjailMessage) HashTreeRoot() common.Root {
+	return ssz.HashSequential(u)
+}
+
+// MarshalSSZTo marshals the UnjailMessage object to SSZ format into the
+// provided buffer.
+func (u *UnjailMessage) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, ssz.EncodeToBytes(buf, u)
+}
+
+// MarshalSSZ marshals the UnjailMessage object to SSZ format.
+func (u *UnjailMessage) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(u))
+	return u.MarshalSSZTo(buf)
+}
+
+func (*UnjailMessage) ValidateAfterDecodingSSZ() error { return nil }
+
+// SizeSSZ returns the size of the SignedUnjail object in SSZ encoding.
+func (*SignedUnjail) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 16 + 96 = 112.
+	return 112
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedUnjail object.
+func (s *SignedUnjail) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.Message)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedUnjail object.
+func (s *SignedUnjail) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+// MarshalSSZTo marshals the SignedUnjail object to SSZ format into the
+// provided buffer.
+func (s *SignedUnjail) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// MarshalSSZ marshals the SignedUnjail object to SSZ format.
+func (s *SignedUnjail) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(s))
+	return s.MarshalSSZTo(buf)
+}
+
+// UnmarshalSSZ unmarshals the SignedUnjail object from SSZ format.
+func (s *SignedUnjail) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+func (*SignedUnjail) ValidateAfterDecodingSSZ() error { return nil }
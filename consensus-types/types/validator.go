@@ -234,6 +234,11 @@ func (v Validator) IsSlashed() bool {
 	return v.Slashed
 }
 
+// SetSlashed marks the validator as slashed.
+func (v *Validator) SetSlashed() {
+	v.Slashed = true
+}
+
 // IsFullyWithdrawable as defined in the Ethereum 2.0 specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#is_fully_withdrawable_validator
 func (v Validator) IsFullyWithdrawable(
@@ -323,7 +328,9 @@ func (v Validator) HasCompoundingWithdrawalCredential() bool {
 	return true
 }
 
-// Status returns the current validator status based on its set epoch values.
+// Status returns the canonical Beacon API status of the validator (e.g.
+// pending_initialized, active_ongoing, withdrawal_possible), derived from its
+// activation, exit and withdrawable epochs relative to the given current epoch.
 // This function taken from Prysm:
 // https://github.com/prysmaticlabs/prysm/blob/0229a2055e6349655a471b2427f349e40c275cee/beacon-chain/rpc/eth/helpers/validator_status.go#L31
 func (v *Validator) Status(currentEpoch math.Epoch) (string, error) {
@@ -927,3 +927,125 @@ func TestValidator_GetEffectiveBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_Status(t *testing.T) {
+	t.Parallel()
+	far := constants.FarFutureEpoch
+	tests := []struct {
+		name      string
+		validator *types.Validator
+		epoch     math.Epoch
+		want      string
+	}{
+		{
+			name: "pending initialized",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: far,
+				ActivationEpoch:            far,
+				ExitEpoch:                  far,
+				WithdrawableEpoch:          far,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusPendingInitialized,
+		},
+		{
+			name: "pending queued",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 4,
+				ActivationEpoch:            far,
+				ExitEpoch:                  far,
+				WithdrawableEpoch:          far,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusPendingQueued,
+		},
+		{
+			name: "active ongoing",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  far,
+				WithdrawableEpoch:          far,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusActiveOngoing,
+		},
+		{
+			name: "active exiting",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  10,
+				WithdrawableEpoch:          20,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusActiveExiting,
+		},
+		{
+			name: "active slashed",
+			validator: &types.Validator{
+				Slashed:                    true,
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  10,
+				WithdrawableEpoch:          20,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusActiveSlashed,
+		},
+		{
+			name: "exited unslashed",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  3,
+				WithdrawableEpoch:          20,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusExitedUnslashed,
+		},
+		{
+			name: "exited slashed",
+			validator: &types.Validator{
+				Slashed:                    true,
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  3,
+				WithdrawableEpoch:          20,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusExitedSlashed,
+		},
+		{
+			name: "withdrawal possible",
+			validator: &types.Validator{
+				EffectiveBalance:           32e9,
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  3,
+				WithdrawableEpoch:          4,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusWithdrawalPossible,
+		},
+		{
+			name: "withdrawal done",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            2,
+				ExitEpoch:                  3,
+				WithdrawableEpoch:          4,
+			},
+			epoch: 5,
+			want:  constants.ValidatorStatusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.validator.Status(tt.epoch)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -18,28 +18,21 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-//nolint:dupl // separate file for ease of future implementation
 package types
 
 import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
-	"github.com/berachain/beacon-kit/primitives/constraints"
 	"github.com/karalabe/ssz"
 )
 
-// Compile-time assertions to ensure VoluntaryExit implements necessary interfaces.
-var (
-	_ ssz.StaticObject                    = (*VoluntaryExit)(nil)
-	_ constraints.SSZMarshallableRootable = (*VoluntaryExit)(nil)
-	_ common.UnusedEnforcer               = (*VoluntaryExits)(nil)
-)
+// Compile-time assertions to ensure VoluntaryExits implements necessary interfaces.
+var _ common.UnusedEnforcer = (*VoluntaryExits)(nil)
 
-type (
-	VoluntaryExit  = common.UnusedType
-	VoluntaryExits []*VoluntaryExit
-)
+// VoluntaryExits is the voluntary_exits field of the block body. Exits may only
+// be included from Electra1 on; the field must be left empty before that.
+type VoluntaryExits []*SignedVoluntaryExit
 
 // SizeSSZ returns the SSZ encoded size in bytes for the VoluntaryExits.
 func (vs VoluntaryExits) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
@@ -49,13 +42,13 @@ func (vs VoluntaryExits) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
 // DefineSSZ defines the SSZ encoding for the VoluntaryExits object.
 func (vs VoluntaryExits) DefineSSZ(c *ssz.Codec) {
 	c.DefineDecoder(func(*ssz.Decoder) {
-		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*VoluntaryExit)(&vs), constants.MaxVoluntaryExits)
+		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*SignedVoluntaryExit)(&vs), constants.MaxVoluntaryExits)
 	})
 	c.DefineEncoder(func(*ssz.Encoder) {
-		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*VoluntaryExit)(&vs), constants.MaxVoluntaryExits)
+		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*SignedVoluntaryExit)(&vs), constants.MaxVoluntaryExits)
 	})
 	c.DefineHasher(func(*ssz.Hasher) {
-		ssz.DefineSliceOfStaticObjectsOffset(c, (*[]*VoluntaryExit)(&vs), constants.MaxVoluntaryExits)
+		ssz.DefineSliceOfStaticObjectsOffset(c, (*[]*SignedVoluntaryExit)(&vs), constants.MaxVoluntaryExits)
 	})
 }
 
@@ -65,8 +58,7 @@ func (vs VoluntaryExits) HashTreeRoot() common.Root {
 }
 
 // EnforceUnused return true if the length of the VoluntaryExits is 0.
-// As long as this type remains unimplemented and unvalidated by consensus,
-// we must enforce that it contains no data.
+// Blocks before Electra1 do not process exits, so they must not contain any.
 func (vs VoluntaryExits) EnforceUnused() error {
 	if len(vs) != 0 {
 		return errors.New("VoluntaryExits must be unused")
@@ -23,9 +23,15 @@ import (
 	"bytes"
 
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
 )
 
 const (
+	// BLSWithdrawalPrefix is the prefix for withdrawal credentials derived from
+	// a BLS withdrawal pubkey.
+	BLSWithdrawalPrefix = byte(0)
+
 	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
 	EthSecp256k1CredentialPrefix = byte(1)
 
@@ -54,6 +60,20 @@ func (wc WithdrawalCredentials) IsValidEth1WithdrawalCredentials() bool {
 	return wc[0] == EthSecp256k1CredentialPrefix && bytes.Equal(wc[1:12], zeroBytes)
 }
 
+// IsBLSWithdrawalCredentials checks if the withdrawal credentials carry the
+// BLS_WITHDRAWAL_PREFIX and therefore still have to be rotated to an execution
+// address before the validator can withdraw.
+func (wc WithdrawalCredentials) IsBLSWithdrawalCredentials() bool {
+	return wc[0] == BLSWithdrawalPrefix
+}
+
+// MatchesBLSPubkey checks if the withdrawal credentials are the BLS withdrawal
+// credentials of the given pubkey, i.e. BLS_WITHDRAWAL_PREFIX + hash(pubkey)[1:].
+func (wc WithdrawalCredentials) MatchesBLSPubkey(pubkey crypto.BLSPubkey) bool {
+	hashed := sha256.Hash(pubkey[:])
+	return wc.IsBLSWithdrawalCredentials() && bytes.Equal(wc[1:], hashed[1:])
+}
+
 // ToExecutionAddress converts the WithdrawalCredentials to an ExecutionAddress.
 // Returns error if the withdrawal credentials are not valid.
 func (wc WithdrawalCredentials) ToExecutionAddress() (common.ExecutionAddress, error) {
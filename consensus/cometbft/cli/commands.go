@@ -244,7 +244,7 @@ using a light client`,
 			if height == 0 {
 				home := v.GetString(flags.FlagHome)
 				var dbi dbm.DB
-				dbi, err = db.OpenDB(home, dbm.PebbleDBBackend)
+				dbi, err = db.OpenAppDB(home, cfg.DBBackend)
 				if err != nil {
 					return err
 				}
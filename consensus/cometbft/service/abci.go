@@ -41,9 +41,8 @@ func (s *Service) InitChain(
 	req *cmtabci.InitChainRequest,
 ) (*cmtabci.InitChainResponse, error) {
 	// Check if ctx is still good. CometBFT does not check this.
-	if s.ctx.Err() != nil {
-		// If the context is getting cancelled, we are shutting down.
-		return &cmtabci.InitChainResponse{}, s.ctx.Err()
+	if s.shuttingDown() {
+		return &cmtabci.InitChainResponse{}, errShuttingDown
 	}
 	//nolint:contextcheck // see s.ctx comment for more details
 	return s.initChain(s.ctx, req)
@@ -56,8 +55,7 @@ func (s *Service) PrepareProposal(
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
 	// Check if ctx is still good. CometBFT does not check this.
-	if s.ctx.Err() != nil {
-		// If the context is getting cancelled, we are shutting down.
+	if s.shuttingDown() {
 		// It is ok returning an empty proposal.
 		//nolint:nilerr // explicitly allowing this case
 		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
@@ -95,11 +93,11 @@ func (s *Service) ProcessProposal(
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
 	// Check if ctx is still good. CometBFT does not check this.
-	if s.ctx.Err() != nil {
+	if s.shuttingDown() {
 		// Node will panic on context cancel with "CONSENSUS FAILURE!!!" due to
 		// returning an error. This is expected. We do not want to accept or
 		// reject a proposal based on incomplete data.
-		return nil, s.ctx.Err()
+		return nil, errShuttingDown
 	}
 	//nolint:contextcheck // see s.ctx comment for more details
 	return s.processProposal(s.ctx, req)
@@ -110,13 +108,20 @@ func (s *Service) FinalizeBlock(
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
 	// Check if ctx is still good. CometBFT does not check this.
-	if s.ctx.Err() != nil {
+	// Once shutdown started, no new block is finalized. Otherwise the block is
+	// in flight until it is committed, and shutdown waits for it.
+	if s.ctx.Err() != nil || !s.guard.begin() {
 		// Node will panic on context cancel with "CONSENSUS FAILURE!!!" due to error.
 		// We expect this to happen and do not want to finalize any incomplete or invalid state.
-		return nil, s.ctx.Err()
+		return nil, errShuttingDown
 	}
 	//nolint:contextcheck // see s.ctx comment for more details
-	return s.finalizeBlock(s.ctx, req)
+	res, err := s.finalizeBlock(s.ctx, req)
+	if err != nil {
+		// The block will not be committed.
+		s.guard.end()
+	}
+	return res, err
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -129,16 +134,48 @@ func (s *Service) FinalizeBlock(
 func (s *Service) Commit(
 	_ context.Context, req *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
+	// The in-flight block is committed even if shutdown started, since its
+	// side effects on the blockchain stores already happened in FinalizeBlock.
+	defer s.guard.end()
+
 	// Check if ctx is still good. CometBFT does not check this.
 	if s.ctx.Err() != nil {
 		// Node will panic on context cancel with "CONSENSUS FAILURE!!!" due to error.
-		// We expect this to happen and do not want to commit any incomplete or invalid state.
+		// This only happens once the drain deadline passed.
 		return nil, s.ctx.Err()
 	}
 
 	return s.commit(req)
 }
 
+// ExtendVote implements the ExtendVote ABCI method and returns the data of
+// the vote extension providers to be attached to the precommit of this node.
+func (s *Service) ExtendVote(
+	_ context.Context,
+	req *abci.ExtendVoteRequest,
+) (*abci.ExtendVoteResponse, error) {
+	if s.shuttingDown() {
+		return &abci.ExtendVoteResponse{}, nil
+	}
+	//nolint:contextcheck // see s.ctx comment for more details
+	return s.extendVote(s.ctx, req), nil
+}
+
+// VerifyVoteExtension implements the VerifyVoteExtension ABCI method and
+// checks the data another validator attached to its precommit.
+func (s *Service) VerifyVoteExtension(
+	_ context.Context,
+	req *abci.VerifyVoteExtensionRequest,
+) (*abci.VerifyVoteExtensionResponse, error) {
+	//nolint:contextcheck // see s.ctx comment for more details
+	return s.verifyVoteExtension(s.ctx, req), nil
+}
+
+// shuttingDown reports whether the node stopped accepting new blocks.
+func (s *Service) shuttingDown() bool {
+	return s.ctx.Err() != nil || s.guard.stopping()
+}
+
 //
 // NOOP methods
 //
@@ -150,48 +187,6 @@ func (Service) Query(
 	return &abci.QueryResponse{}, nil
 }
 
-func (Service) ListSnapshots(
-	context.Context,
-	*abci.ListSnapshotsRequest,
-) (*abci.ListSnapshotsResponse, error) {
-	return &abci.ListSnapshotsResponse{}, nil
-}
-
-func (Service) LoadSnapshotChunk(
-	context.Context,
-	*abci.LoadSnapshotChunkRequest,
-) (*abci.LoadSnapshotChunkResponse, error) {
-	return &abci.LoadSnapshotChunkResponse{}, nil
-}
-
-func (Service) OfferSnapshot(
-	context.Context,
-	*abci.OfferSnapshotRequest,
-) (*abci.OfferSnapshotResponse, error) {
-	return &abci.OfferSnapshotResponse{}, nil
-}
-
-func (Service) ApplySnapshotChunk(
-	context.Context,
-	*abci.ApplySnapshotChunkRequest,
-) (*abci.ApplySnapshotChunkResponse, error) {
-	return &abci.ApplySnapshotChunkResponse{}, nil
-}
-
-func (Service) ExtendVote(
-	context.Context,
-	*abci.ExtendVoteRequest,
-) (*abci.ExtendVoteResponse, error) {
-	return &abci.ExtendVoteResponse{}, nil
-}
-
-func (Service) VerifyVoteExtension(
-	context.Context,
-	*abci.VerifyVoteExtensionRequest,
-) (*abci.VerifyVoteExtensionResponse, error) {
-	return &abci.VerifyVoteExtensionResponse{}, nil
-}
-
 func (*Service) CheckTx(
 	context.Context,
 	*abci.CheckTxRequest,
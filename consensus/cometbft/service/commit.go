@@ -25,7 +25,9 @@ import (
 	"fmt"
 
 	"cosmossdk.io/store/rootmulti"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) commit(
@@ -39,14 +41,30 @@ func (s *Service) commit(
 	header := s.finalizeBlockState.Context().BlockHeader()
 	retainHeight := s.GetBlockRetentionHeight(header.Height)
 
+	//nolint:contextcheck // see s.ctx comment for more details
+	_, span := tracing.StartSpan(
+		tracing.ContextWithBlockTrace(s.ctx, header.Height),
+		"Commit",
+		attribute.Int64("height", header.Height),
+	)
+	defer span.End()
+
 	rms, ok := s.sm.GetCommitMultiStore().(*rootmulti.Store)
 	if ok {
 		rms.SetCommitHeader(header)
 	}
 	s.sm.GetCommitMultiStore().Commit()
 
+	// State is already on disk, so a failure here only means recovery will
+	// roll this slot forward on the next startup.
+	if err := s.journal.commit(header.Height); err != nil {
+		s.logger.Error("Failed to update commit journal", "height", header.Height, "error", err)
+	}
+
 	s.finalizeBlockState = nil
 
+	s.snapshotIfApplicable(header.Height)
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
@@ -118,6 +136,14 @@ func (s *Service) GetBlockRetentionHeight(commitHeight int64) int64 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	// Define the state snapshot retention range, keeping the blocks since
+	// the oldest snapshot available for nodes restoring from it.
+	if s.snapshotManager != nil {
+		if heights := s.snapshotManager.GetSnapshotBlockRetentionHeights(); heights > 0 {
+			retentionHeight = minNonZero(retentionHeight, commitHeight-heights)
+		}
+	}
+
 	v := commitHeight - int64(s.minRetainBlocks) // #nosec G115
 	retentionHeight = minNonZero(retentionHeight, v)
 
@@ -63,7 +63,8 @@ var (
 func DefaultConfig() *cmtcfg.Config {
 	cfg := cmtcfg.DefaultConfig()
 
-	// BeaconKit forces PebbleDB as the database backend.
+	// BeaconKit defaults to PebbleDB as the database backend. GoLevelDB is
+	// also supported, see `beacond db migrate`.
 	cfg.BaseConfig.DBBackend = "pebbledb"
 
 	// These settings are set by default for performance reasons.
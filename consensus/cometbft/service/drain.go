@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// errShuttingDown is returned by ABCI methods once the node stopped
+	// accepting new blocks.
+	errShuttingDown = errors.New("node is shutting down")
+
+	// errDrainTimeout is returned when the in-flight block is not committed
+	// before the drain deadline.
+	errDrainTimeout = errors.New("in-flight block not committed before shutdown deadline")
+)
+
+// blockGuard tracks the block in flight between FinalizeBlock and Commit, so
+// that shutdown waits for it to be committed instead of interrupting it, which
+// would leave the blockchain stores ahead of the committed state.
+type blockGuard struct {
+	mu       sync.Mutex
+	closing  bool
+	inFlight bool
+	// idle is closed whenever no block is in flight.
+	idle chan struct{}
+	// held is non-nil while block processing is paused, and closed once it
+	// resumes.
+	held chan struct{}
+}
+
+func newBlockGuard() *blockGuard {
+	idle := make(chan struct{})
+	close(idle)
+	return &blockGuard{idle: idle}
+}
+
+// begin marks a block as in flight. It reports false once the guard stopped
+// accepting blocks, in which case the block must not be processed.
+func (g *blockGuard) begin() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for g.held != nil && !g.closing {
+		held := g.held
+		g.mu.Unlock()
+		<-held
+		g.mu.Lock()
+	}
+	if g.closing {
+		return false
+	}
+	if !g.inFlight {
+		g.inFlight = true
+		g.idle = make(chan struct{})
+	}
+	return true
+}
+
+// end marks the in-flight block as committed, or abandoned on error.
+func (g *blockGuard) end() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.inFlight {
+		g.inFlight = false
+		close(g.idle)
+	}
+}
+
+// stopAccepting makes subsequent calls to begin fail.
+func (g *blockGuard) stopAccepting() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closing = true
+	g.resumeLocked()
+}
+
+// hold pauses block processing: it waits for the in-flight block, if any, to
+// be committed and makes begin wait until the returned release is called.
+func (g *blockGuard) hold(ctx context.Context) (func(), error) {
+	g.mu.Lock()
+	for g.held != nil {
+		held := g.held
+		g.mu.Unlock()
+		select {
+		case <-held:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		g.mu.Lock()
+	}
+	if g.closing {
+		g.mu.Unlock()
+		return nil, errShuttingDown
+	}
+	held := make(chan struct{})
+	g.held = held
+	idle := g.idle
+	g.mu.Unlock()
+
+	release := func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if g.held == held {
+			g.resumeLocked()
+		}
+	}
+	select {
+	case <-idle:
+		return release, nil
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	}
+}
+
+// resumeLocked lets blocks be processed again. g.mu must be held.
+func (g *blockGuard) resumeLocked() {
+	if g.held != nil {
+		close(g.held)
+		g.held = nil
+	}
+}
+
+// stopping reports whether the guard stopped accepting blocks.
+func (g *blockGuard) stopping() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.closing
+}
+
+// drain stops accepting blocks and waits up to timeout for the in-flight
+// block, if any, to be committed.
+func (g *blockGuard) drain(timeout time.Duration) error {
+	g.mu.Lock()
+	g.closing = true
+	g.resumeLocked()
+	idle := g.idle
+	g.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-idle:
+		return nil
+	case <-timer.C:
+		return errDrainTimeout
+	}
+}
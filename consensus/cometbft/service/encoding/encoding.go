@@ -18,6 +18,9 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
+// Package encoding decodes the beacon block and blob sidecars carried as the
+// txs of CometBFT blocks. Both are SSZ encoded, see
+// docs/block-transport-encoding.md for why no other encoding is used.
 package encoding
 
 import (
@@ -30,21 +33,23 @@ import (
 )
 
 // ExtractBlobsAndBlockFromRequest extracts the blobs and block from an ABCI
-// request.
+// request. Decoding of the block is recorded in sszMetrics, which may be nil.
 func ExtractBlobsAndBlockFromRequest(
 	req ABCIRequest,
 	beaconBlkIndex uint,
 	blobSidecarsIndex uint,
 	forkVersion common.Version,
+	sszMetrics *ssz.Metrics,
 ) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
 	if req == nil {
 		return nil, nil, ErrNilABCIRequest
 	}
 
-	blk, err := UnmarshalBeaconBlockFromABCIRequest(
+	blk, err := unmarshalBeaconBlock(
 		req.GetTxs(),
 		beaconBlkIndex,
 		forkVersion,
+		sszMetrics,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -64,6 +69,17 @@ func UnmarshalBeaconBlockFromABCIRequest(
 	txs [][]byte,
 	bzIndex uint,
 	forkVersion common.Version,
+) (*ctypes.SignedBeaconBlock, error) {
+	return unmarshalBeaconBlock(txs, bzIndex, forkVersion, nil)
+}
+
+// unmarshalBeaconBlock extracts a beacon block from the transactions of an
+// ABCI request, recording the decoding in sszMetrics if it is not nil.
+func unmarshalBeaconBlock(
+	txs [][]byte,
+	bzIndex uint,
+	forkVersion common.Version,
+	sszMetrics *ssz.Metrics,
 ) (*ctypes.SignedBeaconBlock, error) {
 	var signedBlk *ctypes.SignedBeaconBlock
 	lenTxs := uint(len(txs))
@@ -87,9 +103,13 @@ func UnmarshalBeaconBlockFromABCIRequest(
 	if err != nil {
 		return nil, fmt.Errorf("attempt at building block with wrong version %s: %w", forkVersion, err)
 	}
-	if err = ssz.Unmarshal(blkBz, block); err != nil {
+	if err = sszMetrics.Unmarshal(ssz.TypeBeaconBlock, blkBz, block); err != nil {
 		return nil, err
 	}
+	sszMetrics.ObserveSize(
+		ssz.TypeExecutionPayload,
+		block.GetBeaconBlock().GetBody().GetExecutionPayload(),
+	)
 	return block, nil
 }
 
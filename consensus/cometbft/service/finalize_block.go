@@ -24,18 +24,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/berachain/beacon-kit/observability/tracing"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/sourcegraph/conc/iter"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) finalizeBlock(
 	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
+	ctx, span := tracing.StartSpan(
+		tracing.ContextWithBlockTrace(ctx, req.Height),
+		"FinalizeBlock",
+		attribute.Int64("height", req.Height),
+	)
 	res, err := s.finalizeBlockInternal(ctx, req)
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
+	tracing.EndSpan(span, err)
 
 	return res, err
 }
@@ -47,6 +55,16 @@ func (s *Service) finalizeBlockInternal(
 	if err := s.validateFinalizeBlockHeight(req); err != nil {
 		return nil, err
 	}
+	// Halt before the first block of an upgrade this binary does not
+	// implement, so that nothing of it is persisted.
+	if s.upgrade != nil {
+		if err := s.upgrade.Halt(req.Height, req.Time); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.journal.begin(req.Height); err != nil {
+		return nil, err
+	}
 
 	// finalizeBlockState should be set on InitChain or ProcessProposal. If it
 	// is nil, it means we are replaying this block and we need to set the state
@@ -79,6 +97,9 @@ func (s *Service) finalizeBlockInternal(
 	if err != nil {
 		return nil, err
 	}
+	if err = s.aggregateVoteExtensions(s.finalizeBlockState.Context(), req); err != nil {
+		return nil, err
+	}
 
 	valUpdates, err := iter.MapErr(
 		finalizeBlock,
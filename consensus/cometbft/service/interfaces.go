@@ -21,7 +21,10 @@
 package cometbft
 
 import (
+	"context"
 	"time"
+
+	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -29,3 +32,12 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
 }
+
+// ValidatorStore looks up validators in the state carried by a context.
+type ValidatorStore interface {
+	// PubKeyByCometBFTAddress returns the public key of the validator with
+	// the given CometBFT address.
+	PubKeyByCometBFTAddress(
+		ctx context.Context, address []byte,
+	) (crypto.BLSPubkey, error)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const journalFile = "commit_journal.json"
+
+// errJournalGap is returned when the commit journal records a pending slot
+// that is not the one following the committed height, which recovery cannot
+// reconcile.
+var errJournalGap = errors.New("commit journal does not follow committed height")
+
+// journalEntry is the on-disk record of the last slot written by FinalizeBlock.
+type journalEntry struct {
+	Slot      int64 `json:"slot"`
+	Committed bool  `json:"committed"`
+}
+
+// commitJournal is a write-ahead marker spanning the blockchain stores and
+// the commit multistore. FinalizeBlock marks a slot pending before it touches
+// any store, and Commit marks it committed once state is flushed. A pending
+// entry found at startup means the node crashed in between.
+type commitJournal struct {
+	path string
+}
+
+func newCommitJournal(dir string) *commitJournal {
+	return &commitJournal{path: filepath.Join(dir, journalFile)}
+}
+
+// load returns the last journal entry, reporting false if none was written.
+func (j *commitJournal) load() (journalEntry, bool, error) {
+	var entry journalEntry
+	bz, err := os.ReadFile(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return entry, false, nil
+	}
+	if err != nil {
+		return entry, false, fmt.Errorf("failed reading commit journal: %w", err)
+	}
+	if err = json.Unmarshal(bz, &entry); err != nil {
+		return entry, false, fmt.Errorf("failed decoding commit journal: %w", err)
+	}
+	return entry, true, nil
+}
+
+func (j *commitJournal) begin(slot int64) error {
+	return j.write(journalEntry{Slot: slot})
+}
+
+func (j *commitJournal) commit(slot int64) error {
+	return j.write(journalEntry{Slot: slot, Committed: true})
+}
+
+// write replaces the journal atomically, so a crash leaves either the old or
+// the new entry on disk.
+func (j *commitJournal) write(entry journalEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(j.path), 0o700); err != nil {
+		return err
+	}
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(bz); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed writing commit journal: %w", err)
+	}
+	return os.Rename(tmp, j.path)
+}
+
+// RecoverUncommitted reconciles the commit journal with the committed height
+// after an unclean shutdown. A pending slot at or below the committed height
+// was committed before the crash and is rolled forward. A pending slot one
+// above it is rolled back, so that CometBFT replays it against clean stores.
+// Start calls it before CometBFT replays any block.
+func (s *Service) RecoverUncommitted(ctx context.Context) error {
+	entry, found, err := s.journal.load()
+	if err != nil || !found || entry.Committed {
+		return err
+	}
+
+	committed := s.LastBlockHeight()
+	switch {
+	case entry.Slot <= committed:
+		s.logger.Info(
+			"Rolling forward slot committed before shutdown",
+			"slot", entry.Slot,
+		)
+	case entry.Slot == committed+1:
+		//#nosec: G115 // slot is a positive block height.
+		if err = s.Blockchain.RollbackSlot(ctx, math.Slot(entry.Slot)); err != nil {
+			return fmt.Errorf("failed rolling back slot %d: %w", entry.Slot, err)
+		}
+	default:
+		return fmt.Errorf(
+			"%w: pending slot %d, committed height %d",
+			errJournalGap, entry.Slot, committed,
+		)
+	}
+	return s.journal.commit(committed)
+}
+
+// ResetCommitJournal marks height as the last committed slot in the journal
+// kept under dbDir. Offline tools that rewind the committed state must call it
+// so that startup recovery does not mistake the rewind for a crash.
+func ResetCommitJournal(dbDir string, height int64) error {
+	return newCommitJournal(dbDir).commit(height)
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// writePendingSlot leaves the journal under home as FinalizeBlock does before
+// touching any store, as if the node crashed before committing slot.
+func writePendingSlot(t *testing.T, home string, slot int64) {
+	t.Helper()
+	cfg := cometbft.DefaultConfig()
+	cfg.SetRoot(home)
+	require.NoError(t, os.MkdirAll(cfg.DBDir(), 0o700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(cfg.DBDir(), "commit_journal.json"),
+		fmt.Appendf(nil, `{"slot":%d,"committed":false}`, slot),
+		0o600,
+	))
+}
+
+// TestRecoverUncommitted checks how the journal is reconciled with the
+// committed height of a fresh service, which is 0.
+func TestRecoverUncommitted(t *testing.T) {
+	t.Parallel()
+	errRollback := errors.New("rollback failed")
+	tests := []struct {
+		name        string
+		setup       func(t *testing.T, home string)
+		rollbackErr error
+		rolledBack  []math.Slot
+		expectedErr string
+	}{
+		{
+			name:  "no journal",
+			setup: func(*testing.T, string) {},
+		},
+		{
+			name: "committed",
+			setup: func(t *testing.T, home string) {
+				t.Helper()
+				writePendingSlot(t, home, 1)
+				cfg := cometbft.DefaultConfig()
+				cfg.SetRoot(home)
+				require.NoError(t, cometbft.ResetCommitJournal(cfg.DBDir(), 0))
+			},
+		},
+		{
+			name: "pending slot already committed",
+			setup: func(t *testing.T, home string) {
+				t.Helper()
+				writePendingSlot(t, home, 0)
+			},
+		},
+		{
+			name: "pending slot after the committed height",
+			setup: func(t *testing.T, home string) {
+				t.Helper()
+				writePendingSlot(t, home, 1)
+			},
+			rolledBack: []math.Slot{1},
+		},
+		{
+			name: "failed rollback",
+			setup: func(t *testing.T, home string) {
+				t.Helper()
+				writePendingSlot(t, home, 1)
+			},
+			rollbackErr: errRollback,
+			expectedErr: errRollback.Error(),
+		},
+		{
+			name: "pending slot beyond the next height",
+			setup: func(t *testing.T, home string) {
+				t.Helper()
+				writePendingSlot(t, home, 3)
+			},
+			expectedErr: "commit journal does not follow committed height",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			home := t.TempDir()
+			tt.setup(t, home)
+			bc := &stubBlockchain{rollbackErr: tt.rollbackErr}
+			svc := newTestServiceAt(t, home, bc)
+
+			err := svc.RecoverUncommitted(context.Background())
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.rolledBack, bc.rolledBack)
+
+			// Recovery marks the committed height, so a restart finds
+			// nothing left to recover.
+			svc = newTestServiceAt(t, home, bc)
+			require.NoError(t, svc.RecoverUncommitted(context.Background()))
+			require.Equal(t, tt.rolledBack, bc.rolledBack)
+		})
+	}
+}
@@ -22,9 +22,14 @@ package cometbft
 
 import (
 	"fmt"
+	"time"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/consensus/voteext"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
 )
 
 // File for storing in-package cometbft optional functions,
@@ -71,6 +76,39 @@ func SetIAVLDisableFastNode(disable bool) func(*Service) {
 	}
 }
 
+// SetDBRegistry registers the application database, and the CometBFT
+// databases once opened, with r.
+func SetDBRegistry(r *storagedb.Registry) func(*Service) {
+	return func(s *Service) {
+		s.dbRegistry = r
+		r.Register("application", s.sm.DB())
+	}
+}
+
+// SetSnapshot enables state sync snapshots, kept in store and taken according
+// to opts. Nodes restoring from state sync need it even if they take none.
+func SetSnapshot(
+	store *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*Service) {
+	return func(s *Service) { s.setSnapshot(store, opts) }
+}
+
+// SetSnapshotExtensions adds stores kept outside of the commit multistore to
+// state sync snapshots. It must be applied after SetSnapshot.
+func SetSnapshotExtensions(
+	extensions ...snapshottypes.ExtensionSnapshotter,
+) func(*Service) {
+	return func(s *Service) {
+		if s.snapshotManager == nil {
+			return
+		}
+		if err := s.snapshotManager.RegisterExtensions(extensions...); err != nil {
+			panic(fmt.Errorf("failed registering snapshot extensions: %w", err))
+		}
+	}
+}
+
 // SetInterBlockCache provides a Service option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*Service) {
@@ -79,7 +117,58 @@ func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*Servic
 	}
 }
 
+// SetVoteExtensions sets the manager of the vote extension providers, and
+// the store used to check the signatures of the vote extensions included in
+// proposals.
+func SetVoteExtensions(
+	m *voteext.Manager, validators ValidatorStore,
+) func(*Service) {
+	return func(s *Service) {
+		s.voteExtensions = m
+		s.validatorStore = validators
+	}
+}
+
+// SetProposalMutators sets the mutators applied, in order, to the consensus
+// transactions of proposals.
+func SetProposalMutators(mutators ...ProposalMutator) func(*Service) {
+	return func(s *Service) { s.proposalMutators = mutators }
+}
+
+// SetUnjails injects the pooled unjails into proposals if validators can be
+// jailed, as set by the chain spec. Otherwise proposals carrying unjails are
+// rejected, like any other Extra transaction.
+func SetUnjails(
+	cs JailingSpec, pool UnjailPool, states UnjailStates, processor UnjailProcessor,
+) func(*Service) {
+	return func(s *Service) {
+		if cs.DowntimeJailThreshold() > 0 {
+			s.proposalMutators = append(
+				s.proposalMutators, NewUnjailMutator(pool, states, processor),
+			)
+		}
+	}
+}
+
+// SetPerformanceTracker sets the tracker fed with the rounds and blocks of
+// consensus.
+func SetPerformanceTracker(tracker PerformanceTracker) func(*Service) {
+	return func(s *Service) { s.performance = tracker }
+}
+
+// SetUpgradeCoordinator sets the coordinator halting the node at scheduled
+// upgrades.
+func SetUpgradeCoordinator(coordinator UpgradeCoordinator) func(*Service) {
+	return func(s *Service) { s.upgrade = coordinator }
+}
+
 // SetChainID sets the chain ID in cometbft.
 func SetChainID(chainID string) func(*Service) {
 	return func(s *Service) { s.chainID = chainID }
 }
+
+// SetDrainTimeout sets how long shutdown waits for the in-flight block to be
+// committed.
+func SetDrainTimeout(timeout time.Duration) func(*Service) {
+	return func(s *Service) { s.drainTimeout = timeout }
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// Peers returns the peers the node is connected to, or nil if the node has
+// not been started.
+func (s *Service) Peers() []*types.PeerInfo {
+	if s.node == nil {
+		return nil
+	}
+
+	var peers []*types.PeerInfo
+	s.node.Switch().Peers().ForEach(func(peer p2p.Peer) {
+		info := &types.PeerInfo{
+			ID:       string(peer.ID()),
+			Outbound: peer.IsOutbound(),
+		}
+		if addr := peer.SocketAddr(); addr != nil {
+			info.Address = addr.String()
+		}
+		peers = append(peers, info)
+	})
+	return peers
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+const (
+	// performanceSubscriber is the name of the event bus subscriber feeding
+	// the performance tracker.
+	performanceSubscriber = "performance"
+	// performanceBuffer is the number of events buffered for the tracker.
+	// CometBFT cancels the subscription if the buffer fills up.
+	performanceBuffer = 100
+)
+
+// PerformanceTracker tracks the proposals of validators from the rounds and
+// blocks of consensus.
+type PerformanceTracker interface {
+	// RoundStarted marks that the round of the height started at the given
+	// time, to be proposed by proposer.
+	RoundStarted(height int64, round int32, proposer []byte, at time.Time)
+	// BlockCommitted marks that the block proposed by proposer was committed
+	// at the given height and time.
+	BlockCommitted(height int64, proposer []byte, at time.Time) error
+}
+
+// roundsAndBlocksQuery matches the new round and new block events, so that
+// a single subscription receives them in the order they are published.
+type roundsAndBlocksQuery struct{}
+
+func (roundsAndBlocksQuery) Matches(events map[string][]string) (bool, error) {
+	for _, event := range events[cmttypes.EventTypeKey] {
+		if event == cmttypes.EventNewRound || event == cmttypes.EventNewBlock {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (roundsAndBlocksQuery) String() string {
+	return fmt.Sprintf("%s='%s' OR %s='%s'",
+		cmttypes.EventTypeKey, cmttypes.EventNewRound,
+		cmttypes.EventTypeKey, cmttypes.EventNewBlock,
+	)
+}
+
+// trackPerformance feeds the performance tracker with the rounds and blocks
+// of consensus until ctx is done. Rounds are only published while following
+// consensus, so the heights processed while syncing are not tracked.
+func (s *Service) trackPerformance(ctx context.Context) {
+	bus := s.node.EventBus()
+	sub, err := bus.Subscribe(
+		ctx, performanceSubscriber, roundsAndBlocksQuery{}, performanceBuffer,
+	)
+	if err != nil {
+		s.logger.Error("Failed subscribing to consensus events, not tracking performance", "error", err)
+		return
+	}
+	defer func() {
+		//nolint:contextcheck // ctx may be done already.
+		if errUnsub := bus.UnsubscribeAll(context.Background(), performanceSubscriber); errUnsub != nil {
+			s.logger.Debug("Failed unsubscribing from consensus events", "error", errUnsub)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sub.Canceled():
+			s.logger.Error("Consensus events subscription canceled, not tracking performance", "error", sub.Err())
+			return
+		case msg := <-sub.Out():
+			switch event := msg.Data().(type) {
+			case cmttypes.EventDataNewRound:
+				s.performance.RoundStarted(
+					event.Height, event.Round, event.Proposer.Address, time.Now(),
+				)
+			case cmttypes.EventDataNewBlock:
+				if err = s.performance.BlockCommitted(
+					event.Block.Height, event.Block.ProposerAddress, time.Now(),
+				); err != nil {
+					s.logger.Error("Failed tracking block proposal", "height", event.Block.Height, "error", err)
+				}
+			}
+		}
+	}
+}
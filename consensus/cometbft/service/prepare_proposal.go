@@ -25,9 +25,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) prepareProposal(
@@ -47,6 +50,25 @@ func (s *Service) prepareProposal(
 		)
 	}
 
+	ctx, span := tracing.StartSpan(
+		tracing.ContextWithBlockTrace(ctx, req.Height),
+		"PrepareProposal",
+		attribute.Int64("height", req.Height),
+	)
+	defer span.End()
+
+	// Leave blocks past an upgrade this binary does not implement to the
+	// upgraded proposers.
+	if s.upgrade != nil {
+		if err := s.upgrade.CheckBlock(req.Time); err != nil {
+			s.logger.Error(
+				"failed to prepare proposal",
+				"height", req.Height, "time", req.Time, "err", err,
+			)
+			return &cmtabci.PrepareProposalResponse{Txs: [][]byte{}}, nil
+		}
+	}
+
 	// Always reset state given that PrepareProposal can timeout
 	// and be called again in a subsequent round.
 	s.prepareProposalState = s.resetState(ctx)
@@ -65,6 +87,7 @@ func (s *Service) prepareProposal(
 		req.GetProposerAddress(),
 		req.GetTime(),
 	)
+	slotData.SetMisbehaviors(blockchain.DuplicateVoteMisbehaviors(req.GetMisbehavior()))
 
 	//nolint:contextcheck // ctx already passed via resetState
 	blkBz, sidecarsBz, err := s.BlockBuilder.BuildBlockAndSidecars(
@@ -72,16 +95,26 @@ func (s *Service) prepareProposal(
 		slotData,
 	)
 	if err != nil {
+		span.RecordError(err)
 		s.logger.Error(
 			"failed to prepare proposal",
 			"height", req.Height,
 			"time", req.Time,
+			"trace_id", tracing.TraceID(ctx),
 			"err", err,
 		)
 		return &cmtabci.PrepareProposalResponse{Txs: [][]byte{}}, nil
 	}
 
+	txs := ProposalTxs{
+		BeaconBlock:    blkBz,
+		BlobSidecars:   sidecarsBz,
+		VoteExtensions: s.voteExtensionsTx(req),
+	}
+	//nolint:contextcheck // ctx already passed via resetState
 	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
+		Txs: s.mutateProposal(
+			s.prepareProposalState.Context(), req.Height, req.MaxTxBytes, txs,
+		),
 	}, nil
 }
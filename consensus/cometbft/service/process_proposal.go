@@ -25,7 +25,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) processProposal(
@@ -45,6 +48,13 @@ func (s *Service) processProposal(
 		)
 	}
 
+	ctx, span := tracing.StartSpan(
+		tracing.ContextWithBlockTrace(ctx, req.Height),
+		"ProcessProposal",
+		attribute.Int64("height", req.Height),
+	)
+	defer span.End()
+
 	// Since the application can get access to FinalizeBlock state and write to
 	// it, we must be sure to reset it in case ProcessProposal timeouts and is
 	// called
@@ -69,19 +79,42 @@ func (s *Service) processProposal(
 	// whether the block was valid or not. Viceversa, we signal that a block
 	// is invalid by its status, but we do return nil error in such a case.
 	status := cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT
-	err := s.Blockchain.ProcessProposal(
-		s.processProposalState.Context(),
-		req,
-	)
+	var err error
+	if s.upgrade != nil {
+		err = s.upgrade.CheckBlock(req.Time)
+	}
+	if err == nil {
+		//nolint:contextcheck // ctx already passed via resetState
+		err = s.proposalMutators.Verify(
+			s.processProposalState.Context(), req.Height, req.Txs,
+		)
+	}
+	if err == nil {
+		// The beacon chain only knows about the transactions up to the vote
+		// extensions, the ones injected by mutators were just verified.
+		consensusReq := *req
+		consensusReq.Txs = req.Txs[:min(len(req.Txs), blockchain.MaxConsensusTxsCount)]
+		err = s.Blockchain.ProcessProposal(
+			s.processProposalState.Context(),
+			&consensusReq,
+		)
+	}
+	if err == nil {
+		//nolint:contextcheck // ctx already passed via resetState
+		err = s.validateVoteExtensionsTx(s.processProposalState.Context(), req)
+	}
 	if err != nil {
 		status = cmtabci.PROCESS_PROPOSAL_STATUS_REJECT
+		span.RecordError(err)
 		s.logger.Error(
 			"failed to process proposal",
 			"height", req.Height,
 			"time", req.Time,
 			"hash", fmt.Sprintf("%X", req.Hash),
+			"trace_id", tracing.TraceID(ctx),
 			"err", err,
 		)
 	}
+	span.SetAttributes(attribute.String("status", status.String()))
 	return &cmtabci.ProcessProposalResponse{Status: status}, nil
 }
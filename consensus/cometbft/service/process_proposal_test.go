@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBlockchain accepts every proposal, recording the transactions it is
+// given, and the slots it is asked to roll back. Rollbacks fail with
+// rollbackErr if set.
+type stubBlockchain struct {
+	proposalTxs [][]byte
+	rolledBack  []math.Slot
+	rollbackErr error
+}
+
+func (*stubBlockchain) ProcessGenesisData(
+	context.Context, []byte,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+func (b *stubBlockchain) ProcessProposal(
+	_ sdk.Context, req *cmtabci.ProcessProposalRequest,
+) error {
+	b.proposalTxs = req.Txs
+	return nil
+}
+
+func (*stubBlockchain) FinalizeBlock(
+	sdk.Context, *cmtabci.FinalizeBlockRequest,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+func (b *stubBlockchain) RollbackSlot(_ context.Context, slot math.Slot) error {
+	if b.rollbackErr != nil {
+		return b.rollbackErr
+	}
+	b.rolledBack = append(b.rolledBack, slot)
+	return nil
+}
+
+// newTestService returns a service over an in-memory database, with a
+// genesis file in its home directory.
+func newTestService(
+	t *testing.T, bc blockchain.BlockchainI, opts ...func(*cometbft.Service),
+) *cometbft.Service {
+	t.Helper()
+	return newTestServiceAt(t, t.TempDir(), bc, opts...)
+}
+
+// newTestServiceAt is newTestService with home as its home directory.
+func newTestServiceAt(
+	t *testing.T, home string, bc blockchain.BlockchainI, opts ...func(*cometbft.Service),
+) *cometbft.Service {
+	t.Helper()
+	cmtCfg := cometbft.DefaultConfig()
+	cmtCfg.SetRoot(home)
+	require.NoError(t, os.MkdirAll(filepath.Dir(cmtCfg.GenesisFile()), 0o755))
+	appGenesis := &genutiltypes.AppGenesis{
+		ChainID:       "beacond-test",
+		AppState:      []byte("{}"),
+		InitialHeight: 1,
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: cometbft.DefaultConsensusParams(crypto.CometBLSType),
+		},
+	}
+	require.NoError(t, genutil.ExportGenesisFile(appGenesis, cmtCfg.GenesisFile()))
+
+	svc := cometbft.NewService(
+		phuslu.NewLogger(io.Discard, nil),
+		dbm.NewMemDB(),
+		bc,
+		nil,
+		cmtCfg,
+		metrics.NewNoOpTelemetrySink(),
+		opts...,
+	)
+	svc.ResetAppCtx(context.Background())
+	return svc
+}
+
+// TestProcessProposal_Unjails checks the Extra transactions of proposals
+// processed by a service set up like the node: only unjails are accepted,
+// and only if validators can be jailed.
+func TestProcessProposal_Unjails(t *testing.T) {
+	t.Parallel()
+	cs := jailingSpec(t)
+	slot := math.Slot(cs.SlotsPerEpoch()) + 10
+	_, _, _, unjail := setupJailedValidator(t, cs, slot)
+	unjailTx, err := blockchain.EncodeUnjailTx(unjail)
+	require.NoError(t, err)
+
+	noJailingData := spec.DevnetChainSpecData()
+	noJailingData.DowntimeJailThreshold = 0
+	noJailing, err := chain.NewSpec(noJailingData)
+	require.NoError(t, err)
+
+	base := [][]byte{[]byte("block"), []byte("sidecars"), nil}
+	with := func(extra ...[]byte) [][]byte {
+		return append(append([][]byte{}, base...), extra...)
+	}
+	tests := []struct {
+		name     string
+		cs       chain.Spec
+		txs      [][]byte
+		expected cmtabci.ProcessProposalStatus
+	}{
+		{
+			name:     "unjail",
+			cs:       cs,
+			txs:      with(unjailTx),
+			expected: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		{
+			name:     "unknown tx after an unjail",
+			cs:       cs,
+			txs:      with(unjailTx, []byte("junk")),
+			expected: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		{
+			name:     "unknown tx",
+			cs:       cs,
+			txs:      with([]byte("junk")),
+			expected: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		{
+			name:     "malformed unjail",
+			cs:       cs,
+			txs:      with(unjailTx[:len(unjailTx)-1]),
+			expected: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		{
+			name:     "unjail with jailing disabled",
+			cs:       noJailing,
+			txs:      with(unjailTx),
+			expected: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Validator 1 is jailed until the slot of the proposal.
+			sp, st, _, unjail := setupJailedValidator(t, cs, slot)
+			bc := &stubBlockchain{}
+			svc := newTestService(t, bc, cometbft.SetUnjails(
+				tt.cs, stubUnjailPool{unjail}, stubStates{st: st}, sp,
+			))
+
+			resp, err := svc.ProcessProposal(context.Background(), &cmtabci.ProcessProposalRequest{
+				Txs:    tt.txs,
+				Height: int64(slot),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp.Status)
+			if tt.expected == cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT {
+				// The beacon chain is only given the transactions it knows.
+				require.Equal(t, base, bc.proposalTxs)
+			}
+		})
+	}
+}
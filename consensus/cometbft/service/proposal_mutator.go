@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var (
+	// errUnorderedProposal is returned when the Extra transactions of a
+	// proposal are not in the order of the proposal mutators.
+	errUnorderedProposal = errors.New("proposal transactions out of order")
+	// errUnclaimedProposalTx is returned when an Extra transaction of a
+	// proposal is not claimed by any proposal mutator.
+	errUnclaimedProposalTx = errors.New("proposal transaction claimed by no mutator")
+)
+
+// ProposalTxs are the consensus transactions of a proposal, as opposed to the
+// execution transactions carried by the beacon block.
+type ProposalTxs struct {
+	// BeaconBlock is the encoded beacon block.
+	BeaconBlock []byte
+	// BlobSidecars are the encoded blob sidecars of the beacon block.
+	BlobSidecars []byte
+	// VoteExtensions are the encoded vote extensions of the last commit,
+	// empty if there are none.
+	VoteExtensions []byte
+	// Extra are the transactions injected by proposal mutators.
+	Extra [][]byte
+}
+
+// ProposalMutator lets chain integrators reorder or inject consensus
+// transactions while preparing a proposal. Every node locates the beacon
+// block, blob sidecars and vote extensions by position, so only the Extra
+// transactions following them can be changed. Mutators must be deterministic
+// and run by all nodes, which check the proposals of others with
+// VerifyProposal. Every Extra transaction of a proposal must be claimed by a
+// mutator, mutators which only reorder transactions claim none.
+type ProposalMutator interface {
+	// MutateProposal returns the transactions of a proposal being prepared
+	// for the given height, with the Extra transactions reordered or
+	// injected.
+	MutateProposal(
+		ctx context.Context, height int64, txs ProposalTxs,
+	) (ProposalTxs, error)
+	// VerifyProposal checks the Extra transactions of a proposal for the
+	// given height are the ones MutateProposal would have produced.
+	VerifyProposal(ctx context.Context, height int64, txs ProposalTxs) error
+	// ClaimsTx reports whether the Extra transaction is one the mutator
+	// injects.
+	ClaimsTx(tx []byte) bool
+}
+
+// newProposalTxs splits the transactions of a proposal.
+func newProposalTxs(txs [][]byte) ProposalTxs {
+	var p ProposalTxs
+	for i, tx := range txs {
+		switch uint(i) {
+		case blockchain.BeaconBlockTxIndex:
+			p.BeaconBlock = tx
+		case blockchain.BlobSidecarsTxIndex:
+			p.BlobSidecars = tx
+		case blockchain.VoteExtensionsTxIndex:
+			p.VoteExtensions = tx
+		default:
+			p.Extra = append(p.Extra, tx)
+		}
+	}
+	return p
+}
+
+// Txs returns the transactions of the proposal in order. An empty vote
+// extensions transaction holds the place of missing vote extensions if
+// there are Extra transactions.
+func (p ProposalTxs) Txs() [][]byte {
+	txs := [][]byte{p.BeaconBlock, p.BlobSidecars}
+	if len(p.VoteExtensions) == 0 && len(p.Extra) == 0 {
+		return txs
+	}
+	txs = append(txs, p.VoteExtensions)
+	return append(txs, p.Extra...)
+}
+
+// ProposalMutators are proposal mutators applied in order.
+type ProposalMutators []ProposalMutator
+
+// Mutate applies the proposal mutators in order, skipping the ones that
+// fail, and drops Extra transactions from the end until the proposal fits in
+// maxTxBytes. It returns the transactions of the proposal, along with the
+// errors of the skipped mutators.
+func (ms ProposalMutators) Mutate(
+	ctx context.Context, height int64, maxTxBytes int64, txs ProposalTxs,
+) ([][]byte, error) {
+	var errs []error
+	for _, m := range ms {
+		mutated, err := m.MutateProposal(ctx, height, txs)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		txs = mutated
+	}
+
+	out := txs.Txs()
+	for len(txs.Extra) > 0 && cmttypes.ToTxs(out).Validate(maxTxBytes) != nil {
+		txs.Extra = txs.Extra[:len(txs.Extra)-1]
+		out = txs.Txs()
+	}
+	return out, errors.Join(errs...)
+}
+
+// Verify checks the Extra transactions of the proposal with the proposal
+// mutators. A proposal can only carry Extra transactions if mutators are set,
+// and each of them must be claimed by one of the mutators.
+func (ms ProposalMutators) Verify(ctx context.Context, height int64, txs [][]byte) error {
+	p := newProposalTxs(txs)
+	if len(p.Extra) > 0 && len(ms) == 0 {
+		return fmt.Errorf("max expected %d, got %d: %w",
+			blockchain.MaxConsensusTxsCount,
+			int(blockchain.MaxConsensusTxsCount)+len(p.Extra),
+			blockchain.ErrTooManyConsensusTxs,
+		)
+	}
+	for i, tx := range p.Extra {
+		if !ms.claimsTx(tx) {
+			return fmt.Errorf("%w: extra tx %d", errUnclaimedProposalTx, i)
+		}
+	}
+	for _, m := range ms {
+		if err := m.VerifyProposal(ctx, height, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// claimsTx reports whether one of the proposal mutators claims the Extra
+// transaction.
+func (ms ProposalMutators) claimsTx(tx []byte) bool {
+	for _, m := range ms {
+		if m.ClaimsTx(tx) {
+			return true
+		}
+	}
+	return false
+}
+
+// mutateProposal applies the proposal mutators of the service, logging the
+// ones that fail.
+func (s *Service) mutateProposal(
+	ctx context.Context, height int64, maxTxBytes int64, txs ProposalTxs,
+) [][]byte {
+	out, err := s.proposalMutators.Mutate(ctx, height, maxTxBytes, txs)
+	if err != nil {
+		s.logger.Error(
+			"Proposal mutators failed, skipped them",
+			"height", height, "err", err,
+		)
+	}
+	return out
+}
+
+// PriorityOrdering is a ProposalMutator ordering the Extra transactions of
+// proposals by decreasing priority. Transactions of equal priority keep
+// their order.
+//
+// Deposits and blob commitments cannot be prioritized against each other:
+// they are not consensus transactions of their own but part of the beacon
+// block, whose deposits are ordered by index and whose commitments follow
+// the blob transactions of the execution payload. Only the Extra
+// transactions, e.g. unjails, can be ordered.
+type PriorityOrdering struct {
+	// Priority returns the priority of a transaction.
+	Priority func(tx []byte) int
+}
+
+// MutateProposal orders the Extra transactions by decreasing priority.
+func (o PriorityOrdering) MutateProposal(
+	_ context.Context, _ int64, txs ProposalTxs,
+) (ProposalTxs, error) {
+	extra := append([][]byte(nil), txs.Extra...)
+	sort.SliceStable(extra, func(i, j int) bool {
+		return o.Priority(extra[i]) > o.Priority(extra[j])
+	})
+	txs.Extra = extra
+	return txs, nil
+}
+
+// VerifyProposal checks the Extra transactions are ordered by decreasing
+// priority.
+func (o PriorityOrdering) VerifyProposal(
+	_ context.Context, _ int64, txs ProposalTxs,
+) error {
+	for i := 1; i < len(txs.Extra); i++ {
+		if o.Priority(txs.Extra[i-1]) < o.Priority(txs.Extra[i]) {
+			return fmt.Errorf("%w: transaction %d", errUnorderedProposal, i)
+		}
+	}
+	return nil
+}
+
+// ClaimsTx claims no transaction, PriorityOrdering only orders the ones of
+// other mutators.
+func (PriorityOrdering) ClaimsTx([]byte) bool {
+	return false
+}
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errMutator = errors.New("mutator failed")
+
+// injector appends its transactions to the Extra transactions of proposals,
+// and only accepts proposals carrying them.
+type injector struct {
+	txs [][]byte
+}
+
+func (i injector) MutateProposal(
+	_ context.Context, _ int64, txs cometbft.ProposalTxs,
+) (cometbft.ProposalTxs, error) {
+	txs.Extra = append(txs.Extra, i.txs...)
+	return txs, nil
+}
+
+func (i injector) VerifyProposal(_ context.Context, _ int64, txs cometbft.ProposalTxs) error {
+	for _, tx := range i.txs {
+		found := false
+		for _, extra := range txs.Extra {
+			found = found || bytes.Equal(tx, extra)
+		}
+		if !found {
+			return errMutator
+		}
+	}
+	return nil
+}
+
+func (i injector) ClaimsTx(tx []byte) bool {
+	for _, own := range i.txs {
+		if bytes.Equal(own, tx) {
+			return true
+		}
+	}
+	return false
+}
+
+// failing is a mutator which always fails to mutate proposals.
+type failing struct{}
+
+func (failing) MutateProposal(
+	_ context.Context, _ int64, txs cometbft.ProposalTxs,
+) (cometbft.ProposalTxs, error) {
+	return txs, errMutator
+}
+
+func (failing) VerifyProposal(context.Context, int64, cometbft.ProposalTxs) error {
+	return nil
+}
+
+func (failing) ClaimsTx([]byte) bool {
+	return false
+}
+
+// byFirstByte prioritizes transactions by their first byte.
+func byFirstByte(tx []byte) int {
+	return int(tx[0])
+}
+
+func baseProposal() cometbft.ProposalTxs {
+	return cometbft.ProposalTxs{
+		BeaconBlock:  []byte("block"),
+		BlobSidecars: []byte("sidecars"),
+	}
+}
+
+func TestProposalMutators_RoundTrip(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mutators := cometbft.ProposalMutators{
+		injector{txs: [][]byte{{1, 'a'}, {3, 'b'}, {2, 'c'}}},
+		failing{},
+		cometbft.PriorityOrdering{Priority: byFirstByte},
+	}
+
+	txs, err := mutators.Mutate(ctx, 7, 1<<20, baseProposal())
+	require.ErrorIs(t, err, errMutator)
+	// An empty vote extensions transaction holds the place of the missing
+	// vote extensions, so that the Extra transactions follow them.
+	require.Equal(t, [][]byte{
+		[]byte("block"), []byte("sidecars"), nil, {3, 'b'}, {2, 'c'}, {1, 'a'},
+	}, txs)
+	require.Len(t, txs, int(blockchain.MaxConsensusTxsCount)+3)
+	require.NoError(t, mutators.Verify(ctx, 7, txs))
+
+	// Reordered or missing Extra transactions are rejected.
+	reordered := append([][]byte{}, txs...)
+	reordered[3], reordered[4] = reordered[4], reordered[3]
+	require.Error(t, mutators.Verify(ctx, 7, reordered))
+	require.ErrorIs(t, mutators.Verify(ctx, 7, txs[:4]), errMutator)
+}
+
+func TestProposalMutators_NoExtra(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mutators := cometbft.ProposalMutators{cometbft.PriorityOrdering{Priority: byFirstByte}}
+
+	// Proposals without Extra transactions keep their layout.
+	txs, err := mutators.Mutate(ctx, 7, 1<<20, baseProposal())
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("block"), []byte("sidecars")}, txs)
+	require.NoError(t, mutators.Verify(ctx, 7, txs))
+
+	withVoteExtensions := baseProposal()
+	withVoteExtensions.VoteExtensions = []byte("votes")
+	txs, err = mutators.Mutate(ctx, 7, 1<<20, withVoteExtensions)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("block"), []byte("sidecars"), []byte("votes")}, txs)
+}
+
+func TestProposalMutators_WithoutMutators(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	var mutators cometbft.ProposalMutators
+
+	require.NoError(t, mutators.Verify(ctx, 7, [][]byte{[]byte("block"), []byte("sidecars")}))
+	err := mutators.Verify(ctx, 7, [][]byte{[]byte("block"), []byte("sidecars"), nil, {1}})
+	require.ErrorIs(t, err, blockchain.ErrTooManyConsensusTxs)
+}
+
+func TestProposalMutators_UnclaimedExtra(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mutators := cometbft.ProposalMutators{
+		injector{txs: [][]byte{{2, 'a'}}},
+		cometbft.PriorityOrdering{Priority: byFirstByte},
+	}
+
+	txs, err := mutators.Mutate(ctx, 7, 1<<20, baseProposal())
+	require.NoError(t, err)
+	require.NoError(t, mutators.Verify(ctx, 7, txs))
+
+	// Transactions no mutator injects are rejected, wherever they are.
+	require.Error(t, mutators.Verify(ctx, 7, append(txs, []byte{1, 'x'})))
+	junkFirst := append(append([][]byte{}, txs[:3]...), []byte{3, 'x'}, txs[3])
+	require.Error(t, mutators.Verify(ctx, 7, junkFirst))
+}
+
+func TestProposalMutators_DropsExtraBeyondMaxTxBytes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	big := func(priority byte) []byte {
+		return append([]byte{priority}, make([]byte, 100)...)
+	}
+	mutators := cometbft.ProposalMutators{
+		injector{txs: [][]byte{big(1), big(3), big(2)}},
+		cometbft.PriorityOrdering{Priority: byFirstByte},
+	}
+
+	// The lowest priority transaction, last once ordered, is dropped.
+	fits := [][]byte{[]byte("block"), []byte("sidecars"), nil, big(3), big(2)}
+	maxTxBytes := cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(fits))
+	txs, err := mutators.Mutate(ctx, 7, maxTxBytes, baseProposal())
+	require.NoError(t, err)
+	require.Equal(t, fits, txs)
+}
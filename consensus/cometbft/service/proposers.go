@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+)
+
+// ErrNodeNotStarted is returned when querying consensus data before the
+// CometBFT node has been started.
+var ErrNodeNotStarted = errors.New("cometbft node not started")
+
+// ExpectedProposerAddresses returns the CometBFT addresses of the validators
+// expected to propose the next count heights, starting at LastBlockHeight+1.
+//
+// CometBFT advances proposer priorities once per height, independently of the
+// rounds needed to decide it, so the schedule is exact as long as every height
+// is decided in round 0 and the validator set does not change.
+func (s *Service) ExpectedProposerAddresses(count int) ([][]byte, error) {
+	if s.node == nil {
+		return nil, ErrNodeNotStarted
+	}
+	cmtState := s.node.ConsensusState().GetState()
+	if cmtState.Validators == nil || cmtState.NextValidators == nil {
+		return nil, errors.New("validator set not available")
+	}
+
+	proposers := make([][]byte, 0, count)
+	// The current validator set proposes the next height, and the next
+	// validator set already has its priorities advanced for the height after.
+	if count > 0 {
+		proposers = append(proposers, cmtState.Validators.GetProposer().Address)
+	}
+	vals := cmtState.NextValidators.Copy()
+	for i := 1; i < count; i++ {
+		if i > 1 {
+			vals.IncrementProposerPriority(1)
+		}
+		proposers = append(proposers, vals.GetProposer().Address)
+	}
+	return proposers, nil
+}
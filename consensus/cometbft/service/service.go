@@ -24,17 +24,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
 	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 	statem "github.com/berachain/beacon-kit/consensus/cometbft/service/state"
+	"github.com/berachain/beacon-kit/consensus/voteext"
 	errorsmod "github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/storage"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	cmtdb "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
@@ -50,6 +55,10 @@ import (
 const (
 	initialAppVersion uint64 = 0
 	AppName           string = "beacond"
+
+	// defaultDrainTimeout is how long shutdown waits for the in-flight block
+	// to be committed by default.
+	defaultDrainTimeout = 30 * time.Second
 )
 
 type Service struct {
@@ -104,7 +113,38 @@ type Service struct {
 	// Thus the app cannot tell when the context as been cancelled or not.
 	// TODO: We must use this as a workaround for now until CometBFT properly
 	// generates contexts that inherit from the parent context we provide.
+	//
+	// It is detached from the cancellation of the node context, so that the
+	// in-flight block keeps talking to the execution client while draining.
 	ctx context.Context
+	// cancelCtx cancels ctx once the service is stopped.
+	cancelCtx context.CancelFunc
+
+	// guard lets shutdown wait for the in-flight block to be committed.
+	guard *blockGuard
+	// drainTimeout bounds how long shutdown waits for the in-flight block.
+	drainTimeout time.Duration
+	// journal detects slots left half-written across stores by a crash.
+	journal *commitJournal
+	// snapshotManager takes and restores state sync snapshots, nil if
+	// snapshots are not set up.
+	snapshotManager *snapshots.Manager
+
+	// dbRegistry, if set, records the databases opened by CometBFT so that
+	// they can be checkpointed with the application's.
+	dbRegistry *storagedb.Registry
+
+	// voteExtensions, if set, attaches the data of its providers to the
+	// votes of this node and aggregates the data attached by all validators.
+	voteExtensions *voteext.Manager
+	// validatorStore looks up the keys vote extensions are checked against.
+	validatorStore ValidatorStore
+	// proposalMutators reorder or inject consensus transactions of proposals.
+	proposalMutators ProposalMutators
+	// performance, if set, tracks the proposals of validators.
+	performance PerformanceTracker
+	// upgrade, if set, halts the node at scheduled upgrades.
+	upgrade UpgradeCoordinator
 }
 
 func NewService(
@@ -137,6 +177,9 @@ func NewService(
 		cmtConsensusParams: cmtConsensusParams,
 		cmtCfg:             cmtCfg,
 		telemetrySink:      telemetrySink,
+		guard:              newBlockGuard(),
+		drainTimeout:       defaultDrainTimeout,
+		journal:            newCommitJournal(cmtCfg.DBDir()),
 	}
 
 	s.MountStore(storage.StoreKey, storetypes.StoreTypeIAVL)
@@ -176,7 +219,29 @@ func (s *Service) Start(
 		return err
 	}
 
-	s.ResetAppCtx(ctx)
+	// Refuse to start an old binary after halting for an upgrade.
+	if s.upgrade != nil {
+		if err = s.upgrade.VerifyRestart(); err != nil {
+			return err
+		}
+	}
+
+	// Reconcile the stores with the committed state before CometBFT starts
+	// replaying blocks.
+	if err = s.RecoverUncommitted(ctx); err != nil {
+		return err
+	}
+
+	// Stop accepting new blocks as soon as shutdown starts, but let the
+	// in-flight block complete with a context that outlives the node context.
+	appCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	s.cancelCtx = cancel
+	s.ResetAppCtx(appCtx)
+	go func() {
+		<-ctx.Done()
+		s.guard.stopAccepting()
+	}()
+
 	s.node, err = node.NewNode(
 		ctx,
 		cfg,
@@ -184,7 +249,7 @@ func (s *Service) Start(
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
 		GetGenDocProvider(cfg),
-		cmtcfg.DefaultDBProvider,
+		s.dbProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
 	)
@@ -209,12 +274,22 @@ func (s *Service) Start(
 
 	close(started)
 
+	if err == nil && s.performance != nil {
+		go s.trackPerformance(appCtx)
+	}
 	return err
 }
 
 func (s *Service) Stop() error {
 	var errs []error
 
+	// Wait for the in-flight block to be committed, so that the blockchain
+	// stores are not left ahead of the committed state.
+	s.logger.Info("Waiting for in-flight block to be committed")
+	if err := s.guard.drain(s.drainTimeout); err != nil {
+		errs = append(errs, err)
+	}
+
 	if s.node != nil && s.node.IsRunning() {
 		s.logger.Info("Stopping CometBFT Node")
 		err := s.node.Stop()
@@ -224,6 +299,9 @@ func (s *Service) Stop() error {
 		s.logger.Info("Waiting for CometBFT Node to stop")
 		s.node.Wait()
 	}
+	if s.cancelCtx != nil {
+		s.cancelCtx()
+	}
 
 	s.logger.Info("Closing application.db")
 	if err := s.sm.Close(); err != nil {
@@ -232,6 +310,23 @@ func (s *Service) Stop() error {
 	return errors.Join(errs...)
 }
 
+// PauseBlocks waits for the in-flight block, if any, to be committed and holds
+// off processing further blocks until the returned resume is called. Stores
+// are consistent with the last committed height while blocks are paused.
+func (s *Service) PauseBlocks(ctx context.Context) (func(), error) {
+	return s.guard.hold(ctx)
+}
+
+// dbProvider opens the CometBFT databases, registering them if a database
+// registry is set.
+func (s *Service) dbProvider(ctx *cmtcfg.DBContext) (cmtdb.DB, error) {
+	db, err := cmtcfg.DefaultDBProvider(ctx)
+	if err == nil && s.dbRegistry != nil {
+		s.dbRegistry.Register(ctx.ID, db)
+	}
+	return db, err
+}
+
 // ResetAppCtx sets the app ctx for the service. This is used
 // primarily for the mock service.
 func (s *Service) ResetAppCtx(ctx context.Context) {
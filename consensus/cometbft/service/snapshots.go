@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+)
+
+// State sync snapshots capture the commit multistore, which holds the beacon
+// state, plus the registered extensions for stores kept outside of it. They
+// are taken every snapshot interval after Commit and served to, or restored
+// from, peers through the ABCI methods below.
+
+func (s *Service) ListSnapshots(
+	context.Context,
+	*abci.ListSnapshotsRequest,
+) (*abci.ListSnapshotsResponse, error) {
+	res := &abci.ListSnapshotsResponse{Snapshots: []*abci.Snapshot{}}
+	if s.snapshotManager == nil {
+		return res, nil
+	}
+
+	list, err := s.snapshotManager.List()
+	if err != nil {
+		s.logger.Error("Failed to list snapshots", "error", err)
+		return nil, err
+	}
+	for _, snapshot := range list {
+		metadata, mErr := snapshot.Metadata.Marshal()
+		if mErr != nil {
+			s.logger.Error("Failed to encode snapshot metadata", "error", mErr)
+			return nil, mErr
+		}
+		res.Snapshots = append(res.Snapshots, &abci.Snapshot{
+			Height:   snapshot.Height,
+			Format:   snapshot.Format,
+			Chunks:   snapshot.Chunks,
+			Hash:     snapshot.Hash,
+			Metadata: metadata,
+		})
+	}
+	return res, nil
+}
+
+func (s *Service) LoadSnapshotChunk(
+	_ context.Context,
+	req *abci.LoadSnapshotChunkRequest,
+) (*abci.LoadSnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		return &abci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := s.snapshotManager.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		s.logger.Error(
+			"Failed to load snapshot chunk",
+			"height", req.Height, "format", req.Format, "chunk", req.Chunk,
+			"error", err,
+		)
+		return nil, err
+	}
+	return &abci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+func (s *Service) OfferSnapshot(
+	_ context.Context,
+	req *abci.OfferSnapshotRequest,
+) (*abci.OfferSnapshotResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ABORT}, nil
+	}
+	if req.Snapshot == nil {
+		s.logger.Error("Received nil snapshot")
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	}
+
+	snapshot := snapshottypes.Snapshot{
+		Height: req.Snapshot.Height,
+		Format: req.Snapshot.Format,
+		Chunks: req.Snapshot.Chunks,
+		Hash:   req.Snapshot.Hash,
+	}
+	if err := snapshot.Metadata.Unmarshal(req.Snapshot.Metadata); err != nil {
+		s.logger.Error("Failed to decode snapshot metadata", "height", snapshot.Height, "error", err)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	}
+
+	err := s.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		s.logger.Info("Restoring snapshot", "height", snapshot.Height, "format", snapshot.Format)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT}, nil
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT}, nil
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		s.logger.Error("Rejecting invalid snapshot", "height", snapshot.Height, "error", err)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	default:
+		// The stores cannot be reset to retry with another snapshot, so
+		// CometBFT is asked to abort restoration altogether.
+		s.logger.Error("Failed to restore snapshot", "height", snapshot.Height, "error", err)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ABORT}, nil
+	}
+}
+
+func (s *Service) ApplySnapshotChunk(
+	_ context.Context,
+	req *abci.ApplySnapshotChunkRequest,
+) (*abci.ApplySnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}, nil
+	}
+
+	done, err := s.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil:
+		if done {
+			s.logger.Info("Restored snapshot", "height", s.LastBlockHeight())
+		}
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		s.logger.Error(
+			"Snapshot chunk checksum mismatch, rejecting sender and refetching",
+			"chunk", req.Index, "sender", req.Sender, "error", err,
+		)
+		return &abci.ApplySnapshotChunkResponse{
+			Result:        abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+	default:
+		s.logger.Error("Failed to restore snapshot chunk", "chunk", req.Index, "error", err)
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}, nil
+	}
+}
+
+// snapshotIfApplicable takes a snapshot in the background if height falls on
+// the snapshot interval.
+func (s *Service) snapshotIfApplicable(height int64) {
+	if s.snapshotManager != nil {
+		s.snapshotManager.SnapshotIfApplicable(height)
+	}
+}
+
+// setSnapshot creates the snapshot manager over the commit multistore.
+func (s *Service) setSnapshot(
+	store *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) {
+	s.snapshotManager = snapshots.NewManager(
+		store, opts, s.sm.GetCommitMultiStore(), nil, servercmtlog.WrapSDKLogger(s.logger),
+	)
+}
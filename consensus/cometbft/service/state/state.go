@@ -87,6 +87,11 @@ func (sm *Manager) Close() error {
 	return sm.db.Close()
 }
 
+// DB returns the database backing the CommitMultiStore.
+func (sm *Manager) DB() dbm.DB {
+	return sm.db
+}
+
 // GetCommitMultiStore returns the CommitMultiStore of the Manager.
 func (sm *Manager) GetCommitMultiStore() storetypes.CommitMultiStore {
 	return sm.cms
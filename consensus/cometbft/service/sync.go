@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// peerHeight is implemented by the consensus state CometBFT keeps for every
+// peer.
+type peerHeight interface {
+	GetHeight() int64
+}
+
+// SyncStatus returns the number of blocks the node is behind its most
+// advanced peer, and whether it is still catching up through block sync.
+func (s *Service) SyncStatus() (int64, bool, error) {
+	if s.node == nil {
+		return 0, false, ErrNodeNotStarted
+	}
+
+	var highest int64
+	s.node.Switch().Peers().ForEach(func(peer p2p.Peer) {
+		ps, ok := peer.Get(cmttypes.PeerStateKey).(peerHeight)
+		if !ok {
+			return
+		}
+		// Peers report the height they are deciding, which is one past the
+		// last height they committed.
+		highest = max(highest, ps.GetHeight()-1)
+	})
+
+	distance := max(highest-s.node.BlockStore().Height(), 0)
+	return distance, s.node.ConsensusReactor().WaitSync(), nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+var _ ProposalMutator = UnjailMutator{}
+
+// JailingSpec tells whether validators can be jailed.
+type JailingSpec interface {
+	// DowntimeJailThreshold returns the number of blocks in a row a
+	// validator can miss before being jailed, 0 if jailing is disabled.
+	DowntimeJailThreshold() uint64
+}
+
+// UnjailPool holds the unjails waiting for inclusion.
+type UnjailPool interface {
+	// Unjails returns the pooled unjails.
+	Unjails() ([]*ctypes.SignedUnjail, error)
+}
+
+// UnjailStates returns the beacon state of a context.
+type UnjailStates interface {
+	// StateFromContext returns the beacon state of the context.
+	StateFromContext(ctx context.Context) *statedb.StateDB
+}
+
+// UnjailProcessor validates unjails.
+type UnjailProcessor interface {
+	// ProcessSlots advances the state to the slot.
+	ProcessSlots(st *statedb.StateDB, slot math.Slot) (transition.ValidatorUpdates, error)
+	// ValidateUnjail checks the unjail can be applied to the state.
+	ValidateUnjail(st *statedb.StateDB, signed *ctypes.SignedUnjail) error
+}
+
+// UnjailMutator is a ProposalMutator injecting the pooled unjails into
+// proposals, as Extra transactions. Unjails are validated against the state
+// advanced to the slot of the proposal, which proposers and verifiers both
+// derive: the proposer from its prepared state, already at that slot, and
+// verifiers from the committed state of the previous slot. It claims the
+// Extra transactions carrying an unjail, the others must be claimed by
+// other mutators for the proposal to be accepted.
+type UnjailMutator struct {
+	pool      UnjailPool
+	states    UnjailStates
+	processor UnjailProcessor
+}
+
+// NewUnjailMutator creates a new unjail mutator.
+func NewUnjailMutator(
+	pool UnjailPool, states UnjailStates, processor UnjailProcessor,
+) UnjailMutator {
+	return UnjailMutator{pool: pool, states: states, processor: processor}
+}
+
+// MutateProposal appends the pooled unjails which are valid at the slot of
+// the proposal.
+func (m UnjailMutator) MutateProposal(
+	ctx context.Context, height int64, txs ProposalTxs,
+) (ProposalTxs, error) {
+	unjails, err := m.pool.Unjails()
+	if err != nil {
+		return txs, err
+	}
+	st, err := m.stateAt(ctx, height)
+	if err != nil {
+		return txs, err
+	}
+	for _, unjail := range unjails {
+		if m.processor.ValidateUnjail(st, unjail) != nil {
+			continue
+		}
+		tx, errEnc := blockchain.EncodeUnjailTx(unjail)
+		if errEnc != nil {
+			return txs, errEnc
+		}
+		txs.Extra = append(txs.Extra, tx)
+	}
+	return txs, nil
+}
+
+// VerifyProposal checks the unjails among the Extra transactions are valid
+// at the slot of the proposal, and unjail distinct validators.
+func (m UnjailMutator) VerifyProposal(
+	ctx context.Context, height int64, txs ProposalTxs,
+) error {
+	var st *statedb.StateDB
+	seen := make(map[math.ValidatorIndex]struct{}, len(txs.Extra))
+	for i, tx := range txs.Extra {
+		unjail, err := blockchain.DecodeUnjailTx(tx)
+		if err != nil {
+			// Claimed by another mutator, FinalizeBlock ignores it as well.
+			continue
+		}
+		idx := unjail.Message.ValidatorIndex
+		if _, found := seen[idx]; found {
+			return fmt.Errorf("%w: validator %d unjailed twice",
+				core.ErrInvalidUnjail, idx,
+			)
+		}
+		seen[idx] = struct{}{}
+		if st == nil {
+			if st, err = m.stateAt(ctx, height); err != nil {
+				return err
+			}
+		}
+		if err = m.processor.ValidateUnjail(st, unjail); err != nil {
+			return fmt.Errorf("extra tx %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ClaimsTx claims the transactions carrying an unjail.
+func (UnjailMutator) ClaimsTx(tx []byte) bool {
+	_, err := blockchain.DecodeUnjailTx(tx)
+	return err == nil
+}
+
+// stateAt returns a copy of the state of ctx advanced to the slot of the
+// proposal at the given height.
+func (m UnjailMutator) stateAt(ctx context.Context, height int64) (*statedb.StateDB, error) {
+	st := m.states.StateFromContext(ctx).Copy()
+	//#nosec:G115 // heights are not negative.
+	if _, err := m.processor.ProcessSlots(st, math.Slot(height)); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
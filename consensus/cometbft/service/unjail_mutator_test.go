@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type stubUnjailPool []*types.SignedUnjail
+
+func (p stubUnjailPool) Unjails() ([]*types.SignedUnjail, error) {
+	return p, nil
+}
+
+type stubStates struct {
+	st *statedb.StateDB
+}
+
+func (s stubStates) StateFromContext(context.Context) *statedb.StateDB {
+	return s.st
+}
+
+// jailingSpec returns a chain spec jailing validators missing 2 blocks in a
+// row for 5 slots.
+func jailingSpec(t *testing.T) chain.Spec {
+	t.Helper()
+	specData := spec.DevnetChainSpecData()
+	specData.DowntimeJailThreshold = 2
+	specData.MinJailDuration = 5
+	cs, err := chain.NewSpec(specData)
+	require.NoError(t, err)
+	return cs
+}
+
+// setupJailedValidator returns a state at the slot before the given one, in
+// which validator 1 is jailed until that slot, along with its unjail.
+func setupJailedValidator(t *testing.T, cs chain.Spec, slot math.Slot) (
+	*core.StateProcessor, *statedb.StateDB, context.Context, *types.SignedUnjail,
+) {
+	t.Helper()
+	sp, st, ds, tctx, _, _ := statetransition.SetupTestState(t, cs)
+	ctx := tctx.ConsensusCtx()
+
+	var (
+		maxBalance  = cs.MaxEffectiveBalance()
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		deposits    = types.Deposits{
+			{Pubkey: [48]byte{0x00}, Credentials: credentials, Amount: maxBalance, Index: 0},
+			{Pubkey: [48]byte{0x01}, Credentials: credentials, Amount: maxBalance, Index: 1},
+		}
+		header = &types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(ctx, deposits))
+	_, err := sp.InitializeBeaconStateFromEth1(st, deposits, header, cs.GenesisForkVersion())
+	require.NoError(t, err)
+
+	// Jail validator 1 until the slot.
+	pk := deposits[1].Pubkey
+	votes := []core.CommitVote{
+		{ValidatorAddress: cmtcrypto.AddressHash(pk[:]).Bytes(), Absent: true},
+	}
+	require.NoError(t, st.SetSlot(slot-5))
+	for range 2 {
+		_, err = sp.ProcessJailing(st, votes, nil)
+		require.NoError(t, err)
+	}
+	jailedUntil, jailed, err := st.GetJailedUntil(1)
+	require.NoError(t, err)
+	require.True(t, jailed)
+	require.Equal(t, slot, jailedUntil)
+
+	// The committed state is at the previous slot, where the unjail is not
+	// valid yet.
+	require.NoError(t, st.SetSlot(slot-1))
+	unjail := &types.SignedUnjail{
+		Message: &types.UnjailMessage{ValidatorIndex: 1, JailedUntil: jailedUntil},
+	}
+	require.ErrorIs(t, sp.ValidateUnjail(st, unjail), core.ErrInvalidUnjail)
+	return sp, st, ctx, unjail
+}
+
+// TestUnjailMutator_ValidAtProposalSlot checks that an unjail which only
+// becomes valid at the slot of the proposal is proposed by the proposer,
+// whose state is already at that slot, and accepted by the verifiers, whose
+// committed state is at the previous slot.
+func TestUnjailMutator_ValidAtProposalSlot(t *testing.T) {
+	t.Parallel()
+	cs := jailingSpec(t)
+
+	tests := []struct {
+		name string
+		slot math.Slot
+	}{
+		{name: "mid epoch", slot: math.Slot(cs.SlotsPerEpoch()) + 10},
+		{name: "epoch boundary", slot: 2 * math.Slot(cs.SlotsPerEpoch())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Validator 1 is jailed until the slot of the proposal.
+			sp, st, ctx, unjail := setupJailedValidator(t, cs, tt.slot)
+			tx, err := blockchain.EncodeUnjailTx(unjail)
+			require.NoError(t, err)
+
+			mutator := cometbft.NewUnjailMutator(stubUnjailPool{unjail}, stubStates{st: st}, sp)
+			height := int64(tt.slot)
+
+			// Verifiers accept it, leaving the Extra transactions of other
+			// mutators to them, without changing their state.
+			other := []byte("other mutator")
+			require.False(t, mutator.ClaimsTx(other))
+			require.True(t, mutator.ClaimsTx(tx))
+			proposal := cometbft.ProposalTxs{Extra: [][]byte{other, tx}}
+			require.NoError(t, mutator.VerifyProposal(ctx, height, proposal))
+			slot, err := st.GetSlot()
+			require.NoError(t, err)
+			require.Equal(t, tt.slot-1, slot)
+
+			// The proposer builds on the state already advanced to the slot.
+			_, err = sp.ProcessSlots(st, tt.slot)
+			require.NoError(t, err)
+			mutated, err := mutator.MutateProposal(ctx, height, cometbft.ProposalTxs{})
+			require.NoError(t, err)
+			require.Equal(t, [][]byte{tx}, mutated.Extra)
+			require.NoError(t, mutator.VerifyProposal(ctx, height, mutated))
+
+			// The same validator cannot be unjailed twice.
+			twice := cometbft.ProposalTxs{Extra: [][]byte{tx, tx}}
+			require.ErrorIs(t, mutator.VerifyProposal(ctx, height, twice), core.ErrInvalidUnjail)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/consensus/voteext"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var (
+	// errVoteExtensionsDisabled is returned when a proposal carries vote
+	// extensions for a height at which they are not enabled.
+	errVoteExtensionsDisabled = errors.New("vote extensions not enabled")
+
+	// errVoteExtensionsMismatch is returned when the vote extensions of a
+	// proposal do not match the votes of its last commit.
+	errVoteExtensionsMismatch = errors.New("vote extensions do not match last commit")
+
+	// errInsufficientVoteExtensions is returned when the vote extensions of a
+	// proposal are signed by no more than 2/3 of the voting power.
+	errInsufficientVoteExtensions = errors.New("vote extensions signed by insufficient voting power")
+)
+
+// extendVote returns the vote extension of this node for the given height.
+// Failures are logged rather than returned, since they would make CometBFT
+// panic.
+func (s *Service) extendVote(
+	ctx context.Context,
+	req *abci.ExtendVoteRequest,
+) *abci.ExtendVoteResponse {
+	if !s.voteExtensions.Enabled() {
+		return &abci.ExtendVoteResponse{}
+	}
+	ext, err := s.voteExtensions.ExtendVote(ctx, req.Height)
+	if err != nil {
+		s.logger.Error("Failed to extend vote", "height", req.Height, "err", err)
+		return &abci.ExtendVoteResponse{}
+	}
+	return &abci.ExtendVoteResponse{VoteExtension: ext}
+}
+
+// verifyVoteExtension checks the vote extension of another validator. Nodes
+// without vote extension providers accept any vote extension.
+func (s *Service) verifyVoteExtension(
+	ctx context.Context,
+	req *abci.VerifyVoteExtensionRequest,
+) *abci.VerifyVoteExtensionResponse {
+	if !s.voteExtensions.Enabled() {
+		return &abci.VerifyVoteExtensionResponse{
+			Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		}
+	}
+	if err := s.voteExtensions.VerifyVoteExtension(
+		ctx, req.Height, req.VoteExtension,
+	); err != nil {
+		s.logger.Warn(
+			"Rejecting vote extension",
+			"height", req.Height,
+			"validator", fmt.Sprintf("%X", req.ValidatorAddress),
+			"err", err,
+		)
+		return &abci.VerifyVoteExtensionResponse{
+			Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		}
+	}
+	return &abci.VerifyVoteExtensionResponse{
+		Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+	}
+}
+
+// voteExtensionsTx returns the vote extensions of the last commit, to be
+// included in the proposal for the given height so that every node can
+// aggregate them. It returns nil if there is nothing to include.
+func (s *Service) voteExtensionsTx(req *cmtabci.PrepareProposalRequest) []byte {
+	if !s.voteExtensions.Enabled() ||
+		!s.cmtConsensusParams.Feature.VoteExtensionsEnabled(req.Height-1) {
+		return nil
+	}
+	bz, err := req.LocalLastCommit.Marshal()
+	if err != nil {
+		s.logger.Error(
+			"Failed to encode vote extensions, proposing without them",
+			"height", req.Height, "err", err,
+		)
+		return nil
+	}
+	return bz
+}
+
+// validateVoteExtensionsTx checks the vote extensions included in a proposal
+// against its last commit. Their signatures must be valid and cover more
+// than 2/3 of the voting power. The check does not depend on the vote
+// extension providers of this node, so that all nodes agree on it.
+func (s *Service) validateVoteExtensionsTx(
+	ctx context.Context,
+	req *cmtabci.ProcessProposalRequest,
+) error {
+	voteExtensionsBz := newProposalTxs(req.Txs).VoteExtensions
+	if len(voteExtensionsBz) == 0 {
+		return nil
+	}
+	height := req.Height - 1
+	if !s.cmtConsensusParams.Feature.VoteExtensionsEnabled(height) ||
+		s.validatorStore == nil {
+		return errVoteExtensionsDisabled
+	}
+
+	var info cmtabci.ExtendedCommitInfo
+	if err := info.Unmarshal(voteExtensionsBz); err != nil {
+		return fmt.Errorf("decoding vote extensions: %w", err)
+	}
+	commit := req.ProposedLastCommit
+	if info.Round != commit.Round || len(info.Votes) != len(commit.Votes) {
+		return errVoteExtensionsMismatch
+	}
+
+	var totalPower, signedPower int64
+	for i, vote := range info.Votes {
+		expected := commit.Votes[i]
+		if !bytes.Equal(vote.Validator.Address, expected.Validator.Address) ||
+			vote.Validator.Power != expected.Validator.Power ||
+			votedForBlock(vote.BlockIdFlag) != votedForBlock(expected.BlockIdFlag) {
+			return fmt.Errorf(
+				"%w: vote %d of validator %X",
+				errVoteExtensionsMismatch, i, vote.Validator.Address,
+			)
+		}
+		totalPower += vote.Validator.Power
+		if !votedForBlock(vote.BlockIdFlag) {
+			continue
+		}
+		if err := s.verifyVoteExtensionSignature(ctx, height, info.Round, vote); err != nil {
+			return err
+		}
+		signedPower += vote.Validator.Power
+	}
+
+	if signedPower*3 <= totalPower*2 { //nolint:mnd // 2/3 of the voting power.
+		return fmt.Errorf(
+			"%w: %d of %d", errInsufficientVoteExtensions, signedPower, totalPower,
+		)
+	}
+	return nil
+}
+
+// verifyVoteExtensionSignature checks the signature of the vote extension of
+// a precommit for the given height and round.
+func (s *Service) verifyVoteExtensionSignature(
+	ctx context.Context,
+	height int64,
+	round int32,
+	vote cmtabci.ExtendedVoteInfo,
+) error {
+	pubKey, err := s.validatorStore.PubKeyByCometBFTAddress(ctx, vote.Validator.Address)
+	if err != nil {
+		return fmt.Errorf("validator %X: %w", vote.Validator.Address, err)
+	}
+	pk, err := bls12381.NewPublicKeyFromCompressedBytes(pubKey[:])
+	if err != nil {
+		return fmt.Errorf("validator %X: %w", vote.Validator.Address, err)
+	}
+	signBytes := cmttypes.VoteExtensionSignBytes(s.chainID, &cmtproto.Vote{
+		Type:      cmtproto.PrecommitType,
+		Height:    height,
+		Round:     round,
+		Extension: vote.VoteExtension,
+	})
+	if !pk.VerifySignature(signBytes, vote.ExtensionSignature) {
+		return fmt.Errorf(
+			"invalid vote extension signature of validator %X",
+			vote.Validator.Address,
+		)
+	}
+	return nil
+}
+
+// aggregateVoteExtensions hands the vote extensions included in a finalized
+// block to the vote extension providers.
+func (s *Service) aggregateVoteExtensions(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+) error {
+	voteExtensionsBz := newProposalTxs(req.Txs).VoteExtensions
+	if !s.voteExtensions.Enabled() || len(voteExtensionsBz) == 0 {
+		return nil
+	}
+	var info cmtabci.ExtendedCommitInfo
+	if err := info.Unmarshal(voteExtensionsBz); err != nil {
+		return fmt.Errorf("decoding vote extensions: %w", err)
+	}
+
+	votes := make([]voteext.Vote, 0, len(info.Votes))
+	for _, vote := range info.Votes {
+		if !votedForBlock(vote.BlockIdFlag) || len(vote.VoteExtension) == 0 {
+			continue
+		}
+		votes = append(votes, voteext.Vote{
+			ValidatorAddress: vote.Validator.Address,
+			Power:            vote.Validator.Power,
+			Data:             vote.VoteExtension,
+		})
+	}
+	return s.voteExtensions.Aggregate(ctx, req.Height-1, votes)
+}
+
+// votedForBlock returns true if the flag marks a precommit for the block,
+// the only precommits carrying vote extensions. The last commit may flag the
+// precommits of a block as aggregated, unlike the extended commit.
+func votedForBlock(flag cmtproto.BlockIDFlag) bool {
+	switch cmttypes.BlockIDFlag(flag) {
+	case cmttypes.BlockIDFlagCommit,
+		cmttypes.BlockIDFlagAggCommit,
+		cmttypes.BlockIDFlagAggCommitAbsent:
+		return true
+	default:
+		return false
+	}
+}
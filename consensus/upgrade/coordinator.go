@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// InfoFileName is the name of the file written to the data directory when the
+// node halts for an upgrade. It follows the upgrade-info.json format watched
+// by Cosmovisor, so that process managers can hand off to the new binary.
+const InfoFileName = "upgrade-info.json"
+
+// Spec is the part of the chain spec the coordinator relies on.
+type Spec interface {
+	UpgradeName() string
+	UpgradeTime() uint64
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+}
+
+// Info is the content of the upgrade info file.
+type Info struct {
+	// Name is the name of the upgrade.
+	Name string `json:"name"`
+	// Time is the time at which the upgrade activates.
+	Time time.Time `json:"time"`
+	// Height is the height of the first block the node refused to finalize.
+	Height int64 `json:"height"`
+	// Info is left empty, it only exists for Cosmovisor compatibility.
+	Info string `json:"info"`
+	// ForkVersion is the fork version the halted binary had at the upgrade
+	// time. The upgraded binary must be on a later one.
+	ForkVersion common.Version `json:"fork_version"`
+}
+
+// Coordinator halts the node at a scheduled upgrade this binary does not
+// implement, and checks on restart that the upgrade was applied.
+//
+// A binary implements the scheduled upgrade if a fork activates at the
+// upgrade time, which is only the case once the fork is part of the spec.
+type Coordinator struct {
+	logger log.Logger
+	spec   Spec
+	path   string
+}
+
+// NewCoordinator creates a Coordinator writing its upgrade info file to
+// dataDir.
+func NewCoordinator(logger log.Logger, spec Spec, dataDir string) *Coordinator {
+	return &Coordinator{
+		logger: logger,
+		spec:   spec,
+		path:   filepath.Join(dataDir, InfoFileName),
+	}
+}
+
+// CheckBlock returns ErrUpgradeNeeded if a block with the given time must be
+// processed by an upgraded binary.
+func (c *Coordinator) CheckBlock(blockTime time.Time) error {
+	if !c.pending() || blockTime.Before(c.upgradeTime()) {
+		return nil
+	}
+	return fmt.Errorf(
+		"%w: %q activates at %s, this binary does not implement it",
+		ErrUpgradeNeeded, c.spec.UpgradeName(), c.upgradeTime(),
+	)
+}
+
+// Halt is like CheckBlock, but also writes the upgrade info file when the
+// block at height must be processed by an upgraded binary.
+func (c *Coordinator) Halt(height int64, blockTime time.Time) error {
+	checkErr := c.CheckBlock(blockTime)
+	if checkErr == nil {
+		return nil
+	}
+
+	upgradeTime := c.upgradeTime()
+	info := Info{
+		Name:   c.spec.UpgradeName(),
+		Time:   upgradeTime,
+		Height: height,
+		ForkVersion: c.spec.ActiveForkVersionForTimestamp(
+			math.U64(c.spec.UpgradeTime()),
+		),
+	}
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(c.path, bz, 0o600); err != nil { //nolint:mnd // file permissions.
+		return errors.Join(checkErr, err)
+	}
+
+	c.logger.Error(
+		"Halting for upgrade, restart the node with the upgraded binary",
+		"name", info.Name, "height", height, "upgrade_info", c.path,
+	)
+	return fmt.Errorf("%w at height %d", checkErr, height)
+}
+
+// VerifyRestart checks, if the node previously halted for an upgrade, that
+// this binary is on a later fork version at the upgrade time.
+func (c *Coordinator) VerifyRestart() error {
+	bz, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var info Info
+	if err = json.Unmarshal(bz, &info); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", c.path, err)
+	}
+
+	//#nosec G115 -- the upgrade time was written from a uint64.
+	current := c.spec.ActiveForkVersionForTimestamp(math.U64(info.Time.Unix()))
+	if !version.IsAfter(current, info.ForkVersion) {
+		return fmt.Errorf(
+			"%w: %q needs a fork version after %s, got %s",
+			ErrUpgradeNotApplied, info.Name, info.ForkVersion, current,
+		)
+	}
+
+	c.logger.Info(
+		"Running upgraded binary",
+		"name", info.Name, "height", info.Height,
+		"fork", version.Name(current),
+	)
+	return nil
+}
+
+// pending reports whether an upgrade is scheduled that no fork of this binary
+// activates.
+func (c *Coordinator) pending() bool {
+	upgradeTime := c.spec.UpgradeTime()
+	if c.spec.UpgradeName() == "" || upgradeTime == 0 {
+		return false
+	}
+	return c.spec.ActiveForkVersionForTimestamp(math.U64(upgradeTime)) ==
+		c.spec.ActiveForkVersionForTimestamp(math.U64(upgradeTime-1))
+}
+
+// upgradeTime returns the upgrade time of the spec.
+func (c *Coordinator) upgradeTime() time.Time {
+	//#nosec G115 -- fork times fit in an int64.
+	return time.Unix(int64(c.spec.UpgradeTime()), 0).UTC()
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus/upgrade"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSpec schedules the upgrade at upgradeTime and, once the binary
+// implements it, activates Electra2 at forkTime.
+type stubSpec struct {
+	upgradeTime uint64
+	forkTime    uint64
+}
+
+func (stubSpec) UpgradeName() string { return "electra2" }
+
+func (s stubSpec) UpgradeTime() uint64 { return s.upgradeTime }
+
+func (s stubSpec) ActiveForkVersionForTimestamp(timestamp math.U64) common.Version {
+	if s.forkTime != 0 && timestamp.Unwrap() >= s.forkTime {
+		return version.Electra2()
+	}
+	return version.Electra1()
+}
+
+func TestCoordinator(t *testing.T) {
+	t.Parallel()
+	dataDir := t.TempDir()
+	infoFile := filepath.Join(dataDir, upgrade.InfoFileName)
+	const upgradeTime = 1_000
+
+	// The old binary processes blocks up to the upgrade time.
+	old := upgrade.NewCoordinator(
+		noop.NewLogger[any](), stubSpec{upgradeTime: upgradeTime}, dataDir,
+	)
+	require.NoError(t, old.VerifyRestart())
+	require.NoError(t, old.CheckBlock(time.Unix(upgradeTime-1, 0)))
+	require.NoError(t, old.Halt(10, time.Unix(upgradeTime-1, 0)))
+	require.NoFileExists(t, infoFile)
+
+	// Then halts, handing off to the upgraded binary.
+	require.ErrorIs(t, old.CheckBlock(time.Unix(upgradeTime, 0)), upgrade.ErrUpgradeNeeded)
+	require.NoFileExists(t, infoFile)
+	require.ErrorIs(t, old.Halt(11, time.Unix(upgradeTime, 0)), upgrade.ErrUpgradeNeeded)
+	require.FileExists(t, infoFile)
+
+	// And refuses to restart.
+	require.ErrorIs(t, old.VerifyRestart(), upgrade.ErrUpgradeNotApplied)
+
+	// The upgraded binary activates a fork at the upgrade time.
+	upgraded := upgrade.NewCoordinator(
+		noop.NewLogger[any](),
+		stubSpec{upgradeTime: upgradeTime, forkTime: upgradeTime},
+		dataDir,
+	)
+	require.NoError(t, upgraded.VerifyRestart())
+	require.NoError(t, upgraded.Halt(11, time.Unix(upgradeTime, 0)))
+
+	// A corrupted upgrade info file is reported.
+	require.NoError(t, os.WriteFile(infoFile, []byte("{"), 0o600))
+	require.Error(t, upgraded.VerifyRestart())
+}
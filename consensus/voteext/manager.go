@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+// entry is the data of a single provider within a vote extension.
+type entry struct {
+	Provider string `json:"provider"`
+	Data     []byte `json:"data"`
+}
+
+// Manager multiplexes the registered providers into a single vote extension,
+// whose entries are sorted by provider name.
+type Manager struct {
+	logger    log.Logger
+	providers map[string]Provider
+	// names are the sorted names of the providers.
+	names []string
+}
+
+// NewManager creates a Manager for the given providers.
+func NewManager(logger log.Logger, providers ...Provider) (*Manager, error) {
+	m := &Manager{
+		logger:    logger,
+		providers: make(map[string]Provider, len(providers)),
+		names:     make([]string, 0, len(providers)),
+	}
+	for _, p := range providers {
+		if _, found := m.providers[p.Name()]; found {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateProvider, p.Name())
+		}
+		m.providers[p.Name()] = p
+		m.names = append(m.names, p.Name())
+	}
+	sort.Strings(m.names)
+	return m, nil
+}
+
+// Enabled returns true if any provider is registered.
+func (m *Manager) Enabled() bool {
+	return m != nil && len(m.providers) > 0
+}
+
+// ExtendVote returns the vote extension of the local validator for the given
+// height. A provider failing to supply its data is left out, so that one
+// unavailable feed does not hold back the others.
+func (m *Manager) ExtendVote(ctx context.Context, height int64) ([]byte, error) {
+	entries := make([]entry, 0, len(m.names))
+	for _, name := range m.names {
+		data, err := m.providers[name].ExtendVote(ctx, height)
+		if err != nil {
+			m.logger.Warn(
+				"Vote extension provider failed, leaving it out",
+				"provider", name, "height", height, "err", err,
+			)
+			continue
+		}
+		entries = append(entries, entry{Provider: name, Data: data})
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(entries)
+}
+
+// VerifyVoteExtension checks the vote extension of another validator for the
+// given height. An empty vote extension is valid.
+func (m *Manager) VerifyVoteExtension(
+	ctx context.Context, height int64, bz []byte,
+) error {
+	entries, err := decode(bz)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		p, found := m.providers[e.Provider]
+		if !found {
+			return fmt.Errorf("%w: %s", ErrUnknownProvider, e.Provider)
+		}
+		if err = p.VerifyVoteExtension(ctx, height, e.Data); err != nil {
+			return fmt.Errorf("provider %s: %w", e.Provider, err)
+		}
+	}
+	return nil
+}
+
+// Aggregate hands the votes of the given height, each carrying a whole vote
+// extension as data, to the providers to be aggregated. Vote extensions that
+// cannot be decoded and entries of unknown providers are skipped.
+func (m *Manager) Aggregate(ctx context.Context, height int64, votes []Vote) error {
+	byProvider := make(map[string][]Vote, len(m.names))
+	for _, v := range votes {
+		entries, err := decode(v.Data)
+		if err != nil {
+			m.logger.Warn(
+				"Skipping undecodable vote extension",
+				"validator", fmt.Sprintf("%X", v.ValidatorAddress), "err", err,
+			)
+			continue
+		}
+		for _, e := range entries {
+			if _, found := m.providers[e.Provider]; !found {
+				continue
+			}
+			byProvider[e.Provider] = append(byProvider[e.Provider], Vote{
+				ValidatorAddress: v.ValidatorAddress,
+				Power:            v.Power,
+				Data:             e.Data,
+			})
+		}
+	}
+
+	for _, name := range m.names {
+		if err := m.providers[name].Aggregate(ctx, height, byProvider[name]); err != nil {
+			return fmt.Errorf("aggregating vote extensions of %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// decode decodes a vote extension, checking its entries are strictly sorted
+// by provider name.
+func decode(bz []byte) ([]entry, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var entries []entry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i-1].Provider >= entries[i].Provider {
+			return nil, ErrUnsortedEntries
+		}
+	}
+	return entries, nil
+}
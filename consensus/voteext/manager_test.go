@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus/voteext"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/stretchr/testify/require"
+)
+
+var errStubInvalid = errors.New("invalid stub data")
+
+type stubProvider struct {
+	name       string
+	data       []byte
+	extendErr  error
+	aggregated []voteext.Vote
+}
+
+func (p *stubProvider) Name() string { return p.name }
+
+func (p *stubProvider) ExtendVote(context.Context, int64) ([]byte, error) {
+	return p.data, p.extendErr
+}
+
+func (p *stubProvider) VerifyVoteExtension(_ context.Context, _ int64, data []byte) error {
+	if string(data) != string(p.data) {
+		return errStubInvalid
+	}
+	return nil
+}
+
+func (p *stubProvider) Aggregate(_ context.Context, _ int64, votes []voteext.Vote) error {
+	p.aggregated = votes
+	return nil
+}
+
+func TestManagerRoundTrip(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	prices := &stubProvider{name: "prices", data: []byte("BERA=1")}
+	rates := &stubProvider{name: "rates", data: []byte("3%")}
+	m, err := voteext.NewManager(noop.NewLogger[any](), rates, prices)
+	require.NoError(t, err)
+	require.True(t, m.Enabled())
+
+	ext, err := m.ExtendVote(ctx, 10)
+	require.NoError(t, err)
+	require.NoError(t, m.VerifyVoteExtension(ctx, 10, ext))
+	require.NoError(t, m.VerifyVoteExtension(ctx, 10, nil))
+
+	votes := []voteext.Vote{
+		{ValidatorAddress: []byte{0x01}, Power: 10, Data: ext},
+		{ValidatorAddress: []byte{0x02}, Power: 5, Data: []byte("garbage")},
+	}
+	require.NoError(t, m.Aggregate(ctx, 10, votes))
+	require.Equal(t, []voteext.Vote{
+		{ValidatorAddress: []byte{0x01}, Power: 10, Data: []byte("BERA=1")},
+	}, prices.aggregated)
+	require.Equal(t, []voteext.Vote{
+		{ValidatorAddress: []byte{0x01}, Power: 10, Data: []byte("3%")},
+	}, rates.aggregated)
+}
+
+func TestManagerRejectsInvalidExtensions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	prices := &stubProvider{name: "prices", data: []byte("BERA=1")}
+	_, err := voteext.NewManager(noop.NewLogger[any](), prices, prices)
+	require.ErrorIs(t, err, voteext.ErrDuplicateProvider)
+
+	m, err := voteext.NewManager(noop.NewLogger[any](), prices)
+	require.NoError(t, err)
+
+	err = m.VerifyVoteExtension(ctx, 10, []byte(`[{"provider":"prices","data":"AA=="}]`))
+	require.ErrorIs(t, err, errStubInvalid)
+	err = m.VerifyVoteExtension(ctx, 10, []byte(`[{"provider":"volumes","data":null}]`))
+	require.ErrorIs(t, err, voteext.ErrUnknownProvider)
+	err = m.VerifyVoteExtension(
+		ctx, 10, []byte(`[{"provider":"prices","data":null},{"provider":"prices","data":null}]`),
+	)
+	require.ErrorIs(t, err, voteext.ErrUnsortedEntries)
+}
+
+func TestManagerLeavesOutFailingProviders(t *testing.T) {
+	t.Parallel()
+
+	failing := &stubProvider{name: "failing", extendErr: errStubInvalid}
+	m, err := voteext.NewManager(noop.NewLogger[any](), failing)
+	require.NoError(t, err)
+
+	ext, err := m.ExtendVote(context.Background(), 10)
+	require.NoError(t, err)
+	require.Empty(t, ext)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package voteext
+
+import "context"
+
+// Provider supplies one kind of data, e.g. a price feed, that validators
+// attach to their precommit votes. The votes of a height are handed back to
+// the provider in the following block to be aggregated.
+type Provider interface {
+	// Name uniquely identifies the provider within a vote extension.
+	Name() string
+	// ExtendVote returns the data the local validator attaches to its vote
+	// for the given height.
+	ExtendVote(ctx context.Context, height int64) ([]byte, error)
+	// VerifyVoteExtension checks the data another validator attached to its
+	// vote for the given height.
+	VerifyVoteExtension(ctx context.Context, height int64, data []byte) error
+	// Aggregate combines the data attached to the votes of the given height.
+	// It is called while finalizing the next block and ctx carries that
+	// block's state, so the result must be deterministic.
+	Aggregate(ctx context.Context, height int64, votes []Vote) error
+}
+
+// Vote is the data a validator attached to its vote.
+type Vote struct {
+	// ValidatorAddress is the CometBFT address of the validator.
+	ValidatorAddress []byte
+	// Power is the voting power of the validator.
+	Power int64
+	// Data is the data attached by the validator.
+	Data []byte
+}
@@ -30,7 +30,6 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/merkle"
-	"golang.org/x/sync/errgroup"
 )
 
 // SidecarFactory is a factory for sidecars.
@@ -62,7 +61,6 @@ func (f *SidecarFactory) BuildSidecars(
 		blk         = signedBlk.GetBeaconBlock()
 		body        = blk.GetBody()
 		header      = blk.GetHeader()
-		g           = errgroup.Group{}
 	)
 
 	startTime := time.Now()
@@ -75,25 +73,57 @@ func (f *SidecarFactory) BuildSidecars(
 	// signing root.
 	sigHeader := ctypes.NewSignedBeaconBlockHeader(header, signedBlk.GetSignature())
 
+	inclusionProofs, err := f.BuildKZGInclusionProofs(body, numBlobs)
+	if err != nil {
+		return nil, err
+	}
 	for i := range numBlobs {
-		g.Go(func() error {
-			inclusionProof, err := f.BuildKZGInclusionProof(body, math.U64(i))
-			if err != nil {
-				return err
-			}
-			sidecars[i] = types.BuildBlobSidecar(
-				math.U64(i),
-				sigHeader,
-				blobs[i],
-				commitments[i],
-				proofs[i],
-				inclusionProof,
-			)
-			return nil
-		})
+		sidecars[i] = types.BuildBlobSidecar(
+			math.U64(i),
+			sigHeader,
+			blobs[i],
+			commitments[i],
+			proofs[i],
+			inclusionProofs[i],
+		)
 	}
+	return sidecars, nil
+}
 
-	return sidecars, g.Wait()
+// BuildKZGInclusionProofs builds the KZG inclusion proofs of the first count
+// commitments of the body. The body and commitments trees are built once and
+// shared by all proofs, rather than once per proof as BuildKZGInclusionProof
+// does.
+func (f *SidecarFactory) BuildKZGInclusionProofs(
+	body *ctypes.BeaconBlockBody,
+	count uint64,
+) ([][]common.Root, error) {
+	bodyProof, err := f.BuildBlockBodyProof(body)
+	if err != nil {
+		return nil, err
+	}
+	commitmentsTree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		body.GetBlobKzgCommitments().Leafify(),
+		constants.MaxBlobCommitmentsPerBlock,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inclusionProofs := make([][]common.Root, count)
+	for i := range count {
+		startTime := time.Now()
+		var commitmentProof []common.Root
+		commitmentProof, err = commitmentsTree.MerkleProofWithMixin(i)
+		if err != nil {
+			return nil, err
+		}
+		// By property of the merkle tree, we can concatenate the
+		// two proofs to get the final proof.
+		inclusionProofs[i] = append(commitmentProof, bodyProof...)
+		f.metrics.measureBuildKZGInclusionProofDuration(startTime)
+	}
+	return inclusionProofs, nil
 }
 
 // BuildKZGInclusionProof builds a KZG inclusion proof.
@@ -29,9 +29,11 @@ import (
 	dastore "github.com/berachain/beacon-kit/da/store"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Processor is the blob processor that handles the processing and verification
@@ -76,13 +78,20 @@ func (sp *Processor) VerifySidecars(
 		return nil
 	}
 
+	ctx, span := tracing.StartSpan(
+		ctx, "blob.VerifySidecars",
+		attribute.Int("num_sidecars", len(sidecars)),
+	)
+
 	// Verify the blobs and ensure they match the local state.
-	return sp.verifier.verifySidecars(
+	err := sp.verifier.verifySidecars(
 		ctx,
 		sidecars,
 		blkHeader,
 		kzgCommitments,
 	)
+	tracing.EndSpan(span, err)
+	return err
 }
 
 // ProcessSidecars processes the blobs and ensures they match the local state.
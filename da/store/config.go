@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+)
+
+const hoursPerDay = 24
+
+// Config is the configuration for the data availability store.
+type Config struct {
+	// BlobRetention is how long blob sidecars are retained, either as a number
+	// of days (e.g. "30d") or as a Go duration (e.g. "72h"). It is converted to
+	// slots using the target block time of the chain spec. Blobs are never
+	// retained for less than MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS, which is
+	// also what an empty value resolves to.
+	//
+	// NOTE: CometBFT finalizes every committed block, so there are no blobs
+	// referenced by unfinalized forks that would need to outlive the retention.
+	BlobRetention string `mapstructure:"blob-retention"`
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		BlobRetention: "",
+	}
+}
+
+// ParseBlobRetention parses a blob retention, either as a number of days
+// suffixed by "d" or as a Go duration. An empty retention parses to zero.
+func ParseBlobRetention(retention string) (time.Duration, error) {
+	if retention == "" {
+		return 0, nil
+	}
+	if days, ok := strings.CutSuffix(retention, "d"); ok {
+		n, err := strconv.ParseUint(days, 10, 16)
+		if err != nil {
+			return 0, errors.Wrapf(ErrInvalidBlobRetention, "%q", retention)
+		}
+		return time.Duration(n) * hoursPerDay * time.Hour, nil
+	}
+	d, err := time.ParseDuration(retention)
+	if err != nil || d < 0 {
+		return 0, errors.Wrapf(ErrInvalidBlobRetention, "%q", retention)
+	}
+	return d, nil
+}
+
+// BlobRetentionSlots returns the number of slots blob sidecars are retained
+// for given the duration of a slot, which is never less than minSlots.
+func (c Config) BlobRetentionSlots(secondsPerSlot, minSlots uint64) (uint64, error) {
+	retention, err := ParseBlobRetention(c.BlobRetention)
+	if err != nil {
+		return 0, err
+	}
+	if secondsPerSlot == 0 {
+		return 0, errors.Wrap(ErrInvalidBlobRetention, "zero slot duration")
+	}
+	slotDuration := time.Duration(secondsPerSlot) * time.Second
+	// Round up so that blobs are kept for at least the configured duration.
+	//#nosec: G115 // the retention is never negative.
+	slots := uint64((retention + slotDuration - 1) / slotDuration)
+	return max(slots, minSlots), nil
+}
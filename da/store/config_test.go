@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/da/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlobRetention(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "", want: 0},
+		{input: "30d", want: 30 * 24 * time.Hour},
+		{input: "72h", want: 72 * time.Hour},
+		{input: "d", wantErr: true},
+		{input: "-1h", wantErr: true},
+		{input: "1w", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := store.ParseBlobRetention(tt.input)
+		if tt.wantErr {
+			require.ErrorIs(t, err, store.ErrInvalidBlobRetention, tt.input)
+			continue
+		}
+		require.NoError(t, err, tt.input)
+		require.Equal(t, tt.want, got, tt.input)
+	}
+}
+
+func TestConfig_BlobRetentionSlots(t *testing.T) {
+	t.Parallel()
+	const minSlots = 100
+
+	// An empty retention falls back to the minimum.
+	slots, err := store.DefaultConfig().BlobRetentionSlots(2, minSlots)
+	require.NoError(t, err)
+	require.Equal(t, uint64(minSlots), slots)
+
+	// One day of 2 second slots.
+	slots, err = store.Config{BlobRetention: "1d"}.BlobRetentionSlots(2, minSlots)
+	require.NoError(t, err)
+	require.Equal(t, uint64(43200), slots)
+
+	// Partial slots are rounded up.
+	slots, err = store.Config{BlobRetention: "301s"}.BlobRetentionSlots(2, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(151), slots)
+
+	// The retention never goes below the minimum.
+	slots, err = store.Config{BlobRetention: "10s"}.BlobRetentionSlots(2, minSlots)
+	require.NoError(t, err)
+	require.Equal(t, uint64(minSlots), slots)
+}
@@ -34,4 +34,8 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrInvalidBlobRetention is returned when the configured blob retention
+	// cannot be parsed.
+	ErrInvalidBlobRetention = errors.New("invalid blob retention")
 )
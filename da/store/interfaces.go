@@ -29,9 +29,24 @@ type IndexDB interface {
 	// Prune returns error if start > end.
 	Prune(start uint64, end uint64) error
 
+	// DeleteIndex removes all entries stored under index.
+	DeleteIndex(index uint64) error
+
 	// GetByIndex takes the database index and returns all associated entries,
 	// expecting database keys to follow the prefix() format. If index does not
 	// exist in the DB for any reason (pruned, invalid index), an empty list is
 	// returned with no error.
 	GetByIndex(index uint64) ([][]byte, error)
+
+	// GetByIndexMatching behaves like GetByIndex, but only returns the entries
+	// whose first prefixLen bytes satisfy match.
+	GetByIndexMatching(index uint64, prefixLen int, match func(prefix []byte) bool) ([][]byte, error)
+
+	// DiskUsage returns the number of bytes taken by the stored entries.
+	DiskUsage() (uint64, error)
+
+	// PruneToSize prunes the lowest indexes until the stored entries take at
+	// most maxBytes, never pruning index end or above. It returns the number
+	// of bytes taken afterwards.
+	PruneToSize(maxBytes, end uint64) (uint64, error)
 }
@@ -21,7 +21,10 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"encoding/binary"
+	"slices"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/types"
@@ -30,6 +33,10 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+// sidecarIndexLength is the length of the index of a blob sidecar, which is
+// the first field of its SSZ encoding.
+const sidecarIndexLength = 8
+
 // Store is the default implementation of the AvailabilityStore.
 type Store struct {
 	// IndexDB is a basic database interface.
@@ -85,6 +92,47 @@ func (s *Store) GetBlobSidecars(slot math.Slot) (types.BlobSidecars, error) {
 	return sidecars, nil
 }
 
+// GetBlobSidecarsByIndices fetches the sidecars for a specific slot with the
+// given indices, ordered by index. All sidecars are returned if no indices are
+// given. Sidecars that were not requested are never decoded, nor read past
+// their SSZ encoded index.
+func (s *Store) GetBlobSidecarsByIndices(slot math.Slot, indices []uint64) (types.BlobSidecars, error) {
+	requested := make(map[uint64]struct{}, len(indices))
+	for _, idx := range indices {
+		requested[idx] = struct{}{}
+	}
+	sidecarBzs, err := s.IndexDB.GetByIndexMatching(
+		slot.Unwrap(), sidecarIndexLength, func(prefix []byte) bool {
+			if len(requested) == 0 {
+				return true
+			}
+			_, ok := requested[binary.LittleEndian.Uint64(prefix)]
+			return ok
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make(types.BlobSidecars, 0, len(sidecarBzs))
+	for _, sidecarBz := range sidecarBzs {
+		sidecar := new(types.BlobSidecar)
+		if err = ssz.Unmarshal(sidecarBz, sidecar); err != nil {
+			return nil, err
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	slices.SortFunc(sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.GetIndex(), b.GetIndex())
+	})
+	return sidecars, nil
+}
+
+// DeleteSlot removes every sidecar stored for the given slot.
+func (s *Store) DeleteSlot(slot math.Slot) error {
+	return s.IndexDB.DeleteIndex(slot.Unwrap())
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store) Persist(sidecars types.BlobSidecars) error {
@@ -87,3 +87,46 @@ func TestStore_PersistRace(t *testing.T) {
 	err = s.Persist(sidecars)
 	require.NoError(t, err)
 }
+
+func TestStore_GetBlobSidecarsByIndices(t *testing.T) {
+	t.Parallel()
+	logger := log.NewNopLogger()
+	s := store.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(filedb.WithRootDirectory(t.TempDir()),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(0700),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger.With("service", "da-store"),
+	)
+
+	sc := make(datypes.BlobSidecars, 6)
+	for i := range sc {
+		sc[i] = &datypes.BlobSidecar{
+			Index: uint64(i),
+			SignedBeaconBlockHeader: &types.SignedBeaconBlockHeader{
+				Header: &types.BeaconBlockHeader{Slot: 3},
+			},
+			InclusionProof: make([]common.Root, types.KZGInclusionProofDepth),
+		}
+		// Sidecars are keyed by commitment, which is not ordered by index.
+		sc[i].KzgCommitment[0] = byte(len(sc) - i)
+	}
+	require.NoError(t, s.Persist(sc))
+
+	sidecars, err := s.GetBlobSidecarsByIndices(3, []uint64{4, 1})
+	require.NoError(t, err)
+	require.Len(t, sidecars, 2)
+	require.Equal(t, uint64(1), sidecars[0].GetIndex())
+	require.Equal(t, uint64(4), sidecars[1].GetIndex())
+	require.Equal(t, sc[4].InclusionProof, sidecars[1].InclusionProof)
+
+	sidecars, err = s.GetBlobSidecarsByIndices(3, nil)
+	require.NoError(t, err)
+	require.Len(t, sidecars, len(sc))
+	for i, sidecar := range sidecars {
+		require.Equal(t, uint64(i), sidecar.GetIndex())
+	}
+}
@@ -171,6 +171,34 @@ func TestHasValidInclusionProof(t *testing.T) {
 			},
 			expectedResult: true,
 		},
+		{
+			name: "Valid inclusion proofs built for the whole body",
+			sidecars: func(t *testing.T) types.BlobSidecars {
+				t.Helper()
+				block := utils.GenerateValidBeaconBlock(t, version.Electra())
+
+				sidecarFactory := blob.NewSidecarFactory(sink)
+				commitments := block.GetBody().GetBlobKzgCommitments()
+				inclusionProofs, incErr := sidecarFactory.BuildKZGInclusionProofs(
+					block.GetBody(), uint64(len(commitments)),
+				)
+				require.NoError(t, incErr)
+				sigHeader := ctypes.NewSignedBeaconBlockHeader(block.GetHeader(), crypto.BLSSignature{})
+				sidecars := make(types.BlobSidecars, len(commitments))
+				for i := range commitments {
+					sidecars[i] = types.BuildBlobSidecar(
+						math.U64(i),
+						sigHeader,
+						&eip4844.Blob{},
+						commitments[i],
+						eip4844.KZGProof{},
+						inclusionProofs[i],
+					)
+				}
+				return sidecars
+			},
+			expectedResult: true,
+		},
 	}
 
 	for _, tt := range tests {
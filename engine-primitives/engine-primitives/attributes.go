@@ -57,19 +57,13 @@ func NewPayloadAttributes(
 	withdrawals Withdrawals,
 	parentBeaconBlockRoot common.Root,
 ) (*PayloadAttributes, error) {
-	pa := &PayloadAttributes{
-		Timestamp:             timestamp,
-		PrevRandao:            prevRandao,
-		SuggestedFeeRecipient: suggestedFeeRecipient,
-		Withdrawals:           withdrawals,
-		ParentBeaconBlockRoot: parentBeaconBlockRoot,
-	}
-
-	if err := pa.Validate(forkVersion); err != nil {
-		return nil, err
-	}
-
-	return pa, nil
+	return NewPayloadAttributesBuilder(forkVersion).
+		WithTimestamp(timestamp).
+		WithPrevRandao(prevRandao).
+		WithSuggestedFeeRecipient(suggestedFeeRecipient).
+		WithWithdrawals(withdrawals).
+		WithParentBeaconBlockRoot(parentBeaconBlockRoot).
+		Build()
 }
 
 // GetSuggestedFeeRecipient returns the suggested fee recipient.
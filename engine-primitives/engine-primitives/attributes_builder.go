@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// PayloadAttributesBuilder assembles PayloadAttributes for a specific fork
+// version. Fork-specific attribute fields are set through dedicated setters so
+// that introducing a new field does not require changing every call site.
+type PayloadAttributesBuilder struct {
+	// forkVersion is the fork version the attributes are built for.
+	forkVersion common.Version
+	// attrs are the attributes being assembled.
+	attrs PayloadAttributes
+}
+
+// NewPayloadAttributesBuilder returns a new builder for the given fork version.
+func NewPayloadAttributesBuilder(forkVersion common.Version) *PayloadAttributesBuilder {
+	b := &PayloadAttributesBuilder{forkVersion: forkVersion}
+
+	// For any fork version Capella onwards, non-nil withdrawals are required.
+	if version.EqualsOrIsAfter(forkVersion, version.Capella()) {
+		b.attrs.Withdrawals = make(Withdrawals, 0)
+	}
+	return b
+}
+
+// WithTimestamp sets the timestamp at which the payload will be built.
+func (b *PayloadAttributesBuilder) WithTimestamp(
+	timestamp math.U64,
+) *PayloadAttributesBuilder {
+	b.attrs.Timestamp = timestamp
+	return b
+}
+
+// WithPrevRandao sets the previous Randao value.
+func (b *PayloadAttributesBuilder) WithPrevRandao(
+	prevRandao common.Bytes32,
+) *PayloadAttributesBuilder {
+	b.attrs.PrevRandao = prevRandao
+	return b
+}
+
+// WithSuggestedFeeRecipient sets the suggested fee recipient.
+func (b *PayloadAttributesBuilder) WithSuggestedFeeRecipient(
+	feeRecipient common.ExecutionAddress,
+) *PayloadAttributesBuilder {
+	b.attrs.SuggestedFeeRecipient = feeRecipient
+	return b
+}
+
+// WithWithdrawals sets the withdrawals to be included in the payload. A nil
+// value is passed through as is and rejected by Build post Capella.
+func (b *PayloadAttributesBuilder) WithWithdrawals(
+	withdrawals Withdrawals,
+) *PayloadAttributesBuilder {
+	b.attrs.Withdrawals = withdrawals
+	return b
+}
+
+// WithParentBeaconBlockRoot sets the parent beacon block root (EIP-4788).
+func (b *PayloadAttributesBuilder) WithParentBeaconBlockRoot(
+	root common.Root,
+) *PayloadAttributesBuilder {
+	b.attrs.ParentBeaconBlockRoot = root
+	return b
+}
+
+// ForkVersion returns the fork version the builder targets.
+func (b *PayloadAttributesBuilder) ForkVersion() common.Version {
+	return b.forkVersion
+}
+
+// Build validates the assembled attributes against the builder's fork version
+// and returns a copy of them.
+func (b *PayloadAttributesBuilder) Build() (*PayloadAttributes, error) {
+	pa := b.attrs
+	if err := pa.Validate(b.forkVersion); err != nil {
+		return nil, err
+	}
+	return &pa, nil
+}
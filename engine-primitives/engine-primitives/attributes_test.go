@@ -122,3 +122,41 @@ func TestPayloadAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestPayloadAttributesBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults non-nil withdrawals post Capella", func(t *testing.T) {
+		t.Parallel()
+		got, err := engineprimitives.NewPayloadAttributesBuilder(version.Deneb()).
+			WithTimestamp(math.U64(123456789)).
+			WithPrevRandao(common.Bytes32{1, 2, 3}).
+			Build()
+		require.NoError(t, err)
+		require.NotNil(t, got.Withdrawals)
+		require.Empty(t, got.Withdrawals)
+	})
+
+	t.Run("Build returns independent copies", func(t *testing.T) {
+		t.Parallel()
+		b := engineprimitives.NewPayloadAttributesBuilder(version.Deneb()).
+			WithTimestamp(math.U64(1)).
+			WithPrevRandao(common.Bytes32{1})
+		first, err := b.Build()
+		require.NoError(t, err)
+		second, err := b.WithTimestamp(math.U64(2)).Build()
+		require.NoError(t, err)
+		require.Equal(t, math.U64(1), first.Timestamp)
+		require.Equal(t, math.U64(2), second.Timestamp)
+	})
+
+	t.Run("Validation uses the builder fork version", func(t *testing.T) {
+		t.Parallel()
+		_, err := engineprimitives.NewPayloadAttributesBuilder(version.Capella()).
+			WithTimestamp(math.U64(1)).
+			WithPrevRandao(common.Bytes32{1}).
+			WithWithdrawals(nil).
+			Build()
+		require.ErrorIs(t, err, engineprimitives.ErrNilWithdrawals)
+	})
+}
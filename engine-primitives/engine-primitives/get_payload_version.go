@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// CellsPerExtBlob is the number of cells of an extended blob, i.e. the number
+// of cell proofs per blob in a BlobsBundleV2.
+const CellsPerExtBlob = 128
+
+// GetPayloadVersion is the version of the engine_getPayload method. It
+// determines the shape of the response of the execution client.
+type GetPayloadVersion uint8
+
+const (
+	// GetPayloadV3 is engine_getPayloadV3, which returns a BlobsBundleV1 and
+	// no execution requests.
+	GetPayloadV3 GetPayloadVersion = 3
+	// GetPayloadV4 is engine_getPayloadV4, which adds the execution requests.
+	GetPayloadV4 GetPayloadVersion = 4
+	// GetPayloadV5 is engine_getPayloadV5, which returns a BlobsBundleV2 with
+	// a proof per cell rather than per blob.
+	GetPayloadV5 GetPayloadVersion = 5
+)
+
+var (
+	// ErrUnsupportedGetPayloadVersion is returned when no engine_getPayload
+	// version is supported for a fork version.
+	ErrUnsupportedGetPayloadVersion = errors.New("unsupported getPayload version")
+
+	// ErrUnexpectedExecutionRequests is returned when a getPayload response
+	// carries execution requests although its version does not define them,
+	// or lacks them although its version requires them.
+	ErrUnexpectedExecutionRequests = errors.New(
+		"execution requests do not match getPayload version",
+	)
+
+	// ErrInvalidBlobsBundle is returned when the commitments, proofs and blobs
+	// of a blobs bundle do not match in length.
+	ErrInvalidBlobsBundle = errors.New("invalid blobs bundle")
+)
+
+// GetPayloadVersionForFork returns the engine_getPayload version to use for
+// payloads built for the given fork version.
+func GetPayloadVersionForFork(forkVersion common.Version) (GetPayloadVersion, error) {
+	switch {
+	case version.Equals(forkVersion, version.Deneb()),
+		version.Equals(forkVersion, version.Deneb1()):
+		return GetPayloadV3, nil
+	case version.Equals(forkVersion, version.Electra()),
+		version.Equals(forkVersion, version.Electra1()):
+		return GetPayloadV4, nil
+	default:
+		return 0, fmt.Errorf(
+			"%w: fork %s", ErrUnsupportedGetPayloadVersion, version.Name(forkVersion),
+		)
+	}
+}
+
+// HasExecutionRequests returns whether the response of this version carries
+// execution requests.
+func (v GetPayloadVersion) HasExecutionRequests() bool {
+	return v >= GetPayloadV4
+}
+
+// HasCellProofs returns whether the blobs bundle of this version carries a
+// proof per cell rather than a proof per blob.
+func (v GetPayloadVersion) HasCellProofs() bool {
+	return v >= GetPayloadV5
+}
+
+// ValidateBlobsBundle checks that the lengths of the commitments, proofs and
+// blobs of the bundle match the shape of this version.
+func (v GetPayloadVersion) ValidateBlobsBundle(bundle BlobsBundle) error {
+	numBlobs := len(bundle.GetBlobs())
+	if len(bundle.GetCommitments()) != numBlobs {
+		return fmt.Errorf(
+			"%w: %d commitments for %d blobs",
+			ErrInvalidBlobsBundle, len(bundle.GetCommitments()), numBlobs,
+		)
+	}
+	numProofs := numBlobs
+	if v.HasCellProofs() {
+		numProofs *= CellsPerExtBlob
+	}
+	if len(bundle.GetProofs()) != numProofs {
+		return fmt.Errorf(
+			"%w: %d proofs for %d blobs, expected %d",
+			ErrInvalidBlobsBundle, len(bundle.GetProofs()), numBlobs, numProofs,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+)
+
+// Stable, machine-readable codes of the engine failures. They are used as
+// metric labels and returned in node API error responses, so they must never
+// change.
+const (
+	CodeSyncingEL                = "EL_SYNCING"
+	CodeInvalidPayloadStatus     = "EL_INVALID_PAYLOAD_STATUS"
+	CodeAuthFailed               = "EL_AUTH_FAILED"
+	CodeTimeout                  = "EL_TIMEOUT"
+	CodeBadConnection            = "EL_BAD_CONNECTION"
+	CodeUnknownPayload           = "EL_UNKNOWN_PAYLOAD"
+	CodeInvalidForkchoiceState   = "EL_INVALID_FORKCHOICE_STATE"
+	CodeInvalidPayloadAttributes = "EL_INVALID_PAYLOAD_ATTRIBUTES"
+	CodeRequestTooLarge          = "EL_REQUEST_TOO_LARGE"
+	CodeInvalidResponse          = "EL_INVALID_RESPONSE"
+	CodeUnknown                  = "EL_UNKNOWN"
+)
+
+var (
+	// ErrSyncingEL is returned when the execution client cannot validate a
+	// payload because it is syncing, i.e. on a SYNCING or ACCEPTED payload
+	// status.
+	ErrSyncingEL = errors.New("execution client is syncing")
+
+	// ErrAuthFailed is returned when the execution client rejects the JWT
+	// authentication.
+	ErrAuthFailed = errors.New("execution client authentication failed")
+
+	// ErrTimeout is returned when a request to the execution client times
+	// out, either on the engine API or on the HTTP client.
+	ErrTimeout = errors.New("execution client request timed out")
+
+	// ErrBadConnection is returned when no connection could be established
+	// with the execution client.
+	ErrBadConnection = errors.New("connection error")
+)
+
+// codes maps the engine errors to their code, most specific first.
+//
+//nolint:gochecknoglobals // read-only.
+var codes = []struct {
+	err  error
+	code string
+}{
+	{ErrSyncingEL, CodeSyncingEL},
+	{ErrInvalidPayloadStatus, CodeInvalidPayloadStatus},
+	{ErrAuthFailed, CodeAuthFailed},
+	{ErrTimeout, CodeTimeout},
+	{ErrBadConnection, CodeBadConnection},
+	{ErrUnknownPayload, CodeUnknownPayload},
+	{ErrInvalidForkchoiceState, CodeInvalidForkchoiceState},
+	{ErrInvalidPayloadAttributes, CodeInvalidPayloadAttributes},
+	{ErrRequestTooLarge, CodeRequestTooLarge},
+	{ErrUnknownPayloadStatus, CodeInvalidResponse},
+	{ErrNilForkchoiceResponse, CodeInvalidResponse},
+	{ErrNilBlobsBundle, CodeInvalidResponse},
+	{ErrNilExecutionPayloadEnvelope, CodeInvalidResponse},
+	{ErrNilPayloadStatus, CodeInvalidResponse},
+}
+
+// Code returns the code of the given engine failure, CodeUnknown if it is
+// not classified, or the empty string if err is nil.
+func Code(err error) string {
+	if err == nil {
+		return ""
+	}
+	for _, c := range codes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return CodeUnknown
+}
+
+// IsClassified reports whether err is an engine failure with a known code.
+func IsClassified(err error) bool {
+	code := Code(err)
+	return code != "" && code != CodeUnknown
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors_test
+
+import (
+	"testing"
+
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		err        error
+		code       string
+		classified bool
+	}{
+		{name: "nil", err: nil, code: ""},
+		{
+			name:       "syncing",
+			err:        errors.Join(engineerrors.ErrSyncingEL, engineerrors.ErrSyncingPayloadStatus),
+			code:       engineerrors.CodeSyncingEL,
+			classified: true,
+		},
+		{
+			name:       "wrapped invalid payload status",
+			err:        errors.Wrap(engineerrors.ErrInvalidPayloadStatus, "new payload"),
+			code:       engineerrors.CodeInvalidPayloadStatus,
+			classified: true,
+		},
+		{
+			name:       "auth failed",
+			err:        errors.Join(engineerrors.ErrAuthFailed, errors.New("401")),
+			code:       engineerrors.CodeAuthFailed,
+			classified: true,
+		},
+		{
+			name:       "timeout",
+			err:        errors.Join(engineerrors.ErrTimeout, engineerrors.ErrEngineAPITimeout),
+			code:       engineerrors.CodeTimeout,
+			classified: true,
+		},
+		{
+			name:       "nil payload status",
+			err:        engineerrors.ErrNilPayloadStatus,
+			code:       engineerrors.CodeInvalidResponse,
+			classified: true,
+		},
+		{name: "unknown", err: errors.New("boom"), code: engineerrors.CodeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.code, engineerrors.Code(tt.err))
+			require.Equal(t, tt.classified, engineerrors.IsClassified(tt.err))
+		})
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"sync"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
 	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
@@ -44,14 +46,31 @@ type EngineClient struct {
 	logger log.Logger
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
+	// chainSpec resolves the fork whose methods the execution client must
+	// support.
+	chainSpec ChainSpec
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
+	// capabilities is the set of capabilities the execution client reported
+	// on the last exchange.
+	capabilitiesMu sync.RWMutex
+	capabilities   map[string]struct{}
+	// allowedVersions and deniedVersions are the execution client versions
+	// known to be compatible and incompatible, respectively.
+	allowedVersions []ClientVersionRule
+	deniedVersions  []ClientVersionRule
+	// clientVersion is the version last reported by the execution client.
+	clientVersionMu sync.RWMutex
+	clientVersion   *engineprimitives.ClientVersionV1
 	// connected will be set to true when we have successfully connected
 	// to the execution client.
 	connectedMu sync.RWMutex
 	connected   bool
+	// lastErr is the last error returned by the execution client, along with
+	// when it was returned.
+	lastErrMu sync.RWMutex
+	lastErr   error
+	lastErrAt time.Time
 }
 
 // New creates a new engine client EngineClient.
@@ -63,11 +82,17 @@ func New(
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
+	chainSpec ChainSpec,
 ) *EngineClient {
 	ethClient := ethclientrpc.NewClient(
 		cfg.RPCDialURL.String(),
 		jwtSecret,
 		cfg.RPCJWTRefreshInterval,
+		ethclientrpc.WithTransport(ethclientrpc.TransportConfig{
+			MaxIdleConns:    cfg.RPCMaxIdleConns,
+			IdleConnTimeout: cfg.RPCIdleConnTimeout,
+			KeepAlive:       cfg.RPCKeepAlive,
+		}),
 	)
 
 	// Enforcing minimum rpc timeout
@@ -90,15 +115,27 @@ func New(
 		logger.Warn("rpc-retries is deprecated and the configured value will be ignored")
 	}
 
-	return &EngineClient{
+	s := &EngineClient{
 		cfg:          cfg,
 		logger:       logger,
 		Client:       ethclient.New(ethClient),
 		capabilities: make(map[string]struct{}),
 		eth1ChainID:  eth1ChainID,
+		chainSpec:    chainSpec,
 		metrics:      newClientMetrics(telemetrySink, logger),
 		connected:    false,
 	}
+	s.allowedVersions = s.parseClientVersionRules(cfg.AllowedClientVersions)
+	s.deniedVersions = s.parseClientVersionRules(cfg.DeniedClientVersions)
+	return s
+}
+
+// LastError returns when the execution client last returned an error along
+// with that error, which is nil if there was none.
+func (s *EngineClient) LastError() (time.Time, error) {
+	s.lastErrMu.RLock()
+	defer s.lastErrMu.RUnlock()
+	return s.lastErrAt, s.lastErr
 }
 
 // Name returns the name of the engine client.
@@ -119,6 +156,8 @@ func (s *EngineClient) Start(ctx context.Context) error {
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
 	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+		s.setConnected(true)
+		go s.reconnectLoop(ctx)
 		return nil
 	}
 
@@ -140,14 +179,42 @@ func (s *EngineClient) Start(ctx context.Context) error {
 				}
 				continue
 			}
-			s.connectedMu.Lock()
-			s.connected = true
-			s.connectedMu.Unlock()
+			s.setConnected(true)
+			go s.reconnectLoop(ctx)
 			return nil
 		}
 	}
 }
 
+// reconnectLoop re-establishes the connection to the execution client,
+// exchanging capabilities again, whenever a request finds it unreachable.
+// The execution client may have been restarted or upgraded in the meantime.
+func (s *EngineClient) reconnectLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.RPCStartupCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if s.IsConnected() {
+				continue
+			}
+			if err := s.verifyChainIDAndConnection(ctx); err != nil {
+				if errors.Is(err, ErrMismatchedEth1ChainID) {
+					s.logger.Error(err.Error())
+				}
+				continue
+			}
+			s.logger.Info(
+				"Reconnected to execution client",
+				"dial_url", s.cfg.RPCDialURL.String(),
+			)
+			s.setConnected(true)
+		}
+	}
+}
+
 func (s *EngineClient) Stop() error {
 	return nil
 }
@@ -158,7 +225,24 @@ func (s *EngineClient) IsConnected() bool {
 	return s.connected
 }
 
+// setConnected records whether the execution client is reachable.
+func (s *EngineClient) setConnected(connected bool) {
+	s.connectedMu.Lock()
+	defer s.connectedMu.Unlock()
+	if s.connected && !connected {
+		s.logger.Warn(
+			"Lost connection to execution client",
+			"dial_url", s.cfg.RPCDialURL.String(),
+		)
+	}
+	s.connected = connected
+}
+
+// HasCapability returns whether the execution client reported supporting
+// the given engine API method on the last capabilities exchange.
 func (s *EngineClient) HasCapability(capability string) bool {
+	s.capabilitiesMu.RLock()
+	defer s.capabilitiesMu.RUnlock()
 	_, ok := s.capabilities[capability]
 	return ok
 }
@@ -189,6 +273,7 @@ func (s *EngineClient) verifyChainIDAndConnection(
 		if errors.Is(err, http.ErrUnauthorized) {
 			// We always log this error as it is a critical error.
 			s.logger.Error(UnauthenticatedConnectionErrorStr)
+			return errors.Join(engineerrors.ErrAuthFailed, err)
 		}
 		return err
 	}
@@ -225,6 +310,12 @@ func (s *EngineClient) verifyChainIDAndConnection(
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
+
+	// Check the version of the execution client, which may have changed
+	// since the last connection.
+	s.refreshClientVersion(
+		ctx, s.chainSpec.ActiveForkVersionForTimestamp(math.U64(time.Now().Unix())),
+	)
 	return nil
 }
 
@@ -239,3 +330,7 @@ func (s *EngineClient) GetRPCRetryInterval() time.Duration {
 func (s *EngineClient) GetRPCMaxRetryInterval() time.Duration {
 	return s.cfg.RPCMaxRetryInterval
 }
+
+func (s *EngineClient) GetRPCGetPayloadRetries() uint64 {
+	return s.cfg.RPCGetPayloadRetries
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"strings"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+var (
+	// ErrInvalidClientVersionRule is returned when an entry of the allowed or
+	// denied client versions cannot be parsed.
+	ErrInvalidClientVersionRule = errors.New("invalid client version rule")
+
+	// ErrDeniedClientVersion is returned when the execution client runs a
+	// version denied for the active fork.
+	ErrDeniedClientVersion = errors.New("execution client version is denied")
+)
+
+// ClientVersionRule matches execution client versions, as reported by
+// engine_getClientVersionV1. It is written as name/version[@fork], e.g.
+// "reth/v1.3.0@electra" or "geth/1.14.*".
+type ClientVersionRule struct {
+	// Name is matched case-insensitively against the client name or code.
+	Name string
+	// Version is matched exactly, or as a prefix if it ends with '*'.
+	Version string
+	// Fork restricts the rule to a fork, by name. Empty matches every fork.
+	Fork string
+}
+
+// ParseClientVersionRule parses a rule written as name/version[@fork].
+func ParseClientVersionRule(s string) (ClientVersionRule, error) {
+	var rule ClientVersionRule
+	spec, fork, hasFork := strings.Cut(strings.TrimSpace(s), "@")
+	name, ver, ok := strings.Cut(spec, "/")
+	if !ok || name == "" || ver == "" || (hasFork && fork == "") {
+		return rule, errors.Wrapf(
+			ErrInvalidClientVersionRule, "%q, want name/version[@fork]", s,
+		)
+	}
+	rule.Name, rule.Version, rule.Fork = name, ver, fork
+	return rule, nil
+}
+
+// Matches returns whether the rule covers the client version at the given
+// fork.
+func (r ClientVersionRule) Matches(
+	v engineprimitives.ClientVersionV1, forkVersion common.Version,
+) bool {
+	if !strings.EqualFold(r.Name, v.Name) && !strings.EqualFold(r.Name, v.Code) {
+		return false
+	}
+	if r.Fork != "" && !strings.EqualFold(r.Fork, version.Name(forkVersion)) {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(r.Version, "*"); ok {
+		return strings.HasPrefix(v.Version, prefix)
+	}
+	return r.Version == v.Version
+}
+
+// matchesClient returns whether the rule covers the client version at any
+// fork.
+func (r ClientVersionRule) matchesClient(v engineprimitives.ClientVersionV1) bool {
+	r.Fork = ""
+	return r.Matches(v, common.Version{})
+}
+
+// String returns the rule as written in the configuration.
+func (r ClientVersionRule) String() string {
+	if r.Fork == "" {
+		return r.Name + "/" + r.Version
+	}
+	return r.Name + "/" + r.Version + "@" + r.Fork
+}
+
+// parseClientVersionRules parses the configured rules, skipping and logging
+// the invalid ones.
+func (s *EngineClient) parseClientVersionRules(
+	entries []string,
+) []ClientVersionRule {
+	rules := make([]ClientVersionRule, 0, len(entries))
+	for _, entry := range entries {
+		rule, err := ParseClientVersionRule(entry)
+		if err != nil {
+			s.logger.Error("Ignoring client version rule", "err", err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// CheckClientVersion returns ErrDeniedClientVersion if the execution client
+// last reported a version denied at the given fork.
+func (s *EngineClient) CheckClientVersion(forkVersion common.Version) error {
+	s.clientVersionMu.RLock()
+	defer s.clientVersionMu.RUnlock()
+	if s.clientVersion == nil {
+		return nil
+	}
+	for _, rule := range s.deniedVersions {
+		if rule.Matches(*s.clientVersion, forkVersion) {
+			return errors.Wrapf(
+				ErrDeniedClientVersion, "%s %s matches %s",
+				s.clientVersion.Name, s.clientVersion.Version, rule,
+			)
+		}
+	}
+	return nil
+}
+
+// refreshClientVersion fetches the version of the execution client and
+// checks it against the allowed and denied versions.
+func (s *EngineClient) refreshClientVersion(
+	ctx context.Context, forkVersion common.Version,
+) {
+	if len(s.allowedVersions) == 0 && len(s.deniedVersions) == 0 {
+		return
+	}
+	if !s.HasCapability(ethclient.GetClientVersionV1) {
+		s.logger.Warn(
+			"Cannot check execution client version compatibility",
+			"unsupported_capability", ethclient.GetClientVersionV1,
+		)
+		return
+	}
+	versions, err := s.Client.GetClientVersionV1(ctx)
+	if err != nil || len(versions) == 0 {
+		s.logger.Warn("Failed to get execution client version", "err", err)
+		return
+	}
+	v := versions[0]
+	s.clientVersionMu.Lock()
+	s.clientVersion = &v
+	s.clientVersionMu.Unlock()
+
+	if len(s.allowedVersions) > 0 && !matchesAny(s.allowedVersions, v, forkVersion) {
+		s.logger.Warn(
+			"Execution client version is not in the allowed versions 🚸",
+			"name", v.Name, "version", v.Version,
+			"fork", version.Name(forkVersion),
+		)
+	}
+	for _, rule := range s.deniedVersions {
+		switch {
+		case !rule.matchesClient(v):
+			continue
+		case rule.Matches(v, forkVersion):
+			s.logger.Error(
+				"Execution client version is denied for the active fork, "+
+					"block proposals are refused until it is changed",
+				"name", v.Name, "version", v.Version, "rule", rule.String(),
+			)
+		default:
+			s.logger.Warn(
+				"Execution client version is denied for another fork",
+				"name", v.Name, "version", v.Version, "rule", rule.String(),
+			)
+		}
+	}
+}
+
+// matchesAny returns whether any of the rules covers the client version at
+// the given fork.
+func matchesAny(
+	rules []ClientVersionRule,
+	v engineprimitives.ClientVersionV1,
+	forkVersion common.Version,
+) bool {
+	for _, rule := range rules {
+		if rule.Matches(v, forkVersion) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClientVersionRule(t *testing.T) {
+	t.Parallel()
+
+	rule, err := client.ParseClientVersionRule("reth/v1.3.*@electra")
+	require.NoError(t, err)
+	require.Equal(t, client.ClientVersionRule{
+		Name: "reth", Version: "v1.3.*", Fork: "electra",
+	}, rule)
+	require.Equal(t, "reth/v1.3.*@electra", rule.String())
+
+	for _, s := range []string{"", "reth", "reth/", "/v1.3.0", "reth/v1.3.0@"} {
+		_, err = client.ParseClientVersionRule(s)
+		require.ErrorIs(t, err, client.ErrInvalidClientVersionRule, s)
+	}
+}
+
+func TestClientVersionRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	reth := engineprimitives.ClientVersionV1{
+		Code: "RH", Name: "Reth", Version: "v1.3.4",
+	}
+	tests := []struct {
+		rule string
+		want bool
+	}{
+		{rule: "reth/v1.3.4", want: true},
+		{rule: "RH/v1.3.4", want: true},
+		{rule: "reth/v1.3.*", want: true},
+		{rule: "reth/v1.3.4@electra", want: true},
+		{rule: "reth/v1.3.4@deneb", want: false},
+		{rule: "reth/v1.3.5", want: false},
+		{rule: "geth/v1.3.4", want: false},
+	}
+	for _, tt := range tests {
+		rule, err := client.ParseClientVersionRule(tt.rule)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, rule.Matches(reth, version.Electra()), tt.rule)
+	}
+}
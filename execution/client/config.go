@@ -23,6 +23,7 @@ package client
 import (
 	"time"
 
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/primitives/net/url"
 )
 
@@ -42,6 +43,9 @@ const (
 func DefaultConfig() Config {
 	//#nosec:G703 // ignoring on purpose since it is the default URL.
 	dialURL, _ := url.NewFromRaw(defaultDialURL)
+	//#nosec:G703 // an empty URL always parses.
+	verificationDialURL, _ := url.NewFromRaw("")
+	transport := ethclientrpc.DefaultTransportConfig()
 	return Config{
 		RPCDialURL:              dialURL,
 		RPCRetryInterval:        defaultRPCRetryInterval,
@@ -49,7 +53,11 @@ func DefaultConfig() Config {
 		RPCTimeout:              MinRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCMaxIdleConns:         transport.MaxIdleConns,
+		RPCIdleConnTimeout:      transport.IdleConnTimeout,
+		RPCKeepAlive:            transport.KeepAlive,
 		JWTSecretPath:           defaultJWTSecretPath,
+		VerificationRPCDialURL:  verificationDialURL,
 	}
 }
 
@@ -65,10 +73,50 @@ type Config struct {
 	RPCMaxRetryInterval time.Duration `mapstructure:"rpc-max-retry-interval"`
 	// RPCTimeout is the RPC timeout for individual execution client calls.
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
+	// RPCNewPayloadTimeout is the timeout for engine_newPayload calls. Zero
+	// means RPCTimeout is used.
+	RPCNewPayloadTimeout time.Duration `mapstructure:"rpc-new-payload-timeout"`
+	// RPCForkchoiceUpdatedTimeout is the timeout for engine_forkchoiceUpdated
+	// calls. Zero means RPCTimeout is used.
+	RPCForkchoiceUpdatedTimeout time.Duration `mapstructure:"rpc-forkchoice-updated-timeout"`
+	// RPCGetPayloadTimeout is the timeout for engine_getPayload calls. Zero
+	// means RPCTimeout is used. Unlike RPCTimeout it is not raised to
+	// MinRPCTimeout, since getPayload must return well within the proposal.
+	RPCGetPayloadTimeout time.Duration `mapstructure:"rpc-get-payload-timeout"`
+	// RPCGetPayloadRetries is the number of times a timed out
+	// engine_getPayload call is retried, with jittered backoff.
+	RPCGetPayloadRetries uint64 `mapstructure:"rpc-get-payload-retries"`
 	// RPCStartupCheckInterval is the Interval for the startup check.
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCMaxIdleConns is the number of idle connections kept open to the
+	// execution client.
+	RPCMaxIdleConns int `mapstructure:"rpc-max-idle-conns"`
+	// RPCIdleConnTimeout is how long an idle connection to the execution
+	// client is kept open.
+	RPCIdleConnTimeout time.Duration `mapstructure:"rpc-idle-conn-timeout"`
+	// RPCKeepAlive is the TCP keep-alive period of the connections to the
+	// execution client.
+	RPCKeepAlive time.Duration `mapstructure:"rpc-keep-alive"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// VerificationRPCDialURL is the url of an optional second execution
+	// client that every payload is also validated against. Empty disables
+	// cross-validation.
+	VerificationRPCDialURL *url.ConnectionURL `mapstructure:"verification-rpc-dial-url"`
+	// VerificationJWTSecretPath is the path to the JWT secret of the
+	// verification execution client. Empty means JWTSecretPath is used.
+	VerificationJWTSecretPath string `mapstructure:"verification-jwt-secret-path"`
+	// HaltOnVerificationMismatch stops block proposals once the execution
+	// clients disagree on the validity of a payload.
+	HaltOnVerificationMismatch bool `mapstructure:"halt-on-verification-mismatch"`
+	// AllowedClientVersions are the execution client versions known to be
+	// compatible, written as name/version[@fork]. Other versions are warned
+	// about. Empty disables the check.
+	AllowedClientVersions []string `mapstructure:"allowed-client-versions"`
+	// DeniedClientVersions are the execution client versions known to have
+	// consensus bugs, written as name/version[@fork]. Block proposals are
+	// refused while connected to one of them at the fork.
+	DeniedClientVersions []string `mapstructure:"denied-client-versions"`
 }
@@ -30,6 +30,8 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 /* -------------------------------------------------------------------------- */
@@ -43,7 +45,7 @@ func (s *EngineClient) NewPayload(
 ) (*common.ExecutionHash, error) {
 	var (
 		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		cctx, cancel = s.createContextWithTimeout(ctx, s.cfg.RPCNewPayloadTimeout)
 	)
 	defer s.metrics.measureNewPayloadDuration(startTime)
 	defer cancel()
@@ -86,7 +88,7 @@ func (s *EngineClient) ForkchoiceUpdated(
 ) (*engineprimitives.PayloadID, error) {
 	var (
 		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		cctx, cancel = s.createContextWithTimeout(ctx, s.cfg.RPCForkchoiceUpdatedTimeout)
 	)
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
 	defer cancel()
@@ -131,7 +133,7 @@ func (s *EngineClient) GetPayload(
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
 	var (
 		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		cctx, cancel = s.createContextWithTimeout(ctx, s.cfg.RPCGetPayloadTimeout)
 	)
 	defer s.metrics.measureGetPayloadDuration(startTime)
 	defer cancel()
@@ -166,18 +168,38 @@ func (s *EngineClient) ExchangeCapabilities(
 		return nil, err
 	}
 
-	// Capture and log the capabilities that the execution client has.
+	// Capture and log the capabilities that the execution client has. The
+	// set is replaced, as the execution client may have been swapped or
+	// upgraded since the last exchange.
+	capabilities := make(map[string]struct{}, len(result))
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		capabilities[capability] = struct{}{}
 	}
-
-	// Log the capabilities that the execution client does not have.
-	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
+	s.capabilitiesMu.Lock()
+	s.capabilities = capabilities
+	s.capabilitiesMu.Unlock()
+
+	// Log the capabilities that the execution client does not have. Missing
+	// methods of the current fork prevent following the chain, while optional
+	// ones only disable the features relying on them.
+	forkVersion := s.chainSpec.ActiveForkVersionForTimestamp(
+		math.U64(time.Now().Unix()),
+	)
+	for _, capability := range ethclient.RequiredCapabilities(forkVersion) {
 		if !s.HasCapability(capability) {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
 				"unsupported_capability", capability,
+				"fork", version.Name(forkVersion),
+			)
+		}
+	}
+	for _, capability := range ethclient.OptionalCapabilities() {
+		if !s.HasCapability(capability) {
+			s.logger.Info(
+				"Execution client lacks optional capability, disabling dependent features",
+				"unsupported_capability", capability,
 			)
 		}
 	}
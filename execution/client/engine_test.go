@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEngineClient_GetPayloadTimeout checks that getPayload calls time out
+// after RPCGetPayloadTimeout, or after RPCTimeout if it is zero.
+func TestEngineClient_GetPayloadTimeout(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		methodTimeout time.Duration
+		minElapsed    time.Duration
+		maxElapsed    time.Duration
+	}{
+		{
+			name:          "method timeout",
+			methodTimeout: 100 * time.Millisecond,
+			minElapsed:    100 * time.Millisecond,
+			maxElapsed:    client.MinRPCTimeout,
+		},
+		{
+			name:       "default timeout",
+			minElapsed: client.MinRPCTimeout,
+			maxElapsed: 2 * client.MinRPCTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// The execution client never answers.
+			srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			}))
+			defer srv.Close()
+
+			dialURL, err := url.NewFromRaw(srv.URL)
+			require.NoError(t, err)
+			cfg := client.DefaultConfig()
+			cfg.RPCDialURL = dialURL
+			cfg.RPCTimeout = client.MinRPCTimeout
+			cfg.RPCGetPayloadTimeout = tt.methodTimeout
+			ec := client.New(
+				&cfg,
+				phuslu.NewLogger(io.Discard, nil),
+				nil,
+				metrics.NewNoOpTelemetrySink(),
+				nil,
+				nil,
+			)
+
+			start := time.Now()
+			_, err = ec.GetPayload(
+				context.Background(), engineprimitives.PayloadID{}, version.Electra(),
+			)
+			elapsed := time.Since(start)
+			require.ErrorIs(t, err, engineerrors.ErrTimeout)
+			require.True(t, client.IsNonFatalError(err))
+			require.GreaterOrEqual(t, elapsed, tt.minElapsed)
+			require.Less(t, elapsed, tt.maxElapsed)
+		})
+	}
+}
@@ -21,6 +21,8 @@
 package client
 
 import (
+	"time"
+
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/net/http"
@@ -41,10 +43,11 @@ var (
 
 	// ErrBadConnection indicates that the http.Client was unable to
 	// establish a connection.
-	ErrBadConnection = errors.New("connection error")
+	ErrBadConnection = engineerrors.ErrBadConnection
 )
 
 // Handles errors received from the RPC server according to the specification.
+// The returned errors are classified by engineerrors.Code.
 func (s *EngineClient) handleRPCError(
 	err error,
 ) error {
@@ -52,24 +55,29 @@ func (s *EngineClient) handleRPCError(
 		//nolint:nilerr // appease nilaway
 		return err
 	}
+	s.lastErrMu.Lock()
+	s.lastErr, s.lastErrAt = err, time.Now()
+	s.lastErrMu.Unlock()
 
 	// Check for timeout errors.
 	if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 		s.metrics.incrementEngineAPITimeout()
-		return err
+		return errors.Join(engineerrors.ErrTimeout, err)
 	}
 	if http.IsTimeoutError(err) {
 		s.metrics.incrementHTTPTimeoutCounter()
-		return http.ErrTimeout
+		return errors.Join(engineerrors.ErrTimeout, http.ErrTimeout)
 	}
 	// Check for authorization errors
 	if errors.Is(err, http.ErrUnauthorized) {
-		return err
+		return errors.Join(engineerrors.ErrAuthFailed, err)
 	}
 	// Check for connection errors.
 	var e jsonrpc.Error
 	ok := errors.As(err, &e)
 	if !ok || e == nil {
+		// Capabilities are exchanged again once the connection is back.
+		s.setConnected(false)
 		return errors.Join(ErrBadConnection, err)
 	}
 
@@ -134,6 +142,7 @@ func IsFatalError(err error) bool {
 func IsNonFatalError(err error) bool {
 	return errors.IsAny(
 		err,
+		engineerrors.ErrTimeout,
 		engineerrors.ErrEngineAPITimeout,
 		http.ErrTimeout,
 	)
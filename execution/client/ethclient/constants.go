@@ -20,16 +20,48 @@
 
 package ethclient
 
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
 // BeaconKitSupportedCapabilities returns the full list of capabilities
 // of the beacon kit client.
 func BeaconKitSupportedCapabilities() []string {
-	return []string{
+	return append([]string{
 		NewPayloadMethodV3,
 		NewPayloadMethodV4,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
 		GetPayloadMethodV4,
+	}, OptionalCapabilities()...)
+}
+
+// OptionalCapabilities returns the capabilities that beacon kit makes use of
+// when the execution client supports them, but can operate without.
+func OptionalCapabilities() []string {
+	return []string{
 		GetClientVersionV1,
+		GetBlobsV1,
+		GetPayloadBodiesByHashV1,
+		GetPayloadBodiesByRangeV1,
+	}
+}
+
+// RequiredCapabilities returns the capabilities the execution client must
+// support to follow the chain at the given fork version.
+func RequiredCapabilities(forkVersion common.Version) []string {
+	if version.IsBefore(forkVersion, version.Electra()) {
+		return []string{
+			NewPayloadMethodV3,
+			ForkchoiceUpdatedMethodV3,
+			GetPayloadMethodV3,
+		}
+	}
+	return []string{
+		NewPayloadMethodV4,
+		ForkchoiceUpdatedMethodV3,
+		GetPayloadMethodV4,
 	}
 }
 
@@ -45,6 +77,9 @@ const (
 	GetPayloadMethodV3 = "engine_getPayloadV3"
 	// GetPayloadMethodV4 for retrieving a payload in Electra.
 	GetPayloadMethodV4 = "engine_getPayloadV4"
+	// GetPayloadMethodV5 for retrieving a payload with cell proofs in its
+	// blobs bundle.
+	GetPayloadMethodV5 = "engine_getPayloadV5"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
@@ -53,4 +88,10 @@ const (
 	ExchangeCapabilities = "engine_exchangeCapabilities"
 	// GetClientVersionV1 for retrieving the capabilities of the peer.
 	GetClientVersionV1 = "engine_getClientVersionV1"
+	// GetBlobsV1 for retrieving blobs and proofs from the transaction pool.
+	GetBlobsV1 = "engine_getBlobsV1"
+	// GetPayloadBodiesByHashV1 for retrieving payload bodies by block hash.
+	GetPayloadBodiesByHashV1 = "engine_getPayloadBodiesByHashV1"
+	// GetPayloadBodiesByRangeV1 for retrieving payload bodies by block range.
+	GetPayloadBodiesByRangeV1 = "engine_getPayloadBodiesByRangeV1"
 )
@@ -151,26 +151,19 @@ func (s *Client) ForkchoiceUpdatedV3(
 /*                                 GetPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// GetPayload calls the appropriate version of the Engine API GetPayload method.
+// GetPayload calls the version of the Engine API GetPayload method used by
+// the given fork version.
 func (s *Client) GetPayload(
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion common.Version,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
-	switch {
-	case version.IsBefore(forkVersion, version.Deneb()):
+	getPayloadVersion, err := engineprimitives.GetPayloadVersionForFork(forkVersion)
+	if err != nil {
 		// Versions before Deneb are not supported for calling GetPayload.
-		return nil, ErrInvalidVersion
-
-	case version.Equals(forkVersion, version.Deneb()), version.Equals(forkVersion, version.Deneb1()):
-		return s.GetPayloadV3(ctx, payloadID, forkVersion)
-
-	case version.Equals(forkVersion, version.Electra()), version.Equals(forkVersion, version.Electra1()):
-		return s.GetPayloadV4(ctx, payloadID, forkVersion)
-
-	default:
-		return nil, ErrInvalidVersion
+		return nil, fmt.Errorf("%w: %w", ErrInvalidVersion, err)
 	}
+	return s.getPayload(ctx, payloadID, forkVersion, getPayloadVersion)
 }
 
 // GetPayloadV3 calls the engine_getPayloadV3 method via JSON-RPC.
@@ -179,11 +172,7 @@ func (s *Client) GetPayloadV3(
 	payloadID engineprimitives.PayloadID,
 	forkVersion common.Version,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
-	result := ctypes.NewEmptyExecutionPayloadEnvelope[*engineprimitives.BlobsBundleV1](forkVersion)
-	if err := s.Call(ctx, result, GetPayloadMethodV3, payloadID); err != nil {
-		return nil, fmt.Errorf("failed GetPayloadV3 call: %w", err)
-	}
-	return result, nil
+	return s.getPayload(ctx, payloadID, forkVersion, engineprimitives.GetPayloadV3)
 }
 
 // GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC.
@@ -192,13 +181,42 @@ func (s *Client) GetPayloadV4(
 	payloadID engineprimitives.PayloadID,
 	forkVersion common.Version,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
-	result := ctypes.NewEmptyExecutionPayloadEnvelope[*engineprimitives.BlobsBundleV1](forkVersion)
-	if err := s.Call(ctx, result, GetPayloadMethodV4, payloadID); err != nil {
-		return nil, fmt.Errorf("failed GetPayloadV4 call: %w", err)
+	return s.getPayload(ctx, payloadID, forkVersion, engineprimitives.GetPayloadV4)
+}
+
+// getPayload calls the given version of the engine_getPayload method and
+// decodes the response into an envelope for the fork version.
+func (s *Client) getPayload(
+	ctx context.Context,
+	payloadID engineprimitives.PayloadID,
+	forkVersion common.Version,
+	getPayloadVersion engineprimitives.GetPayloadVersion,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	method, err := getPayloadMethod(getPayloadVersion)
+	if err != nil {
+		return nil, err
+	}
+	result := ctypes.NewExecutionPayloadEnvelope(forkVersion, getPayloadVersion)
+	if err = s.Call(ctx, result, method, payloadID); err != nil {
+		return nil, fmt.Errorf("failed %s call: %w", method, err)
 	}
 	return result, nil
 }
 
+// getPayloadMethod returns the JSON-RPC method of a GetPayload version.
+func getPayloadMethod(v engineprimitives.GetPayloadVersion) (string, error) {
+	switch v {
+	case engineprimitives.GetPayloadV3:
+		return GetPayloadMethodV3, nil
+	case engineprimitives.GetPayloadV4:
+		return GetPayloadMethodV4, nil
+	case engineprimitives.GetPayloadV5:
+		return GetPayloadMethodV5, nil
+	default:
+		return "", fmt.Errorf("%w: getPayloadV%d", ErrInvalidVersion, v)
+	}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
@@ -22,6 +22,7 @@ package ethclient_test
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
@@ -169,4 +170,5 @@ func (tc *stubRPCClient) Call(_ context.Context, target any, _ string, _ ...any)
 
 	return nil
 }
-func (tc *stubRPCClient) Close() error { return nil }
+func (tc *stubRPCClient) Close() error         { return nil }
+func (tc *stubRPCClient) SetCapture(io.Writer) {}
@@ -21,14 +21,18 @@
 package ethclient
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/geth-primitives/rpc"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 )
@@ -44,6 +48,123 @@ func (s *Client) ChainID(
 	return result, nil
 }
 
+// NetworkID retrieves the network ID of the execution client.
+func (s *Client) NetworkID(ctx context.Context) (math.U64, error) {
+	var result string
+	if err := s.Call(ctx, &result, "net_version"); err != nil {
+		return 0, err
+	}
+	return math.U64FromString(result)
+}
+
+// BalanceAt returns the balance in Wei of the given account at the given
+// block number, or at the latest block if number is nil.
+func (s *Client) BalanceAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+	number *big.Int,
+) (*big.Int, error) {
+	var result hexutil.Big
+	if err := s.Call(
+		ctx, &result, "eth_getBalance", account, toBlockNumArg(number),
+	); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&result), nil
+}
+
+// FeeHistory is the result of eth_feeHistory. BaseFee and BlobBaseFee hold
+// one more entry than the number of blocks, the last being the fee of the
+// block after the newest one.
+type FeeHistory struct {
+	OldestBlock      *hexutil.Big     `json:"oldestBlock"`
+	Reward           [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee          []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio     []float64        `json:"gasUsedRatio"`
+	BlobBaseFee      []*hexutil.Big   `json:"baseFeePerBlobGas,omitempty"`
+	BlobGasUsedRatio []float64        `json:"blobGasUsedRatio,omitempty"`
+}
+
+// FeeHistory returns the fee history of the last blockCount blocks up to
+// the latest one, with the priority fees paid at the given percentiles of
+// each block.
+func (s *Client) FeeHistory(
+	ctx context.Context,
+	blockCount uint64,
+	rewardPercentiles []float64,
+) (*FeeHistory, error) {
+	var result FeeHistory
+	if err := s.Call(
+		ctx, &result, "eth_feeHistory",
+		hexutil.Uint64(blockCount), "latest", rewardPercentiles,
+	); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Syncing reports whether the execution client is syncing, i.e. whether
+// eth_syncing returns a sync progress rather than false.
+func (s *Client) Syncing(ctx context.Context) (bool, error) {
+	var result json.RawMessage
+	if err := s.Call(ctx, &result, "eth_syncing"); err != nil {
+		return false, err
+	}
+	return !bytes.Equal(result, []byte("false")), nil
+}
+
+// CallContract executes a message call against the state at the given block
+// number, or at the latest block if number is nil, without creating a
+// transaction.
+func (s *Client) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	number *big.Int,
+) ([]byte, error) {
+	var result hexutil.Bytes
+	if err := s.Call(
+		ctx, &result, "eth_call", toCallArg(msg), toBlockNumArg(number),
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CodeAt returns the code of the given account at the given block number, or
+// at the latest block if number is nil.
+func (s *Client) CodeAt(
+	ctx context.Context,
+	account gethcommon.Address,
+	number *big.Int,
+) ([]byte, error) {
+	var result hexutil.Bytes
+	if err := s.Call(
+		ctx, &result, "eth_getCode", account, toBlockNumArg(number),
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// toCallArg converts a call message to the eth_call arguments. Only the
+// fields used to read contract state are forwarded.
+func toCallArg(msg ethereum.CallMsg) map[string]any {
+	arg := map[string]any{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["input"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	return arg
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
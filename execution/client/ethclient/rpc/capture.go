@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"io"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// CapturedCall is a request sent to the execution client and the response it
+// received, as recorded in capture mode.
+type CapturedCall struct {
+	Time     time.Time       `json:"time"`
+	Method   string          `json:"method"`
+	Duration string          `json:"duration"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// SetCapture records every subsequent request and its response to w as one
+// JSON line per call. A nil w stops capturing.
+func (rpc *client) SetCapture(w io.Writer) {
+	rpc.captureMu.Lock()
+	defer rpc.captureMu.Unlock()
+	rpc.capture = w
+}
+
+// captureCall records a call if capture mode is on. Failing to record a call
+// never fails the call itself.
+func (rpc *client) captureCall(
+	start time.Time, method string, request, response []byte, err error,
+) {
+	rpc.captureMu.Lock()
+	defer rpc.captureMu.Unlock()
+	if rpc.capture == nil {
+		return
+	}
+
+	call := CapturedCall{
+		Time:     start.UTC(),
+		Method:   method,
+		Duration: time.Since(start).String(),
+		Request:  request,
+	}
+	if json.Valid(response) {
+		call.Response = response
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+
+	line, mErr := json.Marshal(call)
+	if mErr != nil {
+		return
+	}
+	//nolint:errcheck // capture is best effort.
+	rpc.capture.Write(append(line, '\n'))
+}
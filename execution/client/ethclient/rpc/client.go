@@ -32,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/encoding/json"
 	beaconhttp "github.com/berachain/beacon-kit/primitives/net/http"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	beaconurl "github.com/berachain/beacon-kit/primitives/net/url"
 )
 
 var _ Client = (*client)(nil)
@@ -40,6 +41,9 @@ type Client interface {
 	Start(context.Context)
 	Call(ctx context.Context, target any, method string, params ...any) error
 	Close() error
+	// SetCapture records every subsequent request and its response to w as
+	// one JSON line per call. A nil w stops capturing.
+	SetCapture(w io.Writer)
 }
 
 // client is an Ethereum RPC client that provides a
@@ -49,6 +53,10 @@ type client struct {
 	url string
 	// client is the HTTP client used to make RPC calls.
 	client *http.Client
+	// ipc sends the RPC calls when the endpoint is a Unix domain socket.
+	ipc *ipcPool
+	// transport configures the connections to the endpoint.
+	transport TransportConfig
 	// reqPool is a sync.Pool for reusing RPC request objects.
 	reqPool *sync.Pool
 	// jwtSecret is the JWT secret used for authentication.
@@ -62,17 +70,25 @@ type client struct {
 
 	// header is the HTTP header used for RPC requests.
 	header http.Header
+
+	// captureMu protects capture for concurrent access.
+	captureMu sync.Mutex
+	// capture is where calls are recorded in capture mode, nil otherwise.
+	capture io.Writer
 }
 
-// New create new rpc client with given url.
+// New create new rpc client with given url. An ipc:// url, or an absolute
+// socket path, sends the calls over the Unix domain socket at its path rather
+// than over HTTP, without JWT authentication.
 func NewClient(
 	url string,
 	secret *jwt.Secret,
 	jwtRefreshInterval time.Duration,
+	opts ...Option,
 ) Client {
 	rpc := &client{
-		url:    url,
-		client: http.DefaultClient,
+		url:       url,
+		transport: DefaultTransportConfig(),
 		reqPool: &sync.Pool{
 			New: func() any {
 				return &Request{
@@ -85,12 +101,24 @@ func NewClient(
 		jwtRefreshInterval: jwtRefreshInterval,
 		header:             http.Header{"Content-Type": {"application/json"}},
 	}
+	for _, opt := range opts {
+		opt(rpc)
+	}
 
+	rpc.client = newHTTPClient(rpc.transport)
+	if u, err := beaconurl.NewFromRaw(url); err == nil && u.IsIPC() {
+		rpc.ipc = newIPCPool(u.Path, rpc.transport)
+	}
 	return rpc
 }
 
 // Start starts the rpc client.
 func (rpc *client) Start(ctx context.Context) {
+	// Calls over IPC are not authenticated, there is no JWT to refresh.
+	if rpc.ipc != nil {
+		return
+	}
+
 	ticker := time.NewTicker(rpc.jwtRefreshInterval)
 	defer ticker.Stop()
 
@@ -113,6 +141,9 @@ func (rpc *client) Start(ctx context.Context) {
 // Close closes the RPC client.
 func (rpc *client) Close() error {
 	rpc.client.CloseIdleConnections()
+	if rpc.ipc != nil {
+		rpc.ipc.close()
+	}
 	return nil
 }
 
@@ -156,6 +187,35 @@ func (rpc *client) callRaw(
 		return nil, err
 	}
 
+	var (
+		start = time.Now()
+		data  []byte
+	)
+	if rpc.ipc != nil {
+		data, err = rpc.ipc.call(ctx, body)
+	} else {
+		data, err = rpc.post(ctx, body)
+	}
+	rpc.captureCall(start, method, body, data, err)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(Response)
+	if err = json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Error != nil {
+		return nil, *resp.Error
+	}
+
+	return resp.Result, nil
+}
+
+// post sends the encoded request body to the RPC endpoint and returns the
+// encoded response.
+func (rpc *client) post(ctx context.Context, body []byte) ([]byte, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
@@ -193,15 +253,5 @@ func (rpc *client) callRaw(
 		// Return a default error
 		return nil, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, string(data))
 	}
-
-	resp := new(Response)
-	if err = json.Unmarshal(data, resp); err != nil {
-		return nil, err
-	}
-
-	if resp.Error != nil {
-		return nil, *resp.Error
-	}
-
-	return resp.Result, nil
+	return data, nil
 }
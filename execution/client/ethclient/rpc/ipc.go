@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+)
+
+// ipcPool sends JSON-RPC calls over a Unix domain socket, keeping a pool of
+// idle connections. Each connection carries one call at a time.
+type ipcPool struct {
+	path    string
+	dialer  net.Dialer
+	maxIdle int
+
+	mu   sync.Mutex
+	idle []net.Conn
+}
+
+// newIPCPool creates a pool of connections to the socket at path.
+func newIPCPool(path string, cfg TransportConfig) *ipcPool {
+	return &ipcPool{
+		path:    path,
+		dialer:  net.Dialer{Timeout: dialTimeout},
+		maxIdle: cfg.MaxIdleConns,
+	}
+}
+
+// call sends the encoded request and returns the encoded response.
+func (p *ipcPool) call(ctx context.Context, body []byte) ([]byte, error) {
+	conn, err := p.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unblock the read and write below once the context is done.
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+
+	var response json.RawMessage
+	if _, err = conn.Write(body); err == nil {
+		err = json.NewDecoder(conn).Decode(&response)
+	}
+	if err != nil {
+		_ = conn.Close()
+		if ctx.Err() != nil {
+			return nil, context.Cause(ctx)
+		}
+		return nil, err
+	}
+	p.put(conn)
+	return response, nil
+}
+
+// get returns an idle connection, or dials a new one.
+func (p *ipcPool) get(ctx context.Context) (net.Conn, error) {
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 {
+		conn := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return conn, nil
+	}
+	p.mu.Unlock()
+	return p.dialer.DialContext(ctx, "unix", p.path)
+}
+
+// put returns a connection to the pool, closing it if the pool is full.
+func (p *ipcPool) put(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.idle) >= p.maxIdle {
+		_ = conn.Close()
+		return
+	}
+	p.idle = append(p.idle, conn)
+}
+
+// close closes all idle connections.
+func (p *ipcPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, conn := range p.idle {
+		_ = conn.Close()
+	}
+	p.idle = nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// serveIPC answers every request on the socket with the method name as the
+// result and returns the number of accepted connections.
+func serveIPC(t *testing.T, path string) *atomic.Int32 {
+	t.Helper()
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	var conns atomic.Int32
+	go func() {
+		for {
+			conn, aErr := listener.Accept()
+			if aErr != nil {
+				return
+			}
+			conns.Add(1)
+			go func() {
+				defer conn.Close()
+				decoder := json.NewDecoder(conn)
+				for {
+					var req rpc.Request
+					if dErr := decoder.Decode(&req); dErr != nil {
+						return
+					}
+					result, _ := json.Marshal(req.Method)
+					resp, _ := json.Marshal(rpc.Response{
+						ID: req.ID, JSONRPC: req.JSONRPC, Result: result,
+					})
+					if _, wErr := conn.Write(append(resp, '\n')); wErr != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return &conns
+}
+
+func TestClient_IPC(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "engine.ipc")
+	conns := serveIPC(t, path)
+	c := rpc.NewClient("ipc://"+path, nil, time.Minute)
+	t.Cleanup(func() { _ = c.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for _, method := range []string{"eth_chainId", "engine_getPayloadV4"} {
+		var result string
+		require.NoError(t, c.Call(ctx, &result, method))
+		require.Equal(t, method, result)
+	}
+
+	// Sequential calls reuse the pooled connection.
+	require.Equal(t, int32(1), conns.Load())
+
+	// A bare socket path is detected as an IPC endpoint.
+	bare := rpc.NewClient(path, nil, time.Minute)
+	t.Cleanup(func() { _ = bare.Close() })
+	var result string
+	require.NoError(t, bare.Call(ctx, &result, "eth_chainId"))
+	require.Equal(t, "eth_chainId", result)
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultMaxIdleConns is the default number of idle connections kept
+	// open to the execution client.
+	defaultMaxIdleConns = 16
+	// defaultIdleConnTimeout is the default time an idle connection is kept
+	// open, well above a slot so that every call finds a warm connection.
+	defaultIdleConnTimeout = 5 * time.Minute
+	// defaultKeepAlive is the default TCP keep-alive period.
+	defaultKeepAlive = 15 * time.Second
+	// dialTimeout bounds establishing a new connection.
+	dialTimeout = 5 * time.Second
+)
+
+// TransportConfig configures the connections to the execution client.
+type TransportConfig struct {
+	// MaxIdleConns is the number of idle connections kept open.
+	MaxIdleConns int
+	// IdleConnTimeout is how long an idle connection is kept open.
+	IdleConnTimeout time.Duration
+	// KeepAlive is the TCP keep-alive period of the connections.
+	KeepAlive time.Duration
+}
+
+// DefaultTransportConfig returns the default transport configuration.
+func DefaultTransportConfig() TransportConfig {
+	return TransportConfig{
+		MaxIdleConns:    defaultMaxIdleConns,
+		IdleConnTimeout: defaultIdleConnTimeout,
+		KeepAlive:       defaultKeepAlive,
+	}
+}
+
+// Option configures the RPC client.
+type Option func(*client)
+
+// WithTransport sets the configuration of the connections to the endpoint.
+func WithTransport(cfg TransportConfig) Option {
+	return func(rpc *client) {
+		rpc.transport = cfg
+	}
+}
+
+// newHTTPClient returns an HTTP client keeping a pool of warm connections
+// to the single endpoint it talks to, so that calls do not pay for TCP and
+// TLS handshakes.
+func newHTTPClient(cfg TransportConfig) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: cfg.KeepAlive,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        cfg.MaxIdleConns,
+			MaxIdleConnsPerHost: cfg.MaxIdleConns,
+			IdleConnTimeout:     cfg.IdleConnTimeout,
+			TLSHandshakeTimeout: dialTimeout,
+		},
+	}
+}
@@ -22,27 +22,36 @@ package client
 
 import (
 	"context"
+	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 )
 
-// createContextWithTimeout creates a context with a timeout and returns it
-// along with the cancel function.
+// createContextWithTimeout creates a context with the given method timeout,
+// or the RPC timeout if it is zero, and returns it along with the cancel
+// function.
 func (s *EngineClient) createContextWithTimeout(
 	ctx context.Context,
+	methodTimeout time.Duration,
 ) (context.Context, context.CancelFunc) {
+	timeout := s.cfg.RPCTimeout
+	if methodTimeout > 0 {
+		timeout = methodTimeout
+	}
 	dctx, cancel := context.WithTimeoutCause(
 		ctx,
-		s.cfg.RPCTimeout,
+		timeout,
 		engineerrors.ErrEngineAPITimeout,
 	)
 	return dctx, cancel
 }
 
 // processPayloadStatusResult processes the payload status result and
-// returns the latest valid hash or an error.
+// returns the latest valid hash or an error. SYNCING and ACCEPTED statuses are
+// both classified as engineerrors.ErrSyncingEL.
 func processPayloadStatusResult(
 	result *engineprimitives.PayloadStatusV1,
 ) (*common.ExecutionHash, error) {
@@ -50,9 +59,9 @@ func processPayloadStatusResult(
 	case engineprimitives.PayloadStatusValid:
 		return result.LatestValidHash, nil
 	case engineprimitives.PayloadStatusAccepted:
-		return nil, engineerrors.ErrAcceptedPayloadStatus
+		return nil, errors.Join(engineerrors.ErrSyncingEL, engineerrors.ErrAcceptedPayloadStatus)
 	case engineprimitives.PayloadStatusSyncing:
-		return nil, engineerrors.ErrSyncingPayloadStatus
+		return nil, errors.Join(engineerrors.ErrSyncingEL, engineerrors.ErrSyncingPayloadStatus)
 	case engineprimitives.PayloadStatusInvalid:
 		return nil, engineerrors.ErrInvalidPayloadStatus
 	default:
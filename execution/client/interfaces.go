@@ -22,8 +22,18 @@ package client
 
 import (
 	"time"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+// ChainSpec is the subset of the chain spec used by the engine client.
+type ChainSpec interface {
+	// ActiveForkVersionForTimestamp returns the fork version active at the
+	// given timestamp.
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
@@ -38,12 +38,20 @@ import (
 type WrappedDepositContract struct {
 	// DepositContractFilterer is a pointer to the codegen ABI binding.
 	deposit.DepositContractFilterer
+	// caller reads the state of the contract.
+	caller *deposit.DepositContractCaller
+}
+
+// ContractClient reads the logs and the state of a contract.
+type ContractClient interface {
+	bind.ContractFilterer
+	bind.ContractCaller
 }
 
 // NewWrappedDepositContract creates a new DepositContract.
 func NewWrappedDepositContract(
 	address common.ExecutionAddress,
-	client bind.ContractFilterer,
+	client ContractClient,
 ) (*WrappedDepositContract, error) {
 	contract, err := deposit.NewDepositContractFilterer(
 		gethprimitives.ExecutionAddress(address), client,
@@ -55,17 +63,32 @@ func NewWrappedDepositContract(
 		return nil, errors.New("contract must not be nil")
 	}
 
+	caller, err := deposit.NewDepositContractCaller(
+		gethprimitives.ExecutionAddress(address), client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &WrappedDepositContract{
 		DepositContractFilterer: *contract,
+		caller:                  caller,
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// DepositCount returns the number of deposits made to the contract as of
+// the latest execution block.
+func (dc *WrappedDepositContract) DepositCount(ctx context.Context) (uint64, error) {
+	return dc.caller.DepositCount(&bind.CallOpts{Context: ctx})
+}
+
+// ReadDeposits reads deposits from the deposit contract, along with the
+// execution layer location of the log each deposit was read from.
 func (dc *WrappedDepositContract) ReadDeposits(
 	ctx context.Context,
 	fromBlock math.U64,
 	toBlock math.U64,
-) ([]*ctypes.Deposit, error) {
+) ([]*ctypes.Deposit, []*ctypes.DepositLocation, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
@@ -74,10 +97,11 @@ func (dc *WrappedDepositContract) ReadDeposits(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	deposits := make([]*ctypes.Deposit, 0)
+	locations := make([]*ctypes.DepositLocation, 0)
 	for logs.Next() {
 		var (
 			cred   bytes.B32
@@ -86,15 +110,15 @@ func (dc *WrappedDepositContract) ReadDeposits(
 		)
 		pubKey, err = bytes.ToBytes48(logs.Event.Pubkey)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading pub key: %w", err)
+			return nil, nil, fmt.Errorf("failed reading pub key: %w", err)
 		}
 		cred, err = bytes.ToBytes32(logs.Event.Credentials)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading credentials: %w", err)
+			return nil, nil, fmt.Errorf("failed reading credentials: %w", err)
 		}
 		sign, err = bytes.ToBytes96(logs.Event.Signature)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading signature: %w", err)
+			return nil, nil, fmt.Errorf("failed reading signature: %w", err)
 		}
 		deposit := &ctypes.Deposit{
 			Pubkey:      pubKey,
@@ -104,7 +128,13 @@ func (dc *WrappedDepositContract) ReadDeposits(
 			Index:       logs.Event.Index,
 		}
 		deposits = append(deposits, deposit)
+		locations = append(locations, &ctypes.DepositLocation{
+			Index:       math.U64(logs.Event.Index),
+			BlockNumber: math.U64(logs.Event.Raw.BlockNumber),
+			LogIndex:    math.U64(logs.Event.Raw.Index),
+			TxHash:      common.ExecutionHash(logs.Event.Raw.TxHash),
+		})
 	}
 
-	return deposits, nil
+	return deposits, locations, nil
 }
@@ -29,10 +29,11 @@ import (
 
 // Contract is the ABI for the deposit contract.
 type Contract interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads deposits from the deposit contract, along with the
+	// execution layer location of each of them.
 	ReadDeposits(
 		ctx context.Context,
 		fromBlock math.U64,
 		toBlock math.U64,
-	) ([]*ctypes.Deposit, error)
+	) ([]*ctypes.Deposit, []*ctypes.DepositLocation, error)
 }
@@ -29,8 +29,10 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/cenkalti/backoff/v5"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Engine is Beacon-Kit's implementation of the `ExecutionEngine`
@@ -38,35 +40,74 @@ import (
 type Engine struct {
 	// ec is the engine client that the engine will use to
 	// interact with the execution layer.
-	ec *client.EngineClient
+	ec EngineClient
 	// logger is the logger for the engine.
 	logger log.Logger
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// verifier cross-checks payloads against a second execution client.
+	verifier *Verifier
+	// optimistic tracks the payloads imported while the execution client
+	// is syncing.
+	optimistic *OptimisticTracker
 }
 
 // New creates a new Engine.
 func New(
-	engineClient *client.EngineClient,
+	engineClient EngineClient,
+	verifier *Verifier,
+	optimistic *OptimisticTracker,
 	logger log.Logger,
 	telemtrySink TelemetrySink,
 ) *Engine {
 	return &Engine{
-		ec:      engineClient,
-		logger:  logger,
-		metrics: newEngineMetrics(telemtrySink, logger),
+		ec:         engineClient,
+		logger:     logger,
+		metrics:    newEngineMetrics(telemtrySink, logger),
+		verifier:   verifier,
+		optimistic: optimistic,
 	}
 }
 
+// ProposingHalted returns true if block proposals were halted because the
+// execution clients disagreed on the validity of a payload.
+func (ee *Engine) ProposingHalted() bool {
+	return ee.verifier.ProposingHalted()
+}
+
+// CheckClientVersion returns an error if the execution client runs a version
+// denied at the given fork.
+func (ee *Engine) CheckClientVersion(forkVersion common.Version) error {
+	return ee.ec.CheckClientVersion(forkVersion)
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
+// Since getPayload is idempotent, timed out calls are retried up to the
+// configured number of times.
 func (ee *Engine) GetPayload(
 	ctx context.Context,
 	req *ctypes.GetPayloadRequest,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
-	return ee.ec.GetPayload(
-		ctx, req.PayloadID,
-		req.ForkVersion,
+	tries := uint(ee.ec.GetRPCGetPayloadRetries()) + 1 // #nosec G115 -- retries are small.
+	ctx, span := tracing.StartSpan(ctx, "engine.GetPayload")
+	envelope, err := backoff.Retry(
+		ctx,
+		func() (ctypes.BuiltExecutionPayloadEnv, error) {
+			envelope, err := ee.ec.GetPayload(
+				ctx, req.PayloadID,
+				req.ForkVersion,
+			)
+			if err != nil && !client.IsNonFatalError(err) {
+				return envelope, backoff.Permanent(err)
+			}
+			return envelope, err
+		},
+		backoff.WithBackOff(ee.newBackoff()),
+		backoff.WithMaxTries(tries),
+		backoff.WithMaxElapsedTime(0), // bounded by the number of tries.
 	)
+	tracing.EndSpan(span, err)
+	return envelope, err
 }
 
 // NotifyForkchoiceUpdate notifies the execution client of a forkchoice update.
@@ -79,7 +120,12 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 		hasPayloadAttributes = req.PayloadAttributes != nil
 	)
 
-	return backoff.Retry(
+	ctx, span := tracing.StartSpan(
+		ctx, "engine.ForkchoiceUpdated",
+		attribute.String("head_eth1_hash", req.State.HeadBlockHash.Hex()),
+		attribute.Bool("has_payload_attributes", hasPayloadAttributes),
+	)
+	payloadID, err := backoff.Retry(
 		ctx,
 		func() (*engineprimitives.PayloadID, error) {
 			// Log and call the forkchoice update.
@@ -110,10 +156,28 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 					return nil, backoff.Permanent(ErrNilPayloadOnValidResponse)
 				}
 
+				// A valid head validates the blocks imported optimistically
+				// up to it.
+				if n := ee.optimistic.Validate(req.State.HeadBlockHash); n > 0 {
+					ee.metrics.markOptimisticValidated(n)
+				}
+
 				// We've received a valid response, no more retries.
 				return payloadID, nil
 
-			case errors.IsAny(err, engineerrors.ErrSyncingPayloadStatus):
+			case errors.Is(err, engineerrors.ErrSyncingEL) &&
+				!hasPayloadAttributes &&
+				ee.optimistic.IsOptimisticHash(req.State.HeadBlockHash):
+				// The head was imported optimistically, so the EL is expected
+				// to be syncing up to it. There is nothing to wait for, as
+				// the head is validated once the EL catches up.
+				ee.logger.Info(
+					"NotifyForkchoiceUpdate: EL syncing to optimistic head",
+					"head_eth1_hash", req.State.HeadBlockHash,
+				)
+				return nil, nil //nolint:nilnil // no payload is requested.
+
+			case errors.Is(err, engineerrors.ErrSyncingEL):
 				ee.logger.Info("NotifyForkchoiceUpdate: EL syncing. Retrying...")
 				ee.metrics.markForkchoiceUpdateSyncing(req.State, err)
 				return nil, err
@@ -129,7 +193,10 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 			case errors.Is(err, engineerrors.ErrInvalidPayloadStatus):
 				// During payload building, then there is an invalid payload and should error.
 				// During FinalizeBlock, something is broken because this should never happen.
-				ee.logger.Error("NotifyForkchoiceUpdate: EL returned invalid payload.")
+				ee.logger.Error(
+					"NotifyForkchoiceUpdate: EL returned invalid payload.",
+					"optimistic_head", ee.optimistic.IsOptimisticHash(req.State.HeadBlockHash),
+				)
 				ee.metrics.markForkchoiceUpdateInvalid(req.State, err)
 				return nil, backoff.Permanent(err)
 
@@ -154,6 +221,11 @@ func (ee *Engine) NotifyForkchoiceUpdate(
 		backoff.WithMaxTries(0),       // 0 for infinite retries.
 		backoff.WithMaxElapsedTime(0), // 0 for infinite max elapsed time.
 	)
+	if err == nil {
+		ee.verifier.followForkchoice(req.State, req.ForkVersion)
+	}
+	tracing.EndSpan(span, err)
+	return payloadID, err
 }
 
 // NotifyNewPayload notifies the execution client of the new payload.
@@ -168,8 +240,15 @@ func (ee *Engine) NotifyNewPayload(
 		engineAPIBackoff  = ee.newBackoff()
 		payloadHash       = req.GetExecutionPayload().GetBlockHash()
 		payloadParentHash = req.GetExecutionPayload().GetParentHash()
+		payloadNumber     = req.GetExecutionPayload().GetNumber()
+		primaryVerdict    = verdictUnknown
 	)
 
+	ctx, span := tracing.StartSpan(
+		ctx, "engine.NewPayload",
+		attribute.String("payload_hash", payloadHash.Hex()),
+		attribute.Int64("payload_number", int64(payloadNumber.Unwrap())), // #nosec G115
+	)
 	_, err := backoff.Retry(
 		ctx,
 		func() (*common.ExecutionHash, error) {
@@ -185,10 +264,16 @@ func (ee *Engine) NotifyNewPayload(
 			switch {
 			case err == nil:
 				ee.metrics.markNewPayloadValid(payloadHash, payloadParentHash)
+				primaryVerdict = verdictValid
+				// A valid payload validates its ancestors, among which the
+				// blocks imported optimistically.
+				if n := ee.optimistic.ValidateUpTo(payloadNumber); n > 0 {
+					ee.metrics.markOptimisticValidated(n)
+				}
 				// We've received a valid response, no more retries.
 				return lastValidHash, nil
 
-			case errors.IsAny(err, engineerrors.ErrSyncingPayloadStatus, engineerrors.ErrAcceptedPayloadStatus):
+			case errors.Is(err, engineerrors.ErrSyncingEL):
 				ee.logger.Info(
 					"NotifyNewPayload: EL returns non valid status. Retrying...",
 					"err", err,
@@ -201,17 +286,11 @@ func (ee *Engine) NotifyNewPayload(
 				if retryOnSyncingStatus {
 					return nil, err
 				}
-				// During FinalizeBlock, we do not need to verify the block.
-				// We do not need to retry here, as the following call to
-				// NotifyForkchoiceUpdate will inform the EL of the new head
-				// and then wait for it to sync.
-				// Don't return error here, because we want to send the forkchoice update regardless.
-				ee.logger.Warn(
-					"NotifyNewPayload: pushed new payload to SYNCING node.",
-					"error", err,
-					"blockNum", req.GetExecutionPayload().GetNumber(),
-					"blockHash", payloadHash,
-				)
+				// During FinalizeBlock, the block was already verified by
+				// a supermajority of validators, so it is imported
+				// optimistically until the EL catches up and validates it.
+				ee.optimistic.Import(payloadNumber, payloadHash)
+				ee.metrics.markOptimisticImport(payloadHash, payloadNumber)
 				return &common.ExecutionHash{}, nil
 
 			case client.IsNonFatalError(err):
@@ -229,6 +308,7 @@ func (ee *Engine) NotifyNewPayload(
 			case errors.Is(err, engineerrors.ErrInvalidPayloadStatus):
 				ee.logger.Error("NotifyNewPayload: EL returned invalid payload.")
 				ee.metrics.markNewPayloadInvalidPayloadStatus(payloadHash)
+				primaryVerdict = verdictInvalid
 				// During payload building, then there is an invalid
 				// payload and should error.
 				// During FinalizeBlock, something is broken because
@@ -260,6 +340,8 @@ func (ee *Engine) NotifyNewPayload(
 		backoff.WithMaxTries(0),       // 0 for infinite retries.
 		backoff.WithMaxElapsedTime(0), // 0 for infinite max elapsed time.
 	)
+	ee.verifier.verifyPayload(req, primaryVerdict)
+	tracing.EndSpan(span, err)
 	return err
 }
 
@@ -267,7 +349,8 @@ func (ee *Engine) newBackoff() *backoff.ExponentialBackOff {
 	// Configure backoff. This will retry maxRetries number of times.
 	// Specifying 0 maxRetries will retry infinitely. Between each retry, it
 	// will wait RPCRetryInterval amount of time. This backoff will increase
-	// exponentially until it reaches RPCMaxRetryInterval.
+	// exponentially until it reaches RPCMaxRetryInterval. Each interval is
+	// randomized to avoid retrying in lockstep.
 	engineAPIBackoff := backoff.NewExponentialBackOff()
 	engineAPIBackoff.InitialInterval = ee.ec.GetRPCRetryInterval()
 	engineAPIBackoff.MaxInterval = ee.ec.GetRPCMaxRetryInterval()
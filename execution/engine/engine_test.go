@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// errEngineTimeout is the error of a timed out call to the execution client.
+var errEngineTimeout = errors.Join(engineerrors.ErrTimeout, engineerrors.ErrEngineAPITimeout)
+
+// stubClient is an execution client answering newPayload calls with
+// newPayloadErr, and failing getPayload calls with getPayloadErr until it
+// was called failures times.
+type stubClient struct {
+	mu            sync.Mutex
+	newPayloadErr error
+	retries       uint64
+	failures      int
+	getPayloadErr error
+	getPayloads   int
+}
+
+func (c *stubClient) NewPayload(
+	context.Context, ctypes.NewPayloadRequest,
+) (*common.ExecutionHash, error) {
+	if c.newPayloadErr != nil {
+		return nil, c.newPayloadErr
+	}
+	return &common.ExecutionHash{}, nil
+}
+
+func (*stubClient) ForkchoiceUpdated(
+	_ context.Context,
+	_ *engineprimitives.ForkchoiceStateV1,
+	attrs *engineprimitives.PayloadAttributes,
+	_ common.Version,
+) (*engineprimitives.PayloadID, error) {
+	if attrs != nil {
+		return &engineprimitives.PayloadID{}, nil
+	}
+	return nil, nil //nolint:nilnil // no payload is requested.
+}
+
+func (c *stubClient) GetPayload(
+	context.Context, engineprimitives.PayloadID, common.Version,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.getPayloads++
+	if c.getPayloads <= c.failures {
+		return nil, c.getPayloadErr
+	}
+	return nil, nil
+}
+
+func (*stubClient) CheckClientVersion(common.Version) error { return nil }
+
+func (*stubClient) GetRPCRetryInterval() time.Duration { return time.Millisecond }
+
+func (*stubClient) GetRPCMaxRetryInterval() time.Duration { return time.Millisecond }
+
+func (c *stubClient) GetRPCGetPayloadRetries() uint64 { return c.retries }
+
+// countingSink counts the metrics it is given.
+type countingSink struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newCountingSink() *countingSink {
+	return &countingSink{counts: make(map[string]int)}
+}
+
+func (s *countingSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts[key]++
+}
+
+func (s *countingSink) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counts[key]
+}
+
+// newTestEngine returns an engine over ec, checking payloads with verifier or
+// with a disabled one if nil.
+func newTestEngine(ec engine.EngineClient, verifier *engine.Verifier) *engine.Engine {
+	logger := phuslu.NewLogger(io.Discard, nil)
+	sink := newCountingSink()
+	if verifier == nil {
+		verifier = engine.NewVerifier(nil, false, logger, sink)
+	}
+	return engine.New(ec, verifier, engine.NewOptimisticTracker(), logger, sink)
+}
+
+// TestEngine_GetPayloadRetries checks that only timed out getPayload calls
+// are retried, up to the configured number of times.
+func TestEngine_GetPayloadRetries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		retries       uint64
+		failures      int
+		getPayloadErr error
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "no failure",
+			retries:       3,
+			expectedCalls: 1,
+		},
+		{
+			name:          "timeouts within the limit",
+			retries:       3,
+			failures:      3,
+			getPayloadErr: errEngineTimeout,
+			expectedCalls: 4,
+		},
+		{
+			name:          "timeouts beyond the limit",
+			retries:       2,
+			failures:      5,
+			getPayloadErr: errEngineTimeout,
+			expectedCalls: 3,
+			expectedErr:   engineerrors.ErrTimeout,
+		},
+		{
+			name:          "no retries",
+			failures:      1,
+			getPayloadErr: errEngineTimeout,
+			expectedCalls: 1,
+			expectedErr:   engineerrors.ErrTimeout,
+		},
+		{
+			name:          "fatal error",
+			retries:       3,
+			failures:      1,
+			getPayloadErr: engineerrors.ErrUnknownPayload,
+			expectedCalls: 1,
+			expectedErr:   engineerrors.ErrUnknownPayload,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ec := &stubClient{
+				retries:       tt.retries,
+				failures:      tt.failures,
+				getPayloadErr: tt.getPayloadErr,
+			}
+			_, err := newTestEngine(ec, nil).GetPayload(
+				context.Background(),
+				&ctypes.GetPayloadRequest{ForkVersion: version.Electra()},
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectedCalls, ec.getPayloads)
+		})
+	}
+}
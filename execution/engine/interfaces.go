@@ -20,6 +20,68 @@
 
 package engine
 
+import (
+	"context"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// EngineClient is the execution client the engine calls through the Engine
+// API. It is implemented by client.EngineClient.
+type EngineClient interface {
+	// NewPayload calls engine_newPayload for the payload of the request.
+	NewPayload(
+		ctx context.Context, req ctypes.NewPayloadRequest,
+	) (*common.ExecutionHash, error)
+	// ForkchoiceUpdated calls engine_forkchoiceUpdated, starting to build a
+	// payload if attrs is not nil.
+	ForkchoiceUpdated(
+		ctx context.Context,
+		state *engineprimitives.ForkchoiceStateV1,
+		attrs *engineprimitives.PayloadAttributes,
+		forkVersion common.Version,
+	) (*engineprimitives.PayloadID, error)
+	// GetPayload calls engine_getPayload for the payload being built.
+	GetPayload(
+		ctx context.Context,
+		payloadID engineprimitives.PayloadID,
+		forkVersion common.Version,
+	) (ctypes.BuiltExecutionPayloadEnv, error)
+	// CheckClientVersion returns an error if the execution client runs a
+	// version denied at the given fork.
+	CheckClientVersion(forkVersion common.Version) error
+	// GetRPCRetryInterval returns the initial backoff between retries.
+	GetRPCRetryInterval() time.Duration
+	// GetRPCMaxRetryInterval returns the maximum backoff between retries.
+	GetRPCMaxRetryInterval() time.Duration
+	// GetRPCGetPayloadRetries returns how many times timed out getPayload
+	// calls are retried.
+	GetRPCGetPayloadRetries() uint64
+}
+
+// VerificationClient is the execution client payloads are cross-checked
+// against. It is implemented by client.EngineClient.
+type VerificationClient interface {
+	// Start connects to the execution client, blocking until it is
+	// connected or ctx is done.
+	Start(ctx context.Context) error
+	// NewPayload calls engine_newPayload for the payload of the request.
+	NewPayload(
+		ctx context.Context, req ctypes.NewPayloadRequest,
+	) (*common.ExecutionHash, error)
+	// ForkchoiceUpdated calls engine_forkchoiceUpdated, starting to build a
+	// payload if attrs is not nil.
+	ForkchoiceUpdated(
+		ctx context.Context,
+		state *engineprimitives.ForkchoiceStateV1,
+		attrs *engineprimitives.PayloadAttributes,
+		forkVersion common.Version,
+	) (*engineprimitives.PayloadID, error)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // engineMetrics is a struct that contains metrics for the engine.
@@ -132,7 +133,7 @@ func (em *engineMetrics) markNewPayloadNonFatalError(
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.new_payload_non_fatal_error",
-		"error", err.Error(),
+		"code", engineerrors.Code(err),
 	)
 }
 
@@ -152,7 +153,7 @@ func (em *engineMetrics) markNewPayloadFatalError(
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.new_payload_fatal_error",
-		"error", err.Error(),
+		"code", engineerrors.Code(err),
 	)
 }
 
@@ -170,7 +171,7 @@ func (em *engineMetrics) markNewPayloadUndefinedError(
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.new_payload_undefined_error",
-		"error", err.Error(),
+		"code", engineerrors.Code(err),
 	)
 }
 
@@ -226,8 +227,8 @@ func (em *engineMetrics) markForkchoiceUpdateSyncing(
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_syncing",
-		"error",
-		err.Error(),
+		"code",
+		engineerrors.Code(err),
 	)
 }
 
@@ -247,8 +248,8 @@ func (em *engineMetrics) markForkchoiceUpdateInvalid(
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_invalid",
-		"error",
-		err.Error(),
+		"code",
+		engineerrors.Code(err),
 	)
 }
 
@@ -262,7 +263,7 @@ func (em *engineMetrics) markForkchoiceUpdateFatalError(err error) {
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_fatal_error",
-		"error", err.Error(),
+		"code", engineerrors.Code(err),
 	)
 }
 
@@ -276,7 +277,7 @@ func (em *engineMetrics) markForkchoiceUpdateNonFatalError(err error) {
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_non_fatal_error",
-		"error", err.Error(),
+		"code", engineerrors.Code(err),
 	)
 }
 
@@ -291,6 +292,36 @@ func (em *engineMetrics) markForkchoiceUpdateUndefinedError(err error) {
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_undefined_error",
-		"error", err.Error(),
+		"code", engineerrors.Code(err),
+	)
+}
+
+// markOptimisticImport increments the counter for payloads imported
+// optimistically while the execution client is syncing.
+func (em *engineMetrics) markOptimisticImport(
+	payloadHash common.ExecutionHash,
+	payloadNumber math.U64,
+) {
+	em.logger.Warn(
+		"Imported payload optimistically, execution client is syncing",
+		"payload_block_hash", payloadHash,
+		"payload_block_number", payloadNumber,
+	)
+
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.optimistic_import",
+	)
+}
+
+// markOptimisticValidated increments the counter for optimistically
+// imported payloads the execution client validated once caught up.
+func (em *engineMetrics) markOptimisticValidated(count int) {
+	em.logger.Info(
+		"Execution client validated optimistically imported payloads",
+		"count", count,
+	)
+
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.optimistic_validated",
 	)
 }
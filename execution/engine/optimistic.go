@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// optimisticBlock is an execution block imported optimistically.
+type optimisticBlock struct {
+	number math.U64
+	hash   common.ExecutionHash
+}
+
+// OptimisticTracker tracks the execution blocks imported optimistically, i.e.
+// whose payload was finalized while the execution client was syncing and
+// could not validate it yet. Since the execution client validating a block
+// validates its ancestors too, the optimistic blocks always are the tip of
+// the chain, from the first block imported while syncing. The tracker is kept
+// in memory only, so the blocks imported optimistically before a restart are
+// not reported as such after it.
+type OptimisticTracker struct {
+	mu sync.RWMutex
+	// blocks are the optimistic blocks, by increasing number.
+	blocks []optimisticBlock
+}
+
+// NewOptimisticTracker creates an OptimisticTracker without any optimistic
+// block.
+func NewOptimisticTracker() *OptimisticTracker {
+	return &OptimisticTracker{}
+}
+
+// Import marks the block as imported optimistically.
+func (t *OptimisticTracker) Import(number math.U64, hash common.ExecutionHash) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// Blocks are finalized in order, so a block at the same or a lower
+	// number can only be a replay of the tip.
+	t.blocks = slices.DeleteFunc(t.blocks, func(b optimisticBlock) bool {
+		return b.number >= number
+	})
+	t.blocks = append(t.blocks, optimisticBlock{number: number, hash: hash})
+}
+
+// ValidateUpTo marks the blocks up to the given number as validated, and
+// returns the number of optimistic blocks validated.
+func (t *OptimisticTracker) ValidateUpTo(number math.U64) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	i := slices.IndexFunc(t.blocks, func(b optimisticBlock) bool {
+		return b.number > number
+	})
+	if i < 0 {
+		i = len(t.blocks)
+	}
+	t.blocks = t.blocks[i:]
+	return i
+}
+
+// Validate marks the block with the given hash and its ancestors as
+// validated, and returns the number of optimistic blocks validated.
+func (t *OptimisticTracker) Validate(hash common.ExecutionHash) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	i := slices.IndexFunc(t.blocks, func(b optimisticBlock) bool {
+		return b.hash == hash
+	})
+	t.blocks = t.blocks[i+1:]
+	return i + 1
+}
+
+// IsOptimistic returns true if the block with the given number, which must
+// have been imported, was imported optimistically and is not validated yet.
+func (t *OptimisticTracker) IsOptimistic(number math.U64) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.blocks) > 0 && number >= t.blocks[0].number
+}
+
+// IsOptimisticHash returns true if the block with the given hash was
+// imported optimistically and is not validated yet.
+func (t *OptimisticTracker) IsOptimisticHash(hash common.ExecutionHash) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return slices.ContainsFunc(t.blocks, func(b optimisticBlock) bool {
+		return b.hash == hash
+	})
+}
+
+// Len returns the number of optimistic blocks.
+func (t *OptimisticTracker) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.blocks)
+}
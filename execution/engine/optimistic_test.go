@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimisticTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := engine.NewOptimisticTracker()
+	require.False(t, tracker.IsOptimistic(10))
+
+	// Blocks 10 to 13 are imported while the execution client is syncing.
+	for i := range 4 {
+		tracker.Import(math.U64(10+i), common.ExecutionHash{byte(i)})
+	}
+	require.Equal(t, 4, tracker.Len())
+	require.False(t, tracker.IsOptimistic(9))
+	require.True(t, tracker.IsOptimistic(12))
+	require.True(t, tracker.IsOptimisticHash(common.ExecutionHash{1}))
+
+	// A valid block validates its ancestors.
+	require.Equal(t, 2, tracker.ValidateUpTo(11))
+	require.False(t, tracker.IsOptimistic(11))
+	require.True(t, tracker.IsOptimistic(12))
+	require.False(t, tracker.IsOptimisticHash(common.ExecutionHash{1}))
+
+	// Validating an unknown hash validates nothing.
+	require.Zero(t, tracker.Validate(common.ExecutionHash{0xff}))
+	require.Equal(t, 2, tracker.Len())
+
+	// A valid head validates the remaining blocks.
+	require.Equal(t, 2, tracker.Validate(common.ExecutionHash{3}))
+	require.Zero(t, tracker.Len())
+	require.False(t, tracker.IsOptimistic(13))
+}
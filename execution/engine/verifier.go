@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"context"
+	"sync/atomic"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// verdict is the outcome of an execution client validating a payload.
+type verdict string
+
+const (
+	verdictUnknown verdict = ""
+	verdictValid   verdict = "valid"
+	verdictInvalid verdict = "invalid"
+)
+
+// verdictOf classifies the result of a newPayload call. Syncing, timeouts
+// and other errors are inconclusive.
+func verdictOf(err error) verdict {
+	switch {
+	case err == nil:
+		return verdictValid
+	case errors.Is(err, engineerrors.ErrInvalidPayloadStatus):
+		return verdictInvalid
+	default:
+		return verdictUnknown
+	}
+}
+
+// verificationQueueSize bounds the calls waiting for the verification
+// execution client. Calls are dropped once it is full.
+const verificationQueueSize = 16
+
+// Verifier cross-checks the newPayload verdicts of the primary execution
+// client against a secondary, verification execution client, to catch
+// execution client consensus bugs before they finalize. It is disabled when
+// no verification client is configured.
+//
+// Calls to the verification client are queued and made in order by a single
+// goroutine, so that a slow or stuck verification client never holds up
+// block processing. Disagreements are only logged and counted, and halt
+// proposals if configured.
+type Verifier struct {
+	// ec is the verification engine client, nil if disabled.
+	ec VerificationClient
+	// haltOnMismatch halts block proposals on the first disagreement.
+	haltOnMismatch bool
+	// ready is set once the verification client is connected.
+	ready atomic.Bool
+	// halted is set once proposals are halted.
+	halted atomic.Bool
+	// queue holds the calls waiting for the verification client.
+	queue  chan func(context.Context)
+	logger log.Logger
+	sink   TelemetrySink
+}
+
+// NewVerifier creates a Verifier checking against the given engine client,
+// which may be nil to disable verification.
+func NewVerifier(
+	ec VerificationClient,
+	haltOnMismatch bool,
+	logger log.Logger,
+	sink TelemetrySink,
+) *Verifier {
+	return &Verifier{
+		ec:             ec,
+		haltOnMismatch: haltOnMismatch,
+		queue:          make(chan func(context.Context), verificationQueueSize),
+		logger:         logger,
+		sink:           sink,
+	}
+}
+
+// Name returns the name of the verifier service.
+func (v *Verifier) Name() string {
+	return "verification-engine-client"
+}
+
+// Start connects to the verification execution client in the background,
+// so that an unavailable verification client never holds up the node, then
+// makes the queued calls until ctx is done.
+func (v *Verifier) Start(ctx context.Context) error {
+	if v.ec == nil {
+		return nil
+	}
+	go func() {
+		if err := v.ec.Start(ctx); err != nil {
+			if !errors.Is(err, context.Canceled) {
+				v.logger.Error("Failed to start verification execution client", "err", err)
+			}
+			return
+		}
+		v.ready.Store(true)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case call := <-v.queue:
+				call(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the verifier service.
+func (v *Verifier) Stop() error {
+	return nil
+}
+
+// Connected returns true once the verification execution client is connected
+// and payloads are checked against it.
+func (v *Verifier) Connected() bool {
+	return v.ready.Load()
+}
+
+// ProposingHalted returns true once a disagreement between the execution
+// clients halted block proposals.
+func (v *Verifier) ProposingHalted() bool {
+	return v.halted.Load()
+}
+
+// enqueue queues the call for the verification execution client, dropping
+// it if the client is not connected or the queue is full. Drops of a full
+// queue are counted, as they leave payloads unverified.
+func (v *Verifier) enqueue(call func(context.Context)) {
+	if !v.ready.Load() {
+		return
+	}
+	select {
+	case v.queue <- call:
+	default:
+		v.sink.IncrementCounter("beacon_kit.execution.engine.verification_dropped")
+		v.logger.Warn("Verification execution client is lagging, dropped a call")
+	}
+}
+
+// verifyPayload queues a check of the payload against the verification
+// execution client, which reports a disagreement with the primary verdict.
+func (v *Verifier) verifyPayload(req ctypes.NewPayloadRequest, primary verdict) {
+	if primary == verdictUnknown {
+		return
+	}
+	v.enqueue(func(ctx context.Context) {
+		v.checkPayload(ctx, req, primary)
+	})
+}
+
+// checkPayload checks the payload against the verification execution client
+// and reports a disagreement with the primary verdict.
+func (v *Verifier) checkPayload(
+	ctx context.Context, req ctypes.NewPayloadRequest, primary verdict,
+) {
+	payloadHash := req.GetExecutionPayload().GetBlockHash()
+	_, err := v.ec.NewPayload(ctx, req)
+	secondary := verdictOf(err)
+	if secondary == verdictUnknown {
+		v.logger.Debug(
+			"Verification execution client gave no verdict",
+			"payload_block_hash", payloadHash, "err", err,
+		)
+		return
+	}
+	if secondary == primary {
+		return
+	}
+
+	v.sink.IncrementCounter("beacon_kit.execution.engine.verification_mismatch")
+	v.logger.Error(
+		"CRITICAL: execution clients disagree on payload validity",
+		"payload_block_hash", payloadHash,
+		"payload_number", req.GetExecutionPayload().GetNumber(),
+		"primary", string(primary),
+		"verification", string(secondary),
+	)
+	if v.haltOnMismatch && !v.halted.Swap(true) {
+		v.logger.Error("Halting block proposals until the node is restarted")
+	}
+}
+
+// followForkchoice queues a forkchoice update moving the head of the
+// verification execution client along with the primary one, so that it can
+// validate the next payloads. Payload attributes are never forwarded, the
+// verification client does not build payloads.
+func (v *Verifier) followForkchoice(
+	state *engineprimitives.ForkchoiceStateV1,
+	forkVersion common.Version,
+) {
+	// The update is sent later, do not share the state with the caller.
+	stateCopy := *state
+	v.enqueue(func(ctx context.Context) {
+		if _, err := v.ec.ForkchoiceUpdated(ctx, &stateCopy, nil, forkVersion); err != nil &&
+			!errors.Is(err, engineerrors.ErrSyncingEL) {
+			v.logger.Warn(
+				"Failed to update verification execution client forkchoice",
+				"head_eth1_hash", stateCopy.HeadBlockHash, "err", err,
+			)
+		}
+	})
+}
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/engine"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mismatchMetric = "beacon_kit.execution.engine.verification_mismatch"
+	droppedMetric  = "beacon_kit.execution.engine.verification_dropped"
+)
+
+// stubVerificationClient is a verification execution client answering
+// newPayload calls with newPayloadErr. If set, entered is signaled when a
+// newPayload call starts, and the call waits for release to be closed.
+type stubVerificationClient struct {
+	mu            sync.Mutex
+	newPayloadErr error
+	entered       chan struct{}
+	release       chan struct{}
+	newPayloads   int
+	// forkchoiceAttrs records whether each forkchoice update carried
+	// payload attributes.
+	forkchoiceAttrs []bool
+}
+
+func (*stubVerificationClient) Start(context.Context) error { return nil }
+
+func (c *stubVerificationClient) NewPayload(
+	context.Context, ctypes.NewPayloadRequest,
+) (*common.ExecutionHash, error) {
+	if c.entered != nil {
+		select {
+		case c.entered <- struct{}{}:
+		default:
+		}
+	}
+	if c.release != nil {
+		<-c.release
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.newPayloads++
+	if c.newPayloadErr != nil {
+		return nil, c.newPayloadErr
+	}
+	return &common.ExecutionHash{}, nil
+}
+
+func (c *stubVerificationClient) ForkchoiceUpdated(
+	_ context.Context,
+	_ *engineprimitives.ForkchoiceStateV1,
+	attrs *engineprimitives.PayloadAttributes,
+	_ common.Version,
+) (*engineprimitives.PayloadID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forkchoiceAttrs = append(c.forkchoiceAttrs, attrs != nil)
+	return nil, nil //nolint:nilnil // no payload is requested.
+}
+
+func (c *stubVerificationClient) setNewPayloadErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.newPayloadErr = err
+}
+
+func (c *stubVerificationClient) calls() (int, []bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.newPayloads, append([]bool(nil), c.forkchoiceAttrs...)
+}
+
+// payloadRequest is a newPayload request for an empty payload.
+type payloadRequest struct {
+	ctypes.NewPayloadRequest
+}
+
+func (payloadRequest) GetExecutionPayload() *ctypes.ExecutionPayload {
+	return &ctypes.ExecutionPayload{}
+}
+
+// newVerifiedEngine returns an engine over ec whose payloads are checked
+// against vc, once the verifier is connected to it.
+func newVerifiedEngine(
+	t *testing.T, ec engine.EngineClient, vc engine.VerificationClient, haltOnMismatch bool,
+) (*engine.Engine, *countingSink) {
+	t.Helper()
+	sink := newCountingSink()
+	verifier := engine.NewVerifier(vc, haltOnMismatch, phuslu.NewLogger(io.Discard, nil), sink)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, verifier.Start(ctx))
+	require.Eventually(t, verifier.Connected, time.Second, time.Millisecond)
+	return newTestEngine(ec, verifier), sink
+}
+
+// flush waits until the calls queued for vc before it are made. Calls are
+// made in order, so a forkchoice update reaching vc marks them done.
+func flush(t *testing.T, eng *engine.Engine, vc *stubVerificationClient) {
+	t.Helper()
+	_, before := vc.calls()
+	_, err := eng.NotifyForkchoiceUpdate(
+		context.Background(),
+		ctypes.BuildForkchoiceUpdateRequestNoAttrs(
+			&engineprimitives.ForkchoiceStateV1{}, version.Electra(),
+		),
+	)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, after := vc.calls()
+		return len(after) > len(before)
+	}, time.Second, time.Millisecond)
+}
+
+// TestVerifier_Verdicts checks which combinations of primary and
+// verification verdicts are reported as disagreements.
+func TestVerifier_Verdicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		primaryErr      error
+		verificationErr error
+		haltOnMismatch  bool
+		verified        int
+		mismatch        bool
+	}{
+		{
+			name:     "valid, valid",
+			verified: 1,
+		},
+		{
+			name:            "valid, invalid",
+			verificationErr: engineerrors.ErrInvalidPayloadStatus,
+			haltOnMismatch:  true,
+			verified:        1,
+			mismatch:        true,
+		},
+		{
+			name:           "invalid, valid",
+			primaryErr:     engineerrors.ErrInvalidPayloadStatus,
+			haltOnMismatch: true,
+			verified:       1,
+			mismatch:       true,
+		},
+		{
+			name:            "invalid, invalid",
+			primaryErr:      engineerrors.ErrInvalidPayloadStatus,
+			verificationErr: engineerrors.ErrInvalidPayloadStatus,
+			haltOnMismatch:  true,
+			verified:        1,
+		},
+		{
+			name:            "valid, syncing",
+			verificationErr: engineerrors.ErrSyncingEL,
+			haltOnMismatch:  true,
+			verified:        1,
+		},
+		{
+			name:            "valid, timed out",
+			verificationErr: errEngineTimeout,
+			haltOnMismatch:  true,
+			verified:        1,
+		},
+		{
+			name:           "syncing, not verified",
+			primaryErr:     engineerrors.ErrSyncingEL,
+			haltOnMismatch: true,
+		},
+		{
+			name:            "valid, invalid, without halting",
+			verificationErr: engineerrors.ErrInvalidPayloadStatus,
+			verified:        1,
+			mismatch:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			vc := &stubVerificationClient{newPayloadErr: tt.verificationErr}
+			eng, sink := newVerifiedEngine(
+				t, &stubClient{newPayloadErr: tt.primaryErr}, vc, tt.haltOnMismatch,
+			)
+
+			err := eng.NotifyNewPayload(context.Background(), payloadRequest{}, false)
+			if errors.Is(tt.primaryErr, engineerrors.ErrInvalidPayloadStatus) {
+				require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+			} else {
+				require.NoError(t, err)
+			}
+			flush(t, eng, vc)
+
+			verified, _ := vc.calls()
+			require.Equal(t, tt.verified, verified)
+			if tt.mismatch {
+				require.Equal(t, 1, sink.count(mismatchMetric))
+			} else {
+				require.Zero(t, sink.count(mismatchMetric))
+			}
+			require.Equal(t, tt.mismatch && tt.haltOnMismatch, eng.ProposingHalted())
+		})
+	}
+}
+
+// TestVerifier_HaltsOnce checks that proposals stay halted after the first
+// disagreement, while further ones are still counted.
+func TestVerifier_HaltsOnce(t *testing.T) {
+	t.Parallel()
+	vc := &stubVerificationClient{newPayloadErr: engineerrors.ErrInvalidPayloadStatus}
+	eng, sink := newVerifiedEngine(t, &stubClient{}, vc, true)
+
+	for range 2 {
+		require.NoError(t, eng.NotifyNewPayload(context.Background(), payloadRequest{}, false))
+	}
+	flush(t, eng, vc)
+	require.Equal(t, 2, sink.count(mismatchMetric))
+	require.True(t, eng.ProposingHalted())
+
+	// Agreeing again does not resume proposals.
+	vc.setNewPayloadErr(nil)
+	require.NoError(t, eng.NotifyNewPayload(context.Background(), payloadRequest{}, false))
+	flush(t, eng, vc)
+	require.Equal(t, 2, sink.count(mismatchMetric))
+	require.True(t, eng.ProposingHalted())
+}
+
+// TestVerifier_FollowsForkchoice checks that every forkchoice update is
+// forwarded, without its payload attributes.
+func TestVerifier_FollowsForkchoice(t *testing.T) {
+	t.Parallel()
+	vc := &stubVerificationClient{}
+	eng, _ := newVerifiedEngine(t, &stubClient{}, vc, false)
+
+	_, err := eng.NotifyForkchoiceUpdate(
+		context.Background(),
+		ctypes.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{},
+			&engineprimitives.PayloadAttributes{},
+			version.Electra(),
+		),
+	)
+	require.NoError(t, err)
+	flush(t, eng, vc)
+
+	_, attrs := vc.calls()
+	require.Equal(t, []bool{false, false}, attrs)
+}
+
+// TestVerifier_QueueOverflow checks that calls are dropped and counted while
+// the verification client lags, without holding up the primary one.
+func TestVerifier_QueueOverflow(t *testing.T) {
+	t.Parallel()
+	const payloads = 40
+	vc := &stubVerificationClient{
+		entered: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	eng, sink := newVerifiedEngine(t, &stubClient{}, vc, false)
+
+	// The first call holds up the verification client.
+	require.NoError(t, eng.NotifyNewPayload(context.Background(), payloadRequest{}, false))
+	select {
+	case <-vc.entered:
+	case <-time.After(time.Second):
+		require.FailNow(t, "verification client not called")
+	}
+
+	for range payloads {
+		require.NoError(t, eng.NotifyNewPayload(context.Background(), payloadRequest{}, false))
+	}
+	dropped := sink.count(droppedMetric)
+	require.Positive(t, dropped)
+	require.Less(t, dropped, payloads)
+
+	// Once the verification client catches up, the queued calls are made.
+	close(vc.release)
+	require.Eventually(t, func() bool {
+		verified, _ := vc.calls()
+		return verified == 1+payloads-dropped
+	}, time.Second, time.Millisecond)
+}
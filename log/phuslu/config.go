@@ -27,8 +27,11 @@ type Config struct {
 	TimeFormat string `mapstructure:"time-format"`
 	// Logger will log messages with verbosity up to LogLevel.
 	LogLevel string `mapstructure:"log-level"`
-	// pretty or json.
+	// pretty, json or structured.
 	Style string `mapstructure:"style"`
+	// ModuleLevels overrides LogLevel for named modules, as a comma separated
+	// list of module=level pairs, e.g. "engine-client=debug,da=warn".
+	ModuleLevels string `mapstructure:"module-levels"`
 }
 
 // DefaultConfig is a function that returns a new Config with default values.
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"io"
+
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/phuslu/log"
+)
+
+// moduleKey is the context key set by Named, lifted to the top level of
+// structured records.
+const moduleKey = "module"
+
+// jsonRecord is a single structured log record. Its schema is stable so log
+// pipelines can index it without parsing the message.
+type jsonRecord struct {
+	TS     string            `json:"ts"`
+	Level  string            `json:"level"`
+	Module string            `json:"module,omitempty"`
+	Msg    string            `json:"msg"`
+	Fields map[string]string `json:"fields,omitempty"`
+	Caller string            `json:"caller,omitempty"`
+	Stack  string            `json:"stack,omitempty"`
+}
+
+// JSONFormatter formats log messages as single-line JSON records.
+type JSONFormatter struct{}
+
+// Format writes args to out as a JSON record followed by a line break.
+func (JSONFormatter) Format(
+	out io.Writer,
+	args *log.FormatterArgs,
+) (int, error) {
+	record := jsonRecord{
+		TS:     args.Time,
+		Level:  args.Level,
+		Msg:    args.Message,
+		Caller: args.Caller,
+		Stack:  args.Stack,
+	}
+	for _, kv := range args.KeyValues {
+		if kv.Key == moduleKey {
+			record.Module = kv.Value
+			continue
+		}
+		if record.Fields == nil {
+			record.Fields = make(map[string]string, len(args.KeyValues))
+		}
+		record.Fields[kv.Key] = kv.Value
+	}
+
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return 0, err
+	}
+	return out.Write(append(bz, '\n'))
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_StructuredStyle(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	cfg := phuslu.DefaultConfig()
+	cfg.Style = phuslu.StyleStructured
+	logger := phuslu.NewLogger(&buf, &cfg)
+
+	logger.Named("da").With("service", "da-store").Info("Stored sidecars", "slot", 7)
+	logger.Debug("Filtered out")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 1)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(lines[0], &record))
+	require.NotEmpty(t, record["ts"])
+	require.Equal(t, "info", record["level"])
+	require.Equal(t, "da", record["module"])
+	require.Equal(t, "Stored sidecars", record["msg"])
+	require.Equal(t, map[string]any{
+		"service": "da-store",
+		"slot":    "7",
+	}, record["fields"])
+}
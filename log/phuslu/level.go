@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/phuslu/log"
+)
+
+// ErrInvalidLevel is returned when setting an unknown log level.
+var ErrInvalidLevel = errors.New("invalid log level")
+
+// inheritLevel marks a module without a level override.
+const inheritLevel = -1
+
+// levels maps the accepted log level names to their levels.
+//
+//nolint:gochecknoglobals // read-only lookup table.
+var levels = map[string]log.Level{
+	"trace": log.TraceLevel,
+	"debug": log.DebugLevel,
+	"info":  log.InfoLevel,
+	"warn":  log.WarnLevel,
+	"error": log.ErrorLevel,
+	"fatal": log.FatalLevel,
+	"panic": log.PanicLevel,
+}
+
+// parseLevel returns the level with the given name.
+func parseLevel(level string) (log.Level, error) {
+	lvl, ok := levels[strings.ToLower(strings.TrimSpace(level))]
+	if !ok {
+		return 0, errors.Wrapf(ErrInvalidLevel, "%q", level)
+	}
+	return lvl, nil
+}
+
+// levelName returns the name of the given level.
+func levelName(lvl log.Level) string {
+	for name, v := range levels {
+		if v == lvl {
+			return name
+		}
+	}
+	return lvl.String()
+}
+
+// moduleLevels holds the level overrides of named modules. It is shared by
+// every logger derived from the same root.
+type moduleLevels struct {
+	mu     sync.Mutex
+	levels map[string]*atomic.Int32
+}
+
+// newModuleLevels creates an empty set of module levels.
+func newModuleLevels() *moduleLevels {
+	return &moduleLevels{levels: make(map[string]*atomic.Int32)}
+}
+
+// get returns the level override of module, registering the module without
+// an override if it is not known yet.
+func (m *moduleLevels) get(module string) *atomic.Int32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	lvl, ok := m.levels[module]
+	if !ok {
+		lvl = new(atomic.Int32)
+		lvl.Store(inheritLevel)
+		m.levels[module] = lvl
+	}
+	return lvl
+}
+
+// Named returns a logger for the given module. Its level follows the level
+// of the root logger unless overridden with SetModuleLevel.
+func (l *Logger) Named(module string) *Logger {
+	named := l.With("module", module)
+	named.module = l.modules.get(module)
+	return named
+}
+
+// SetLevel changes the level of the logger at runtime. The change applies to
+// every logger sharing the same root, including those derived with With,
+// except for modules with a level override.
+func (l *Logger) SetLevel(level string) error {
+	lvl, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.level.Store(uint32(lvl))
+	return nil
+}
+
+// Level returns the name of the level of the root logger.
+func (l *Logger) Level() string {
+	return levelName(log.Level(l.level.Load()))
+}
+
+// SetModuleLevel overrides the level of the given module at runtime. An empty
+// level removes the override so the module follows the root logger again.
+func (l *Logger) SetModuleLevel(module, level string) error {
+	if level == "" {
+		l.modules.get(module).Store(inheritLevel)
+		return nil
+	}
+	lvl, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.modules.get(module).Store(int32(lvl)) // #nosec G115 -- levels are small.
+	return nil
+}
+
+// ModuleLevels returns the known modules and their level. Modules without
+// an override report the level of the root logger.
+func (l *Logger) ModuleLevels() map[string]string {
+	l.modules.mu.Lock()
+	defer l.modules.mu.Unlock()
+	out := make(map[string]string, len(l.modules.levels))
+	for module, lvl := range l.modules.levels {
+		if v := lvl.Load(); v != inheritLevel {
+			out[module] = levelName(log.Level(v))
+			continue
+		}
+		out[module] = l.Level()
+	}
+	return out
+}
+
+// ApplyLevels sets the level of the root logger and of every module from the
+// given config. Modules missing from the config follow the root logger. The
+// module levels are left untouched if any of them is invalid.
+func (l *Logger) ApplyLevels(cfg *Config) error {
+	overrides, err := parseModuleLevels(cfg.ModuleLevels)
+
+	// Filtering happens in the wrapper, so the underlying logger lets every
+	// message through.
+	l.level.Store(uint32(log.ParseLevel(cfg.LogLevel)))
+	l.logger.Level = log.TraceLevel
+	if err != nil {
+		return err
+	}
+
+	l.modules.mu.Lock()
+	defer l.modules.mu.Unlock()
+	for _, lvl := range l.modules.levels {
+		lvl.Store(inheritLevel)
+	}
+	for module, lvl := range overrides {
+		v, ok := l.modules.levels[module]
+		if !ok {
+			v = new(atomic.Int32)
+			l.modules.levels[module] = v
+		}
+		v.Store(int32(lvl)) // #nosec G115 -- levels are small.
+	}
+	return nil
+}
+
+// parseModuleLevels parses a comma separated list of module=level pairs.
+func parseModuleLevels(s string) (map[string]log.Level, error) {
+	out := make(map[string]log.Level)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		module, level, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(module) == "" {
+			return nil, errors.Wrapf(ErrInvalidLevel, "%q is not a module=level pair", pair)
+		}
+		lvl, err := parseLevel(level)
+		if err != nil {
+			return nil, err
+		}
+		out[strings.TrimSpace(module)] = lvl
+	}
+	return out, nil
+}
+
+// enabled reports whether messages at the given level are logged.
+func (l *Logger) enabled(lvl log.Level) bool {
+	if l.module != nil {
+		if v := l.module.Load(); v != inheritLevel {
+			return log.Level(v) <= lvl
+		}
+	}
+	return log.Level(l.level.Load()) <= lvl
+}
@@ -22,6 +22,7 @@ package phuslu
 
 import (
 	"io"
+	"sync/atomic"
 
 	"github.com/phuslu/log"
 )
@@ -36,6 +37,15 @@ type Logger struct {
 	out io.Writer
 	// formatter is the formatter to use for the logger.
 	formatter *Formatter
+	// level is the minimum level of logged messages. It is shared with the
+	// loggers derived with With so it can be changed at runtime.
+	level *atomic.Uint32
+	// modules holds the level overrides of the named modules derived from
+	// the same root logger.
+	modules *moduleLevels
+	// module is the level override of this logger's module, nil if the
+	// logger is not named.
+	module *atomic.Int32
 }
 
 // NewLogger initializes a new wrapped phuslogger with the provided config.
@@ -48,6 +58,8 @@ func NewLogger(
 		context:   make(log.Fields),
 		out:       out,
 		formatter: NewFormatter(),
+		level:     new(atomic.Uint32),
+		modules:   newModuleLevels(),
 	}
 	logger.WithConfig(cfg)
 	return logger
@@ -55,7 +67,7 @@ func NewLogger(
 
 // Info logs a message at level Info.
 func (l *Logger) Info(msg string, keyVals ...any) {
-	if l.logger.Level > log.InfoLevel {
+	if !l.enabled(log.InfoLevel) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Info(), keyVals...)
@@ -63,7 +75,7 @@ func (l *Logger) Info(msg string, keyVals ...any) {
 
 // Warn logs a message at level Warn.
 func (l *Logger) Warn(msg string, keyVals ...any) {
-	if l.logger.Level > log.WarnLevel {
+	if !l.enabled(log.WarnLevel) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Warn(), keyVals...)
@@ -71,7 +83,7 @@ func (l *Logger) Warn(msg string, keyVals ...any) {
 
 // Error logs a message at level Error.
 func (l *Logger) Error(msg string, keyVals ...any) {
-	if l.logger.Level > log.ErrorLevel {
+	if !l.enabled(log.ErrorLevel) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Error(), keyVals...)
@@ -79,7 +91,7 @@ func (l *Logger) Error(msg string, keyVals ...any) {
 
 // Debug logs a message at level Debug.
 func (l *Logger) Debug(msg string, keyVals ...any) {
-	if l.logger.Level > log.DebugLevel {
+	if !l.enabled(log.DebugLevel) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Debug(), keyVals...)
@@ -138,7 +150,9 @@ func (l *Logger) WithConfig(cfg *Config) *Logger {
 	}
 	l.withTimeFormat(cfg.TimeFormat)
 	l.withStyle(cfg.Style)
-	l.withLogLevel(cfg.LogLevel)
+	if err := l.ApplyLevels(cfg); err != nil {
+		l.Warn("Ignoring invalid module log levels", "error", err)
+	}
 	return l
 }
 
@@ -157,18 +171,16 @@ func (l *Logger) AddKeyValColor(key any, val any, color Color) {
 
 // sets the style of the logger.
 func (l *Logger) withStyle(style string) {
-	if style == StylePretty {
+	switch style {
+	case StylePretty:
 		l.useConsoleWriter()
-	} else if style == StyleJSON {
+	case StyleJSON:
 		l.useJSONWriter()
+	case StyleStructured:
+		l.useStructuredWriter()
 	}
 }
 
-// SetLevel sets the log level of the logger.
-func (l *Logger) withLogLevel(level string) {
-	l.logger.Level = log.ParseLevel(level)
-}
-
 // useConsoleWriter sets the logger to use a console writer.
 func (l *Logger) useConsoleWriter() {
 	l.setWriter(&log.ConsoleWriter{
@@ -182,6 +194,14 @@ func (l *Logger) useJSONWriter() {
 	l.setWriter(log.IOWriter{Writer: l.out})
 }
 
+// useStructuredWriter sets the logger to write single-line JSON records.
+func (l *Logger) useStructuredWriter() {
+	l.setWriter(&log.ConsoleWriter{
+		Writer:    l.out,
+		Formatter: JSONFormatter{}.Format,
+	})
+}
+
 // setWriter sets the writer of the logger.
 func (l *Logger) setWriter(writer log.Writer) {
 	l.logger.Writer = writer
@@ -61,4 +61,7 @@ const (
 	// output styles flags.
 	StylePretty = "pretty"
 	StyleJSON   = "json"
+	// StyleStructured outputs single-line JSON records with a stable
+	// ts, level, module, msg and fields schema.
+	StyleStructured = "structured"
 )
@@ -21,26 +21,85 @@
 package backend
 
 import (
+	"context"
+	"math/big"
 	"sync/atomic"
 
+	"github.com/berachain/beacon-kit/beacon/pool"
 	"github.com/berachain/beacon-kit/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
+// ExecutionClient reads execution layer state.
+type ExecutionClient interface {
+	// BalanceAt returns the balance in Wei of the given account at the given
+	// block number.
+	BalanceAt(
+		ctx context.Context, account common.ExecutionAddress, number *big.Int,
+	) (*big.Int, error)
+	// FeeHistory returns the fee history of the last blockCount blocks.
+	FeeHistory(
+		ctx context.Context, blockCount uint64, rewardPercentiles []float64,
+	) (*ethclient.FeeHistory, error)
+}
+
+// OptimisticTracker tracks the execution blocks imported optimistically while
+// the execution client is syncing.
+type OptimisticTracker interface {
+	// IsOptimistic returns true if the execution block with the given
+	// number is imported optimistically and not validated yet.
+	IsOptimistic(number math.U64) bool
+	// Len returns the number of optimistic blocks.
+	Len() int
+}
+
+// StateProcessor is the subset of the state processor used to advance query
+// states, e.g. to preview the data of the next block.
+type StateProcessor interface {
+	ProcessSlots(st *statedb.StateDB, slot math.Slot) (transition.ValidatorUpdates, error)
+	ProcessFork(st *statedb.StateDB, timestamp math.U64, logUpgrade bool) error
+	ValidateBLSToExecutionChange(st *statedb.StateDB, signed *ctypes.SignedBLSToExecutionChange) error
+	ValidateVoluntaryExit(st *statedb.StateDB, signed *ctypes.SignedVoluntaryExit) error
+	ActivationQueue(st *statedb.StateDB) ([]*core.QueuedValidator, error)
+	ValidateUnjail(st *statedb.StateDB, signed *ctypes.SignedUnjail) error
+	SimulateDeposit(
+		st *statedb.StateDB, pending ctypes.Deposits, dep *ctypes.Deposit,
+	) (*core.DepositSimulation, error)
+}
+
 // Backend is the db access layer for the beacon node-api.
 // It serves as a wrapper around the storage backend and provides an abstraction
 // over building the query context for a given state.
 type Backend struct {
 	sb   *storage.Backend
 	cs   chain.Spec
+	sp   StateProcessor
+	el   ExecutionClient
 	node types.ConsensusService
 
+	// optimistic tracks the payloads imported while the execution client is
+	// syncing, nil if not tracked.
+	optimistic OptimisticTracker
+
+	// blsChanges holds the BLS to execution changes submitted through the API.
+	blsChanges *pool.Pool[*ctypes.SignedBLSToExecutionChange]
+	// voluntaryExits holds the voluntary exits submitted through the API.
+	voluntaryExits *pool.Pool[*ctypes.SignedVoluntaryExit]
+	// unjails holds the unjails submitted through the API.
+	unjails *pool.Pool[*ctypes.SignedUnjail]
+
 	// genesisValidatorsRoot is cached in the backend.
 	genesisValidatorsRoot atomic.Pointer[common.Root]
 
@@ -51,15 +110,26 @@ type Backend struct {
 	genesisForkVersion atomic.Pointer[common.Version]
 }
 
-// New creates and returns a new Backend instance.
+// New creates and returns a new Backend instance. The operation pools are
+// persisted to poolDB, or kept in memory only if it is nil.
 func New(
 	storageBackend *storage.Backend,
 	cs chain.Spec,
 	cmtCfg *cmtcfg.Config,
+	sp StateProcessor,
+	el ExecutionClient,
+	optimistic OptimisticTracker,
+	poolDB dbm.DB,
 ) (*Backend, error) {
 	b := &Backend{
-		sb: storageBackend,
-		cs: cs,
+		sb:         storageBackend,
+		cs:         cs,
+		sp:         sp,
+		el:         el,
+		optimistic: optimistic,
+	}
+	if err := b.initPools(poolDB); err != nil {
+		return nil, err
 	}
 
 	// Load the genesis file from cometbft config.
@@ -106,6 +176,18 @@ func (b *Backend) GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error
 	return b.sb.BlockStore().GetParentSlotByTimestamp(timestamp)
 }
 
+// GetSlotByExecutionHash retrieves the slot by an execution block hash from
+// the block store.
+func (b *Backend) GetSlotByExecutionHash(blockHash common.ExecutionHash) (math.Slot, error) {
+	return b.sb.BlockStore().GetSlotByExecutionHash(blockHash)
+}
+
+// GetSlotByExecutionNumber retrieves the slot by an execution block number
+// from the block store.
+func (b *Backend) GetSlotByExecutionNumber(blockNumber math.U64) (math.Slot, error) {
+	return b.sb.BlockStore().GetSlotByExecutionNumber(blockNumber)
+}
+
 // Spec returns the chain spec used by the backend.
 func (b *Backend) Spec() (chain.Spec, error) {
 	if b.cs == nil {
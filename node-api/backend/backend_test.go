@@ -28,6 +28,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/chain"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -79,3 +80,15 @@ func (t *testConsensusService) Name() string {
 func (t *testConsensusService) LastBlockHeight() int64 {
 	panic(errTestMemberNotImplemented)
 }
+
+func (t *testConsensusService) ExpectedProposerAddresses(int) ([][]byte, error) {
+	return nil, errTestMemberNotImplemented
+}
+
+func (t *testConsensusService) SyncStatus() (int64, bool, error) {
+	return 0, false, errTestMemberNotImplemented
+}
+
+func (t *testConsensusService) Peers() []*consensustypes.PeerInfo {
+	panic(errTestMemberNotImplemented)
+}
@@ -51,7 +51,7 @@ func (b *Backend) BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*ap
 	}
 
 	// Validate request indices.
-	if uint64(len(indices)) >= b.cs.MaxBlobsPerBlock() {
+	if uint64(len(indices)) > b.cs.MaxBlobsPerBlock() {
 		return nil, errors.New("too many indices requested")
 	}
 	for _, index := range indices {
@@ -60,34 +60,16 @@ func (b *Backend) BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*ap
 		}
 	}
 
-	blobSidecars, err := b.sb.AvailabilityStore().GetBlobSidecars(slot)
+	// Only the requested sidecars are loaded. Each of them carries the proof of
+	// inclusion of its commitment in the block body.
+	blobSidecars, err := b.sb.AvailabilityStore().GetBlobSidecarsByIndices(slot, indices)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a map of requested indices for O(1) index lookups.
-	isRequestIndex := make(map[uint64]bool)
-	for _, idx := range indices {
-		isRequestIndex[idx] = true
-	}
-
-	// Preallocate response slice - if indices specified, size will be len(indices),
-	// otherwise size will be all sidecars.
-	responseCap := len(blobSidecars)
-	if len(indices) > 0 {
-		responseCap = len(indices)
-	}
-	blobSidecarsResponse := make([]*apitypes.Sidecar, 0, responseCap)
-
-	for _, blobSidecar := range blobSidecars {
-		// Skip if indices specified and this index not requested.
-		if len(indices) > 0 && !isRequestIndex[blobSidecar.GetIndex()] {
-			continue
-		}
-		// Craft and append the blob sidecar serialized data to the response.
-		blobSidecarsResponse = append(blobSidecarsResponse,
-			apitypes.SidecarFromConsensus(blobSidecar),
-		)
+	blobSidecarsResponse := make([]*apitypes.Sidecar, len(blobSidecars))
+	for i, blobSidecar := range blobSidecars {
+		blobSidecarsResponse[i] = apitypes.SidecarFromConsensus(blobSidecar)
 	}
 	return blobSidecarsResponse, nil
 }
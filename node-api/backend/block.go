@@ -21,11 +21,15 @@
 package backend
 
 import (
+	"context"
+	"math/big"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/block"
 )
 
 // BlockHeaderAtSlot returns the block header at the given slot.
@@ -63,14 +67,77 @@ func (b *Backend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
 	return blockHeader.HashTreeRoot(), nil
 }
 
-// TODO: Implement this.
-func (b *Backend) BlockRewardsAtSlot(_ math.Slot) (*types.BlockRewardsData, error) {
+// BlockRewardsAtSlot returns the rewards of the proposer of the block at the
+// given slot, in Gwei. The consensus layer pays no attestation, sync committee
+// or slashing rewards, so the total is what the block's fee recipient earned
+// from its execution payload, i.e. the increase of its balance over the block.
+func (b *Backend) BlockRewardsAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (*types.BlockRewardsData, error) {
+	st, _, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+	blockHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get latest block header")
+	}
+	payloadHeader, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get latest execution payload header")
+	}
+
+	fees, err := b.feeRecipientDelta(
+		ctx, payloadHeader.GetFeeRecipient(), payloadHeader.GetNumber(),
+	)
+	if err != nil {
+		return nil, err
+	}
 	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
+		ProposerIndex: blockHeader.GetProposerIndex().Unwrap(),
+		Total:         fees.Unwrap(),
 	}, nil
 }
+
+// feeRecipientDelta returns the increase of the balance of the fee recipient
+// over the given execution block, in Gwei. A decrease counts as no reward.
+func (b *Backend) feeRecipientDelta(
+	ctx context.Context,
+	feeRecipient common.ExecutionAddress,
+	number math.U64,
+) (math.Gwei, error) {
+	if number == 0 {
+		return 0, nil
+	}
+	if b.el == nil {
+		return 0, errors.New("execution client not available")
+	}
+
+	after, err := b.el.BalanceAt(ctx, feeRecipient, new(big.Int).SetUint64(number.Unwrap()))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get fee recipient balance at block %d", number)
+	}
+	before, err := b.el.BalanceAt(ctx, feeRecipient, new(big.Int).SetUint64(number.Unwrap()-1))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get fee recipient balance at block %d", number-1)
+	}
+
+	delta := after.Sub(after, before)
+	if delta.Sign() <= 0 {
+		return 0, nil
+	}
+	return math.GweiFromWei(delta)
+}
+
+// PayloadSummaries returns the execution payload summaries of up to the last
+// count finalized blocks held by the block store, in ascending slot order.
+func (b *Backend) PayloadSummaries(count uint64) []block.PayloadSummary {
+	return b.sb.BlockStore().GetPayloadSummaries(count)
+}
+
+// PayloadSummaryAtSlot returns the execution payload summary of the block at
+// the given slot from the block store.
+func (b *Backend) PayloadSummaryAtSlot(slot math.Slot) (block.PayloadSummary, error) {
+	return b.sb.BlockStore().GetPayloadSummary(slot)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// ProcessedDepositCount returns the number of deposits processed by the
+// beacon chain as of the latest committed state.
+func (b *Backend) ProcessedDepositCount() (uint64, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get latest state")
+	}
+	return st.GetEth1DepositIndex()
+}
+
+// DepositsByIndex returns up to count deposits from the deposit store,
+// starting at the given index.
+func (b *Backend) DepositsByIndex(
+	ctx context.Context,
+	startIndex uint64,
+	count uint64,
+) (ctypes.Deposits, error) {
+	deposits, _, err := b.sb.DepositStore().GetDepositsByIndex(ctx, startIndex, count)
+	return deposits, err
+}
+
+// DepositLocation returns the execution layer location of the deposit at the
+// given index, or nil if it is unknown.
+func (b *Backend) DepositLocation(
+	ctx context.Context,
+	index uint64,
+) (*ctypes.DepositLocation, error) {
+	return b.sb.DepositStore().GetDepositLocation(ctx, index)
+}
+
+// HeadExecutionNumber returns the number of the execution block of the
+// latest committed state, which is the latest execution block deposits
+// were counted confirmations from.
+func (b *Backend) HeadExecutionNumber() (math.U64, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get latest state")
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get latest execution payload header")
+	}
+	return header.GetNumber(), nil
+}
+
+// SimulateDeposit reports the effect the deposit would have if it was
+// processed on top of the latest committed state, after the deposits read
+// from the execution layer but not processed yet.
+func (b *Backend) SimulateDeposit(
+	ctx context.Context,
+	dep *ctypes.Deposit,
+) (*core.DepositSimulation, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get latest state")
+	}
+	processed, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	latest, err := b.sb.DepositStore().GetLatestDepositLocation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending ctypes.Deposits
+	if latest != nil && latest.Index.Unwrap() >= processed {
+		count := latest.Index.Unwrap() - processed + 1
+		if pending, _, err = b.sb.DepositStore().GetDepositsByIndex(ctx, processed, count); err != nil {
+			return nil, err
+		}
+	}
+	return b.sp.SimulateDeposit(st, pending, dep)
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// ErrEpochTooFarAhead is returned when duties are requested for an epoch
+// beyond the next one, for which no assignment can be predicted.
+var ErrEpochTooFarAhead = errors.New("epoch is too far in the future")
+
+// ProposerDuties returns the proposer of every slot in the given epoch, along
+// with the dependent root of the assignments. Proposers of committed slots are
+// read from the stored block headers, while proposers of upcoming slots are
+// predicted from the CometBFT proposer schedule. Only the current and next
+// epochs can be requested.
+func (b *Backend) ProposerDuties(
+	epoch math.Epoch,
+) (common.Root, []*validatortypes.ProposerDutyData, error) {
+	//#nosec:G115 // LastBlockHeight is never negative.
+	head := math.Slot(b.node.LastBlockHeight())
+	if epoch > b.cs.SlotToEpoch(head)+1 {
+		return common.Root{}, nil, errors.Wrapf(
+			ErrEpochTooFarAhead, "requested epoch %d, head epoch %d", epoch, b.cs.SlotToEpoch(head),
+		)
+	}
+
+	st, _, err := b.StateAtSlot(head)
+	if err != nil {
+		return common.Root{}, nil, errors.Wrapf(err, "failed to get state from slot %d", head)
+	}
+
+	slotsPerEpoch := b.cs.SlotsPerEpoch()
+	start := math.Slot(epoch.Unwrap() * slotsPerEpoch)
+	end := start + math.Slot(slotsPerEpoch)
+
+	// Predict the proposers of all the upcoming slots in the epoch at once.
+	var expected [][]byte
+	if end > head+1 {
+		//#nosec:G115 // bounded by two epochs worth of slots.
+		expected, err = b.node.ExpectedProposerAddresses(int(end - head - 1))
+		if err != nil {
+			return common.Root{}, nil, errors.Wrap(err, "failed to get expected proposers")
+		}
+	}
+
+	duties := make([]*validatortypes.ProposerDutyData, 0, slotsPerEpoch)
+	for slot := start; slot < end; slot++ {
+		// The genesis slot has no proposer.
+		if slot == 0 {
+			continue
+		}
+
+		var index math.ValidatorIndex
+		if slot <= head {
+			index, err = b.committedProposerIndex(slot)
+		} else {
+			index, err = proposerIndexByAddress(st, expected[slot-head-1])
+		}
+		if err != nil {
+			return common.Root{}, nil, err
+		}
+
+		proposer, errVal := st.ValidatorByIndex(index)
+		if errVal != nil {
+			return common.Root{}, nil, errors.Wrapf(errVal, "failed to get validator at index %d", index)
+		}
+		duties = append(duties, &validatortypes.ProposerDutyData{
+			Pubkey:         proposer.GetPubkey(),
+			ValidatorIndex: index.Unwrap(),
+			Slot:           slot.Unwrap(),
+		})
+	}
+
+	dependentRoot, err := b.proposerDependentRoot(start, head)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	return dependentRoot, duties, nil
+}
+
+// committedProposerIndex returns the proposer index of the block committed at
+// the given slot.
+func (b *Backend) committedProposerIndex(slot math.Slot) (math.ValidatorIndex, error) {
+	header, err := b.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get block header at slot %d", slot)
+	}
+	return header.GetProposerIndex(), nil
+}
+
+// proposerIndexByAddress returns the index of the validator whose CometBFT
+// address matches the given one, through the CometBFT address index of the
+// state.
+func proposerIndexByAddress(st *statedb.StateDB, address []byte) (math.ValidatorIndex, error) {
+	index, err := st.ValidatorIndexByCometBFTAddress(address)
+	switch {
+	case err == nil:
+		return index, nil
+	case errors.Is(err, collections.ErrNotFound):
+		return 0, errors.Wrapf(ErrValidatorNotFound, "no validator with comet address %x", address)
+	default:
+		return 0, errors.Wrapf(err, "failed to get validator index by comet address %x", address)
+	}
+}
+
+// proposerDependentRoot returns the root of the last block before the given
+// epoch start slot, capped at head. The genesis epoch has no dependent block,
+// and slot 0 cannot be queried as it resolves to the head state.
+func (b *Backend) proposerDependentRoot(start, head math.Slot) (common.Root, error) {
+	if start <= 1 {
+		return common.Root{}, nil
+	}
+	return b.BlockRootAtSlot(min(start-1, head))
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ErrSlotNotReached is returned when the fork version of a slot the chain has
+// not reached yet is requested. Forks activate by timestamp, so it cannot be
+// predicted.
+var ErrSlotNotReached = errors.New("slot not reached yet")
+
+// ForkVersionAtSlot returns the fork version of the committed state at the
+// given slot, resolving a slot of 0 to the latest slot.
+func (b *Backend) ForkVersionAtSlot(slot math.Slot) (common.Version, error) {
+	//#nosec:G115 // LastBlockHeight is never negative.
+	if head := math.Slot(b.node.LastBlockHeight()); slot > head {
+		return common.Version{}, errors.Wrapf(
+			ErrSlotNotReached, "requested slot %d, head slot %d", slot, head,
+		)
+	}
+	st, _, err := b.StateAtSlot(slot)
+	if err != nil {
+		return common.Version{}, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+	fork, err := st.GetFork()
+	if err != nil {
+		return common.Version{}, errors.Wrapf(err, "failed to get fork from slot %d", slot)
+	}
+	return fork.CurrentVersion, nil
+}
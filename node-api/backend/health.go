@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SyncStatus returns the number of blocks the node is behind its peers and
+// whether it is still catching up.
+func (b *Backend) SyncStatus() (int64, bool, error) {
+	return b.node.SyncStatus()
+}
+
+// HeadExecutionTimestamp returns the latest committed slot and the timestamp
+// of its execution payload.
+func (b *Backend) HeadExecutionTimestamp() (math.Slot, math.U64, error) {
+	st, slot, err := b.StateAtSlot(0)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to get latest state")
+	}
+
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return slot, 0, errors.Wrapf(err, "failed to get latest execution payload header")
+	}
+	return slot, header.GetTimestamp(), nil
+}
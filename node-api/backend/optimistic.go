@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ExecutionOptimistic returns true if the execution payload of the state at
+// the given slot was imported optimistically and is not validated yet by the
+// execution client.
+func (b *Backend) ExecutionOptimistic(slot math.Slot) (bool, error) {
+	// Avoid loading the state when no payload is optimistic, i.e. whenever
+	// the execution client is synced.
+	if b.optimistic == nil || b.optimistic.Len() == 0 {
+		return false, nil
+	}
+	st, _, err := b.StateAtSlot(slot)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get latest execution payload header")
+	}
+	return b.optimistic.IsOptimistic(lph.GetNumber()), nil
+}
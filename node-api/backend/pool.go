@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/beacon/pool"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	blsChangesPoolPrefix     = "bls_to_execution_changes/"
+	voluntaryExitsPoolPrefix = "voluntary_exits/"
+	unjailsPoolPrefix        = "unjails/"
+)
+
+// initPools creates the operation pools, persisted to db if set. Every
+// operation applies at most once per validator, so the registry limit bounds
+// the pools.
+func (b *Backend) initPools(db dbm.DB) error {
+	//#nosec: G115 // the registry limit fits into an int.
+	limit := int(b.cs.ValidatorRegistryLimit())
+
+	var err error
+	b.blsChanges, err = newPool(limit, db, blsChangesPoolPrefix,
+		func(bz []byte) (*ctypes.SignedBLSToExecutionChange, error) {
+			change := ctypes.NewEmptySignedBLSToExecutionChange()
+			return change, change.UnmarshalSSZ(bz)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed loading BLS to execution changes pool: %w", err)
+	}
+	b.voluntaryExits, err = newPool(limit, db, voluntaryExitsPoolPrefix,
+		func(bz []byte) (*ctypes.SignedVoluntaryExit, error) {
+			exit := ctypes.NewEmptySignedVoluntaryExit()
+			return exit, exit.UnmarshalSSZ(bz)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed loading voluntary exits pool: %w", err)
+	}
+	b.unjails, err = newPool(limit, db, unjailsPoolPrefix,
+		func(bz []byte) (*ctypes.SignedUnjail, error) {
+			unjail := ctypes.NewEmptySignedUnjail()
+			return unjail, unjail.UnmarshalSSZ(bz)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed loading unjails pool: %w", err)
+	}
+	return nil
+}
+
+func newPool[T interface{ MarshalSSZ() ([]byte, error) }](
+	limit int, db dbm.DB, prefix string, decode func([]byte) (T, error),
+) (*pool.Pool[T], error) {
+	if db == nil {
+		return pool.New[T](limit), nil
+	}
+	return pool.NewPersistent(limit, dbm.NewPrefixDB(db, []byte(prefix)), pool.Codec[T]{
+		Encode: func(op T) ([]byte, error) { return op.MarshalSSZ() },
+		Decode: decode,
+	})
+}
+
+// SubmitBLSToExecutionChanges validates the given changes against the head
+// state and adds the valid ones to the pool. The returned slice holds the
+// validation error of each change, nil for the accepted ones.
+func (b *Backend) SubmitBLSToExecutionChanges(
+	changes []*ctypes.SignedBLSToExecutionChange,
+) ([]error, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading head state: %w", err)
+	}
+
+	failures := make([]error, len(changes))
+	for i, change := range changes {
+		if err = b.sp.ValidateBLSToExecutionChange(st, change); err != nil {
+			failures[i] = err
+			continue
+		}
+		if _, err = b.blsChanges.Insert(change.Message.ValidatorIndex, change); err != nil {
+			failures[i] = err
+		}
+	}
+	return failures, nil
+}
+
+// BLSToExecutionChanges returns the pooled BLS to execution changes, ordered by
+// validator index. Changes which no longer apply, e.g. since they were included
+// in a finalized block, are pruned.
+func (b *Backend) BLSToExecutionChanges() ([]*ctypes.SignedBLSToExecutionChange, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading head state: %w", err)
+	}
+	if err = b.blsChanges.Prune(func(idx math.ValidatorIndex, change *ctypes.SignedBLSToExecutionChange) bool {
+		validator, errVal := st.ValidatorByIndex(idx)
+		return errVal == nil && change.Message.ValidateForValidator(validator) == nil
+	}); err != nil {
+		return nil, err
+	}
+	return b.blsChanges.All(), nil
+}
+
+// SubmitVoluntaryExit validates the given exit against the head state and adds
+// it to the pool.
+func (b *Backend) SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return fmt.Errorf("failed loading head state: %w", err)
+	}
+	if err = b.sp.ValidateVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+	_, err = b.voluntaryExits.Insert(exit.Message.ValidatorIndex, exit)
+	return err
+}
+
+// VoluntaryExits returns the pooled voluntary exits, ordered by validator
+// index. Exits of validators which exited in the meantime, e.g. through a
+// withdrawal request, are pruned.
+func (b *Backend) VoluntaryExits() ([]*ctypes.SignedVoluntaryExit, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading head state: %w", err)
+	}
+	if err = b.voluntaryExits.Prune(func(idx math.ValidatorIndex, _ *ctypes.SignedVoluntaryExit) bool {
+		validator, errVal := st.ValidatorByIndex(idx)
+		return errVal == nil && validator.GetExitEpoch() == constants.FarFutureEpoch
+	}); err != nil {
+		return nil, err
+	}
+	return b.voluntaryExits.All(), nil
+}
+
+// SubmitUnjail validates the given unjail against the head state and adds it
+// to the pool, for the next proposers to include it.
+func (b *Backend) SubmitUnjail(unjail *ctypes.SignedUnjail) error {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return fmt.Errorf("failed loading head state: %w", err)
+	}
+	if err = b.sp.ValidateUnjail(st, unjail); err != nil {
+		return err
+	}
+	_, err = b.unjails.Insert(unjail.Message.ValidatorIndex, unjail)
+	return err
+}
+
+// Unjails returns the pooled unjails, ordered by validator index. Unjails of
+// validators which are no longer jailed for the slot they were signed for,
+// e.g. because they were included in a block, are pruned.
+func (b *Backend) Unjails() ([]*ctypes.SignedUnjail, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading head state: %w", err)
+	}
+	if err = b.unjails.Prune(func(idx math.ValidatorIndex, unjail *ctypes.SignedUnjail) bool {
+		jailedUntil, jailed, errJail := st.GetJailedUntil(idx)
+		return errJail == nil && jailed && jailedUntil == unjail.Message.JailedUntil
+	}); err != nil {
+		return nil, err
+	}
+	return b.unjails.All(), nil
+}
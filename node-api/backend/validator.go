@@ -21,16 +21,20 @@
 package backend
 
 import (
+	"cmp"
 	"slices"
+	"strings"
 
 	"cosmossdk.io/collections"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
 )
 
 // ErrValidatorNotFound is an error for when a validator is not found.
@@ -75,8 +79,10 @@ func (f *validatorFilters) parseID(id string) {
 	// Silently skip invalid IDs
 }
 
-// FilteredValidators will grab all of the validators from the state at the
-// given slot. It will then filter them by the provided ids and statuses.
+// FilteredValidators returns the validators of the state at the given slot
+// that match the provided ids and statuses. Validators requested by id are
+// resolved through the pubkey index of the state, so that only the full
+// registry is scanned when no id is provided.
 func (b *Backend) FilteredValidators(
 	slot math.Slot, ids []string, statuses []string,
 ) ([]*beacontypes.ValidatorData, error) {
@@ -85,83 +91,106 @@ func (b *Backend) FilteredValidators(
 		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot)
 	}
 
-	validators, err := st.GetValidators()
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get validators")
-	}
-
 	// Parse all IDs and pubkeys once at the start
 	filters := parseValidatorIDs(ids)
 	epoch := b.cs.SlotToEpoch(resolvedSlot)
 
-	return filterAndBuildValidatorData(st, validators, filters, epoch, statuses)
-}
-
-// filterAndBuildValidatorData processes all validators and builds their data based on filters
-func filterAndBuildValidatorData(
-	st *statedb.StateDB,
-	validators []*types.Validator,
-	filters *validatorFilters,
-	epoch math.Epoch,
-	statuses []string,
-) ([]*beacontypes.ValidatorData, error) {
-	validatorData := make([]*beacontypes.ValidatorData, 0, len(validators))
-
-	for _, validator := range validators {
-		index, err := st.ValidatorIndexByPubkey(validator.GetPubkey())
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get validator index by pubkey %s", validator.GetPubkey())
+	if len(filters.numericIDs) == 0 && len(filters.pubkeys) == 0 {
+		validators, errVals := st.GetValidators()
+		if errVals != nil {
+			return nil, errors.Wrapf(errVals, "failed to get validators")
 		}
-
-		if !matchesFilters(validator, index, filters) {
-			continue
+		validatorData := make([]*beacontypes.ValidatorData, 0, len(validators))
+		for i, validator := range validators {
+			//#nosec:G115 // i comes from a range loop.
+			validatorData, err = appendValidatorData(
+				validatorData, st, validator, math.U64(i), epoch, statuses,
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
+		return validatorData, nil
+	}
 
-		data, err := buildValidatorData(st, validator, index, epoch, statuses)
+	indices, err := filters.resolveIndices(st)
+	if err != nil {
+		return nil, err
+	}
+	validatorData := make([]*beacontypes.ValidatorData, 0, len(indices))
+	for _, index := range indices {
+		validator, errVal := st.ValidatorByIndex(index)
 		switch {
-		case err == nil:
-			validatorData = append(validatorData, data)
-		case errors.Is(err, ErrStatusFilterMismatch):
+		case errVal == nil:
+		case errors.Is(errVal, collections.ErrNotFound):
+			// Silently skip unknown indices.
 			continue
 		default:
+			return nil, errors.Wrapf(errVal, "failed to get validator by index %d", index)
+		}
+		validatorData, err = appendValidatorData(
+			validatorData, st, validator, index, epoch, statuses,
+		)
+		if err != nil {
 			return nil, err
 		}
 	}
-
 	return validatorData, nil
 }
 
-// matchesFilters checks if a validator matches the filters.
-func matchesFilters(validator *types.Validator, index math.U64, filters *validatorFilters) bool {
-	// If no filters, accept all validators
-	if len(filters.numericIDs) == 0 && len(filters.pubkeys) == 0 {
-		return true
+// resolveIndices returns the sorted and deduplicated indices of the
+// validators matching the filters, looking pubkeys up in the pubkey index of
+// the state. Unknown pubkeys are skipped.
+func (f *validatorFilters) resolveIndices(st *statedb.StateDB) ([]math.ValidatorIndex, error) {
+	indices := make([]math.ValidatorIndex, 0, len(f.numericIDs)+len(f.pubkeys))
+	for _, id := range f.numericIDs {
+		indices = append(indices, math.ValidatorIndex(id))
 	}
-
-	// Check numeric IDs
-	if len(filters.numericIDs) > 0 && matchesIndex(index, filters.numericIDs) {
-		return true
-	}
-
-	// Check pubkeys
-	if len(filters.pubkeys) > 0 && matchesPubkey(validator, filters.pubkeys) {
-		return true
+	for _, pubkey := range f.pubkeys {
+		index, err := st.ValidatorIndexByPubkey(pubkey)
+		switch {
+		case err == nil:
+			indices = append(indices, index)
+		case errors.Is(err, collections.ErrNotFound):
+			continue
+		default:
+			return nil, errors.Wrapf(err, "failed to get validator index by pubkey %s", pubkey)
+		}
 	}
-
-	return false
-}
-
-func matchesPubkey(validator *types.Validator, parsedPubkeys []crypto.BLSPubkey) bool {
-	validatorPubkey := validator.GetPubkey()
-	return slices.Contains(parsedPubkeys, validatorPubkey)
+	slices.Sort(indices)
+	return slices.Compact(indices), nil
 }
 
-func matchesIndex(index math.U64, ids []uint64) bool {
-	return slices.Contains(ids, index.Unwrap())
+// appendValidatorData appends the data of the validator to validatorData if
+// it matches the status filter.
+func appendValidatorData(
+	validatorData []*beacontypes.ValidatorData,
+	st *statedb.StateDB,
+	validator *types.Validator,
+	index math.U64,
+	epoch math.Epoch,
+	statuses []string,
+) ([]*beacontypes.ValidatorData, error) {
+	data, err := buildValidatorData(st, validator, index, epoch, statuses)
+	switch {
+	case err == nil:
+		return append(validatorData, data), nil
+	case errors.Is(err, ErrStatusFilterMismatch):
+		return validatorData, nil
+	default:
+		return nil, err
+	}
 }
 
+// matchesStatusFilter checks if a validator status matches the status filter,
+// either exactly or by status category (e.g. "active" matches "active_ongoing").
 func matchesStatusFilter(status string, statuses []string) bool {
-	return len(statuses) == 0 || slices.Contains(statuses, status)
+	if len(statuses) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(statuses, func(filter string) bool {
+		return status == filter || strings.HasPrefix(status, filter+"_")
+	})
 }
 
 func buildValidatorData(
@@ -171,14 +200,29 @@ func buildValidatorData(
 	epoch math.Epoch,
 	statuses []string,
 ) (*beacontypes.ValidatorData, error) {
-	status, err := validator.Status(epoch)
+	data, err := validatorData(st, validator, index, epoch)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get validator status for validator pubkey %s and index %d", validator.GetPubkey(), index)
+		return nil, err
 	}
-
-	if !matchesStatusFilter(status, statuses) {
+	if !matchesStatusFilter(data.Status, statuses) {
 		return nil, ErrStatusFilterMismatch
 	}
+	return data, nil
+}
+
+// validatorData builds the API representation of the validator at the given
+// index, deriving its lifecycle status relative to the given epoch. All
+// validator responses of the node API are expected to be built through it.
+func validatorData(
+	st *statedb.StateDB,
+	validator *types.Validator,
+	index math.U64,
+	epoch math.Epoch,
+) (*beacontypes.ValidatorData, error) {
+	status, err := validator.Status(epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get validator status for validator pubkey %s and index %d", validator.GetPubkey(), index)
+	}
 
 	balance, err := st.GetBalance(index)
 	if err != nil {
@@ -219,22 +263,7 @@ func (b *Backend) ValidatorByID(slot math.Slot, id string) (*beacontypes.Validat
 	default:
 		return nil, errors.Wrapf(err, "failed to get validator by index %d", index)
 	}
-	balance, err := st.GetBalance(index)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get validator balance for validator pubkey %s and index %d", validator.GetPubkey(), index)
-	}
-	status, err := validator.Status(b.cs.SlotToEpoch(resolvedSlot))
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get validator status for validator pubkey %s and index %d", validator.GetPubkey(), index)
-	}
-	return &beacontypes.ValidatorData{
-		ValidatorBalanceData: beacontypes.ValidatorBalanceData{
-			Index:   index.Unwrap(),
-			Balance: balance.Unwrap(),
-		},
-		Status:    status,
-		Validator: beacontypes.ValidatorFromConsensus(validator),
-	}, nil
+	return validatorData(st, validator, index, b.cs.SlotToEpoch(resolvedSlot))
 }
 
 func (b *Backend) ValidatorBalancesByIDs(slot math.Slot, ids []string) ([]*beacontypes.ValidatorBalanceData, error) {
@@ -295,3 +324,102 @@ func (b *Backend) ValidatorBalancesByIDs(slot math.Slot, ids []string) ([]*beaco
 	}
 	return balances, nil
 }
+
+// ActivationQueueAtState returns the validators waiting for activation in the
+// given state, in the order they will be activated.
+func (b *Backend) ActivationQueueAtState(st *statedb.StateDB) ([]*beacontypes.QueuedValidatorData, error) {
+	queue, err := b.sp.ActivationQueue(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activation queue from state")
+	}
+	data := make([]*beacontypes.QueuedValidatorData, len(queue))
+	for i, qv := range queue {
+		data[i] = &beacontypes.QueuedValidatorData{
+			Index:                    qv.Index.Unwrap(),
+			EstimatedActivationEpoch: qv.EstimatedActivationEpoch.Unwrap(),
+			Validator:                beacontypes.ValidatorFromConsensus(qv.Validator),
+		}
+	}
+	return data, nil
+}
+
+// JailedValidatorsAtState returns the validators jailed in the given state,
+// ordered by index.
+func (b *Backend) JailedValidatorsAtState(st *statedb.StateDB) ([]*beacontypes.JailedValidatorData, error) {
+	jailed, err := st.GetJailedValidators()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get jailed validators from state")
+	}
+	data := make([]*beacontypes.JailedValidatorData, 0, len(jailed))
+	for idx, jailedUntil := range jailed {
+		validator, errVal := st.ValidatorByIndex(idx)
+		if errVal != nil {
+			return nil, errors.Wrapf(errVal, "failed to get jailed validator %d", idx)
+		}
+		data = append(data, &beacontypes.JailedValidatorData{
+			Index:       idx.Unwrap(),
+			JailedUntil: jailedUntil.Unwrap(),
+			Tombstoned:  validator.IsSlashed(),
+		})
+	}
+	slices.SortFunc(data, func(x, y *beacontypes.JailedValidatorData) int {
+		return cmp.Compare(x.Index, y.Index)
+	})
+	return data, nil
+}
+
+// CometMapping returns the CometBFT address of every validator of the head
+// state, ordered by validator index.
+func (b *Backend) CometMapping() ([]*validatortypes.CometMappingData, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get head state")
+	}
+	vals, err := st.GetValidators()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validators from state")
+	}
+	data := make([]*validatortypes.CometMappingData, len(vals))
+	for i, val := range vals {
+		pk := val.GetPubkey()
+		data[i] = &validatortypes.CometMappingData{
+			ValidatorIndex: uint64(i), // #nosec:G115 // Safe as i comes from range loop
+			Pubkey:         pk,
+			CometAddress:   cmtcrypto.AddressHash(pk[:]),
+		}
+	}
+	return data, nil
+}
+
+// CometMappingByID returns the CometBFT address of the validator with the
+// given index or pubkey in the head state.
+func (b *Backend) CometMappingByID(id string) (*validatortypes.CometMappingData, error) {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get head state")
+	}
+	index, err := utils.ValidatorIndexByID(st, id)
+	switch {
+	case err == nil:
+		// continue processing
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, ErrValidatorNotFound
+	default:
+		return nil, errors.Wrapf(err, "failed to get validator index by id %s", id)
+	}
+	validator, err := st.ValidatorByIndex(index)
+	switch {
+	case err == nil:
+		// continue processing
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, ErrValidatorNotFound
+	default:
+		return nil, errors.Wrapf(err, "failed to get validator by index %d", index)
+	}
+	pk := validator.GetPubkey()
+	return &validatortypes.CometMappingData{
+		ValidatorIndex: index.Unwrap(),
+		Pubkey:         pk,
+		CometAddress:   cmtcrypto.AddressHash(pk[:]),
+	}, nil
+}
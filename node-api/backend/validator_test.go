@@ -38,6 +38,7 @@ import (
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -82,7 +83,7 @@ func TestFilteredValidators(t *testing.T) {
 	err = appGenesis.SaveAs(genesisFile)
 	require.NoError(t, err)
 
-	b, err := backend.New(sb, cs, cmtCfg)
+	b, err := backend.New(sb, cs, cmtCfg, nil, nil, nil, nil)
 	require.NoError(t, err)
 	tcs := &testConsensusService{
 		cms:     cms,
@@ -316,6 +317,31 @@ func TestFilteredValidators(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "some validators by pubkeys and indexes",
+			inputsF: func() ([]string, []string) {
+				unknownPubkey := crypto.BLSPubkey{0xff}
+				return []string{
+					"3",
+					stateValidators[1].Validator.PublicKey,
+					"1",
+					unknownPubkey.String(),
+					"99",
+				}, nil
+			},
+			expectedErr: nil,
+			checkF: func(t *testing.T, res []*types.ValidatorData) {
+				t.Helper()
+				expectedRes := []*types.ValidatorData{
+					stateValidators[1],
+					stateValidators[3],
+				}
+				require.Len(t, res, len(expectedRes))
+				for i := range res {
+					require.Equal(t, expectedRes[i], res[i], "index %d", i)
+				}
+			},
+		},
 		{
 			name: "some validators by status",
 			inputsF: func() ([]string, []string) {
@@ -21,8 +21,13 @@
 package backend
 
 import (
+	"time"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
@@ -36,11 +41,49 @@ func (b *Backend) PendingPartialWithdrawalsAtState(st *statedb.StateDB) ([]*type
 	partialWithdrawals := make([]*types.PendingPartialWithdrawalData, len(cTypePartialWithdrawals))
 	for i, cTypeWithdrawal := range cTypePartialWithdrawals {
 		partialWithdrawals[i] = &types.PendingPartialWithdrawalData{
-			ValidatorIndex:  cTypeWithdrawal.ValidatorIndex.Unwrap(),
-			Amount:          cTypeWithdrawal.Amount.Unwrap(),
-			WithdrawalEpoch: cTypeWithdrawal.WithdrawableEpoch.Unwrap(),
+			ValidatorIndex:    cTypeWithdrawal.ValidatorIndex.Unwrap(),
+			Amount:            cTypeWithdrawal.Amount.Unwrap(),
+			WithdrawableEpoch: cTypeWithdrawal.WithdrawableEpoch.Unwrap(),
 		}
 	}
 
 	return partialWithdrawals, nil
 }
+
+// ExpectedWithdrawalsAtSlot returns the withdrawals expected in the payload of
+// the block following the given slot, along with the slot of the state they
+// were computed from. The state is advanced to the next slot and fork exactly
+// as done when building the next payload, so the result matches the
+// withdrawals supplied in the payload attributes.
+func (b *Backend) ExpectedWithdrawalsAtSlot(
+	slot math.Slot,
+) (engineprimitives.Withdrawals, math.Slot, error) {
+	st, resolvedSlot, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get latest execution payload header")
+	}
+	//#nosec:G115 // Unix time will never be negative.
+	nextPayloadTimestamp := payloadtime.Next(
+		math.U64(time.Now().Unix()),
+		lph.GetTimestamp(),
+		true, // buildOptimistically
+	)
+
+	// The query state is never committed, so it is safe to advance it.
+	if _, err = b.sp.ProcessSlots(st, resolvedSlot+1); err != nil {
+		return nil, 0, errors.Wrapf(err, "failed processing slot %d", resolvedSlot+1)
+	}
+	if err = b.sp.ProcessFork(st, nextPayloadTimestamp, false); err != nil {
+		return nil, 0, errors.Wrap(err, "failed processing fork")
+	}
+
+	withdrawals, _, err := st.ExpectedWithdrawals(nextPayloadTimestamp)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed computing expected withdrawals")
+	}
+	return withdrawals, resolvedSlot, nil
+}
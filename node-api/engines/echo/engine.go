@@ -23,8 +23,8 @@ package echo
 import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/server/middleware"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 )
 
 // Engine is an implementation of the API engine interface using Echo.
@@ -43,9 +43,6 @@ func New(e *echo.Echo) *Engine {
 // NewDefaultEngine returns a new default Echo Engine instance.
 func NewDefaultEngine() *Engine {
 	engine := echo.New()
-	engine.Use(middleware.CORSWithConfig(
-		middleware.DefaultCORSConfig,
-	))
 	engine.Validator = &CustomValidator{
 		Validator: ConstructValidator(),
 	}
@@ -58,11 +55,17 @@ func (e *Engine) Run(addr string) error {
 	return e.Echo.Start(addr)
 }
 
+// UseMiddleware wraps every route with the given net/http middleware.
+func (e *Engine) UseMiddleware(m middleware.Middleware) {
+	e.Use(echo.WrapMiddleware(m))
+}
+
 // RegisterRoutes registers the given route set with the Echo engine.
 func (e *Engine) RegisterRoutes(hs *handlers.RouteSet, logger log.Logger) {
 	e.logger = logger
 	group := e.Group(hs.BasePath)
 	for _, route := range hs.Routes {
+		route.DecorateWithLimits()
 		route.DecorateWithLogs(e.logger)
 		group.Add(
 			route.Method,
@@ -23,6 +23,7 @@ package echo
 import (
 	"net/http"
 
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
@@ -33,6 +34,9 @@ import (
 type ErrorResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	// ErrorCode is the stable code of the execution client failure which
+	// caused the error, if any.
+	ErrorCode string `json:"error_code,omitempty"`
 }
 
 // responseMiddleware is a middleware that converts errors to an HTTP status
@@ -40,6 +44,11 @@ type ErrorResponse struct {
 func responseMiddleware(handler *handlers.Route) echo.HandlerFunc {
 	return func(c handlers.Context) error {
 		data, err := handler.Handler(c)
+		if c.Response().Committed {
+			// The handler streamed its own response, e.g. server-sent
+			// events, so errors can only be logged.
+			return err
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
@@ -50,6 +59,9 @@ func responseMiddleware(handler *handlers.Route) echo.HandlerFunc {
 func responseFromError(data any, err error) (int, any) {
 	switch {
 	case err == nil:
+		if sc, ok := data.(handlers.StatusCoder); ok {
+			return sc.StatusCode(), data
+		}
 		return http.StatusOK, data
 	case errors.Is(err, types.ErrNotFound):
 		return http.StatusNotFound, ErrorResponse{
@@ -61,11 +73,29 @@ func responseFromError(data any, err error) (int, any) {
 			Code:    http.StatusBadRequest,
 			Message: err.Error(),
 		}
+	case errors.Is(err, types.ErrRequestTooLarge):
+		return http.StatusRequestEntityTooLarge, ErrorResponse{
+			Code:    http.StatusRequestEntityTooLarge,
+			Message: err.Error(),
+		}
+	case errors.Is(err, types.ErrRequestTimeout):
+		return http.StatusRequestTimeout, ErrorResponse{
+			Code:    http.StatusRequestTimeout,
+			Message: err.Error(),
+		}
 	case errors.Is(err, types.ErrNotImplemented):
 		return http.StatusNotImplemented, ErrorResponse{
 			Code:    http.StatusNotImplemented,
 			Message: err.Error(),
 		}
+	case engineerrors.IsClassified(err):
+		// The node cannot serve the request until its execution client
+		// recovers.
+		return http.StatusServiceUnavailable, ErrorResponse{
+			Code:      http.StatusServiceUnavailable,
+			Message:   err.Error(),
+			ErrorCode: engineerrors.Code(err),
+		}
 	default:
 		return http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
@@ -161,6 +161,10 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 		constants.ValidatorStatusPendingQueued:      true,
 		constants.ValidatorStatusWithdrawalDone:     true,
 		constants.ValidatorStatusWithdrawalPossible: true,
+		constants.ValidatorStatusPending:            true,
+		constants.ValidatorStatusActive:             true,
+		constants.ValidatorStatusExited:             true,
+		constants.ValidatorStatusWithdrawal:         true,
 	}
 	return validateAllowedStrings(fl.Field().String(), allowedStatuses)
 }
@@ -21,6 +21,8 @@
 package beacon
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -33,6 +35,7 @@ type Backend interface {
 	GenesisBackend
 	BlobBackend
 	BlockBackend
+	PoolBackend
 	RandaoBackend
 	StateBackend
 	ValidatorBackend
@@ -41,6 +44,9 @@ type Backend interface {
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given root from the store.
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	// ExecutionOptimistic returns true if the execution payload of the state
+	// at the given slot is not validated yet by the execution client.
+	ExecutionOptimistic(slot math.Slot) (bool, error)
 }
 
 type GenesisBackend interface {
@@ -59,10 +65,19 @@ type BlobBackend interface {
 
 type BlockBackend interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
-	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
+	BlockRewardsAtSlot(ctx context.Context, slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
 }
 
+type PoolBackend interface {
+	SubmitBLSToExecutionChanges(changes []*ctypes.SignedBLSToExecutionChange) ([]error, error)
+	BLSToExecutionChanges() ([]*ctypes.SignedBLSToExecutionChange, error)
+	SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error
+	VoluntaryExits() ([]*ctypes.SignedVoluntaryExit, error)
+	SubmitUnjail(unjail *ctypes.SignedUnjail) error
+	Unjails() ([]*ctypes.SignedUnjail, error)
+}
+
 type StateBackend interface {
 	StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
 }
@@ -72,6 +87,8 @@ type WithdrawalBackend interface {
 }
 
 type ValidatorBackend interface {
+	ActivationQueueAtState(st *statedb.StateDB) ([]*types.QueuedValidatorData, error)
+	JailedValidatorsAtState(st *statedb.StateDB) ([]*types.JailedValidatorData, error)
 	ValidatorByID(
 		slot math.Slot, id string,
 	) (*types.ValidatorData, error)
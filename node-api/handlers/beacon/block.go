@@ -37,9 +37,13 @@ func (h *Handler) GetBlockRewards(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	rewards, err := h.backend.BlockRewardsAtSlot(slot)
+	rewards, err := h.backend.BlockRewardsAtSlot(c.Request().Context(), slot)
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(rewards), nil
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(rewards, optimistic), nil
 }
@@ -42,6 +42,10 @@ func (h *Handler) GetBlockHeaders(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.NewResponse(&beacontypes.BlockHeaderResponse{
 		Root:      header.GetBodyRoot(),
 		Canonical: true,
@@ -49,7 +53,7 @@ func (h *Handler) GetBlockHeaders(c handlers.Context) (any, error) {
 			Message:   beacontypes.BeaconBlockHeaderFromConsensus(header),
 			Signature: "", // TODO: implement
 		},
-	}), nil
+	}, optimistic), nil
 }
 
 func (h *Handler) GetBlockHeaderByID(c handlers.Context) (any, error) {
@@ -67,6 +71,10 @@ func (h *Handler) GetBlockHeaderByID(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.NewResponse(&beacontypes.BlockHeaderResponse{
 		Root:      header.GetBodyRoot(),
 		Canonical: true,
@@ -74,5 +82,5 @@ func (h *Handler) GetBlockHeaderByID(c handlers.Context) (any, error) {
 			Message:   beacontypes.BeaconBlockHeaderFromConsensus(header),
 			Signature: "", // TODO: implement
 		},
-	}), nil
+	}, optimistic), nil
 }
@@ -41,7 +41,11 @@ func (h *Handler) GetStateRoot(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(beacontypes.RootData{Root: st.HashTreeRoot()}), nil
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(beacontypes.RootData{Root: st.HashTreeRoot()}, optimistic), nil
 }
 
 func (h *Handler) GetStateFork(c handlers.Context) (any, error) {
@@ -63,5 +67,9 @@ func (h *Handler) GetStateFork(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(fork), nil
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(fork, optimistic), nil
 }
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/beacon/pool"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+func (h *Handler) GetBLSToExecutionChanges(handlers.Context) (any, error) {
+	changes, err := h.backend.BLSToExecutionChanges()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*beacontypes.SignedBLSToExecutionChange, len(changes))
+	for i, change := range changes {
+		data[i] = beacontypes.SignedBLSToExecutionChangeFromConsensus(change)
+	}
+	return beacontypes.PoolResponse{Data: data}, nil
+}
+
+// PostBLSToExecutionChanges submits signed BLS to execution changes to the
+// pool. Valid changes are accepted even if others in the same request are
+// rejected, in which case the failures are reported by index.
+func (h *Handler) PostBLSToExecutionChanges(c handlers.Context) (any, error) {
+	var req []*beacontypes.SignedBLSToExecutionChange
+	if err := c.Bind(&req); err != nil {
+		return nil, utils.BindError(err)
+	}
+	if err := utils.CheckListLengths(req); err != nil {
+		return nil, err
+	}
+	changes := make([]*ctypes.SignedBLSToExecutionChange, len(req))
+	for i, change := range req {
+		converted, err := beacontypes.SignedBLSToExecutionChangeToConsensus(change)
+		if err != nil {
+			return nil, fmt.Errorf("%w: change %d: %w", types.ErrInvalidRequest, i, err)
+		}
+		changes[i] = converted
+	}
+
+	failures, err := h.backend.SubmitBLSToExecutionChanges(changes)
+	if err != nil {
+		return nil, err
+	}
+	if resp := beacontypes.NewIndexedErrorResponse(
+		"some BLS to execution changes failed validation", failures,
+	); resp != nil {
+		return resp, nil
+	}
+	return nil, nil //nolint:nilnil // an empty body is served on success.
+}
+
+func (h *Handler) GetVoluntaryExits(handlers.Context) (any, error) {
+	exits, err := h.backend.VoluntaryExits()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*beacontypes.SignedVoluntaryExit, len(exits))
+	for i, exit := range exits {
+		data[i] = beacontypes.SignedVoluntaryExitFromConsensus(exit)
+	}
+	return beacontypes.PoolResponse{Data: data}, nil
+}
+
+// PostVoluntaryExit submits a signed voluntary exit to the pool.
+func (h *Handler) PostVoluntaryExit(c handlers.Context) (any, error) {
+	var req beacontypes.SignedVoluntaryExit
+	if err := c.Bind(&req); err != nil {
+		return nil, utils.BindError(err)
+	}
+	exit, err := beacontypes.SignedVoluntaryExitToConsensus(&req)
+	if err != nil {
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	}
+
+	switch err = h.backend.SubmitVoluntaryExit(exit); {
+	case err == nil:
+		return nil, nil //nolint:nilnil // an empty body is served on success.
+	case errors.Is(err, core.ErrInvalidVoluntaryExit),
+		errors.Is(err, ctypes.ErrVoluntaryExitSignature),
+		errors.Is(err, pool.ErrPoolFull):
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	default:
+		return nil, err
+	}
+}
+
+func (h *Handler) GetUnjails(handlers.Context) (any, error) {
+	unjails, err := h.backend.Unjails()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*beacontypes.SignedUnjail, len(unjails))
+	for i, unjail := range unjails {
+		data[i] = beacontypes.SignedUnjailFromConsensus(unjail)
+	}
+	return beacontypes.PoolResponse{Data: data}, nil
+}
+
+// PostUnjail submits a signed unjail to the pool, for the next proposers to
+// include it.
+func (h *Handler) PostUnjail(c handlers.Context) (any, error) {
+	var req beacontypes.SignedUnjail
+	if err := c.Bind(&req); err != nil {
+		return nil, utils.BindError(err)
+	}
+	unjail, err := beacontypes.SignedUnjailToConsensus(&req)
+	if err != nil {
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	}
+
+	switch err = h.backend.SubmitUnjail(unjail); {
+	case err == nil:
+		return nil, nil //nolint:nilnil // an empty body is served on success.
+	case errors.Is(err, core.ErrInvalidUnjail),
+		errors.Is(err, ctypes.ErrUnjailSignature),
+		errors.Is(err, pool.ErrPoolFull):
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	default:
+		return nil, err
+	}
+}
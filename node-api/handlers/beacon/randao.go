@@ -51,5 +51,9 @@ func (h *Handler) GetRandao(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(randao), nil
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(randao, optimistic), nil
 }
@@ -108,6 +108,16 @@ func (h *Handler) RegisterRoutes(
 			Path:    "/eth/v1/beacon/states/:state_id/pending_partial_withdrawals",
 			Handler: h.GetPendingPartialWithdrawals,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/beacon/states/:state_id/activation_queue",
+			Handler: h.GetActivationQueue,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/beacon/states/:state_id/jailed_validators",
+			Handler: h.GetJailedValidators,
+		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/headers",
@@ -266,22 +276,32 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.GetVoluntaryExits,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.PostVoluntaryExit,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
-			Handler: h.NotImplemented,
+			Handler: h.GetBLSToExecutionChanges,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
-			Handler: h.NotImplemented,
+			Handler: h.PostBLSToExecutionChanges,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/beacon/pool/unjails",
+			Handler: h.GetUnjails,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "bkit/v1/beacon/pool/unjails",
+			Handler: h.PostUnjail,
 		},
 	})
 }
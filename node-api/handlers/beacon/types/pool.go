@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+type BLSToExecutionChange struct {
+	ValidatorIndex     string `json:"validator_index"`
+	FromBLSPubkey      string `json:"from_bls_pubkey"`
+	ToExecutionAddress string `json:"to_execution_address"`
+}
+
+type SignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange `json:"message"`
+	Signature string                `json:"signature"`
+}
+
+type VoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature string         `json:"signature"`
+}
+
+type Unjail struct {
+	ValidatorIndex string `json:"validator_index"`
+	JailedUntil    string `json:"jailed_until"`
+}
+
+type SignedUnjail struct {
+	Message   *Unjail `json:"message"`
+	Signature string  `json:"signature"`
+}
+
+// PoolResponse is the response of the pool list endpoints, which carry no
+// finality metadata.
+type PoolResponse struct {
+	Data any `json:"data"`
+}
+
+// IndexedError reports why the item at Index of a submitted list was rejected.
+type IndexedError struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// IndexedErrorResponse is served when some of the items of a submitted list
+// were rejected. The valid items are still accepted.
+type IndexedErrorResponse struct {
+	Code     int             `json:"code"`
+	Message  string          `json:"message"`
+	Failures []*IndexedError `json:"failures"`
+}
+
+// NewIndexedErrorResponse builds the response for the rejected items. It
+// returns nil if no item was rejected.
+func NewIndexedErrorResponse(message string, errs []error) *IndexedErrorResponse {
+	var failures []*IndexedError
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, &IndexedError{Index: i, Message: err.Error()})
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return &IndexedErrorResponse{
+		Code:     http.StatusBadRequest,
+		Message:  message,
+		Failures: failures,
+	}
+}
+
+// StatusCode implements handlers.StatusCoder.
+func (r *IndexedErrorResponse) StatusCode() int {
+	return r.Code
+}
+
+func SignedBLSToExecutionChangeFromConsensus(
+	c *ctypes.SignedBLSToExecutionChange,
+) *SignedBLSToExecutionChange {
+	return &SignedBLSToExecutionChange{
+		Message: &BLSToExecutionChange{
+			ValidatorIndex:     c.Message.ValidatorIndex.Base10(),
+			FromBLSPubkey:      c.Message.FromBLSPubkey.String(),
+			ToExecutionAddress: c.Message.ToExecutionAddress.Hex(),
+		},
+		Signature: c.Signature.String(),
+	}
+}
+
+func SignedBLSToExecutionChangeToConsensus(
+	c *SignedBLSToExecutionChange,
+) (*ctypes.SignedBLSToExecutionChange, error) {
+	if c == nil || c.Message == nil {
+		return nil, fmt.Errorf("missing message: %w", ctypes.ErrNilValue)
+	}
+	idx, err := math.U64FromString(c.Message.ValidatorIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing validator index: %w", err)
+	}
+	pk, err := parser.ConvertPubkey(c.Message.FromBLSPubkey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing from_bls_pubkey: %w", err)
+	}
+	var addr common.ExecutionAddress
+	if err = addr.UnmarshalText([]byte(c.Message.ToExecutionAddress)); err != nil {
+		return nil, fmt.Errorf("failed parsing to_execution_address: %w", err)
+	}
+	sig, err := parser.ConvertSignature(c.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing signature: %w", err)
+	}
+	return &ctypes.SignedBLSToExecutionChange{
+		Message: &ctypes.BLSToExecutionChange{
+			ValidatorIndex:     idx,
+			FromBLSPubkey:      pk,
+			ToExecutionAddress: addr,
+		},
+		Signature: sig,
+	}, nil
+}
+
+func SignedVoluntaryExitFromConsensus(e *ctypes.SignedVoluntaryExit) *SignedVoluntaryExit {
+	return &SignedVoluntaryExit{
+		Message: &VoluntaryExit{
+			Epoch:          e.Message.Epoch.Base10(),
+			ValidatorIndex: e.Message.ValidatorIndex.Base10(),
+		},
+		Signature: e.Signature.String(),
+	}
+}
+
+func SignedVoluntaryExitToConsensus(e *SignedVoluntaryExit) (*ctypes.SignedVoluntaryExit, error) {
+	if e == nil || e.Message == nil {
+		return nil, fmt.Errorf("missing message: %w", ctypes.ErrNilValue)
+	}
+	epoch, err := math.U64FromString(e.Message.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing epoch: %w", err)
+	}
+	idx, err := math.U64FromString(e.Message.ValidatorIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing validator index: %w", err)
+	}
+	sig, err := parser.ConvertSignature(e.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing signature: %w", err)
+	}
+	return &ctypes.SignedVoluntaryExit{
+		Message: &ctypes.VoluntaryExitMessage{
+			Epoch:          epoch,
+			ValidatorIndex: idx,
+		},
+		Signature: sig,
+	}, nil
+}
+
+func SignedUnjailFromConsensus(u *ctypes.SignedUnjail) *SignedUnjail {
+	return &SignedUnjail{
+		Message: &Unjail{
+			ValidatorIndex: u.Message.ValidatorIndex.Base10(),
+			JailedUntil:    u.Message.JailedUntil.Base10(),
+		},
+		Signature: u.Signature.String(),
+	}
+}
+
+func SignedUnjailToConsensus(u *SignedUnjail) (*ctypes.SignedUnjail, error) {
+	if u == nil || u.Message == nil {
+		return nil, fmt.Errorf("missing message: %w", ctypes.ErrNilValue)
+	}
+	idx, err := math.U64FromString(u.Message.ValidatorIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing validator index: %w", err)
+	}
+	jailedUntil, err := math.U64FromString(u.Message.JailedUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing jailed until slot: %w", err)
+	}
+	sig, err := parser.ConvertSignature(u.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing signature: %w", err)
+	}
+	return &ctypes.SignedUnjail{
+		Message: &ctypes.UnjailMessage{
+			ValidatorIndex: idx,
+			JailedUntil:    jailedUntil,
+		},
+		Signature: sig,
+	}, nil
+}
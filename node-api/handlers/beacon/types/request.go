@@ -47,14 +47,24 @@ type GetPendingPartialWithdrawalsRequest struct {
 	types.StateIDRequest
 }
 
+type GetActivationQueueRequest struct {
+	types.StateIDRequest
+}
+
+type GetJailedValidatorsRequest struct {
+	types.StateIDRequest
+}
+
 type GetStateValidatorsRequest struct {
 	types.StateIDRequest
+	types.PaginationRequest
 	IDs      []string `query:"id"     validate:"dive,validator_id"`
 	Statuses []string `query:"status" validate:"dive,validator_status"`
 }
 
 type PostStateValidatorsRequest struct {
 	types.StateIDRequest
+	types.PaginationRequest
 	IDs      []string `json:"ids"      validate:"dive,validator_id"`
 	Statuses []string `json:"statuses" validate:"dive,validator_status"`
 }
@@ -32,16 +32,34 @@ type GenericResponse struct {
 }
 
 // NewResponse creates a new response with CometBFT's finality guarantees.
-func NewResponse(data any) GenericResponse {
+// The data is execution optimistic if it derives from a block whose payload
+// the execution client has not validated yet, as it was syncing.
+func NewResponse(data any, executionOptimistic bool) GenericResponse {
 	return GenericResponse{
 		// All data is finalized in CometBFT since we only return data for slots up to head
-		Finalized: true,
-		// Never optimistic since we only return finalized data
-		ExecutionOptimistic: false,
+		Finalized:           true,
+		ExecutionOptimistic: executionOptimistic,
 		Data:                data,
 	}
 }
 
+// PaginatedResponse is a GenericResponse for list endpoints which also carries
+// the token of the next page, if any.
+type PaginatedResponse struct {
+	GenericResponse
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// NewPaginatedResponse creates a new paginated response.
+func NewPaginatedResponse(
+	data any, executionOptimistic bool, nextPageToken string,
+) PaginatedResponse {
+	return PaginatedResponse{
+		GenericResponse: NewResponse(data, executionOptimistic),
+		NextPageToken:   nextPageToken,
+	}
+}
+
 type BlockResponse struct {
 	Version string `json:"version"`
 	GenericResponse
@@ -114,6 +132,22 @@ type Validator struct {
 	WithdrawableEpoch          string `json:"withdrawable_epoch"`
 }
 
+// QueuedValidatorData is a validator waiting for activation. It is a
+// BeaconKit extension to the beacon API.
+type QueuedValidatorData struct {
+	Index                    uint64     `json:"index,string"`
+	EstimatedActivationEpoch uint64     `json:"estimated_activation_epoch,string"`
+	Validator                *Validator `json:"validator"`
+}
+
+// JailedValidatorData is a validator removed from the consensus validator
+// set for downtime. It is a BeaconKit extension to the beacon API.
+type JailedValidatorData struct {
+	Index       uint64 `json:"index,string"`
+	JailedUntil uint64 `json:"jailed_until,string"`
+	Tombstoned  bool   `json:"tombstoned"`
+}
+
 //nolint:staticcheck // todo: figure this out.
 type CommitteeData struct {
 	Index      uint64   `json:"index,string"`
@@ -150,20 +184,24 @@ type PendingPartialWithdrawalsResponse struct {
 	GenericResponse
 }
 
+// PendingPartialWithdrawalData mirrors the PendingPartialWithdrawal container
+// queued by EL-triggered withdrawal requests (EIP-7002). The withdrawable
+// epoch keeps its withdrawal_epoch name, which existing clients read.
 type PendingPartialWithdrawalData struct {
-	ValidatorIndex  uint64 `json:"validator_index,string"`
-	Amount          uint64 `json:"amount,string"`
-	WithdrawalEpoch uint64 `json:"withdrawal_epoch,string"`
+	ValidatorIndex    uint64 `json:"validator_index,string"`
+	Amount            uint64 `json:"amount,string"`
+	WithdrawableEpoch uint64 `json:"withdrawal_epoch,string"`
 }
 
 // NewPendingPartialWithdrawalsResponse creates a typed response with PendingPartialWithdrawal data
 func NewPendingPartialWithdrawalsResponse(
 	forkVersion common.Version,
 	withdrawals []*PendingPartialWithdrawalData,
+	executionOptimistic bool,
 ) PendingPartialWithdrawalsResponse {
 	return PendingPartialWithdrawalsResponse{
 		// Version is the name of the fork version.
 		Version:         version.Name(forkVersion),
-		GenericResponse: NewResponse(withdrawals),
+		GenericResponse: NewResponse(withdrawals, executionOptimistic),
 	}
 }
@@ -36,7 +36,12 @@ var ErrNoSlotForStateRoot = errors.New("slot not found at state root")
 // getStateValidators is a helper function to provide implementation
 // consistency between GetStateValidators and PostStateValidators, since they
 // are intended to behave the same way.
-func (h *Handler) getStateValidators(stateID string, ids []string, statuses []string) (any, error) {
+func (h *Handler) getStateValidators(
+	stateID string,
+	ids []string,
+	statuses []string,
+	page types.PaginationRequest,
+) (any, error) {
 	slot, err := utils.SlotFromStateID(stateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -49,8 +54,20 @@ func (h *Handler) getStateValidators(stateID string, ids []string, statuses []st
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
 
-	return beacontypes.NewResponse(validators), nil
+	// Preserve the unpaginated response shape when no page is requested.
+	if page.PageSize == "" && page.PageToken == "" {
+		return beacontypes.NewResponse(validators, optimistic), nil
+	}
+	validators, nextPageToken, err := utils.Paginate(validators, page)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewPaginatedResponse(validators, optimistic, nextPageToken), nil
 }
 
 func (h *Handler) GetStateValidators(c handlers.Context) (any, error) {
@@ -60,7 +77,7 @@ func (h *Handler) GetStateValidators(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return h.getStateValidators(req.StateID, req.IDs, req.Statuses)
+	return h.getStateValidators(req.StateID, req.IDs, req.Statuses, req.PaginationRequest)
 }
 
 func (h *Handler) PostStateValidators(c handlers.Context) (any, error) {
@@ -70,7 +87,7 @@ func (h *Handler) PostStateValidators(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return h.getStateValidators(req.StateID, req.IDs, req.Statuses)
+	return h.getStateValidators(req.StateID, req.IDs, req.Statuses, req.PaginationRequest)
 }
 
 func (h *Handler) GetStateValidator(c handlers.Context) (any, error) {
@@ -104,9 +121,12 @@ func (h *Handler) GetStateValidator(c handlers.Context) (any, error) {
 		}, nil
 	case err != nil:
 		return nil, err
-	default:
-		return beacontypes.NewResponse(validator), nil
 	}
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(validator, optimistic), nil
 }
 
 func (h *Handler) GetStateValidatorBalances(c handlers.Context) (any, error) {
@@ -136,13 +156,20 @@ func (h *Handler) GetStateValidatorBalances(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(balances), nil
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(balances, optimistic), nil
 }
 
 func (h *Handler) PostStateValidatorBalances(c handlers.Context) (any, error) {
 	var ids []string
 	if err := c.Bind(&ids); err != nil {
-		return nil, types.ErrInvalidRequest
+		return nil, utils.BindError(err)
+	}
+	if err := utils.CheckListLengths(ids); err != nil {
+		return nil, err
 	}
 	// Get state_id from URL path parameter
 	req := beacontypes.PostValidatorBalancesRequest{
@@ -173,5 +200,65 @@ func (h *Handler) PostStateValidatorBalances(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(balances), nil
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(balances, optimistic), nil
+}
+
+// GetActivationQueue returns the validators waiting for activation at the
+// requested state, along with the epoch they are expected to be activated at.
+func (h *Handler) GetActivationQueue(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetActivationQueueRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, _, err := h.backend.StateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	queue, err := h.backend.ActivationQueueAtState(st)
+	if err != nil {
+		return nil, err
+	}
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(queue, optimistic), nil
+}
+
+// GetJailedValidators returns the validators jailed for downtime at the
+// requested state, along with the slot they can unjail from.
+func (h *Handler) GetJailedValidators(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetJailedValidatorsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, _, err := h.backend.StateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	jailed, err := h.backend.JailedValidatorsAtState(st)
+	if err != nil {
+		return nil, err
+	}
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(jailed, optimistic), nil
 }
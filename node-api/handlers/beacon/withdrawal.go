@@ -64,8 +64,14 @@ func (h *Handler) GetPendingPartialWithdrawals(c handlers.Context) (any, error)
 		return nil, err
 	}
 
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+
 	return beacontypes.NewPendingPartialWithdrawalsResponse(
 		forkVersion.CurrentVersion,
 		partialWithdrawals,
+		optimistic,
 	), nil
 }
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blocks
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/block"
+)
+
+// Backend is the backend of the blocks API.
+type Backend interface {
+	// GetSlotByExecutionHash retrieves the slot of the block whose execution
+	// payload has the given block hash.
+	GetSlotByExecutionHash(blockHash common.ExecutionHash) (math.Slot, error)
+	// GetSlotByExecutionNumber retrieves the slot of the block whose
+	// execution payload has the given block number.
+	GetSlotByExecutionNumber(blockNumber math.U64) (math.Slot, error)
+	// PayloadSummaryAtSlot returns the execution payload summary of the block
+	// at the given slot.
+	PayloadSummaryAtSlot(slot math.Slot) (block.PayloadSummary, error)
+	// BlockRootAtSlot returns the root of the block at the given slot.
+	BlockRootAtSlot(slot math.Slot) (common.Root, error)
+}
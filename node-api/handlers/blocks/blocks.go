@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blocks
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/blocks/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetBlockByExecutionID returns the beacon block that included the execution
+// block identified by its hash or number. Only blocks still held by the block
+// store, i.e. within its availability window and finalized since the node
+// started, can be resolved.
+func (h *Handler) GetBlockByExecutionID(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetBlockByExecutionIDRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromExecutionID(req.ExecutionID, h.backend)
+	if errors.Is(err, apitypes.ErrInvalidRequest) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Join(apitypes.ErrNotFound, err)
+	}
+	summary, err := h.backend.PayloadSummaryAtSlot(slot)
+	if err != nil {
+		return nil, errors.Join(apitypes.ErrNotFound, err)
+	}
+	root, err := h.backend.BlockRootAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return &types.BlockByExecutionIDResponse{Data: &types.BlockByExecutionIDData{
+		Slot:                 slot.Base10(),
+		BlockRoot:            root.String(),
+		ExecutionBlockHash:   summary.BlockHash.Hex(),
+		ExecutionBlockNumber: summary.BlockNumber.Base10(),
+	}}, nil
+}
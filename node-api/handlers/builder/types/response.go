@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+type ExpectedWithdrawalsResponse struct {
+	ExecutionOptimistic bool                  `json:"execution_optimistic"`
+	Finalized           bool                  `json:"finalized"`
+	Data                []*ExpectedWithdrawal `json:"data"`
+}
+
+// ExpectedWithdrawal is the beacon API representation of a withdrawal, which
+// differs from the engine API one in the casing and encoding of its fields.
+type ExpectedWithdrawal struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
+
+func NewExpectedWithdrawalsResponse(
+	withdrawals engineprimitives.Withdrawals,
+	executionOptimistic bool,
+) ExpectedWithdrawalsResponse {
+	data := make([]*ExpectedWithdrawal, len(withdrawals))
+	for i, w := range withdrawals {
+		data[i] = &ExpectedWithdrawal{
+			Index:          w.GetIndex().Unwrap(),
+			ValidatorIndex: w.GetValidatorIndex().Unwrap(),
+			Address:        w.GetAddress(),
+			Amount:         w.GetAmount().Unwrap(),
+		}
+	}
+	return ExpectedWithdrawalsResponse{
+		ExecutionOptimistic: executionOptimistic,
+		Finalized:           false,
+		Data:                data,
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	buildertypes "github.com/berachain/beacon-kit/node-api/handlers/builder/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetExpectedWithdrawals returns the withdrawals expected in the execution
+// payload of the block built on top of the requested state.
+func (h *Handler) GetExpectedWithdrawals(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[buildertypes.GetExpectedWithdrawalsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals, stateSlot, err := h.backend.ExpectedWithdrawalsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the slot following the requested state can be previewed, since
+	// BeaconKit has no empty slots to advance through.
+	if req.ProposalSlot != "" {
+		var proposalSlot math.Slot
+		proposalSlot, err = math.U64FromString(req.ProposalSlot)
+		if err != nil {
+			return nil, err
+		}
+		if proposalSlot != stateSlot+1 {
+			return nil, fmt.Errorf(
+				"%w: proposal slot %d must be state slot + 1 (%d)",
+				types.ErrInvalidRequest, proposalSlot, stateSlot+1,
+			)
+		}
+	}
+	optimistic, err := h.backend.ExecutionOptimistic(stateSlot)
+	if err != nil {
+		return nil, err
+	}
+	return buildertypes.NewExpectedWithdrawalsResponse(withdrawals, optimistic), nil
+}
@@ -20,12 +20,27 @@
 
 package config
 
-import "github.com/berachain/beacon-kit/chain"
+import (
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
 
 type Backend interface {
 	SpecBackend
+	// GenesisValidatorsRoot returns the genesis validators root of the chain.
+	GenesisValidatorsRoot() (common.Root, error)
+	// ForkVersionAtSlot returns the fork version of the committed state at
+	// the given slot, resolving a slot of 0 to the latest slot.
+	ForkVersionAtSlot(slot math.Slot) (common.Version, error)
 }
 
 type SpecBackend interface {
 	Spec() (chain.Spec, error)
 }
+
+// RuntimeConfig is the effective configuration the node is running with.
+type RuntimeConfig interface {
+	// Redacted returns the configuration with all secret values redacted.
+	Redacted() map[string]any
+}
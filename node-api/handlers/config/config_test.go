@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/node-api/handlers/config"
+	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+type specBackend struct {
+	cs chain.Spec
+}
+
+func (b specBackend) Spec() (chain.Spec, error) { return b.cs, nil }
+
+func (specBackend) GenesisValidatorsRoot() (common.Root, error) { return common.Root{}, nil }
+
+func (b specBackend) ForkVersionAtSlot(math.Slot) (common.Version, error) {
+	return b.cs.GenesisForkVersion(), nil
+}
+
+func TestGetForkSchedule(t *testing.T) {
+	t.Parallel()
+	farFuture := constants.FarFutureEpoch.Base10()
+
+	tests := []struct {
+		name     string
+		setup    func(*chain.SpecData)
+		expected []*types.ForkData
+	}{
+		{
+			name: "all forks at genesis",
+			setup: func(d *chain.SpecData) {
+				d.GenesisTime, d.Deneb1ForkTime, d.ElectraForkTime, d.Electra1ForkTime = 0, 0, 0, 0
+			},
+			expected: []*types.ForkData{
+				{PreviousVersion: version.Electra1(), CurrentVersion: version.Electra1(), Epoch: "0", Timestamp: "0"},
+			},
+		},
+		{
+			name: "forks after genesis",
+			setup: func(d *chain.SpecData) {
+				d.GenesisTime, d.Deneb1ForkTime, d.ElectraForkTime, d.Electra1ForkTime = 10, 20, 30, 40
+			},
+			expected: []*types.ForkData{
+				{PreviousVersion: version.Deneb(), CurrentVersion: version.Deneb(), Epoch: "0", Timestamp: "10"},
+				{PreviousVersion: version.Deneb(), CurrentVersion: version.Deneb1(), Epoch: farFuture, Timestamp: "20"},
+				{PreviousVersion: version.Deneb1(), CurrentVersion: version.Electra(), Epoch: farFuture, Timestamp: "30"},
+				{PreviousVersion: version.Electra(), CurrentVersion: version.Electra1(), Epoch: farFuture, Timestamp: "40"},
+			},
+		},
+		{
+			name: "some forks folded into genesis",
+			setup: func(d *chain.SpecData) {
+				d.GenesisTime, d.Deneb1ForkTime, d.ElectraForkTime, d.Electra1ForkTime = 10, 10, 10, 40
+			},
+			expected: []*types.ForkData{
+				{PreviousVersion: version.Electra(), CurrentVersion: version.Electra(), Epoch: "0", Timestamp: "10"},
+				{PreviousVersion: version.Electra(), CurrentVersion: version.Electra1(), Epoch: farFuture, Timestamp: "40"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			data := spec.DevnetChainSpecData()
+			tt.setup(data)
+			cs, err := chain.NewSpec(data)
+			require.NoError(t, err)
+
+			h := config.NewHandler(specBackend{cs: cs}, nil)
+			res, err := h.GetForkSchedule(nil)
+			require.NoError(t, err)
+			require.Equal(t, types.ForkScheduleResponse{Data: tt.expected}, res)
+		})
+	}
+}
+
+func TestGetDepositContract(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	h := config.NewHandler(specBackend{cs: cs}, nil)
+	res, err := h.GetDepositContract(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.DepositContractResponse{Data: types.DepositContractData{
+		ChainID: "80087",
+		Address: cs.DepositContractAddress().String(),
+	}}, res)
+}
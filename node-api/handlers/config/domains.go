@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// domain is a signature domain type along with whether messages of that
+// domain are signed over the genesis fork version, so that they remain valid
+// across forks.
+type domain struct {
+	domainType    func(chain.Spec) common.DomainType
+	genesisPinned bool
+}
+
+//nolint:gochecknoglobals // read-only lookup table.
+var domains = map[string]domain{
+	"DOMAIN_BEACON_PROPOSER": {domainType: chain.Spec.DomainTypeProposer},
+	"DOMAIN_BEACON_ATTESTER": {domainType: chain.Spec.DomainTypeAttester},
+	"DOMAIN_RANDAO":          {domainType: chain.Spec.DomainTypeRandao},
+	"DOMAIN_SELECTION_PROOF": {domainType: chain.Spec.DomainTypeSelectionProof},
+	"DOMAIN_AGGREGATE_AND_PROOF": {
+		domainType: chain.Spec.DomainTypeAggregateAndProof,
+	},
+	"DOMAIN_DEPOSIT": {domainType: chain.Spec.DomainTypeDeposit, genesisPinned: true},
+	"DOMAIN_VOLUNTARY_EXIT": {
+		domainType: chain.Spec.DomainTypeVoluntaryExit, genesisPinned: true,
+	},
+	"DOMAIN_BLS_TO_EXECUTION_CHANGE": {
+		domainType:    func(chain.Spec) common.DomainType { return ctypes.BLSToExecutionChangeDomainType() },
+		genesisPinned: true,
+	},
+	"DOMAIN_UNJAIL": {
+		domainType:    func(chain.Spec) common.DomainType { return ctypes.UnjailDomainType() },
+		genesisPinned: true,
+	},
+}
+
+// GetDomain returns the signature domain of the given type, along with the
+// fork digest of the fork data it is computed from. Deposits, voluntary
+// exits, BLS to execution changes and unjails are signed over the genesis
+// fork version. Other domains use the fork version active at the given
+// timestamp, at the given epoch of the committed chain, or at the head if
+// neither is given.
+func (h *Handler) GetDomain(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetDomainRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	d, ok := domains[strings.ToUpper(req.Type)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown domain type %s, supported types are %s",
+			apitypes.ErrInvalidRequest, req.Type, strings.Join(slices.Sorted(maps.Keys(domains)), ", "),
+		)
+	}
+	if req.Epoch != "" && req.Timestamp != "" {
+		return nil, fmt.Errorf("%w: only one of epoch and timestamp can be given",
+			apitypes.ErrInvalidRequest,
+		)
+	}
+
+	cs, err := h.backend.Spec()
+	if err != nil {
+		return nil, handlers.NewHTTPError(http.StatusInternalServerError, "failed to get spec: %v", err)
+	}
+	forkVersion, err := h.domainForkVersion(cs, d, req)
+	if err != nil {
+		return nil, err
+	}
+	genesisValidatorsRoot, err := h.backend.GenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	domainType := d.domainType(cs)
+	forkData := ctypes.NewForkData(forkVersion, genesisValidatorsRoot)
+	return types.DomainResponse{Data: types.DomainData{
+		Type:                  strings.ToUpper(req.Type),
+		DomainType:            domainType.String(),
+		ForkVersion:           forkVersion.String(),
+		GenesisValidatorsRoot: genesisValidatorsRoot.Hex(),
+		Domain:                forkData.ComputeDomain(domainType).String(),
+		ForkDigest:            forkData.ComputeForkDigest().String(),
+	}}, nil
+}
+
+// domainForkVersion returns the fork version messages of the domain are
+// signed over.
+func (h *Handler) domainForkVersion(
+	cs chain.Spec, d domain, req types.GetDomainRequest,
+) (common.Version, error) {
+	switch {
+	case d.genesisPinned:
+		return cs.GenesisForkVersion(), nil
+	case req.Timestamp != "":
+		timestamp, err := math.U64FromString(req.Timestamp)
+		if err != nil {
+			return common.Version{}, errors.Join(apitypes.ErrInvalidRequest, err)
+		}
+		return cs.ActiveForkVersionForTimestamp(timestamp), nil
+	case req.Epoch != "":
+		epoch, err := math.U64FromString(req.Epoch)
+		if err != nil {
+			return common.Version{}, errors.Join(apitypes.ErrInvalidRequest, err)
+		}
+		if epoch == constants.GenesisEpoch {
+			return cs.GenesisForkVersion(), nil
+		}
+		version, err := h.backend.ForkVersionAtSlot(math.Slot(epoch.Unwrap() * cs.SlotsPerEpoch()))
+		if errors.Is(err, backend.ErrSlotNotReached) {
+			return common.Version{}, errors.Join(apitypes.ErrInvalidRequest, err)
+		}
+		return version, err
+	default:
+		return h.backend.ForkVersionAtSlot(utils.Head)
+	}
+}
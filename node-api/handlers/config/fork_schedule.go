@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetForkSchedule returns every fork of the chain, starting from the fork
+// active at genesis.
+func (h *Handler) GetForkSchedule(handlers.Context) (any, error) {
+	cs, err := h.backend.Spec()
+	if err != nil {
+		return nil, handlers.NewHTTPError(http.StatusInternalServerError, "failed to get spec: %v", err)
+	}
+	return types.ForkScheduleResponse{Data: forkSchedule(cs)}, nil
+}
+
+// forkSchedule lists the forks of the chain spec in activation order. Forks
+// scheduled at or before genesis are folded into the genesis fork.
+//
+// NOTE: BeaconKit activates forks by timestamp rather than by epoch. Only the
+// genesis fork has a known epoch, later forks report FAR_FUTURE_EPOCH and
+// clients should rely on the timestamp instead.
+func forkSchedule(cs chain.Spec) []*types.ForkData {
+	genesisVersion := cs.GenesisForkVersion()
+	schedule := []*types.ForkData{{
+		PreviousVersion: genesisVersion,
+		CurrentVersion:  genesisVersion,
+		Epoch:           constants.GenesisEpoch.Base10(),
+		Timestamp:       math.U64(cs.GenesisTime()).Base10(),
+	}}
+
+	forks := []struct {
+		version   common.Version
+		timestamp uint64
+	}{
+		{version.Deneb1(), cs.Deneb1ForkTime()},
+		{version.Electra(), cs.ElectraForkTime()},
+		{version.Electra1(), cs.Electra1ForkTime()},
+	}
+	previousVersion := genesisVersion
+	for _, fork := range forks {
+		if !version.IsAfter(fork.version, genesisVersion) {
+			continue
+		}
+		schedule = append(schedule, &types.ForkData{
+			PreviousVersion: previousVersion,
+			CurrentVersion:  fork.version,
+			Epoch:           constants.FarFutureEpoch.Base10(),
+			Timestamp:       math.U64(fork.timestamp).Base10(),
+		})
+		previousVersion = fork.version
+	}
+	return schedule
+}
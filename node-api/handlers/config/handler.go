@@ -24,15 +24,17 @@ import "github.com/berachain/beacon-kit/node-api/handlers"
 
 type Handler struct {
 	*handlers.BaseHandler
-	backend Backend
+	backend       Backend
+	runtimeConfig RuntimeConfig
 }
 
-func NewHandler(backend Backend) *Handler {
+func NewHandler(backend Backend, runtimeConfig RuntimeConfig) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
-		backend: backend,
+		backend:       backend,
+		runtimeConfig: runtimeConfig,
 	}
 	return h
 }
@@ -33,7 +33,7 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/config/fork_schedule",
-			Handler: h.NotImplemented,
+			Handler: h.GetForkSchedule,
 		},
 		{
 			Method:  http.MethodGet,
@@ -43,7 +43,17 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/config/deposit_contract",
-			Handler: h.NotImplemented,
+			Handler: h.GetDepositContract,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/config",
+			Handler: h.GetRuntimeConfig,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/domains",
+			Handler: h.GetDomain,
 		},
 	})
 }
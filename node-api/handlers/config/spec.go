@@ -23,6 +23,7 @@ package config
 import (
 	"net/http"
 
+	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -37,17 +38,67 @@ func (h *Handler) GetSpec(handlers.Context) (any, error) {
 	if err != nil {
 		return nil, handlers.NewHTTPError(http.StatusInternalServerError, "failed to get spec: %v", err)
 	}
-	return types.SpecResponse{Data: types.SpecData{
+	return types.SpecResponse{Data: specData(cs)}, nil
+}
+
+// specData renders the chain spec with the key names of the beacon API.
+func specData(cs chain.Spec) types.SpecData {
+	return types.SpecData{
+		BytesPerBlob:           math.U64(cs.BytesPerBlob()).Base10(),
+		DepositChainID:         math.U64(cs.DepositEth1ChainID()).Base10(),
 		DepositContractAddress: cs.DepositContractAddress().String(),
 
 		// Network ID is same as eth1 chain ID.
 		DepositNetworkID: math.U64(cs.DepositEth1ChainID()).Base10(),
 
-		DomainAggregateAndProof: cs.DomainTypeAggregateAndProof().String(),
+		Deneb1ForkTime:                   math.U64(cs.Deneb1ForkTime()).Base10(),
+		DomainAggregateAndProof:          cs.DomainTypeAggregateAndProof().String(),
+		DomainApplicationMask:            cs.DomainTypeApplicationMask().String(),
+		DomainBeaconAttester:             cs.DomainTypeAttester().String(),
+		DomainBeaconProposer:             cs.DomainTypeProposer().String(),
+		DomainDeposit:                    cs.DomainTypeDeposit().String(),
+		DomainRandao:                     cs.DomainTypeRandao().String(),
+		DomainSelectionProof:             cs.DomainTypeSelectionProof().String(),
+		DomainVoluntaryExit:              cs.DomainTypeVoluntaryExit().String(),
+		DowntimeJailThreshold:            math.U64(cs.DowntimeJailThreshold()).Base10(),
+		EffectiveBalanceIncrement:        cs.EffectiveBalanceIncrement().Base10(),
+		Electra1ForkTime:                 math.U64(cs.Electra1ForkTime()).Base10(),
+		ElectraForkTime:                  math.U64(cs.ElectraForkTime()).Base10(),
+		EpochsPerHistoricalVector:        math.U64(cs.EpochsPerHistoricalVector()).Base10(),
+		EpochsPerSlashingsVector:         math.U64(cs.EpochsPerSlashingsVector()).Base10(),
+		Eth1FollowDistance:               math.U64(cs.Eth1FollowDistance()).Base10(),
+		FieldElementsPerBlob:             math.U64(cs.FieldElementsPerBlob()).Base10(),
+		GenesisForkVersion:               cs.GenesisForkVersion().String(),
+		GenesisTime:                      math.U64(cs.GenesisTime()).Base10(),
+		HistoricalRootsLimit:             math.U64(cs.HistoricalRootsLimit()).Base10(),
+		HysteresisDownwardMultiplier:     cs.HysteresisDownwardMultiplier().Base10(),
+		HysteresisQuotient:               cs.HysteresisQuotient().Base10(),
+		HysteresisUpwardMultiplier:       cs.HysteresisUpwardMultiplier().Base10(),
+		MaxBlobCommitmentsPerBlock:       math.U64(cs.MaxBlobCommitmentsPerBlock()).Base10(),
+		MaxBlobsPerBlock:                 math.U64(cs.MaxBlobsPerBlock()).Base10(),
+		MaxBytesPerTransaction:           math.U64(cs.MaxBytesPerTx()).Base10(),
+		MaxDeposits:                      math.U64(cs.MaxDepositsPerBlock()).Base10(),
+		MaxEffectiveBalance:              cs.MaxEffectiveBalance().Base10(),
+		MaxTransactionsPerPayload:        math.U64(cs.MaxTxsPerPayload()).Base10(),
+		MaxValidatorActivationsPerEpoch:  math.U64(cs.MaxValidatorActivationsPerEpoch()).Base10(),
+		MaxValidatorExitsPerEpoch:        math.U64(cs.MaxValidatorExitsPerEpoch()).Base10(),
+		MaxValidatorsPerWithdrawalsSweep: cs.MaxValidatorsPerWithdrawalsSweep().Base10(),
+		MaxWithdrawalsPerPayload:         math.U64(cs.MaxWithdrawalsPerPayload()).Base10(),
+		MinActivationBalance:             cs.MinActivationBalance().Base10(),
+		MinEpochsForBlobSidecarsRequests: cs.MinEpochsForBlobsSidecarsRequest().Base10(),
+		MinEpochsToInactivityPenalty:     math.U64(cs.MinEpochsToInactivityPenalty()).Base10(),
+		MinJailDuration:                  math.U64(cs.MinJailDuration()).Base10(),
+		MinSlashingPenaltyQuotient:       math.U64(cs.MinSlashingPenaltyQuotient()).Base10(),
+		MinValidatorWithdrawabilityDelay: cs.MinValidatorWithdrawabilityDelay().Base10(),
+		SecondsPerEth1Block:              math.U64(cs.TargetSecondsPerEth1Block()).Base10(),
+		SlotsPerEpoch:                    math.U64(cs.SlotsPerEpoch()).Base10(),
+		SlotsPerHistoricalRoot:           math.U64(cs.SlotsPerHistoricalRoot()).Base10(),
+		ValidatorRegistryLimit:           math.U64(cs.ValidatorRegistryLimit()).Base10(),
+		ValidatorSetCap:                  math.U64(cs.ValidatorSetCap()).Base10(),
 
 		// Currently these are placeholders, will be replaced with the correct values for our
 		// versions like Deneb, Deneb1 etc once we implement slashing for inactivity.
 		InactivityPenaltyQuotient:       InactivityPenaltyQuotientPlaceholder,
 		InactivityPenaltyQuotientAltair: InactivityPenaltyQuotientPlaceholder,
-	}}, nil
+	}
 }
@@ -20,14 +20,102 @@
 
 package types
 
+import "github.com/berachain/beacon-kit/primitives/common"
+
+type RuntimeConfigResponse struct {
+	Data map[string]any `json:"data"`
+}
+
 type SpecResponse struct {
 	Data SpecData `json:"data"`
 }
 
 type SpecData struct {
-	DepositContractAddress          string `json:"DEPOSIT_CONTRACT_ADDRESS"`
-	DepositNetworkID                string `json:"DEPOSIT_NETWORK_ID"`
-	DomainAggregateAndProof         string `json:"DOMAIN_AGGREGATE_AND_PROOF"`
-	InactivityPenaltyQuotient       string `json:"INACTIVITY_PENALTY_QUOTIENT"`
-	InactivityPenaltyQuotientAltair string `json:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR"`
+	BytesPerBlob                     string `json:"BYTES_PER_BLOB"`
+	DepositChainID                   string `json:"DEPOSIT_CHAIN_ID"`
+	DepositContractAddress           string `json:"DEPOSIT_CONTRACT_ADDRESS"`
+	DepositNetworkID                 string `json:"DEPOSIT_NETWORK_ID"`
+	Deneb1ForkTime                   string `json:"DENEB_ONE_FORK_TIME"`
+	DomainAggregateAndProof          string `json:"DOMAIN_AGGREGATE_AND_PROOF"`
+	DomainApplicationMask            string `json:"DOMAIN_APPLICATION_MASK"`
+	DomainBeaconAttester             string `json:"DOMAIN_BEACON_ATTESTER"`
+	DomainBeaconProposer             string `json:"DOMAIN_BEACON_PROPOSER"`
+	DomainDeposit                    string `json:"DOMAIN_DEPOSIT"`
+	DomainRandao                     string `json:"DOMAIN_RANDAO"`
+	DomainSelectionProof             string `json:"DOMAIN_SELECTION_PROOF"`
+	DomainVoluntaryExit              string `json:"DOMAIN_VOLUNTARY_EXIT"`
+	DowntimeJailThreshold            string `json:"DOWNTIME_JAIL_THRESHOLD"`
+	EffectiveBalanceIncrement        string `json:"EFFECTIVE_BALANCE_INCREMENT"`
+	Electra1ForkTime                 string `json:"ELECTRA_ONE_FORK_TIME"`
+	ElectraForkTime                  string `json:"ELECTRA_FORK_TIME"`
+	EpochsPerHistoricalVector        string `json:"EPOCHS_PER_HISTORICAL_VECTOR"`
+	EpochsPerSlashingsVector         string `json:"EPOCHS_PER_SLASHINGS_VECTOR"`
+	Eth1FollowDistance               string `json:"ETH1_FOLLOW_DISTANCE"`
+	FieldElementsPerBlob             string `json:"FIELD_ELEMENTS_PER_BLOB"`
+	GenesisForkVersion               string `json:"GENESIS_FORK_VERSION"`
+	GenesisTime                      string `json:"GENESIS_TIME"`
+	HistoricalRootsLimit             string `json:"HISTORICAL_ROOTS_LIMIT"`
+	HysteresisDownwardMultiplier     string `json:"HYSTERESIS_DOWNWARD_MULTIPLIER"`
+	HysteresisQuotient               string `json:"HYSTERESIS_QUOTIENT"`
+	HysteresisUpwardMultiplier       string `json:"HYSTERESIS_UPWARD_MULTIPLIER"`
+	InactivityPenaltyQuotient        string `json:"INACTIVITY_PENALTY_QUOTIENT"`
+	InactivityPenaltyQuotientAltair  string `json:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR"`
+	MaxBlobCommitmentsPerBlock       string `json:"MAX_BLOB_COMMITMENTS_PER_BLOCK"`
+	MaxBlobsPerBlock                 string `json:"MAX_BLOBS_PER_BLOCK"`
+	MaxBytesPerTransaction           string `json:"MAX_BYTES_PER_TRANSACTION"`
+	MaxDeposits                      string `json:"MAX_DEPOSITS"`
+	MaxEffectiveBalance              string `json:"MAX_EFFECTIVE_BALANCE"`
+	MaxTransactionsPerPayload        string `json:"MAX_TRANSACTIONS_PER_PAYLOAD"`
+	MaxValidatorActivationsPerEpoch  string `json:"MAX_VALIDATOR_ACTIVATIONS_PER_EPOCH"`
+	MaxValidatorExitsPerEpoch        string `json:"MAX_VALIDATOR_EXITS_PER_EPOCH"`
+	MaxValidatorsPerWithdrawalsSweep string `json:"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"`
+	MaxWithdrawalsPerPayload         string `json:"MAX_WITHDRAWALS_PER_PAYLOAD"`
+	MinActivationBalance             string `json:"MIN_ACTIVATION_BALANCE"`
+	MinEpochsForBlobSidecarsRequests string `json:"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS"`
+	MinEpochsToInactivityPenalty     string `json:"MIN_EPOCHS_TO_INACTIVITY_PENALTY"`
+	MinJailDuration                  string `json:"MIN_JAIL_DURATION"`
+	MinSlashingPenaltyQuotient       string `json:"MIN_SLASHING_PENALTY_QUOTIENT"`
+	MinValidatorWithdrawabilityDelay string `json:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
+	SecondsPerEth1Block              string `json:"SECONDS_PER_ETH1_BLOCK"`
+	SlotsPerEpoch                    string `json:"SLOTS_PER_EPOCH"`
+	SlotsPerHistoricalRoot           string `json:"SLOTS_PER_HISTORICAL_ROOT"`
+	ValidatorRegistryLimit           string `json:"VALIDATOR_REGISTRY_LIMIT"`
+	ValidatorSetCap                  string `json:"VALIDATOR_SET_CAP"`
+}
+
+type ForkScheduleResponse struct {
+	Data []*ForkData `json:"data"`
+}
+
+type ForkData struct {
+	PreviousVersion common.Version `json:"previous_version"`
+	CurrentVersion  common.Version `json:"current_version"`
+	Epoch           string         `json:"epoch"`
+	// Timestamp is the activation time of the fork. It is a BeaconKit
+	// extension, since forks are activated by time rather than by epoch.
+	Timestamp string `json:"timestamp"`
+}
+
+type DepositContractResponse struct {
+	Data DepositContractData `json:"data"`
+}
+
+type DepositContractData struct {
+	ChainID string `json:"chain_id"`
+	Address string `json:"address"`
+}
+
+type DomainResponse struct {
+	Data DomainData `json:"data"`
+}
+
+// DomainData is a signature domain along with the fork data it is computed
+// from, and the fork digest of that fork data.
+type DomainData struct {
+	Type                  string `json:"type"`
+	DomainType            string `json:"domain_type"`
+	ForkVersion           string `json:"fork_version"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	Domain                string `json:"domain"`
+	ForkDigest            string `json:"fork_digest"`
 }
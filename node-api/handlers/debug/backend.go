@@ -21,7 +21,11 @@
 package debug
 
 import (
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/node-core/services/depositreconciler"
+	"github.com/berachain/beacon-kit/observability/slottiming"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
@@ -30,4 +34,33 @@ import (
 type Backend interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
+	// ExecutionOptimistic returns true if the execution payload of the state
+	// at the given slot is not validated yet by the execution client.
+	ExecutionOptimistic(slot math.Slot) (bool, error)
+	// GenesisValidatorsRoot returns the genesis validators root of the chain.
+	GenesisValidatorsRoot() (common.Root, error)
+	// Spec returns the chain spec.
+	Spec() (chain.Spec, error)
+}
+
+// SignatureVerifier verifies BLS signatures.
+type SignatureVerifier interface {
+	// VerifySignature verifies the signature of the message by the pubkey.
+	VerifySignature(
+		pubKey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+	) error
+}
+
+// SlotTimings provides the proposer timelines recorded for recent slots.
+type SlotTimings interface {
+	// Get returns the timeline recorded for the slot, if any.
+	Get(slot math.Slot) (slottiming.Timeline, bool)
+}
+
+// DepositReconciler reconciles the deposit store against the deposit
+// contract.
+type DepositReconciler interface {
+	// Status returns the outcome of the latest reconciliation, or nil if none
+	// completed yet.
+	Status() *depositreconciler.Status
 }
@@ -25,16 +25,30 @@ import "github.com/berachain/beacon-kit/node-api/handlers"
 // Handler is the handler for the beacon API.
 type Handler struct {
 	*handlers.BaseHandler
-	backend Backend
+	backend    Backend
+	timings    SlotTimings
+	reconciler DepositReconciler
+	// verifier serves the signature verification endpoint, which is only
+	// registered if set.
+	verifier SignatureVerifier
 }
 
-// NewHandler creates a new handler for the beacon API.
-func NewHandler(backend Backend) *Handler {
+// NewHandler creates a new handler for the beacon API. The signature
+// verification endpoint is only served if verifier is not nil.
+func NewHandler(
+	backend Backend,
+	timings SlotTimings,
+	reconciler DepositReconciler,
+	verifier SignatureVerifier,
+) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
-		backend: backend,
+		backend:    backend,
+		timings:    timings,
+		reconciler: reconciler,
+		verifier:   verifier,
 	}
 	return h
 }
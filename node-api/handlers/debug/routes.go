@@ -45,5 +45,29 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 			Path:    "/eth/v1/debug/fork_choice",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/debug/slot_timings/:slot",
+			Handler: h.GetSlotTimings,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/debug/ssz_schema",
+			Handler: h.GetSSZSchema,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/debug/deposit_reconciliation",
+			Handler: h.GetDepositReconciliation,
+		},
 	})
+	if h.verifier != nil {
+		h.BaseHandler.AddRoutes([]*handlers.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    VerifySignaturePath,
+				Handler: h.PostVerifySignature,
+			},
+		})
+	}
 }
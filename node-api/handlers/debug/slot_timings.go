@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	debugtypes "github.com/berachain/beacon-kit/node-api/handlers/debug/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetSlotTimings returns the proposer timeline this node recorded for a
+// recent slot, to tell a slow execution client from a slow consensus client.
+func (h *Handler) GetSlotTimings(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.GetSlotTimingsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := math.U64FromString(req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	timeline, found := h.timings.Get(slot)
+	if !found {
+		return nil, fmt.Errorf(
+			"%w: no timings recorded for slot %d", apitypes.ErrNotFound, slot,
+		)
+	}
+	return debugtypes.NewSlotTimingsResponse(timeline), nil
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"github.com/berachain/beacon-kit/consensus-types/sszschema"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	debugtypes "github.com/berachain/beacon-kit/node-api/handlers/debug/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetSSZSchema returns the static sizes, field order and limits of the SSZ
+// containers of a fork, for external tooling that builds or checks proofs.
+func (h *Handler) GetSSZSchema(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.SSZSchemaRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	forkVersion, err := h.forkVersionByName(req.Fork)
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []*sszschema.Container
+	if req.Container == "" {
+		containers, err = sszschema.Containers(forkVersion)
+	} else {
+		var container *sszschema.Container
+		container, err = sszschema.Get(forkVersion, req.Container)
+		containers = []*sszschema.Container{container}
+	}
+	if err != nil {
+		return nil, errors.Wrap(apitypes.ErrInvalidRequest, err.Error())
+	}
+	return debugtypes.SSZSchemaResponse{
+		Fork:       version.Name(forkVersion),
+		Containers: containers,
+	}, nil
+}
+
+// forkVersionByName returns the supported fork version with the given name,
+// or the fork version of the head state if no name is given.
+func (h *Handler) forkVersionByName(name string) (common.Version, error) {
+	if name == "" {
+		st, _, err := h.backend.StateAtSlot(0)
+		if err != nil {
+			return common.Version{}, err
+		}
+		fork, err := st.GetFork()
+		if err != nil {
+			return common.Version{}, err
+		}
+		return fork.CurrentVersion, nil
+	}
+
+	for _, v := range version.GetSupportedVersions() {
+		if version.Name(v) == name {
+			return v, nil
+		}
+	}
+	return common.Version{}, errors.Wrapf(apitypes.ErrInvalidRequest, "unknown fork %s", name)
+}
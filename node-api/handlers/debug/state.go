@@ -55,11 +55,15 @@ func (h *Handler) GetState(c handlers.Context) (any, error) {
 		return nil, err
 	}
 
+	optimistic, err := h.backend.ExecutionOptimistic(slot)
+	if err != nil {
+		return nil, err
+	}
+
 	return beacontypes.StateResponse{
 		// All data is finalized in CometBFT since we only return data for slots up to head
-		Finalized: true,
-		// Never optimistic since we only return finalized data
-		ExecutionOptimistic: false,
+		Finalized:           true,
+		ExecutionOptimistic: optimistic,
 
 		Version: version.Name(fork.CurrentVersion),
 		Data:    beaconState,
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+type GetSlotTimingsRequest struct {
+	Slot string `param:"slot" validate:"required,numeric"`
+}
+
+// SSZSchemaRequest is the request for the SSZ schema endpoint. Fork is the
+// name of a fork, e.g. `electra`, and defaults to the fork of the head state.
+// Container optionally restricts the response to a single container.
+type SSZSchemaRequest struct {
+	Fork      string `query:"fork"`
+	Container string `query:"container"`
+}
+
+// VerifySignatureRequest is a BLS signature to verify. Message is the hash
+// tree root of the signed object, which is signed along with the domain of
+// the given type. The domain is computed over the genesis fork version and
+// the genesis validators root of the chain unless given. Deposits signed
+// before genesis use the zero genesis validators root.
+type VerifySignatureRequest struct {
+	Pubkey                string `json:"pubkey"`
+	Message               string `json:"message"`
+	DomainType            string `json:"domain_type"`
+	ForkVersion           string `json:"fork_version,omitempty"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root,omitempty"`
+	Signature             string `json:"signature"`
+}
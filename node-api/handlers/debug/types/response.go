@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/sszschema"
+	"github.com/berachain/beacon-kit/node-core/services/depositreconciler"
+	"github.com/berachain/beacon-kit/observability/slottiming"
+)
+
+// SlotTimingsResponse is the response of the slot timings lookup.
+type SlotTimingsResponse struct {
+	Data *SlotTimingsData `json:"data"`
+}
+
+// SlotTimingsData is the proposer timeline of a slot. Timestamps are unix
+// milliseconds and durations are milliseconds; events that were not
+// observed by this node are omitted.
+type SlotTimingsData struct {
+	Slot                  string `json:"slot"`
+	ProposalRequested     string `json:"proposal_requested,omitempty"`
+	ForkchoiceSent        string `json:"forkchoice_sent,omitempty"`
+	PayloadReady          string `json:"payload_ready,omitempty"`
+	BlockBroadcast        string `json:"block_broadcast,omitempty"`
+	GetPayloadLatencyMs   string `json:"get_payload_latency_ms,omitempty"`
+	ForkchoiceToPayloadMs string `json:"forkchoice_to_payload_ms,omitempty"`
+	PayloadToBroadcastMs  string `json:"payload_to_broadcast_ms,omitempty"`
+	ProposalToBroadcastMs string `json:"proposal_to_broadcast_ms,omitempty"`
+}
+
+// NewSlotTimingsResponse converts a recorded timeline to its API response.
+func NewSlotTimingsResponse(tl slottiming.Timeline) *SlotTimingsResponse {
+	data := &SlotTimingsData{
+		Slot:              tl.Slot.Base10(),
+		ProposalRequested: unixMilli(tl.ProposalRequested),
+		ForkchoiceSent:    unixMilli(tl.ForkchoiceSent),
+		PayloadReady:      unixMilli(tl.PayloadReady),
+		BlockBroadcast:    unixMilli(tl.BlockBroadcast),
+	}
+	if !tl.PayloadReady.IsZero() {
+		data.GetPayloadLatencyMs = strconv.FormatInt(tl.GetPayloadLatency.Milliseconds(), 10)
+	}
+	data.ForkchoiceToPayloadMs = between(tl.ForkchoiceSent, tl.PayloadReady)
+	data.PayloadToBroadcastMs = between(tl.PayloadReady, tl.BlockBroadcast)
+	data.ProposalToBroadcastMs = between(tl.ProposalRequested, tl.BlockBroadcast)
+	return &SlotTimingsResponse{Data: data}
+}
+
+func unixMilli(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+func between(from, to time.Time) string {
+	if from.IsZero() || to.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(to.Sub(from).Milliseconds(), 10)
+}
+
+// SSZSchemaResponse is the response of the SSZ schema lookup.
+type SSZSchemaResponse struct {
+	Fork       string                 `json:"fork"`
+	Containers []*sszschema.Container `json:"containers"`
+}
+
+// DepositReconciliationResponse is the response of the deposit
+// reconciliation lookup.
+type DepositReconciliationResponse struct {
+	Data *DepositReconciliationData `json:"data"`
+}
+
+// DepositReconciliationData is the outcome of the latest reconciliation of
+// the deposit store against the deposit contract. CheckedAt is in unix
+// milliseconds. The divergence fields are omitted when consistent, and the
+// divergence block also when the deposit has no known location.
+type DepositReconciliationData struct {
+	CheckedAt       string `json:"checked_at"`
+	LocalCount      string `json:"local_count"`
+	LocalRoot       string `json:"local_root"`
+	ContractCount   string `json:"contract_count"`
+	Consistent      bool   `json:"consistent"`
+	DivergenceIndex string `json:"divergence_index,omitempty"`
+	DivergenceBlock string `json:"divergence_block,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// NewDepositReconciliationResponse converts a reconciliation outcome to its
+// API response.
+func NewDepositReconciliationResponse(
+	status *depositreconciler.Status,
+) *DepositReconciliationResponse {
+	data := &DepositReconciliationData{
+		CheckedAt:     unixMilli(status.CheckedAt),
+		LocalCount:    strconv.FormatUint(status.LocalCount, 10),
+		LocalRoot:     status.LocalRoot.Hex(),
+		ContractCount: strconv.FormatUint(status.ContractCount, 10),
+		Consistent:    status.Consistent,
+	}
+	if !status.Consistent {
+		data.DivergenceIndex = strconv.FormatUint(status.DivergenceIndex, 10)
+		data.Reason = status.Reason
+		if status.DivergenceBlock != 0 {
+			data.DivergenceBlock = strconv.FormatUint(status.DivergenceBlock, 10)
+		}
+	}
+	return &DepositReconciliationResponse{Data: data}
+}
+
+// VerifySignatureResponse is the response of a signature verification.
+type VerifySignatureResponse struct {
+	Data *VerifySignatureData `json:"data"`
+}
+
+// VerifySignatureData is the outcome of a signature verification, along with
+// the domain and signing root the signature was checked against. Error tells
+// why the signature is invalid.
+type VerifySignatureData struct {
+	Valid       bool   `json:"valid"`
+	Domain      string `json:"domain"`
+	SigningRoot string `json:"signing_root"`
+	Error       string `json:"error,omitempty"`
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	debugtypes "github.com/berachain/beacon-kit/node-api/handlers/debug/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+// VerifySignaturePath is the path of the signature verification endpoint,
+// which must be protected by the auth token to be served.
+const VerifySignaturePath = "bkit/v1/crypto/verify"
+
+// PostVerifySignature verifies a BLS signature against a pubkey, the root of
+// the signed object and a domain type, e.g. to check deposit data or remote
+// signer configurations before going live.
+func (h *Handler) PostVerifySignature(c handlers.Context) (any, error) {
+	var req debugtypes.VerifySignatureRequest
+	if err := c.Bind(&req); err != nil {
+		return nil, utils.BindError(err)
+	}
+	pubkey, err := parser.ConvertPubkey(req.Pubkey)
+	if err != nil {
+		return nil, invalidField("pubkey", err)
+	}
+	message, err := common.NewRootFromHex(req.Message)
+	if err != nil {
+		return nil, invalidField("message", err)
+	}
+	signature, err := parser.ConvertSignature(req.Signature)
+	if err != nil {
+		return nil, invalidField("signature", err)
+	}
+	domainType, err := parseBytes4(req.DomainType)
+	if err != nil {
+		return nil, invalidField("domain type", err)
+	}
+
+	cs, err := h.backend.Spec()
+	if err != nil {
+		return nil, err
+	}
+	forkVersion := cs.GenesisForkVersion()
+	if req.ForkVersion != "" {
+		if forkVersion, err = parseBytes4(req.ForkVersion); err != nil {
+			return nil, invalidField("fork version", err)
+		}
+	}
+	var genesisValidatorsRoot common.Root
+	if req.GenesisValidatorsRoot != "" {
+		genesisValidatorsRoot, err = common.NewRootFromHex(req.GenesisValidatorsRoot)
+		if err != nil {
+			return nil, invalidField("genesis validators root", err)
+		}
+	} else if genesisValidatorsRoot, err = h.backend.GenesisValidatorsRoot(); err != nil {
+		return nil, err
+	}
+
+	domain := ctypes.NewForkData(forkVersion, genesisValidatorsRoot).ComputeDomain(domainType)
+	signingRoot := (&ctypes.SigningData{ObjectRoot: message, Domain: domain}).HashTreeRoot()
+	data := &debugtypes.VerifySignatureData{
+		Valid:       true,
+		Domain:      domain.String(),
+		SigningRoot: signingRoot.Hex(),
+	}
+	if err = h.verifier.VerifySignature(pubkey, signingRoot[:], signature); err != nil {
+		data.Valid = false
+		data.Error = err.Error()
+	}
+	return &debugtypes.VerifySignatureResponse{Data: data}, nil
+}
+
+// parseBytes4 parses a 4 bytes hex string, e.g. a domain type.
+func parseBytes4(s string) (bytes.B4, error) {
+	bz, err := hex.ToBytes(s)
+	if err != nil {
+		return bytes.B4{}, err
+	}
+	return bytes.ToBytes4(bz)
+}
+
+// invalidField wraps the error parsing a request field as an invalid request.
+func invalidField(field string, err error) error {
+	return errors.Join(apitypes.ErrInvalidRequest, fmt.Errorf("failed parsing %s: %w", field, err))
+}
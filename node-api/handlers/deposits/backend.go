@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposits
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// Backend is the backend of the deposits API.
+type Backend interface {
+	// ProcessedDepositCount returns the number of deposits processed by the
+	// beacon chain as of the latest committed state.
+	ProcessedDepositCount() (uint64, error)
+	// DepositsByIndex returns up to count deposits from the deposit store,
+	// starting at the given index.
+	DepositsByIndex(ctx context.Context, startIndex, count uint64) (ctypes.Deposits, error)
+	// DepositLocation returns the execution layer location of the deposit at
+	// the given index, or nil if it is unknown.
+	DepositLocation(ctx context.Context, index uint64) (*ctypes.DepositLocation, error)
+	// HeadExecutionNumber returns the number of the execution block of the
+	// latest committed state.
+	HeadExecutionNumber() (math.U64, error)
+	// Spec returns the chain spec.
+	Spec() (chain.Spec, error)
+	// SimulateDeposit reports the effect the deposit would have if it was
+	// processed on top of the latest committed state.
+	SimulateDeposit(ctx context.Context, dep *ctypes.Deposit) (*core.DepositSimulation, error)
+}
+
+// DepositContract reads deposits from the deposit contract.
+type DepositContract interface {
+	// ReadDeposits reads deposits from the deposit contract, along with the
+	// execution layer location of each of them.
+	ReadDeposits(
+		ctx context.Context, fromBlock math.U64, toBlock math.U64,
+	) ([]*ctypes.Deposit, []*ctypes.DepositLocation, error)
+}
@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposits
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/deposits/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// keepAliveInterval is the longest the deposit stream stays silent, so that
+// proxies do not close idle connections.
+const keepAliveInterval = 15 * time.Second
+
+// GetDeposits returns a page of processed deposits starting at from_index,
+// along with the from_index of the following page.
+func (h *Handler) GetDeposits(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetDepositsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	fromIndex, err := parseIndex(req.FromIndex)
+	if err != nil {
+		return nil, err
+	}
+	limit := uint64(utils.DefaultPageSize)
+	if req.Limit != "" {
+		var l math.U64
+		l, err = math.U64FromString(req.Limit)
+		if err != nil || l == 0 {
+			return nil, apitypes.ErrInvalidRequest
+		}
+		limit = min(l.Unwrap(), utils.MaxPageSize)
+	}
+
+	deposits, next, err := h.processedDeposits(c.Request().Context(), fromIndex, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DepositsResponse{
+		Data:          deposits,
+		NextFromIndex: strconv.FormatUint(next, 10),
+	}, nil
+}
+
+// StreamDeposits streams processed deposits as server-sent events, starting
+// at from_index or right after the Last-Event-ID sent by a reconnecting
+// client. Each event carries the deposit index as its id.
+func (h *Handler) StreamDeposits(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.StreamDepositsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	fromIndex, err := parseIndex(req.FromIndex)
+	if err != nil {
+		return nil, err
+	}
+	if lastID := c.Request().Header.Get("Last-Event-ID"); req.FromIndex == "" && lastID != "" {
+		if fromIndex, err = parseIndex(lastID); err != nil {
+			return nil, err
+		}
+		fromIndex++
+	}
+
+	w := c.Response()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	ctx := c.Request().Context()
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+	lastWrite := time.Now()
+	for {
+		deposits, next, errPoll := h.processedDeposits(ctx, fromIndex, utils.MaxPageSize)
+		if errPoll != nil {
+			// The response is committed, the error can only be logged.
+			return nil, errPoll
+		}
+		for _, deposit := range deposits {
+			if err = writeEvent(w, deposit); err != nil {
+				return nil, nil //nolint:nilerr // client went away.
+			}
+		}
+		wrote := len(deposits) > 0
+		if !wrote && time.Since(lastWrite) >= keepAliveInterval {
+			if _, err = fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return nil, nil //nolint:nilerr // client went away.
+			}
+			wrote = true
+		}
+		if wrote {
+			w.Flush()
+			lastWrite = time.Now()
+		}
+		fromIndex = next
+
+		// Catch up without waiting if a full page was just sent.
+		if len(deposits) == utils.MaxPageSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetPendingDeposits returns the deposits emitted within the follow distance
+// of the execution block of the latest committed state. They are not read
+// into the deposit store yet, and become eligible for inclusion once
+// confirmed by the number of blocks set by the spec's eth1-follow-distance.
+func (h *Handler) GetPendingDeposits(c handlers.Context) (any, error) {
+	cs, err := h.backend.Spec()
+	if err != nil {
+		return nil, err
+	}
+	head, err := h.backend.HeadExecutionNumber()
+	if err != nil {
+		return nil, err
+	}
+	followDistance := math.U64(cs.Eth1FollowDistance())
+	resp := &types.PendingDepositsResponse{
+		Data:                  []*types.PendingDepositData{},
+		HeadBlockNumber:       head.Base10(),
+		RequiredConfirmations: followDistance.Base10(),
+	}
+	if followDistance == 0 || head == 0 {
+		return resp, nil
+	}
+
+	// depositFetcher reads the block at head minus the follow distance when
+	// head is finalized, so blocks after it are pending.
+	fromBlock := math.U64(1)
+	if head > followDistance {
+		fromBlock = head - followDistance + 1
+	}
+	deposits, locations, err := h.contract.ReadDeposits(
+		c.Request().Context(), fromBlock, head,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i, deposit := range deposits {
+		block := locations[i].BlockNumber
+		resp.Data = append(resp.Data, &types.PendingDepositData{
+			DepositData:     types.DepositDataFromConsensus(deposit, locations[i]),
+			Confirmations:   (head - block).Base10(),
+			EligibleAtBlock: (block + followDistance).Base10(),
+		})
+	}
+	return resp, nil
+}
+
+// PostSimulateDeposit reports whether the given deposit would be accepted if
+// it was processed on top of the latest committed state and the deposits
+// not processed yet, i.e. whether it creates a validator, tops one up, or is
+// ignored with its stake lost because of an invalid signature.
+func (h *Handler) PostSimulateDeposit(c handlers.Context) (any, error) {
+	var req types.SimulateDepositRequest
+	if err := c.Bind(&req); err != nil {
+		return nil, utils.BindError(err)
+	}
+	dep, err := types.SimulateDepositRequestToConsensus(&req)
+	if err != nil {
+		return nil, errors.Join(apitypes.ErrInvalidRequest, err)
+	}
+	sim, err := h.backend.SimulateDeposit(c.Request().Context(), dep)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DepositSimulationResponse{Data: types.DepositSimulationFromCore(sim)}, nil
+}
+
+// processedDeposits returns up to limit deposits processed by the beacon
+// chain starting at fromIndex, along with the index following the last one.
+func (h *Handler) processedDeposits(
+	ctx context.Context,
+	fromIndex uint64,
+	limit uint64,
+) ([]*types.DepositData, uint64, error) {
+	processed, err := h.backend.ProcessedDepositCount()
+	if err != nil {
+		return nil, 0, err
+	}
+	if fromIndex >= processed {
+		return []*types.DepositData{}, fromIndex, nil
+	}
+
+	deposits, err := h.backend.DepositsByIndex(ctx, fromIndex, min(limit, processed-fromIndex))
+	if err != nil {
+		return nil, 0, err
+	}
+	data := make([]*types.DepositData, 0, len(deposits))
+	for _, deposit := range deposits {
+		location, errLoc := h.backend.DepositLocation(ctx, deposit.GetIndex().Unwrap())
+		if errLoc != nil {
+			return nil, 0, errLoc
+		}
+		data = append(data, types.DepositDataFromConsensus(deposit, location))
+	}
+	return data, fromIndex + uint64(len(deposits)), nil
+}
+
+// parseIndex parses an optional deposit index, defaulting to zero.
+func parseIndex(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	index, err := math.U64FromString(s)
+	if err != nil {
+		return 0, apitypes.ErrInvalidRequest
+	}
+	return index.Unwrap(), nil
+}
+
+// writeEvent writes a deposit as a server-sent event.
+func writeEvent(w http.ResponseWriter, deposit *types.DepositData) error {
+	bz, err := json.Marshal(deposit)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: deposit\ndata: %s\n\n", deposit.Index, bz)
+	return err
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/cli/utils/parser"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+)
+
+type GetDepositsRequest struct {
+	FromIndex string `query:"from_index" validate:"omitempty,numeric"`
+	Limit     string `query:"limit"      validate:"omitempty,numeric"`
+}
+
+type StreamDepositsRequest struct {
+	FromIndex string `query:"from_index" validate:"omitempty,numeric"`
+}
+
+// SimulateDepositRequest is a deposit as sent to the deposit contract, with
+// the amount in Gwei.
+type SimulateDepositRequest struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+// SimulateDepositRequestToConsensus converts a deposit to simulate to its
+// consensus representation.
+func SimulateDepositRequestToConsensus(r *SimulateDepositRequest) (*ctypes.Deposit, error) {
+	pubkey, err := parser.ConvertPubkey(r.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing pubkey: %w", err)
+	}
+	credentials, err := parser.ConvertWithdrawalCredentials(r.WithdrawalCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing withdrawal credentials: %w", err)
+	}
+	amount, err := parser.ConvertAmount(r.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing amount: %w", err)
+	}
+	signature, err := parser.ConvertSignature(r.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing signature: %w", err)
+	}
+	return &ctypes.Deposit{
+		Pubkey:      pubkey,
+		Credentials: credentials,
+		Amount:      amount,
+		Signature:   signature,
+	}, nil
+}
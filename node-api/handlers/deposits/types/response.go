@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"strconv"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/state-transition/core"
+)
+
+// DepositData is a processed deposit along with the execution layer location
+// of the log it was read from. The location is omitted for genesis deposits.
+type DepositData struct {
+	Index                 string `json:"index"`
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+	BlockNumber           string `json:"block_number,omitempty"`
+	LogIndex              string `json:"log_index,omitempty"`
+	TransactionHash       string `json:"transaction_hash,omitempty"`
+}
+
+// DepositsResponse is a page of processed deposits. NextFromIndex is the
+// from_index to request the following page with, which is also the number of
+// deposits processed so far once the last page has been reached.
+type DepositsResponse struct {
+	Data          []*DepositData `json:"data"`
+	NextFromIndex string         `json:"next_from_index"`
+}
+
+// DepositDataFromConsensus converts a deposit and its optional location to
+// its API representation.
+func DepositDataFromConsensus(
+	d *ctypes.Deposit,
+	location *ctypes.DepositLocation,
+) *DepositData {
+	signature := d.GetSignature()
+	data := &DepositData{
+		Index:                 d.GetIndex().Base10(),
+		Pubkey:                d.GetPubkey().String(),
+		WithdrawalCredentials: d.GetWithdrawalCredentials().String(),
+		Amount:                d.GetAmount().Base10(),
+		Signature:             signature.String(),
+	}
+	if location != nil {
+		data.BlockNumber = location.BlockNumber.Base10()
+		data.LogIndex = location.LogIndex.Base10()
+		data.TransactionHash = location.TxHash.String()
+	}
+	return data
+}
+
+// PendingDepositData is a deposit the node has seen on the execution layer
+// but that does not have enough confirmations yet to be eligible for
+// inclusion.
+type PendingDepositData struct {
+	*DepositData
+	Confirmations   string `json:"confirmations"`
+	EligibleAtBlock string `json:"eligible_at_block"`
+}
+
+// PendingDepositsResponse lists the deposits awaiting confirmation as of the
+// execution block of the latest committed state.
+type PendingDepositsResponse struct {
+	Data                  []*PendingDepositData `json:"data"`
+	HeadBlockNumber       string                `json:"head_block_number"`
+	RequiredConfirmations string                `json:"required_confirmations"`
+}
+
+// DepositSimulationData is the effect a deposit would have if it was
+// processed. Outcome is one of create_validator, top_up or stake_lost, and
+// Valid is false only for the latter.
+type DepositSimulationData struct {
+	Valid                bool     `json:"valid"`
+	Outcome              string   `json:"outcome"`
+	ValidatorIndex       string   `json:"validator_index,omitempty"`
+	CreatingDepositIndex string   `json:"creating_deposit_index,omitempty"`
+	Error                string   `json:"error,omitempty"`
+	Warnings             []string `json:"warnings"`
+}
+
+// DepositSimulationResponse is the response of a deposit simulation.
+type DepositSimulationResponse struct {
+	Data *DepositSimulationData `json:"data"`
+}
+
+// DepositSimulationFromCore converts a deposit simulation to its API
+// representation.
+func DepositSimulationFromCore(sim *core.DepositSimulation) *DepositSimulationData {
+	data := &DepositSimulationData{
+		Valid:    sim.Outcome != core.DepositStakeLost,
+		Outcome:  string(sim.Outcome),
+		Warnings: sim.Warnings,
+	}
+	if data.Warnings == nil {
+		data.Warnings = []string{}
+	}
+	if sim.ValidatorIndex != nil {
+		data.ValidatorIndex = sim.ValidatorIndex.Base10()
+	}
+	if sim.CreatingDepositIndex != nil {
+		data.CreatingDepositIndex = strconv.FormatUint(*sim.CreatingDepositIndex, 10)
+	}
+	if sim.SignatureError != nil {
+		data.Error = "invalid deposit signature: " + sim.SignatureError.Error()
+	}
+	return data
+}
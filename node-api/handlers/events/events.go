@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/events/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// keepAliveInterval is the longest the event stream stays silent, so that
+// proxies do not close idle connections.
+const keepAliveInterval = 15 * time.Second
+
+// supportedTopics are the event topics that can be subscribed to.
+//
+//nolint:gochecknoglobals // read-only.
+var supportedTopics = []string{events.TopicHead, events.TopicChainReorg}
+
+// StreamEvents streams the events of the requested topics as server-sent
+// events. Topics may be repeated or given as a comma separated list.
+func (h *Handler) StreamEvents(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.EventsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	var topics []string
+	for _, topic := range req.Topics {
+		for _, t := range strings.Split(topic, ",") {
+			if !slices.Contains(supportedTopics, t) {
+				return nil, fmt.Errorf("%w: unsupported topic %q", apitypes.ErrInvalidRequest, t)
+			}
+			topics = append(topics, t)
+		}
+	}
+
+	sub, cancel := h.bus.Subscribe(topics...)
+	defer cancel()
+
+	w := c.Response()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	ctx := c.Request().Context()
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-keepAlive.C:
+			if _, err = fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return nil, nil //nolint:nilerr // client went away.
+			}
+		case event := <-sub:
+			data, ok := types.EventFromBus(event)
+			if !ok {
+				continue
+			}
+			if err = writeEvent(w, event.Topic, data); err != nil {
+				return nil, nil //nolint:nilerr // client went away.
+			}
+		}
+		w.Flush()
+	}
+}
+
+// writeEvent writes an event as a server-sent event.
+func writeEvent(w http.ResponseWriter, topic string, data any) error {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, bz)
+	return err
+}
@@ -20,17 +20,22 @@
 
 package events
 
-import "github.com/berachain/beacon-kit/node-api/handlers"
+import (
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+)
 
 type Handler struct {
 	*handlers.BaseHandler
+	bus *events.Bus
 }
 
-func NewHandler() *Handler {
+func NewHandler(bus *events.Bus) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		bus: bus,
 	}
 	return h
 }
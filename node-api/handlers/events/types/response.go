@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+)
+
+// HeadEvent is the data of a head event.
+type HeadEvent struct {
+	Slot                string `json:"slot"`
+	Block               string `json:"block"`
+	State               string `json:"state"`
+	EpochTransition     bool   `json:"epoch_transition"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// ChainReorgEvent is the data of a chain_reorg event.
+type ChainReorgEvent struct {
+	Slot                string `json:"slot"`
+	Depth               string `json:"depth"`
+	OldHeadBlock        string `json:"old_head_block"`
+	NewHeadBlock        string `json:"new_head_block"`
+	OldHeadState        string `json:"old_head_state"`
+	NewHeadState        string `json:"new_head_state"`
+	Epoch               string `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// EventFromBus converts the data of an event published on the bus to its API
+// representation, or returns false if the event is not served by the API.
+func EventFromBus(event events.Event) (any, bool) {
+	switch data := event.Data.(type) {
+	case *events.Head:
+		return &HeadEvent{
+			Slot:                data.Slot.Base10(),
+			Block:               data.Block.String(),
+			State:               data.State.String(),
+			EpochTransition:     data.EpochTransition,
+			ExecutionOptimistic: data.ExecutionOptimistic,
+		}, true
+	case *events.ChainReorg:
+		return &ChainReorgEvent{
+			Slot:                data.Slot.Base10(),
+			Depth:               strconv.FormatUint(data.Depth, 10),
+			OldHeadBlock:        data.OldHeadBlock.String(),
+			NewHeadBlock:        data.NewHeadBlock.String(),
+			OldHeadState:        data.OldHeadState.String(),
+			NewHeadState:        data.NewHeadState.String(),
+			Epoch:               data.Epoch.Base10(),
+			ExecutionOptimistic: data.ExecutionOptimistic,
+		}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fees
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/berachain/beacon-kit/storage/proposals"
+)
+
+// Backend is the backend of the fees API.
+type Backend interface {
+	// PayloadSummaries returns the execution payload summaries of up to the
+	// last count finalized blocks, in ascending slot order.
+	PayloadSummaries(count uint64) []block.PayloadSummary
+	// PayloadSummaryAtSlot returns the execution payload summary of the
+	// finalized block at the given slot.
+	PayloadSummaryAtSlot(slot math.Slot) (block.PayloadSummary, error)
+	// FeeHistory returns the fee history of the last blockCount execution
+	// blocks from the execution client.
+	FeeHistory(
+		ctx context.Context, blockCount uint64, rewardPercentiles []float64,
+	) (*ethclient.FeeHistory, error)
+}
+
+// ProposalStore is the audit log of the blocks proposed by this node.
+type ProposalStore interface {
+	// Range returns up to limit proposals recorded from the slot onwards, in
+	// ascending slot order.
+	Range(from math.Slot, limit int) ([]proposals.Record, error)
+}
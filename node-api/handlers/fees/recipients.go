@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fees
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/fees/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetFeeRecipients returns a page of the blocks proposed by this node from
+// from_slot onwards, with the fee recipient and payload block hash of each,
+// along with the from_slot of the following page.
+func (h *Handler) GetFeeRecipients(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetFeeRecipientsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	var from math.Slot
+	if req.FromSlot != "" {
+		from, err = math.U64FromString(req.FromSlot)
+		if err != nil {
+			return nil, apitypes.ErrInvalidRequest
+		}
+	}
+	limit := utils.DefaultPageSize
+	if req.Limit != "" {
+		var l math.U64
+		l, err = math.U64FromString(req.Limit)
+		if err != nil || l == 0 {
+			return nil, apitypes.ErrInvalidRequest
+		}
+		limit = int(min(l.Unwrap(), utils.MaxPageSize)) // #nosec G115 -- capped.
+	}
+
+	records, err := h.proposals.Range(from, limit)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*types.FeeRecipientData, 0, len(records))
+	next := from
+	for _, r := range records {
+		entry := &types.FeeRecipientData{
+			Slot:         r.Slot.Base10(),
+			FeeRecipient: r.FeeRecipient,
+			BlockHash:    r.BlockHash,
+		}
+		// The block store only keeps the recent blocks, older proposals are
+		// served without the inclusion check.
+		if summary, sErr := h.backend.PayloadSummaryAtSlot(r.Slot); sErr == nil {
+			included := summary.BlockHash == r.BlockHash
+			entry.Included = &included
+		}
+		data = append(data, entry)
+		next = r.Slot + 1
+	}
+	return &types.FeeRecipientsResponse{
+		Data:         data,
+		NextFromSlot: next.Base10(),
+	}, nil
+}
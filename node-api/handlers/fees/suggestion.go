@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fees
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/fees/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// HistoryBlocks is the number of recent execution blocks whose priority fees
+// are aggregated into the suggestion.
+const HistoryBlocks = 20
+
+// rewardPercentiles are the percentiles of the priority fees of each block
+// backing the low, medium and high suggestions.
+//
+//nolint:gochecknoglobals // read-only.
+var rewardPercentiles = []float64{10, 50, 90}
+
+// GetFeeSuggestion serves the fee suggestion, which lets wallets that only
+// talk to the consensus node price their transactions.
+func (h *Handler) GetFeeSuggestion(c handlers.Context) (any, error) {
+	data, err := h.Suggest(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	return &types.FeeSuggestionResponse{Data: data}, nil
+}
+
+// Suggest returns the base fee of the latest finalized block from the block
+// store, together with the next base fees and the priority fees paid over the
+// recent blocks as reported by the execution client.
+func (h *Handler) Suggest(ctx context.Context) (*types.FeeSuggestionData, error) {
+	data := &types.FeeSuggestionData{}
+	if summaries := h.backend.PayloadSummaries(1); len(summaries) > 0 {
+		latest := summaries[0]
+		data.Slot = latest.Slot.Base10()
+		data.BlockNumber = latest.BlockNumber.Base10()
+		if latest.BaseFeePerGas != nil {
+			data.BaseFeePerGas = latest.BaseFeePerGas.Dec()
+		}
+	}
+
+	history, err := h.backend.FeeHistory(ctx, HistoryBlocks, rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+	data.NextBaseFeePerGas = last(history.BaseFee)
+	data.NextBlobBaseFeePerGas = last(history.BlobBaseFee)
+	data.PriorityFeePerGas = &types.PriorityFeeLevels{
+		Low:    median(history.Reward, 0),
+		Medium: median(history.Reward, 1),
+		High:   median(history.Reward, 2),
+	}
+	return data, nil
+}
+
+// last returns the last of the given fees, which fee history reports for the
+// block after the newest one, or zero if there are none.
+func last(fees []*hexutil.Big) string {
+	if len(fees) == 0 || fees[len(fees)-1] == nil {
+		return "0"
+	}
+	return fees[len(fees)-1].ToInt().String()
+}
+
+// median returns the median over blocks of the reward at the given
+// percentile index, or zero if no block reports one.
+func median(rewards [][]*hexutil.Big, index int) string {
+	fees := make([]*big.Int, 0, len(rewards))
+	for _, blockRewards := range rewards {
+		if index < len(blockRewards) && blockRewards[index] != nil {
+			fees = append(fees, blockRewards[index].ToInt())
+		}
+	}
+	if len(fees) == 0 {
+		return "0"
+	}
+	slices.SortFunc(fees, func(a, b *big.Int) int { return a.Cmp(b) })
+	return fees[len(fees)/2].String()
+}
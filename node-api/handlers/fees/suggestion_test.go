@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package fees_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/node-api/handlers/fees"
+	"github.com/berachain/beacon-kit/node-api/handlers/fees/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+var errExecutionClient = errors.New("execution client unavailable")
+
+type stubBackend struct {
+	summaries []block.PayloadSummary
+	history   *ethclient.FeeHistory
+	err       error
+}
+
+func (b stubBackend) PayloadSummaries(uint64) []block.PayloadSummary {
+	return b.summaries
+}
+
+func (b stubBackend) PayloadSummaryAtSlot(slot math.Slot) (block.PayloadSummary, error) {
+	for _, s := range b.summaries {
+		if s.Slot == slot {
+			return s, nil
+		}
+	}
+	return block.PayloadSummary{}, errors.New("payload summary not found")
+}
+
+func (b stubBackend) FeeHistory(
+	context.Context, uint64, []float64,
+) (*ethclient.FeeHistory, error) {
+	return b.history, b.err
+}
+
+func wei(values ...int64) []*hexutil.Big {
+	fees := make([]*hexutil.Big, len(values))
+	for i, v := range values {
+		fees[i] = (*hexutil.Big)(big.NewInt(v))
+	}
+	return fees
+}
+
+func TestHandler_Suggest(t *testing.T) {
+	t.Parallel()
+
+	backend := stubBackend{
+		summaries: []block.PayloadSummary{{
+			Slot:          7,
+			BlockNumber:   42,
+			BaseFeePerGas: math.NewU256(1000),
+		}},
+		history: &ethclient.FeeHistory{
+			BaseFee:     wei(900, 1000, 1100),
+			BlobBaseFee: wei(1, 1, 2),
+			Reward: [][]*hexutil.Big{
+				wei(1, 5, 50),
+				wei(3, 7, 10),
+				wei(2, 6, 30),
+			},
+		},
+	}
+	data, err := fees.NewHandler(backend, nil).Suggest(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &types.FeeSuggestionData{
+		Slot:                  "7",
+		BlockNumber:           "42",
+		BaseFeePerGas:         "1000",
+		NextBaseFeePerGas:     "1100",
+		NextBlobBaseFeePerGas: "2",
+		PriorityFeePerGas: &types.PriorityFeeLevels{
+			Low:    "2",
+			Medium: "6",
+			High:   "30",
+		},
+	}, data)
+
+	// Without any blocks or rewards the suggestion falls back to zero.
+	data, err = fees.NewHandler(stubBackend{history: &ethclient.FeeHistory{}}, nil).
+		Suggest(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "0", data.NextBaseFeePerGas)
+	require.Equal(t, "0", data.PriorityFeePerGas.Medium)
+
+	_, err = fees.NewHandler(stubBackend{err: errExecutionClient}, nil).
+		Suggest(context.Background())
+	require.ErrorIs(t, err, errExecutionClient)
+}
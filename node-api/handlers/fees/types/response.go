@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "github.com/berachain/beacon-kit/primitives/common"
+
+// FeeSuggestionResponse is the response of the fee suggestion.
+type FeeSuggestionResponse struct {
+	Data *FeeSuggestionData `json:"data"`
+}
+
+// FeeSuggestionData holds the fee data of the latest finalized block and the
+// fees suggested for the next one, in Wei.
+type FeeSuggestionData struct {
+	Slot                  string             `json:"slot"`
+	BlockNumber           string             `json:"block_number"`
+	BaseFeePerGas         string             `json:"base_fee_per_gas"`
+	NextBaseFeePerGas     string             `json:"next_base_fee_per_gas"`
+	NextBlobBaseFeePerGas string             `json:"next_blob_base_fee_per_gas"`
+	PriorityFeePerGas     *PriorityFeeLevels `json:"priority_fee_per_gas"`
+}
+
+// PriorityFeeLevels are the median over recent blocks of the priority fees
+// paid at the 10th, 50th and 90th percentile of each block.
+type PriorityFeeLevels struct {
+	Low    string `json:"low"`
+	Medium string `json:"medium"`
+	High   string `json:"high"`
+}
+
+// FeeRecipientsResponse is a page of the proposals of this node, along with
+// the from_slot of the following page.
+type FeeRecipientsResponse struct {
+	Data         []*FeeRecipientData `json:"data"`
+	NextFromSlot string              `json:"next_from_slot"`
+}
+
+// FeeRecipientData is the fee recipient of a block proposed by this node.
+// Included is set if the block store still holds the slot, and reports
+// whether the recorded payload is the one finalized.
+type FeeRecipientData struct {
+	Slot         string                  `json:"slot"`
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	BlockHash    common.ExecutionHash    `json:"block_hash"`
+	Included     *bool                   `json:"included,omitempty"`
+}
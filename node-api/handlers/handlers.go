@@ -33,6 +33,12 @@ type Context = echo.Context
 // handlerFn enforces a signature for all handler functions.
 type handlerFn func(c Context) (any, error)
 
+// StatusCoder is implemented by responses served with a status code other
+// than HTTP 200.
+type StatusCoder interface {
+	StatusCode() int
+}
+
 // Handlers is an interface that all handlers must implement.
 type Handlers interface {
 	// RegisterRoutes is a method that registers the routes for the handler.
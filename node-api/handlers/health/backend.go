@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import "github.com/berachain/beacon-kit/primitives/math"
+
+// Backend is the backend of the health endpoints.
+type Backend interface {
+	// SyncStatus returns the number of blocks the node is behind its peers
+	// and whether it is still catching up.
+	SyncStatus() (int64, bool, error)
+	// HeadExecutionTimestamp returns the latest committed slot and the
+	// timestamp of its execution payload.
+	HeadExecutionTimestamp() (math.Slot, math.U64, error)
+}
+
+// ExecutionClient reports the connectivity to the execution client.
+type ExecutionClient interface {
+	// IsConnected returns true if the execution client is reachable.
+	IsConnected() bool
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+)
+
+// Handler serves the liveness and readiness probes of the node.
+type Handler struct {
+	*handlers.BaseHandler
+	backend         Backend
+	engine          ExecutionClient
+	maxSyncDistance uint64
+	maxFinalizedAge time.Duration
+}
+
+// NewHandler creates a health handler. The node is not ready while it is
+// more than maxSyncDistance blocks behind its peers, or while its last
+// finalized block is older than maxFinalizedAge.
+func NewHandler(
+	backend Backend,
+	engine ExecutionClient,
+	maxSyncDistance uint64,
+	maxFinalizedAge time.Duration,
+) *Handler {
+	return &Handler{
+		BaseHandler: handlers.NewBaseHandler(
+			handlers.NewRouteSet(""),
+		),
+		backend:         backend,
+		engine:          engine,
+		maxSyncDistance: maxSyncDistance,
+		maxFinalizedAge: maxFinalizedAge,
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/health/types"
+)
+
+const (
+	componentExecution = "execution_client"
+	componentSync      = "sync"
+	componentFinalized = "finalized"
+	componentStorage   = "storage"
+)
+
+// GetHealth serves the liveness probe. It only fails if the node cannot read
+// its own storage, since restarting the node does not help with an
+// unreachable execution client or a lagging chain.
+func (h *Handler) GetHealth(handlers.Context) (any, error) {
+	return h.Check(time.Now(), false), nil
+}
+
+// GetReadiness serves the readiness probe. It fails unless every component
+// is healthy.
+func (h *Handler) GetReadiness(handlers.Context) (any, error) {
+	return h.Check(time.Now(), true), nil
+}
+
+// Check returns the health of every component at the given time. The overall
+// status accounts for every component if readiness is set, and for storage
+// only otherwise.
+func (h *Handler) Check(now time.Time, readiness bool) *types.HealthResponse {
+	storage, finalized := h.checkHead(now)
+	components := map[string]*types.ComponentStatus{
+		componentExecution: h.checkExecution(),
+		componentSync:      h.checkSync(),
+		componentFinalized: finalized,
+		componentStorage:   storage,
+	}
+
+	status := types.StatusOK
+	for name, component := range components {
+		if component.Status == types.StatusOK {
+			continue
+		}
+		if readiness || name == componentStorage {
+			status = types.StatusDown
+		}
+	}
+	return types.NewHealthResponse(status, components)
+}
+
+// checkExecution reports whether the execution client is reachable.
+func (h *Handler) checkExecution() *types.ComponentStatus {
+	if !h.engine.IsConnected() {
+		return &types.ComponentStatus{
+			Status:  types.StatusDown,
+			Message: "execution client is not connected",
+		}
+	}
+	return &types.ComponentStatus{Status: types.StatusOK}
+}
+
+// checkSync reports how far the node is behind its peers.
+func (h *Handler) checkSync() *types.ComponentStatus {
+	distance, syncing, err := h.backend.SyncStatus()
+	if err != nil {
+		return &types.ComponentStatus{
+			Status:  types.StatusDown,
+			Message: err.Error(),
+		}
+	}
+
+	component := &types.ComponentStatus{
+		Status: types.StatusOK,
+		Details: map[string]string{
+			"sync_distance": strconv.FormatInt(distance, 10),
+			"is_syncing":    strconv.FormatBool(syncing),
+		},
+	}
+	//#nosec: G115 // the sync distance is never negative.
+	if syncing || uint64(distance) > h.maxSyncDistance {
+		component.Status = types.StatusDegraded
+		component.Message = "node is catching up"
+	}
+	return component
+}
+
+// checkHead reports whether the latest committed state can be read from
+// storage, and how long ago its block was finalized.
+func (h *Handler) checkHead(
+	now time.Time,
+) (*types.ComponentStatus, *types.ComponentStatus) {
+	slot, timestamp, err := h.backend.HeadExecutionTimestamp()
+	if err != nil {
+		down := &types.ComponentStatus{
+			Status:  types.StatusDown,
+			Message: err.Error(),
+		}
+		return down, &types.ComponentStatus{
+			Status:  types.StatusDown,
+			Message: "latest finalized block is unknown",
+		}
+	}
+
+	//#nosec: G115 // block timestamps fit in an int64.
+	age := now.Sub(time.Unix(int64(timestamp), 0)).Truncate(time.Second)
+	finalized := &types.ComponentStatus{
+		Status: types.StatusOK,
+		Details: map[string]string{
+			"slot":        slot.Base10(),
+			"age_seconds": strconv.FormatInt(int64(age.Seconds()), 10),
+		},
+	}
+	if age > h.maxFinalizedAge {
+		finalized.Status = types.StatusDegraded
+		finalized.Message = "latest finalized block is stale"
+	}
+	return &types.ComponentStatus{Status: types.StatusOK}, finalized
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/health"
+	"github.com/berachain/beacon-kit/node-api/handlers/health/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errStorage = errors.New("storage unavailable")
+
+type stubBackend struct {
+	distance  int64
+	syncing   bool
+	timestamp math.U64
+	headErr   error
+}
+
+func (b stubBackend) SyncStatus() (int64, bool, error) {
+	return b.distance, b.syncing, nil
+}
+
+func (b stubBackend) HeadExecutionTimestamp() (math.Slot, math.U64, error) {
+	return 10, b.timestamp, b.headErr
+}
+
+type stubEngine bool
+
+func (e stubEngine) IsConnected() bool { return bool(e) }
+
+func TestHandler_Check(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1_000, 0)
+	fresh := math.U64(now.Add(-2 * time.Second).Unix())
+
+	tests := []struct {
+		name          string
+		backend       stubBackend
+		connected     bool
+		healthCode    int
+		readinessCode int
+		unhealthy     string
+	}{
+		{
+			name:          "healthy",
+			backend:       stubBackend{timestamp: fresh},
+			connected:     true,
+			healthCode:    http.StatusOK,
+			readinessCode: http.StatusOK,
+		},
+		{
+			name:          "execution client down",
+			backend:       stubBackend{timestamp: fresh},
+			healthCode:    http.StatusOK,
+			readinessCode: http.StatusServiceUnavailable,
+			unhealthy:     "execution_client",
+		},
+		{
+			name:          "too far behind",
+			backend:       stubBackend{distance: 9, timestamp: fresh},
+			connected:     true,
+			healthCode:    http.StatusOK,
+			readinessCode: http.StatusServiceUnavailable,
+			unhealthy:     "sync",
+		},
+		{
+			name:          "stale finalized block",
+			backend:       stubBackend{timestamp: math.U64(now.Add(-time.Hour).Unix())},
+			connected:     true,
+			healthCode:    http.StatusOK,
+			readinessCode: http.StatusServiceUnavailable,
+			unhealthy:     "finalized",
+		},
+		{
+			name:          "storage down",
+			backend:       stubBackend{headErr: errStorage},
+			connected:     true,
+			healthCode:    http.StatusServiceUnavailable,
+			readinessCode: http.StatusServiceUnavailable,
+			unhealthy:     "storage",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := health.NewHandler(tt.backend, stubEngine(tt.connected), 8, time.Minute)
+
+			liveness := h.Check(now, false)
+			require.Equal(t, tt.healthCode, liveness.StatusCode())
+
+			readiness := h.Check(now, true)
+			require.Equal(t, tt.readinessCode, readiness.StatusCode())
+			for name, component := range readiness.Components {
+				if name == tt.unhealthy {
+					require.NotEqual(t, types.StatusOK, component.Status)
+				} else if tt.unhealthy != "storage" || name != "finalized" {
+					require.Equal(t, types.StatusOK, component.Status, name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "net/http"
+
+const (
+	// StatusOK reports a healthy component.
+	StatusOK = "ok"
+	// StatusDegraded reports a component which works but should not serve
+	// traffic yet, e.g. while syncing.
+	StatusDegraded = "degraded"
+	// StatusDown reports a failed component.
+	StatusDown = "down"
+)
+
+// ComponentStatus is the health of a single component of the node.
+type ComponentStatus struct {
+	Status  string            `json:"status"`
+	Message string            `json:"message,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// HealthResponse aggregates the health of the node components.
+type HealthResponse struct {
+	Status     string                      `json:"status"`
+	Components map[string]*ComponentStatus `json:"components"`
+
+	code int
+}
+
+// NewHealthResponse creates a response with the given overall status, which
+// is served with an HTTP 200 if ok and an HTTP 503 otherwise.
+func NewHealthResponse(
+	status string,
+	components map[string]*ComponentStatus,
+) *HealthResponse {
+	code := http.StatusOK
+	if status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	return &HealthResponse{
+		Status:     status,
+		Components: components,
+		code:       code,
+	}
+}
+
+// StatusCode returns the HTTP status code the response is served with.
+func (r *HealthResponse) StatusCode() int {
+	return r.code
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+)
+
+const (
+	// MaxBodyBytes is the largest request body accepted, which fits a block
+	// with the maximum number of blobs in any encoding.
+	MaxBodyBytes = 16 << 20
+	// DecodeTimeout bounds the time to read a request body.
+	DecodeTimeout = 10 * time.Second
+	// MaxListLength is the largest number of values accepted in a list, be
+	// it a query parameter or a request body field.
+	MaxListLength = 4096
+)
+
+// DecorateWithLimits rejects requests with a body larger than MaxBodyBytes or
+// a query parameter listing more than MaxListLength values, and bounds the
+// time to read the body to DecodeTimeout.
+func (r *Route) DecorateWithLimits() {
+	handler := r.Handler
+	r.Handler = func(c Context) (any, error) {
+		req := c.Request()
+		if req.ContentLength > MaxBodyBytes {
+			return nil, types.ErrRequestTooLarge
+		}
+		for key, values := range req.URL.Query() {
+			if countValues(values) > MaxListLength {
+				return nil, errors.Wrapf(
+					types.ErrInvalidRequest,
+					"query parameter %s lists more than %d values", key, MaxListLength,
+				)
+			}
+		}
+
+		if req.Body == nil || req.Body == http.NoBody {
+			return handler(c)
+		}
+
+		// The deadline is lifted as soon as the body has been read, as it
+		// would otherwise cancel the request context of slow handlers.
+		// Not every writer supports deadlines, in which case the server
+		// timeouts still apply.
+		rc := http.NewResponseController(c.Response())
+		//nolint:errcheck // best effort.
+		rc.SetReadDeadline(time.Now().Add(DecodeTimeout))
+		body := &deadlineBody{
+			ReadCloser: http.MaxBytesReader(c.Response(), req.Body, MaxBodyBytes),
+			//nolint:errcheck // best effort.
+			clear: func() { rc.SetReadDeadline(time.Time{}) },
+		}
+		defer body.clearDeadline()
+		req.Body = body
+		return handler(c)
+	}
+}
+
+// deadlineBody is a request body which clears the read deadline of the
+// connection once it has been fully read or closed.
+type deadlineBody struct {
+	io.ReadCloser
+	clear func()
+	once  sync.Once
+}
+
+func (b *deadlineBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.clearDeadline()
+	}
+	return n, err
+}
+
+func (b *deadlineBody) Close() error {
+	b.clearDeadline()
+	return b.ReadCloser.Close()
+}
+
+func (b *deadlineBody) clearDeadline() {
+	b.once.Do(b.clear)
+}
+
+// countValues returns the number of values in a query parameter, whether
+// repeated or comma separated.
+func countValues(values []string) int {
+	n := 0
+	for _, v := range values {
+		n += strings.Count(v, ",") + 1
+	}
+	return n
+}
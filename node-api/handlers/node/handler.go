@@ -24,13 +24,24 @@ import "github.com/berachain/beacon-kit/node-api/handlers"
 
 type Handler struct {
 	*handlers.BaseHandler
+	backend Backend
+	engine  ExecutionClient
+	disk    DiskUsage
+	version string
 }
 
-func NewHandler() *Handler {
+// NewHandler creates a node API handler reporting the given node version.
+func NewHandler(
+	backend Backend, engine ExecutionClient, disk DiskUsage, version string,
+) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		backend: backend,
+		engine:  engine,
+		disk:    disk,
+		version: version,
 	}
 	return h
 }
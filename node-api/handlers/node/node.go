@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// clientName is the client name reported by the version endpoint.
+const clientName = "beacond"
+
+// VersionString formats the given semantic version the way Beacon API
+// clients expect, e.g. "beacond/v1.2.0 (linux amd64)".
+func VersionString(version string) string {
+	if version == "" {
+		version = "dev"
+	} else if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return fmt.Sprintf("%s/%s (%s %s)", clientName, version, runtime.GOOS, runtime.GOARCH)
+}
+
+// Syncing reports the head slot and how far the node is behind its peers.
+// Payloads are verified before they are committed, so the node is never
+// optimistic.
+func (h *Handler) Syncing(handlers.Context) (any, error) {
+	headSlot, _, err := h.backend.HeadExecutionTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	distance, syncing, err := h.backend.SyncStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return nodetypes.DataResponse{
+		Data: nodetypes.SyncingData{
+			HeadSlot:     headSlot.Base10(),
+			SyncDistance: strconv.FormatInt(distance, 10),
+			IsSyncing:    syncing,
+			IsOptimistic: false,
+			ELOffline:    !h.engine.IsConnected(),
+		},
+	}, nil
+}
+
+// GetPeers returns the CometBFT peers of the node.
+func (h *Handler) GetPeers(handlers.Context) (any, error) {
+	peers := h.backend.Peers()
+	data := make([]*nodetypes.PeerData, 0, len(peers))
+	for _, peer := range peers {
+		data = append(data, peerData(peer.ID, peer.Address, peer.Outbound))
+	}
+	return nodetypes.PeersResponse{
+		Data: data,
+		Meta: nodetypes.PeersMeta{Count: len(data)},
+	}, nil
+}
+
+// GetPeer returns the CometBFT peer with the requested ID.
+func (h *Handler) GetPeer(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.GetPeerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, peer := range h.backend.Peers() {
+		if peer.ID == req.PeerID {
+			return nodetypes.DataResponse{
+				Data: peerData(peer.ID, peer.Address, peer.Outbound),
+			}, nil
+		}
+	}
+	return nil, types.ErrNotFound
+}
+
+// GetPeerCount returns the number of peers by state. CometBFT only reports
+// connected peers.
+func (h *Handler) GetPeerCount(handlers.Context) (any, error) {
+	return nodetypes.DataResponse{
+		Data: nodetypes.PeerCountData{
+			Disconnected:  "0",
+			Connecting:    "0",
+			Connected:     strconv.Itoa(len(h.backend.Peers())),
+			Disconnecting: "0",
+		},
+	}, nil
+}
+
+// Version returns the version of the node.
+func (h *Handler) Version(handlers.Context) (any, error) {
+	return nodetypes.DataResponse{
+		Data: nodetypes.VersionData{Version: h.version},
+	}, nil
+}
+
+// GetDiskUsage returns the latest disk usage measurement of the node's stores.
+func (h *Handler) GetDiskUsage(handlers.Context) (any, error) {
+	report := h.disk.Report()
+	if report.MeasuredAt.IsZero() {
+		return nil, fmt.Errorf("%w: disk usage not measured yet", types.ErrNotFound)
+	}
+	stores := make([]nodetypes.StoreUsageData, 0, len(report.Stores))
+	for _, store := range report.Stores {
+		stores = append(stores, nodetypes.StoreUsageData{
+			Name:  store.Name,
+			Bytes: strconv.FormatUint(store.Bytes, 10),
+		})
+	}
+	return nodetypes.DataResponse{
+		Data: nodetypes.DiskUsageData{
+			Stores:         stores,
+			TotalBytes:     strconv.FormatUint(report.TotalBytes, 10),
+			BlobQuotaBytes: strconv.FormatUint(report.BlobQuotaBytes, 10),
+			MeasuredAt:     strconv.FormatInt(report.MeasuredAt.Unix(), 10),
+		},
+	}, nil
+}
+
+// GetNodeInfo returns the version and sync status of the node along with the
+// identity and sync status of its execution client, for fleet inventories.
+// Failing to query the execution client does not fail the request.
+func (h *Handler) GetNodeInfo(c handlers.Context) (any, error) {
+	headSlot, _, err := h.backend.HeadExecutionTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	distance, syncing, err := h.backend.SyncStatus()
+	if err != nil {
+		return nil, err
+	}
+	return nodetypes.DataResponse{
+		Data: nodetypes.NodeInfoData{
+			Consensus: nodetypes.ConsensusInfoData{
+				Version:      h.version,
+				HeadSlot:     headSlot.Base10(),
+				SyncDistance: strconv.FormatInt(distance, 10),
+				IsSyncing:    syncing,
+			},
+			Execution: h.executionInfo(c.Request().Context()),
+		},
+	}, nil
+}
+
+// executionInfo queries the identity and sync status of the execution client.
+func (h *Handler) executionInfo(ctx context.Context) nodetypes.ExecutionInfoData {
+	info := nodetypes.ExecutionInfoData{Connected: h.engine.IsConnected()}
+	if h.engine.HasCapability(ethclient.GetClientVersionV1) {
+		versions, err := h.engine.GetClientVersionV1(ctx)
+		if err != nil {
+			info.Errors = append(info.Errors, "client version: "+err.Error())
+		}
+		for _, v := range versions {
+			info.ClientVersions = append(info.ClientVersions, nodetypes.ExecutionClientVersion{
+				Code:    v.Code,
+				Name:    v.Name,
+				Version: v.Version,
+				Commit:  v.Commit,
+			})
+		}
+	} else {
+		info.Errors = append(info.Errors,
+			"client version: "+ethclient.GetClientVersionV1+" not supported",
+		)
+	}
+	if networkID, err := h.engine.NetworkID(ctx); err != nil {
+		info.Errors = append(info.Errors, "network id: "+err.Error())
+	} else {
+		info.NetworkID = networkID.Base10()
+	}
+	if syncing, err := h.engine.Syncing(ctx); err != nil {
+		info.Errors = append(info.Errors, "sync status: "+err.Error())
+	} else {
+		info.IsSyncing = &syncing
+	}
+	return info
+}
+
+// peerData maps a CometBFT peer to the Beacon API schema.
+func peerData(id, address string, outbound bool) *nodetypes.PeerData {
+	direction := nodetypes.PeerDirectionInbound
+	if outbound {
+		direction = nodetypes.PeerDirectionOutbound
+	}
+	return &nodetypes.PeerData{
+		PeerID:             id,
+		LastSeenP2PAddress: address,
+		State:              nodetypes.PeerStateConnected,
+		Direction:          direction,
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-api/handlers/node"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-core/services/diskusage"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+type stubBackend struct {
+	peers []*consensustypes.PeerInfo
+}
+
+func (stubBackend) SyncStatus() (int64, bool, error) { return 3, true, nil }
+
+func (stubBackend) HeadExecutionTimestamp() (math.Slot, math.U64, error) {
+	return 42, 0, nil
+}
+
+func (b stubBackend) Peers() []*consensustypes.PeerInfo { return b.peers }
+
+type stubEngine struct{}
+
+func (stubEngine) IsConnected() bool { return false }
+
+func (stubEngine) HasCapability(string) bool { return false }
+
+func (stubEngine) GetClientVersionV1(context.Context) ([]engineprimitives.ClientVersionV1, error) {
+	return nil, errOffline
+}
+
+func (stubEngine) NetworkID(context.Context) (math.U64, error) { return 0, errOffline }
+
+func (stubEngine) Syncing(context.Context) (bool, error) { return false, errOffline }
+
+var errOffline = errors.New("execution client offline")
+
+type stubDisk struct {
+	report diskusage.Report
+}
+
+func (d stubDisk) Report() diskusage.Report { return d.report }
+
+func TestVersionString(t *testing.T) {
+	t.Parallel()
+	suffix := " (" + runtime.GOOS + " " + runtime.GOARCH + ")"
+	require.Equal(t, "beacond/v1.2.0"+suffix, node.VersionString("1.2.0"))
+	require.Equal(t, "beacond/v1.2.0-rc1"+suffix, node.VersionString("v1.2.0-rc1"))
+	require.Equal(t, "beacond/dev"+suffix, node.VersionString(""))
+}
+
+func TestHandler_SyncingAndPeers(t *testing.T) {
+	t.Parallel()
+	h := node.NewHandler(stubBackend{peers: []*consensustypes.PeerInfo{
+		{ID: "a", Address: "10.0.0.1:26656", Outbound: true},
+		{ID: "b", Address: "10.0.0.2:26656"},
+	}}, stubEngine{}, stubDisk{}, "beacond/v1.2.0")
+
+	res, err := h.Syncing(nil)
+	require.NoError(t, err)
+	require.Equal(t, nodetypes.SyncingData{
+		HeadSlot:     "42",
+		SyncDistance: "3",
+		IsSyncing:    true,
+		ELOffline:    true,
+	}, res.(nodetypes.DataResponse).Data)
+
+	res, err = h.GetPeers(nil)
+	require.NoError(t, err)
+	peers := res.(nodetypes.PeersResponse)
+	require.Equal(t, 2, peers.Meta.Count)
+	require.Equal(t, nodetypes.PeerDirectionOutbound, peers.Data[0].Direction)
+	require.Equal(t, nodetypes.PeerDirectionInbound, peers.Data[1].Direction)
+	require.Equal(t, "10.0.0.2:26656", peers.Data[1].LastSeenP2PAddress)
+}
+
+func TestHandler_GetDiskUsage(t *testing.T) {
+	t.Parallel()
+	h := node.NewHandler(stubBackend{}, stubEngine{}, stubDisk{}, "")
+	_, err := h.GetDiskUsage(nil)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	h = node.NewHandler(stubBackend{}, stubEngine{}, stubDisk{report: diskusage.Report{
+		Stores: []diskusage.StoreUsage{
+			{Name: "blobs", Bytes: 300},
+			{Name: "blockstore.db", Bytes: 700},
+		},
+		TotalBytes:     1000,
+		BlobQuotaBytes: 1 << 30,
+		MeasuredAt:     time.Unix(1700000000, 0),
+	}}, "")
+	res, err := h.GetDiskUsage(nil)
+	require.NoError(t, err)
+	require.Equal(t, nodetypes.DiskUsageData{
+		Stores: []nodetypes.StoreUsageData{
+			{Name: "blobs", Bytes: "300"},
+			{Name: "blockstore.db", Bytes: "700"},
+		},
+		TotalBytes:     "1000",
+		BlobQuotaBytes: "1073741824",
+		MeasuredAt:     "1700000000",
+	}, res.(nodetypes.DataResponse).Data)
+}
@@ -38,17 +38,17 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeers,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers/:peer_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeer,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peer_count",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeerCount,
 		},
 		{
 			Method:  http.MethodGet,
@@ -65,5 +65,15 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 			Path:    "/eth/v1/node/health",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/node/disk_usage",
+			Handler: h.GetDiskUsage,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/node/info",
+			Handler: h.GetNodeInfo,
+		},
 	})
 }
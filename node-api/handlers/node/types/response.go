@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+const (
+	// PeerStateConnected is the state of every peer reported by CometBFT.
+	PeerStateConnected = "connected"
+	// PeerDirectionInbound is the direction of peers which dialed the node.
+	PeerDirectionInbound = "inbound"
+	// PeerDirectionOutbound is the direction of peers dialed by the node.
+	PeerDirectionOutbound = "outbound"
+)
+
+type DataResponse struct {
+	Data any `json:"data"`
+}
+
+type SyncingData struct {
+	HeadSlot     string `json:"head_slot"`
+	SyncDistance string `json:"sync_distance"`
+	IsSyncing    bool   `json:"is_syncing"`
+	IsOptimistic bool   `json:"is_optimistic"`
+	ELOffline    bool   `json:"el_offline"`
+}
+
+// PeerData is a peer in the Beacon API schema. CometBFT peers have no ENR,
+// so it is always empty.
+type PeerData struct {
+	PeerID             string `json:"peer_id"`
+	ENR                string `json:"enr"`
+	LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+	State              string `json:"state"`
+	Direction          string `json:"direction"`
+}
+
+type PeersMeta struct {
+	Count int `json:"count"`
+}
+
+type PeersResponse struct {
+	Data []*PeerData `json:"data"`
+	Meta PeersMeta   `json:"meta"`
+}
+
+type PeerCountData struct {
+	Disconnected  string `json:"disconnected"`
+	Connecting    string `json:"connecting"`
+	Connected     string `json:"connected"`
+	Disconnecting string `json:"disconnecting"`
+}
+
+type VersionData struct {
+	Version string `json:"version"`
+}
+
+// DiskUsageData is the latest disk usage measurement of the node's stores.
+// MeasuredAt is a unix timestamp in seconds. BlobQuotaBytes is zero if no
+// quota is enforced.
+type DiskUsageData struct {
+	Stores         []StoreUsageData `json:"stores"`
+	TotalBytes     string           `json:"total_bytes"`
+	BlobQuotaBytes string           `json:"blob_quota_bytes"`
+	MeasuredAt     string           `json:"measured_at"`
+}
+
+// StoreUsageData is the disk usage of a store, named after its entry in the
+// data directory.
+type StoreUsageData struct {
+	Name  string `json:"name"`
+	Bytes string `json:"bytes"`
+}
+
+// NodeInfoData is the identity and sync status of the node and of the
+// execution client it drives.
+type NodeInfoData struct {
+	Consensus ConsensusInfoData `json:"consensus"`
+	Execution ExecutionInfoData `json:"execution"`
+}
+
+// ConsensusInfoData is the version and sync status of the node.
+type ConsensusInfoData struct {
+	Version      string `json:"version"`
+	HeadSlot     string `json:"head_slot"`
+	SyncDistance string `json:"sync_distance"`
+	IsSyncing    bool   `json:"is_syncing"`
+}
+
+// ExecutionInfoData is the identity and sync status of the execution client.
+// The fields it could not be queried for are omitted, with the reasons
+// listed in Errors.
+type ExecutionInfoData struct {
+	Connected      bool                     `json:"connected"`
+	ClientVersions []ExecutionClientVersion `json:"client_versions,omitempty"`
+	NetworkID      string                   `json:"network_id,omitempty"`
+	IsSyncing      *bool                    `json:"is_syncing,omitempty"`
+	Errors         []string                 `json:"errors,omitempty"`
+}
+
+// ExecutionClientVersion is a version reported by engine_getClientVersionV1.
+type ExecutionClientVersion struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
@@ -21,6 +21,8 @@
 package proof
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -30,6 +32,7 @@ import (
 type Backend interface {
 	BlockBackend
 	StateBackend
+	DepositBackend
 	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 }
 
@@ -37,6 +40,10 @@ type BlockBackend interface {
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
 }
 
+type DepositBackend interface {
+	DepositsByIndex(ctx context.Context, startIndex, count uint64) (ctypes.Deposits, error)
+}
+
 type StateBackend interface {
 	StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
 }
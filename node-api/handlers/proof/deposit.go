@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetDeposit returns a deposit along with a Merkle proof that can be verified
+// against the beacon block root. Only deposits processed as of the block can
+// be proven.
+func (h *Handler) GetDeposit(c handlers.Context) (any, error) {
+	params, err := utils.BindAndValidate[types.DepositRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+
+	depositIndex, err := math.U64FromString(params.DepositIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(params.TimestampID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deposit root of the state commits to every deposit processed so far.
+	depositCount, err := beaconState.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	if depositIndex.Unwrap() >= depositCount {
+		return nil, errors.Wrapf(
+			apitypes.ErrNotFound,
+			"deposit %d not processed as of slot %d, processed deposits: %d",
+			depositIndex, slot, depositCount,
+		)
+	}
+
+	h.Logger().Info(
+		"Generating deposit proofs", "slot", slot, "deposit_index", depositIndex,
+	)
+
+	deposits, err := h.backend.DepositsByIndex(
+		c.Request().Context(), constants.FirstDepositIndex, depositCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+
+	depositProof, beaconBlockRoot, err := merkle.ProveDepositInBlock(
+		depositIndex, deposits, blockHeader, bsm,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.DepositResponse{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   beaconBlockRoot,
+		Deposit:           deposits[depositIndex.Unwrap()],
+		DepositProof:      depositProof,
+	}, nil
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// GetExecutionHeader returns the block hash, block number, timestamp and state
+// root of the latest execution payload header, each along with a Merkle proof
+// that can be verified against the beacon block root.
+func (h *Handler) GetExecutionHeader(c handlers.Context) (any, error) {
+	params, err := utils.BindAndValidate[types.ExecutionHeaderRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(params.TimestampID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info("Generating execution header proofs", "slot", slot)
+
+	executionHeader, err := beaconState.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	zeroGIndexBlock, err := merkle.GetZeroExecutionHeaderGIndexBlock(bsm.GetForkVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	var beaconBlockRoot common.Root
+	proveField := func(fieldOffset uint64) (types.FieldProof, error) {
+		proof, leaf, root, errProve := merkle.ProveExecutionHeaderFieldInBlock(
+			fieldOffset, blockHeader, bsm,
+		)
+		if errProve != nil {
+			return types.FieldProof{}, errProve
+		}
+		beaconBlockRoot = root
+		return types.FieldProof{
+			GeneralizedIndex: zeroGIndexBlock + fieldOffset,
+			Leaf:             leaf,
+			Proof:            proof,
+		}, nil
+	}
+
+	response := types.ExecutionHeaderResponse{
+		BeaconBlockHeader: blockHeader,
+		BlockHash:         executionHeader.GetBlockHash(),
+		BlockNumber:       executionHeader.GetNumber(),
+		Timestamp:         executionHeader.GetTimestamp(),
+		StateRoot:         executionHeader.GetStateRoot(),
+	}
+	if response.BlockHashProof, err = proveField(merkle.ExecutionBlockHashGIndexOffset); err != nil {
+		return nil, err
+	}
+	if response.BlockNumberProof, err = proveField(merkle.ExecutionBlockNumberGIndexOffset); err != nil {
+		return nil, err
+	}
+	if response.TimestampProof, err = proveField(merkle.ExecutionTimestampGIndexOffset); err != nil {
+		return nil, err
+	}
+	if response.StateRootProof, err = proveField(merkle.ExecutionStateRootGIndexOffset); err != nil {
+		return nil, err
+	}
+	response.BeaconBlockRoot = beaconBlockRoot
+	return response, nil
+}
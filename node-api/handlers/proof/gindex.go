@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/consensus-types/gindex"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetGIndex returns the generalized index of the node addressed by an SSZ
+// path, e.g. `BeaconBlockHeader.state_root.validators[123].pubkey`, for the
+// requested fork.
+func (h *Handler) GetGIndex(c handlers.Context) (any, error) {
+	params, err := utils.BindAndValidate[types.GIndexRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+
+	forkVersion, err := h.forkVersionByName(params.Fork)
+	if err != nil {
+		return nil, err
+	}
+
+	gIndex, err := gindex.Compute(forkVersion, params.Path)
+	if err != nil {
+		return nil, errors.Wrap(apitypes.ErrInvalidRequest, err.Error())
+	}
+
+	return types.GIndexResponse{
+		Path:             params.Path,
+		Fork:             version.Name(forkVersion),
+		GeneralizedIndex: strconv.FormatUint(gIndex, 10),
+	}, nil
+}
+
+// forkVersionByName returns the supported fork version with the given name,
+// or the fork version of the head state if no name is given.
+func (h *Handler) forkVersionByName(name string) (common.Version, error) {
+	if name == "" {
+		st, _, err := h.backend.StateAtSlot(0)
+		if err != nil {
+			return common.Version{}, err
+		}
+		fork, err := st.GetFork()
+		if err != nil {
+			return common.Version{}, err
+		}
+		return fork.CurrentVersion, nil
+	}
+
+	for _, v := range version.GetSupportedVersions() {
+		if version.Name(v) == name {
+			return v, nil
+		}
+	}
+	return common.Version{}, errors.Wrapf(apitypes.ErrInvalidRequest, "unknown fork %s", name)
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+)
+
+// ProveDepositInDepositRoot generates a proof for a deposit in the list of
+// all deposits, whose root is the deposit root of the eth1 data. The proof
+// ends with the mixed in length of the list, and the deposit root is
+// returned alongside the proof.
+func ProveDepositInDepositRoot(
+	depositIndex math.U64,
+	deposits ctypes.Deposits,
+) ([]common.Root, common.Root, error) {
+	if depositIndex.Unwrap() >= uint64(len(deposits)) {
+		return nil, common.Root{}, errors.Wrapf(
+			errors.New("deposit index out of range"),
+			"index: %d, deposits: %d", depositIndex, len(deposits),
+		)
+	}
+
+	leaves := make([]common.Root, len(deposits))
+	for i, deposit := range deposits {
+		leaves[i] = deposit.HashTreeRoot()
+	}
+	tree, err := merkle.NewTreeFromLeavesWithDepth(
+		leaves, uint8(constants.DepositContractDepth), // #nosec G115 -- depth is 32.
+	)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	proof, err := tree.MerkleProofWithMixin(depositIndex.Unwrap())
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	return proof, tree.HashTreeRoot(), nil
+}
+
+// ProveDepositRootInState generates a proof for the deposit root of the eth1
+// data in the beacon state, returning the deposit root alongside the proof.
+func ProveDepositRootInState(
+	forkVersion common.Version,
+	bsm types.BeaconStateMarshallable,
+) ([]common.Root, common.Root, error) {
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	depositRootGIndexState, err := GetDepositRootGIndexState(forkVersion)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	depositRootProof, err := stateProofTree.Prove(depositRootGIndexState)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	proof := make([]common.Root, len(depositRootProof.Hashes))
+	for i, hash := range depositRootProof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+	return proof, common.NewRootFromBytes(depositRootProof.Leaf), nil
+}
+
+// ProveDepositInBlock generates a proof for a deposit in the beacon block,
+// given all the deposits included in the beacon state. The proof is verified
+// against the beacon block root as a sanity check and the "correct" beacon
+// block root is returned alongside the proof.
+func ProveDepositInBlock(
+	depositIndex math.U64,
+	deposits ctypes.Deposits,
+	bbh *ctypes.BeaconBlockHeader,
+	bsm types.BeaconStateMarshallable,
+) ([]common.Root, common.Root, error) {
+	forkVersion := bsm.GetForkVersion()
+
+	// 1. Proof of the deposit inside the deposit root.
+	depositInListProof, depositRoot, err := ProveDepositInDepositRoot(
+		depositIndex, deposits,
+	)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	// 2. Proof of the deposit root inside the state. The deposits must be
+	// exactly the ones committed to by the state.
+	depositRootInStateProof, stateDepositRoot, err := ProveDepositRootInState(
+		forkVersion, bsm,
+	)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+	if depositRoot != stateDepositRoot {
+		return nil, common.Root{}, errors.Wrapf(
+			errors.New("deposits do not match the deposit root of the state"),
+			"deposits root: %s, state deposit root: %s", depositRoot, stateDepositRoot,
+		)
+	}
+
+	// 3. Proof of the state inside the block.
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh, false)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	// 4. Combine proofs, from the deposit up to the block.
+	combinedProof := make(
+		[]common.Root,
+		0,
+		len(depositInListProof)+len(depositRootInStateProof)+len(stateInBlockProof),
+	)
+	combinedProof = append(combinedProof, depositInListProof...)
+	combinedProof = append(combinedProof, depositRootInStateProof...)
+	combinedProof = append(combinedProof, stateInBlockProof...)
+
+	// 5. Verify the combined proof against the beacon block root.
+	beaconRoot, err := verifyDepositInBlock(
+		forkVersion, bbh, depositIndex, combinedProof,
+		deposits[depositIndex.Unwrap()].HashTreeRoot(),
+	)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	return combinedProof, beaconRoot, nil
+}
+
+// verifyDepositInBlock verifies the provided Merkle proof of a deposit inside
+// the beacon block and returns the beacon block root that the proof was
+// verified against.
+//
+// NOTE: Proof verification is not strictly necessary for operation, but we do
+// it as a sanity check to avoid propagating malformed proofs downstream.
+func verifyDepositInBlock(
+	forkVersion common.Version,
+	bbh *ctypes.BeaconBlockHeader,
+	depositIndex math.U64,
+	proof []common.Root,
+	leaf common.Root,
+) (common.Root, error) {
+	zeroDepositGIndexBlock, err := GetZeroDepositGIndexBlock(forkVersion)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	beaconRoot := bbh.HashTreeRoot()
+	if !merkle.VerifyProof(
+		beaconRoot, leaf, zeroDepositGIndexBlock+depositIndex.Unwrap(), proof,
+	) {
+		return common.Root{}, errors.Wrapf(
+			errors.New("deposit proof failed to verify against beacon root"),
+			"beacon root: 0x%s", beaconRoot,
+		)
+	}
+
+	return beaconRoot, nil
+}
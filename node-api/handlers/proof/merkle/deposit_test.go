@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDepositProof tests the ProveDepositInBlock function and that the
+// generated proof correctly verifies.
+func TestDepositProof(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name         string
+		forkVersion  common.Version
+		numDeposits  int
+		depositIndex math.U64
+	}{
+		{
+			name:         "Deneb 1 Deposit",
+			forkVersion:  version.Deneb(),
+			numDeposits:  1,
+			depositIndex: 0,
+		},
+		{
+			name:         "Electra 1 Deposit",
+			forkVersion:  version.Electra(),
+			numDeposits:  1,
+			depositIndex: 0,
+		},
+		{
+			name:         "Electra Many Deposits",
+			forkVersion:  version.Electra(),
+			numDeposits:  100,
+			depositIndex: 95,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deposits := make(types.Deposits, tc.numDeposits)
+			for i := range deposits {
+				deposits[i] = &types.Deposit{
+					Pubkey: [48]byte{byte(i)},
+					Credentials: types.NewCredentialsFromExecutionAddress(
+						common.ExecutionAddress{byte(i)},
+					),
+					Amount: 32e9,
+					Index:  uint64(i),
+				}
+			}
+
+			bs := mock.NewBeaconStateWith(
+				4, nil, 0, common.ExecutionAddress{}, tc.forkVersion,
+			)
+			bs.Eth1Data = types.NewEth1Data(deposits.HashTreeRoot())
+			bs.Eth1DepositIndex = uint64(tc.numDeposits)
+			bbh := types.NewBeaconBlockHeader(
+				4, 0, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1},
+			)
+
+			proof, beaconRoot, err := merkle.ProveDepositInBlock(
+				tc.depositIndex, deposits, bbh, bs,
+			)
+			require.NoError(t, err)
+			require.NotEmpty(t, proof)
+			require.Equal(t, bbh.HashTreeRoot(), beaconRoot)
+
+			// Deposits which do not match the state are rejected.
+			_, _, err = merkle.ProveDepositInBlock(
+				tc.depositIndex, append(deposits, &types.Deposit{}), bbh, bs,
+			)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+)
+
+// ProveExecutionHeaderFieldInState generates a proof for a field of the
+// latest execution payload header in the beacon state. The fieldOffset must
+// be one of the Execution*GIndexOffset constants. The proven leaf is returned
+// alongside the proof.
+func ProveExecutionHeaderFieldInState(
+	forkVersion common.Version,
+	bsm types.BeaconStateMarshallable,
+	fieldOffset uint64,
+) ([]common.Root, common.Root, error) {
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	zeroExecutionHeaderGIndexState, err := GetZeroExecutionHeaderGIndexState(forkVersion)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	// The field offset is bounded by the number of fields of the header, so
+	// converting to int is safe.
+	fieldProof, err := stateProofTree.Prove(
+		zeroExecutionHeaderGIndexState + int(fieldOffset), // #nosec G115
+	)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	proof := make([]common.Root, len(fieldProof.Hashes))
+	for i, hash := range fieldProof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+	return proof, common.NewRootFromBytes(fieldProof.Leaf), nil
+}
+
+// ProveExecutionHeaderFieldInBlock generates a proof for a field of the latest
+// execution payload header in the beacon block. The proof is verified against
+// the beacon block root as a sanity check and the proven leaf and "correct"
+// beacon block root are returned alongside the proof.
+func ProveExecutionHeaderFieldInBlock(
+	fieldOffset uint64,
+	bbh *ctypes.BeaconBlockHeader,
+	bsm types.BeaconStateMarshallable,
+) ([]common.Root, common.Root, common.Root, error) {
+	forkVersion := bsm.GetForkVersion()
+
+	// 1. Proof inside the state.
+	fieldInStateProof, leaf, err := ProveExecutionHeaderFieldInState(
+		forkVersion, bsm, fieldOffset,
+	)
+	if err != nil {
+		return nil, common.Root{}, common.Root{}, err
+	}
+
+	// 2. Proof of the state inside the block.
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh, false)
+	if err != nil {
+		return nil, common.Root{}, common.Root{}, err
+	}
+
+	// 3. Combine proofs: state-level hashes come first, followed by block-level
+	// hashes.
+	//
+	//nolint:gocritic // ok.
+	combinedProof := append(fieldInStateProof, stateInBlockProof...)
+
+	// 4. Verify the combined proof against the beacon block root.
+	beaconRoot, err := verifyExecutionHeaderFieldInBlock(
+		forkVersion, bbh, fieldOffset, combinedProof, leaf,
+	)
+	if err != nil {
+		return nil, common.Root{}, common.Root{}, err
+	}
+
+	return combinedProof, leaf, beaconRoot, nil
+}
+
+// verifyExecutionHeaderFieldInBlock verifies the provided Merkle proof of a
+// field of the latest execution payload header inside the beacon block and
+// returns the beacon block root that the proof was verified against.
+//
+// NOTE: Proof verification is not strictly necessary for operation, but we do
+// it as a sanity check to avoid propagating malformed proofs downstream.
+func verifyExecutionHeaderFieldInBlock(
+	forkVersion common.Version,
+	bbh *ctypes.BeaconBlockHeader,
+	fieldOffset uint64,
+	proof []common.Root,
+	leaf common.Root,
+) (common.Root, error) {
+	zeroExecutionHeaderGIndexBlock, err := GetZeroExecutionHeaderGIndexBlock(forkVersion)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	beaconRoot := bbh.HashTreeRoot()
+	if !merkle.VerifyProof(
+		beaconRoot, leaf, zeroExecutionHeaderGIndexBlock+fieldOffset, proof,
+	) {
+		return common.Root{}, errors.Wrapf(
+			errors.New("execution header field proof failed to verify against beacon root"),
+			"beacon root: 0x%s", beaconRoot,
+		)
+	}
+
+	return beaconRoot, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExecutionHeaderFieldProof tests the ProveExecutionHeaderFieldInBlock
+// function and that the proven leaves match the execution payload header.
+func TestExecutionHeaderFieldProof(t *testing.T) {
+	t.Parallel()
+	for _, forkVersion := range []common.Version{version.Deneb(), version.Electra()} {
+		t.Run(version.Name(forkVersion), func(t *testing.T) {
+			t.Parallel()
+
+			bs := mock.NewBeaconStateWith(
+				5, nil, 42, common.ExecutionAddress{1, 2, 3}, forkVersion,
+			)
+			bs.LatestExecutionPayloadHeader.Timestamp = 1_700_000_000
+			bs.LatestExecutionPayloadHeader.BlockHash = common.ExecutionHash{4, 5, 6}
+			bs.LatestExecutionPayloadHeader.StateRoot = common.Bytes32{7, 8, 9}
+			bbh := types.NewBeaconBlockHeader(
+				5, 0, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1},
+			)
+
+			var number, timestamp common.Root
+			binary.LittleEndian.PutUint64(number[:], 42)
+			binary.LittleEndian.PutUint64(timestamp[:], 1_700_000_000)
+			expectedLeaves := map[uint64]common.Root{
+				merkle.ExecutionStateRootGIndexOffset:   common.Root{7, 8, 9},
+				merkle.ExecutionBlockNumberGIndexOffset: number,
+				merkle.ExecutionTimestampGIndexOffset:   timestamp,
+				merkle.ExecutionBlockHashGIndexOffset:   common.Root{4, 5, 6},
+			}
+			for offset, expectedLeaf := range expectedLeaves {
+				proof, leaf, beaconRoot, err := merkle.ProveExecutionHeaderFieldInBlock(
+					offset, bbh, bs,
+				)
+				require.NoError(t, err)
+				require.NotEmpty(t, proof)
+				require.Equal(t, expectedLeaf, leaf)
+				require.Equal(t, bbh.HashTreeRoot(), beaconRoot)
+			}
+		})
+	}
+}
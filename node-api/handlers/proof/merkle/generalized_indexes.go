@@ -77,6 +77,73 @@ const (
 	// GIndices. To get the GIndex of the withdrawal credentials of validator at index n, the formula is:
 	// GIndex = ZeroValidatorCredentialsGIndexElectraBlock + (ValidatorGIndexOffset * n)
 	ZeroValidatorCredentialsGIndexElectraBlock = 6350779162034177
+
+	// ZeroDepositGIndexDepositList is the generalized index of the 0 deposit
+	// in the list of all deposits, whose root is the deposit root of the
+	// eth1 data. The list has a limit of 2^32 deposits.
+	ZeroDepositGIndexDepositList = 8589934592
+
+	// DepositRootGIndexDenebState is the generalized index of the deposit
+	// root of the eth1 data in the beacon state in the Deneb forks.
+	DepositRootGIndexDenebState = 88
+
+	// ZeroDepositGIndexDenebBlock is the generalized index of the 0 deposit
+	// in the beacon block in the Deneb forks. This is calculated by
+	// concatenating the (ZeroDepositGIndexDepositList, DepositRootGIndexDenebState,
+	// StateGIndexBlock) GIndices. To get the GIndex of the deposit at index n,
+	// the formula is: GIndex = ZeroDepositGIndexDenebBlock + n
+	ZeroDepositGIndexDenebBlock = 6253472382976
+
+	// DepositRootGIndexElectraState is the generalized index of the deposit
+	// root of the eth1 data in the beacon state in the Electra forks.
+	DepositRootGIndexElectraState = 152
+
+	// ZeroDepositGIndexElectraBlock is the generalized index of the 0 deposit
+	// in the beacon block in the Electra forks. This is calculated by
+	// concatenating the (ZeroDepositGIndexDepositList, DepositRootGIndexElectraState,
+	// StateGIndexBlock) GIndices. To get the GIndex of the deposit at index n,
+	// the formula is: GIndex = ZeroDepositGIndexElectraBlock + n
+	ZeroDepositGIndexElectraBlock = 12300786335744
+
+	// ZeroExecutionHeaderGIndexDenebState is the generalized index of the 0
+	// field of the latest execution payload header in the beacon state in the
+	// Deneb forks. To get the GIndex of a field of the header, the formula is:
+	// GIndex = ZeroExecutionHeaderGIndexDenebState + FieldGIndexOffset
+	ZeroExecutionHeaderGIndexDenebState = 768
+
+	// ZeroExecutionHeaderGIndexDenebBlock is the generalized index of the 0
+	// field of the latest execution payload header in the beacon block in the
+	// Deneb forks. This is calculated by concatenating the
+	// (ZeroExecutionHeaderGIndexDenebState, StateGIndexBlock) GIndices.
+	ZeroExecutionHeaderGIndexDenebBlock = 5888
+
+	// ZeroExecutionHeaderGIndexElectraState is the generalized index of the 0
+	// field of the latest execution payload header in the beacon state in the
+	// Electra forks. To get the GIndex of a field of the header, the formula is:
+	// GIndex = ZeroExecutionHeaderGIndexElectraState + FieldGIndexOffset
+	ZeroExecutionHeaderGIndexElectraState = 1280
+
+	// ZeroExecutionHeaderGIndexElectraBlock is the generalized index of the 0
+	// field of the latest execution payload header in the beacon block in the
+	// Electra forks. This is calculated by concatenating the
+	// (ZeroExecutionHeaderGIndexElectraState, StateGIndexBlock) GIndices.
+	ZeroExecutionHeaderGIndexElectraBlock = 11520
+
+	// ExecutionStateRootGIndexOffset is the offset of the state root in the
+	// execution payload header.
+	ExecutionStateRootGIndexOffset = 2
+
+	// ExecutionBlockNumberGIndexOffset is the offset of the block number in
+	// the execution payload header.
+	ExecutionBlockNumberGIndexOffset = 6
+
+	// ExecutionTimestampGIndexOffset is the offset of the timestamp in the
+	// execution payload header.
+	ExecutionTimestampGIndexOffset = 9
+
+	// ExecutionBlockHashGIndexOffset is the offset of the block hash in the
+	// execution payload header.
+	ExecutionBlockHashGIndexOffset = 12
 )
 
 // GetZeroValidatorPubkeyGIndexState determines the generalized index of the 0
@@ -120,3 +187,49 @@ func GetZeroValidatorCredentialsGIndexBlock(forkVersion common.Version) (uint64,
 	}
 	return 0, fmt.Errorf("unsupported fork version: %s", forkVersion)
 }
+
+// GetDepositRootGIndexState determines the generalized index of the deposit
+// root of the eth1 data in the beacon state based on the fork version.
+func GetDepositRootGIndexState(forkVersion common.Version) (int, error) {
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		return DepositRootGIndexElectraState, nil
+	} else if version.EqualsOrIsAfter(forkVersion, version.Deneb()) {
+		return DepositRootGIndexDenebState, nil
+	}
+	return 0, fmt.Errorf("unsupported fork version: %s", forkVersion)
+}
+
+// GetZeroDepositGIndexBlock determines the generalized index of the 0 deposit
+// in the beacon block based on the fork version.
+func GetZeroDepositGIndexBlock(forkVersion common.Version) (uint64, error) {
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		return ZeroDepositGIndexElectraBlock, nil
+	} else if version.EqualsOrIsAfter(forkVersion, version.Deneb()) {
+		return ZeroDepositGIndexDenebBlock, nil
+	}
+	return 0, fmt.Errorf("unsupported fork version: %s", forkVersion)
+}
+
+// GetZeroExecutionHeaderGIndexState determines the generalized index of the
+// 0 field of the latest execution payload header in the beacon state based on
+// the fork version.
+func GetZeroExecutionHeaderGIndexState(forkVersion common.Version) (int, error) {
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		return ZeroExecutionHeaderGIndexElectraState, nil
+	} else if version.EqualsOrIsAfter(forkVersion, version.Deneb()) {
+		return ZeroExecutionHeaderGIndexDenebState, nil
+	}
+	return 0, fmt.Errorf("unsupported fork version: %s", forkVersion)
+}
+
+// GetZeroExecutionHeaderGIndexBlock determines the generalized index of the
+// 0 field of the latest execution payload header in the beacon block based on
+// the fork version.
+func GetZeroExecutionHeaderGIndexBlock(forkVersion common.Version) (uint64, error) {
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		return ZeroExecutionHeaderGIndexElectraBlock, nil
+	} else if version.EqualsOrIsAfter(forkVersion, version.Deneb()) {
+		return ZeroExecutionHeaderGIndexDenebBlock, nil
+	}
+	return 0, fmt.Errorf("unsupported fork version: %s", forkVersion)
+}
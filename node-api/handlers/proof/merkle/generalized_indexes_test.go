@@ -23,10 +23,13 @@ package merkle_test
 import (
 	"testing"
 
+	"github.com/berachain/beacon-kit/consensus-types/gindex"
 	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
 	mlib "github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -310,3 +313,181 @@ func TestValidatorWithdrawalCredentialsGIndexElectra(t *testing.T) {
 		int(oneValidatorWithdrawalCredentialsGIndexState-zeroValidatorWithdrawalCredentialsGIndexState),
 	)
 }
+
+// TestGIndicesDeposit tests the generalized indices used by beacon block
+// proofs for deposits on the Deneb and Electra forks.
+func TestGIndicesDeposit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		stateSchema          schema.SSZType
+		headerSchema         schema.SSZType
+		depositRootGIndex    int
+		zeroDepositGIndexBlk uint64
+	}{
+		{
+			name:                 "Deneb",
+			stateSchema:          beaconStateSchemaDeneb,
+			headerSchema:         beaconHeaderSchemaDeneb,
+			depositRootGIndex:    merkle.DepositRootGIndexDenebState,
+			zeroDepositGIndexBlk: merkle.ZeroDepositGIndexDenebBlock,
+		},
+		{
+			name:                 "Electra",
+			stateSchema:          beaconStateSchemaElectra,
+			headerSchema:         beaconHeaderSchemaElectra,
+			depositRootGIndex:    merkle.DepositRootGIndexElectraState,
+			zeroDepositGIndexBlk: merkle.ZeroDepositGIndexElectraBlock,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// GIndex of the deposit root in the state.
+			_, depositRootGIndexState, _, err := mlib.ObjectPath(
+				"Eth1Data/DepositRoot",
+			).GetGeneralizedIndex(tc.stateSchema)
+			require.NoError(t, err)
+			require.Equal(t, tc.depositRootGIndex, int(depositRootGIndexState))
+
+			// GIndex of the deposit root in the block.
+			_, depositRootGIndexBlock, _, err := mlib.ObjectPath(
+				"State/Eth1Data/DepositRoot",
+			).GetGeneralizedIndex(tc.headerSchema)
+			require.NoError(t, err)
+
+			// The 0 deposit is the first leaf of the deposits list, whose
+			// root is the deposit root.
+			concatZeroDepositToBlock := mlib.GeneralizedIndices{
+				mlib.GeneralizedIndex(depositRootGIndexBlock),
+				mlib.GeneralizedIndex(merkle.ZeroDepositGIndexDepositList),
+			}.Concat()
+			require.Equal(t, tc.zeroDepositGIndexBlk, uint64(concatZeroDepositToBlock))
+		})
+	}
+}
+
+// TestGIndicesExecutionHeader tests the generalized indices used by beacon
+// block proofs for fields of the latest execution payload header on the Deneb
+// and Electra forks.
+func TestGIndicesExecutionHeader(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		stateSchema     schema.SSZType
+		headerSchema    schema.SSZType
+		zeroGIndexState int
+		zeroGIndexBlock uint64
+	}{
+		{
+			name:            "Deneb",
+			stateSchema:     beaconStateSchemaDeneb,
+			headerSchema:    beaconHeaderSchemaDeneb,
+			zeroGIndexState: merkle.ZeroExecutionHeaderGIndexDenebState,
+			zeroGIndexBlock: merkle.ZeroExecutionHeaderGIndexDenebBlock,
+		},
+		{
+			name:            "Electra",
+			stateSchema:     beaconStateSchemaElectra,
+			headerSchema:    beaconHeaderSchemaElectra,
+			zeroGIndexState: merkle.ZeroExecutionHeaderGIndexElectraState,
+			zeroGIndexBlock: merkle.ZeroExecutionHeaderGIndexElectraBlock,
+		},
+	}
+	fields := map[string]int{
+		"StateRoot": merkle.ExecutionStateRootGIndexOffset,
+		"Number":    merkle.ExecutionBlockNumberGIndexOffset,
+		"Timestamp": merkle.ExecutionTimestampGIndexOffset,
+		"BlockHash": merkle.ExecutionBlockHashGIndexOffset,
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			for field, offset := range fields {
+				_, gIndexState, _, err := mlib.ObjectPath(
+					"LatestExecutionPayloadHeader/" + field,
+				).GetGeneralizedIndex(tc.stateSchema)
+				require.NoError(t, err)
+				require.Equal(t, tc.zeroGIndexState+offset, int(gIndexState), field)
+
+				_, gIndexBlock, _, err := mlib.ObjectPath(
+					"State/LatestExecutionPayloadHeader/" + field,
+				).GetGeneralizedIndex(tc.headerSchema)
+				require.NoError(t, err)
+				require.Equal(t, tc.zeroGIndexBlock+uint64(offset), uint64(gIndexBlock), field)
+			}
+		})
+	}
+}
+
+// TestGIndicesMatchCalculator tests that the hardcoded generalized indices
+// match the ones computed from the schemas of the consensus types.
+func TestGIndicesMatchCalculator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		forkVersion common.Version
+		path        string
+		expected    uint64
+	}{
+		{version.Deneb(), "BeaconBlockHeader.proposer_index", merkle.ProposerIndexGIndexBlock},
+		{version.Deneb(), "BeaconBlockHeader.state_root", merkle.StateGIndexBlock},
+		{
+			version.Deneb(), "BeaconState.validators[0].pubkey",
+			merkle.ZeroValidatorPubkeyGIndexDenebState,
+		},
+		{
+			version.Deneb(), "BeaconBlockHeader.state_root.validators[0].pubkey",
+			merkle.ZeroValidatorPubkeyGIndexDenebBlock,
+		},
+		{
+			version.Electra(), "BeaconState.validators[0].pubkey",
+			merkle.ZeroValidatorPubkeyGIndexElectraState,
+		},
+		{
+			version.Electra(), "BeaconBlockHeader.state_root.validators[0].pubkey",
+			merkle.ZeroValidatorPubkeyGIndexElectraBlock,
+		},
+		{
+			version.Electra(), "BeaconState.validators[0].withdrawal_credentials",
+			merkle.ZeroValidatorCredentialsGIndexElectraState,
+		},
+		{
+			version.Electra(), "BeaconBlockHeader.state_root.validators[0].withdrawal_credentials",
+			merkle.ZeroValidatorCredentialsGIndexElectraBlock,
+		},
+		{
+			version.Deneb(), "BeaconState.eth1_data.deposit_root",
+			merkle.DepositRootGIndexDenebState,
+		},
+		{
+			version.Deneb(), "BeaconBlockHeader.state_root.eth1_data.deposit_root[0]",
+			merkle.ZeroDepositGIndexDenebBlock,
+		},
+		{
+			version.Electra(), "BeaconState.eth1_data.deposit_root",
+			merkle.DepositRootGIndexElectraState,
+		},
+		{
+			version.Electra(), "BeaconBlockHeader.state_root.eth1_data.deposit_root[0]",
+			merkle.ZeroDepositGIndexElectraBlock,
+		},
+		{
+			version.Deneb(), "BeaconBlockHeader.state_root.latest_execution_payload_header.block_hash",
+			merkle.ZeroExecutionHeaderGIndexDenebBlock + merkle.ExecutionBlockHashGIndexOffset,
+		},
+		{
+			version.Electra(), "BeaconBlockHeader.state_root.latest_execution_payload_header.timestamp",
+			merkle.ZeroExecutionHeaderGIndexElectraBlock + merkle.ExecutionTimestampGIndexOffset,
+		},
+	}
+	for _, tc := range testCases {
+		gIndex, err := gindex.Compute(tc.forkVersion, tc.path)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.expected, gIndex, tc.path)
+	}
+}
@@ -40,5 +40,20 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 			Path:    "bkit/v1/proof/validator_credentials/:timestamp_id/:validator_index",
 			Handler: h.GetValidatorCredentials,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/deposit/:timestamp_id/:deposit_index",
+			Handler: h.GetDeposit,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/execution_header/:timestamp_id",
+			Handler: h.GetExecutionHeader,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/gindex",
+			Handler: h.GetGIndex,
+		},
 	})
 }
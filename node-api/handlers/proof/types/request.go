@@ -34,3 +34,23 @@ type ValidatorCredentialsRequest struct {
 	types.TimestampIDRequest
 	ValidatorIndex string `param:"validator_index" validate:"required,numeric"`
 }
+
+// DepositRequest is the request for the
+// `/proof/deposit/{timestamp_id}/{deposit_index}` endpoint.
+type DepositRequest struct {
+	types.TimestampIDRequest
+	DepositIndex string `param:"deposit_index" validate:"required,numeric"`
+}
+
+// ExecutionHeaderRequest is the request for the
+// `/proof/execution_header/{timestamp_id}` endpoint.
+type ExecutionHeaderRequest struct {
+	types.TimestampIDRequest
+}
+
+// GIndexRequest is the request for the `/proof/gindex` endpoint. Fork is the
+// name of a fork, e.g. `electra`, and defaults to the fork of the head state.
+type GIndexRequest struct {
+	Path string `query:"path" validate:"required"`
+	Fork string `query:"fork"`
+}
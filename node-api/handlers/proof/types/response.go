@@ -24,6 +24,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // BlockProposerResponse is the response for the
@@ -42,7 +43,9 @@ type BlockProposerResponse struct {
 	// ValidatorPubkeyProof can be verified against the beacon block root. Use
 	// a Generalized Index of `z + (8 * ValidatorIndex)`, where z is the
 	// Generalized Index of the 0 validator pubkey in the beacon block. In
-	// the Deneb fork, z is 3254554418216960.
+	// the Deneb fork, z is 3254554418216960. The Generalized Index for any
+	// fork is returned by `/proof/gindex` for the path
+	// `BeaconBlockHeader.state_root.validators[ValidatorIndex].pubkey`.
 	ValidatorPubkeyProof []common.Root `json:"validator_pubkey_proof"`
 
 	// ProposerIndexProof can be verified against the beacon block root. Use
@@ -66,6 +69,86 @@ type ValidatorWithdrawalCredentialsResponse struct {
 	// WithdrawalCredentialsProof can be verified against the beacon block root.
 	// Use a Generalized Index of `z + (8 * ValidatorIndex)`, where z is the
 	// Generalized Index of the 0 validator withdrawal credentials in the beacon
-	// block. In the Electra fork, z is 6350779162034177.
+	// block. In the Electra fork, z is 6350779162034177. The Generalized
+	// Index for any fork is returned by `/proof/gindex` for the path
+	// `BeaconBlockHeader.state_root.validators[ValidatorIndex].withdrawal_credentials`.
 	WithdrawalCredentialsProof []common.Root `json:"withdrawal_credentials_proof"`
 }
+
+// DepositResponse is the response for the
+// `/proof/deposit/{timestamp_id}/{deposit_index}` endpoint.
+type DepositResponse struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// Deposit is the requested deposit. Its hash tree root is the leaf of the
+	// proof.
+	Deposit *ctypes.Deposit `json:"deposit"`
+
+	// DepositProof can be verified against the beacon block root. Use a
+	// Generalized Index of `z + DepositIndex`, where z is the Generalized
+	// Index of the 0 deposit in the beacon block. In the Deneb fork, z is
+	// 6253472382976 and in the Electra fork, z is 12300786335744. The
+	// Generalized Index for any fork is returned by `/proof/gindex` for the
+	// path `BeaconBlockHeader.state_root.eth1_data.deposit_root[DepositIndex]`.
+	DepositProof []common.Root `json:"deposit_proof"`
+}
+
+// FieldProof is a Merkle proof of a single field against the beacon block
+// root.
+type FieldProof struct {
+	// GeneralizedIndex is the Generalized Index of the field in the beacon
+	// block for the fork of the proven state.
+	GeneralizedIndex uint64 `json:"generalized_index"`
+
+	// Leaf is the SSZ chunk of the field, i.e. the leaf of the proof.
+	Leaf common.Root `json:"leaf"`
+
+	// Proof can be verified against the beacon block root.
+	Proof []common.Root `json:"proof"`
+}
+
+// ExecutionHeaderResponse is the response for the
+// `/proof/execution_header/{timestamp_id}` endpoint. Each field of the latest
+// execution payload header comes with its own proof.
+type ExecutionHeaderResponse struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// BlockHash is the hash of the latest execution block.
+	BlockHash      common.ExecutionHash `json:"block_hash"`
+	BlockHashProof FieldProof           `json:"block_hash_proof"`
+
+	// BlockNumber is the number of the latest execution block.
+	BlockNumber      math.U64   `json:"block_number"`
+	BlockNumberProof FieldProof `json:"block_number_proof"`
+
+	// Timestamp is the timestamp of the latest execution block.
+	Timestamp      math.U64   `json:"timestamp"`
+	TimestampProof FieldProof `json:"timestamp_proof"`
+
+	// StateRoot is the state root of the latest execution block.
+	StateRoot      common.Bytes32 `json:"state_root"`
+	StateRootProof FieldProof     `json:"state_root_proof"`
+}
+
+// GIndexResponse is the response for the `/proof/gindex` endpoint.
+type GIndexResponse struct {
+	// Path is the SSZ path the generalized index was computed for.
+	Path string `json:"path"`
+
+	// Fork is the name of the fork the generalized index was computed for.
+	Fork string `json:"fork"`
+
+	// GeneralizedIndex is the generalized index of the node addressed by the
+	// path, as a decimal string since it may not fit a JSON number.
+	GeneralizedIndex string `json:"generalized_index"`
+}
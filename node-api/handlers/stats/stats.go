@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package stats
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/stats/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/block"
+)
+
+// DefaultWindow is the number of blocks aggregated when no window is given.
+const DefaultWindow = 100
+
+// GetBlockStats returns aggregate gas, transaction and blob counts along with
+// base fee percentiles over the last window finalized blocks. Only blocks
+// still held by the block store, i.e. within its availability window and
+// finalized since the node started, are aggregated.
+func (h *Handler) GetBlockStats(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetBlockStatsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	window := uint64(DefaultWindow)
+	if req.Window != "" {
+		var w math.U64
+		w, err = math.U64FromString(req.Window)
+		if err != nil || w == 0 {
+			return nil, apitypes.ErrInvalidRequest
+		}
+		window = w.Unwrap()
+	}
+	return &types.BlockStatsResponse{
+		Data: aggregate(h.backend.PayloadSummaries(window)),
+	}, nil
+}
+
+// aggregate aggregates the given payload summaries, ordered by slot.
+func aggregate(summaries []block.PayloadSummary) *types.BlockStatsData {
+	var gasUsed, gasLimit, txCount, blobCount uint64
+	baseFees := make([]*math.U256, 0, len(summaries))
+	for _, summary := range summaries {
+		gasUsed += summary.GasUsed.Unwrap()
+		gasLimit += summary.GasLimit.Unwrap()
+		txCount += summary.TransactionCount
+		blobCount += summary.BlobCount
+		if summary.BaseFeePerGas != nil {
+			baseFees = append(baseFees, summary.BaseFeePerGas)
+		}
+	}
+
+	data := &types.BlockStatsData{
+		BlockCount:       strconv.Itoa(len(summaries)),
+		GasUsed:          strconv.FormatUint(gasUsed, 10),
+		GasLimit:         strconv.FormatUint(gasLimit, 10),
+		TransactionCount: strconv.FormatUint(txCount, 10),
+		BlobCount:        strconv.FormatUint(blobCount, 10),
+		BaseFeePerGas:    baseFeeStats(baseFees),
+	}
+	if len(summaries) > 0 {
+		data.FromSlot = summaries[0].Slot.Base10()
+		data.ToSlot = summaries[len(summaries)-1].Slot.Base10()
+	}
+	return data
+}
+
+// baseFeeStats returns the nearest-rank percentiles of the given base fees,
+// which are zero if there are none.
+func baseFeeStats(baseFees []*math.U256) *types.BaseFeeStats {
+	slices.SortFunc(baseFees, func(a, b *math.U256) int { return a.Cmp(b) })
+	percentile := func(p int) string {
+		if len(baseFees) == 0 {
+			return "0"
+		}
+		// Nearest rank, i.e. ceil(p * n / 100) counted from one.
+		rank := max((p*len(baseFees)+99)/100, 1)
+		return baseFees[rank-1].Dec()
+	}
+	return &types.BaseFeeStats{
+		Min: percentile(0),
+		P25: percentile(25),
+		P50: percentile(50),
+		P75: percentile(75),
+		P90: percentile(90),
+		Max: percentile(100),
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// BlockStatsResponse is the response of the block stats endpoint.
+type BlockStatsResponse struct {
+	Data *BlockStatsData `json:"data"`
+}
+
+// BlockStatsData aggregates the execution payloads of the blocks from
+// FromSlot to ToSlot. The slots are empty if there are no blocks.
+type BlockStatsData struct {
+	FromSlot         string        `json:"from_slot"`
+	ToSlot           string        `json:"to_slot"`
+	BlockCount       string        `json:"block_count"`
+	GasUsed          string        `json:"gas_used"`
+	GasLimit         string        `json:"gas_limit"`
+	TransactionCount string        `json:"transaction_count"`
+	BlobCount        string        `json:"blob_count"`
+	BaseFeePerGas    *BaseFeeStats `json:"base_fee_per_gas"`
+}
+
+// BaseFeeStats holds percentiles of the base fee per gas, in Wei.
+type BaseFeeStats struct {
+	Min string `json:"min"`
+	P25 string `json:"p25"`
+	P50 string `json:"p50"`
+	P75 string `json:"p75"`
+	P90 string `json:"p90"`
+	Max string `json:"max"`
+}
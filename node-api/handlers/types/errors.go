@@ -23,7 +23,9 @@ package types
 import "errors"
 
 var (
-	ErrNotFound       = errors.New("not found")
-	ErrNotImplemented = errors.New("not implemented")
-	ErrInvalidRequest = errors.New("invalid request")
+	ErrNotFound        = errors.New("not found")
+	ErrNotImplemented  = errors.New("not implemented")
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrRequestTooLarge = errors.New("request too large")
+	ErrRequestTimeout  = errors.New("request timed out")
 )
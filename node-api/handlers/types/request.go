@@ -28,6 +28,14 @@ type BlockIDRequest struct {
 	BlockID string `param:"block_id" validate:"required,block_id"`
 }
 
+// PaginationRequest holds the optional cursor used to page through list
+// endpoints. PageToken is the offset of the first item to return and
+// PageSize the maximum number of items to return.
+type PaginationRequest struct {
+	PageSize  string `query:"page_size"  json:"page_size"  validate:"omitempty,numeric"`
+	PageToken string `query:"page_token" json:"page_token" validate:"omitempty,numeric"`
+}
+
 type TimestampIDRequest struct {
 	TimestampID string `param:"timestamp_id" validate:"required,timestamp_id"`
 }
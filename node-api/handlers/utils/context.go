@@ -33,7 +33,10 @@ func BindAndValidate[RequestT any, ContextT context.Context](
 ) (RequestT, error) {
 	var req RequestT
 	if err := c.Bind(&req); err != nil {
-		return req, types.ErrInvalidRequest
+		return req, BindError(err)
+	}
+	if err := CheckListLengths(req); err != nil {
+		return req, err
 	}
 	if err := c.Validate(&req); err != nil {
 		return req, types.ErrInvalidRequest
@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -123,3 +124,25 @@ func slotFromStateID(id string) (math.Slot, error) {
 		return math.U64FromString(id)
 	}
 }
+
+// SlotFromExecutionID returns the slot of the beacon block that included the
+// execution block identified by the given execution ID, which is either an
+// execution block hash or an execution block number in decimal notation. A
+// malformed execution ID is reported as an invalid request.
+func SlotFromExecutionID[StorageBackendT interface {
+	GetSlotByExecutionHash(blockHash common.ExecutionHash) (math.Slot, error)
+	GetSlotByExecutionNumber(blockNumber math.U64) (math.Slot, error)
+}](executionID string, storage StorageBackendT) (math.Slot, error) {
+	if strings.HasPrefix(executionID, "0x") {
+		hash, err := common.NewRootFromHex(executionID)
+		if err != nil {
+			return 0, errors.Join(types.ErrInvalidRequest, err)
+		}
+		return storage.GetSlotByExecutionHash(common.ExecutionHash(hash))
+	}
+	number, err := math.U64FromString(executionID)
+	if err != nil {
+		return 0, errors.Join(types.ErrInvalidRequest, err)
+	}
+	return storage.GetSlotByExecutionNumber(number)
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils
+
+import (
+	"net/http"
+	"os"
+	"reflect"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+)
+
+// BindError maps an error returned while binding a request to the error
+// served to the client.
+func BindError(err error) error {
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge):
+		return types.ErrRequestTooLarge
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		return types.ErrRequestTimeout
+	default:
+		return types.ErrInvalidRequest
+	}
+}
+
+// CheckListLengths returns an error if any list in req, including those of
+// embedded structs, holds more than handlers.MaxListLength values.
+func CheckListLengths(req any) error {
+	return checkListLengths(reflect.Indirect(reflect.ValueOf(req)))
+}
+
+func checkListLengths(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Len() > handlers.MaxListLength {
+			return errors.Wrapf(
+				types.ErrInvalidRequest,
+				"list holds more than %d values", handlers.MaxListLength,
+			)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if err := checkListLengths(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	default:
+	}
+	return nil
+}
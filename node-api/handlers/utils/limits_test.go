@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckListLengths(t *testing.T) {
+	t.Parallel()
+	type request struct {
+		types.StateIDRequest
+		IDs []string
+	}
+
+	require.NoError(t, utils.CheckListLengths(request{
+		IDs: make([]string, handlers.MaxListLength),
+	}))
+	require.ErrorIs(t, utils.CheckListLengths(request{
+		IDs: make([]string, handlers.MaxListLength+1),
+	}), types.ErrInvalidRequest)
+	require.ErrorIs(t, utils.CheckListLengths(
+		make([]string, handlers.MaxListLength+1),
+	), types.ErrInvalidRequest)
+}
+
+func TestBindError(t *testing.T) {
+	t.Parallel()
+	tooLarge := fmt.Errorf("bind: %w", &http.MaxBytesError{Limit: handlers.MaxBodyBytes})
+	require.ErrorIs(t, utils.BindError(tooLarge), types.ErrRequestTooLarge)
+
+	timeout := fmt.Errorf("bind: %w", os.ErrDeadlineExceeded)
+	require.ErrorIs(t, utils.BindError(timeout), types.ErrRequestTimeout)
+
+	require.ErrorIs(t, utils.BindError(os.ErrClosed), types.ErrInvalidRequest)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// DefaultPageSize is the page size used when only a page token is given.
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum number of items returned in a single page.
+	MaxPageSize = 1000
+)
+
+// Paginate returns the page of items described by the given pagination
+// request, along with the token of the next page. The returned token is empty
+// once the last page has been reached.
+func Paginate[T any](items []T, page types.PaginationRequest) ([]T, string, error) {
+	var (
+		offset   uint64
+		pageSize uint64 = DefaultPageSize
+	)
+	if page.PageToken != "" {
+		token, err := math.U64FromString(page.PageToken)
+		if err != nil {
+			return nil, "", types.ErrInvalidRequest
+		}
+		offset = token.Unwrap()
+	}
+	if page.PageSize != "" {
+		size, err := math.U64FromString(page.PageSize)
+		if err != nil || size == 0 {
+			return nil, "", types.ErrInvalidRequest
+		}
+		pageSize = min(size.Unwrap(), MaxPageSize)
+	}
+
+	total := uint64(len(items))
+	if offset >= total {
+		return []T{}, "", nil
+	}
+	end := min(offset+pageSize, total)
+	if end == total {
+		return items[offset:end], "", nil
+	}
+	return items[offset:end], strconv.FormatUint(end, 10), nil
+}
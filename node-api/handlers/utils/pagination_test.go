@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+	items := []int{0, 1, 2, 3, 4}
+
+	tests := []struct {
+		name      string
+		page      types.PaginationRequest
+		want      []int
+		wantToken string
+		wantErr   error
+	}{
+		{
+			name:      "first page",
+			page:      types.PaginationRequest{PageSize: "2"},
+			want:      []int{0, 1},
+			wantToken: "2",
+		},
+		{
+			name:      "middle page",
+			page:      types.PaginationRequest{PageSize: "2", PageToken: "2"},
+			want:      []int{2, 3},
+			wantToken: "4",
+		},
+		{
+			name: "last page",
+			page: types.PaginationRequest{PageSize: "2", PageToken: "4"},
+			want: []int{4},
+		},
+		{
+			name: "token past the end",
+			page: types.PaginationRequest{PageSize: "2", PageToken: "10"},
+			want: []int{},
+		},
+		{
+			name:    "zero page size",
+			page:    types.PaginationRequest{PageSize: "0"},
+			wantErr: types.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, token, err := utils.Paginate(items, tt.page)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantToken, token)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/observability/performance"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Backend is the interface for backend of the validator API.
+type Backend interface {
+	DutiesBackend
+	MappingBackend
+}
+
+type DutiesBackend interface {
+	// ProposerDuties returns the dependent root and the proposer assignments
+	// for every slot of the given epoch.
+	ProposerDuties(epoch math.Epoch) (common.Root, []*validatortypes.ProposerDutyData, error)
+	// ExecutionOptimistic returns true if the execution payload of the state
+	// at the given slot is not validated yet by the execution client.
+	ExecutionOptimistic(slot math.Slot) (bool, error)
+}
+
+type MappingBackend interface {
+	// CometMapping returns the CometBFT address of every validator in the
+	// registry, ordered by validator index.
+	CometMapping() ([]*validatortypes.CometMappingData, error)
+	// CometMappingByID returns the CometBFT address of the validator with
+	// the given index or pubkey.
+	CometMappingByID(id string) (*validatortypes.CometMappingData, error)
+}
+
+// Performance is the performance of validators tracked by this node.
+type Performance interface {
+	// Performance returns the stats of the validator with the given CometBFT
+	// address, or false if it proposed nothing within the window.
+	Performance(address []byte) (performance.Stats, bool)
+	// Heights returns the number of heights in the window.
+	Heights() int
+}
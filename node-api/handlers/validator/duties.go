@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetProposerDuties returns the expected block proposer for every slot of
+// the requested epoch. Assignments for slots that are not yet committed are
+// derived from the CometBFT proposer priorities.
+func (h *Handler) GetProposerDuties(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.GetProposerDutiesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := math.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	dependentRoot, duties, err := h.backend.ProposerDuties(epoch)
+	switch {
+	case err == nil:
+		// No error, continue
+	case errors.Is(err, backend.ErrEpochTooFarAhead):
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	default:
+		return nil, err
+	}
+	// The duties are derived from the head state.
+	optimistic, err := h.backend.ExecutionOptimistic(0)
+	if err != nil {
+		return nil, err
+	}
+	return validatortypes.ProposerDutiesResponse{
+		DependentRoot:       dependentRoot,
+		ExecutionOptimistic: optimistic,
+		Data:                duties,
+	}, nil
+}
@@ -24,13 +24,17 @@ import "github.com/berachain/beacon-kit/node-api/handlers"
 
 type Handler struct {
 	*handlers.BaseHandler
+	backend     Backend
+	performance Performance
 }
 
-func NewHandler() *Handler {
+func NewHandler(backend Backend, performance Performance) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		backend:     backend,
+		performance: performance,
 	}
 	return h
 }
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+)
+
+// GetValidatorPerformance returns the proposal hits, misses and average
+// inclusion latency of a validator over the heights this node tracked while
+// following consensus.
+func (h *Handler) GetValidatorPerformance(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.GetValidatorPerformanceRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := h.backend.CometMappingByID(req.ValidatorID)
+	switch {
+	case errors.Is(err, backend.ErrValidatorNotFound):
+		return &handlers.HTTPError{
+			Code:    http.StatusNotFound,
+			Message: "Validator not found",
+		}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	// A validator which proposed nothing within the window has zero stats.
+	stats, _ := h.performance.Performance(mapping.CometAddress)
+	return validatortypes.ValidatorPerformanceResponse{
+		Data: &validatortypes.ValidatorPerformanceData{
+			CometMappingData: *mapping,
+			//#nosec: G115 // the window size is positive.
+			WindowHeights:         uint64(h.performance.Heights()),
+			ProposalsHit:          stats.ProposalsHit,
+			ProposalsMissed:       stats.ProposalsMissed,
+			AvgInclusionLatencyMs: stats.AverageLatency().Milliseconds(),
+		},
+	}, nil
+}
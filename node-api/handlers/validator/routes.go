@@ -38,7 +38,17 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/validator/duties/proposer/:epoch",
-			Handler: h.NotImplemented,
+			Handler: h.GetProposerDuties,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/validators/comet_mapping",
+			Handler: h.GetCometMapping,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/validators/:validator_id/performance",
+			Handler: h.GetValidatorPerformance,
 		},
 		{
 			Method:  http.MethodPost,
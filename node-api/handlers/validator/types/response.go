@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+)
+
+type ProposerDutiesResponse struct {
+	DependentRoot       common.Root         `json:"dependent_root"`
+	ExecutionOptimistic bool                `json:"execution_optimistic"`
+	Data                []*ProposerDutyData `json:"data"`
+}
+
+type ProposerDutyData struct {
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	ValidatorIndex uint64           `json:"validator_index,string"`
+	Slot           uint64           `json:"slot,string"`
+}
+
+type CometMappingResponse struct {
+	Data []*CometMappingData `json:"data"`
+}
+
+// CometMappingData maps a beacon validator to its CometBFT address, which is
+// hex encoded in upper case as in CometBFT votes and RPC responses. It is a
+// BeaconKit extension to the beacon API.
+type CometMappingData struct {
+	ValidatorIndex uint64            `json:"validator_index,string"`
+	Pubkey         crypto.BLSPubkey  `json:"pubkey"`
+	CometAddress   cmtcrypto.Address `json:"comet_address"`
+}
+
+type ValidatorPerformanceResponse struct {
+	Data *ValidatorPerformanceData `json:"data"`
+}
+
+// ValidatorPerformanceData is the proposal performance of a validator over
+// the heights this node tracked. It is a BeaconKit extension to the beacon
+// API.
+type ValidatorPerformanceData struct {
+	CometMappingData
+	WindowHeights         uint64 `json:"window_heights,string"`
+	ProposalsHit          uint64 `json:"proposals_hit,string"`
+	ProposalsMissed       uint64 `json:"proposals_missed,string"`
+	AvgInclusionLatencyMs int64  `json:"avg_inclusion_latency_ms,string"`
+}
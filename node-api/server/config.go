@@ -20,8 +20,18 @@
 
 package server
 
+import (
+	"strings"
+	"time"
+)
+
 const (
-	defaultAddress = "127.0.0.1:3500"
+	defaultAddress         = "127.0.0.1:3500"
+	defaultMaxSyncDistance = 8
+	defaultMaxFinalizedAge = time.Minute
+	defaultRateLimitBurst  = 20
+	defaultProtectedPaths  = "/eth/v1/debug,/eth/v2/debug,/bkit/v1/crypto"
+	defaultCORSOrigins     = "*"
 )
 
 // Config is the configuration for the node API server.
@@ -32,13 +42,70 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// MaxSyncDistance is the number of blocks the node may lag behind its
+	// peers and still report ready.
+	MaxSyncDistance uint64 `mapstructure:"max-sync-distance"`
+	// MaxFinalizedAge is the age of the latest finalized block past which
+	// the node reports not ready.
+	MaxFinalizedAge time.Duration `mapstructure:"max-finalized-age"`
+	// RateLimit is the number of requests per second allowed from each
+	// client IP. Zero disables rate limiting.
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst is the number of requests a client IP may send at once.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// AuthToken is the bearer token required on protected paths. Empty
+	// disables authentication.
+	AuthToken string `mapstructure:"auth-token" redact:"true"`
+	// ProtectedPaths is a comma separated list of path prefixes requiring
+	// the auth token.
+	ProtectedPaths string `mapstructure:"protected-paths"`
+	// CORSAllowedOrigins is a comma separated list of origins browsers may
+	// call the API from, "*" for any. Empty disables CORS.
+	CORSAllowedOrigins string `mapstructure:"cors-allowed-origins"`
+	// Compression is the flag to compress responses for clients accepting
+	// gzip or deflate.
+	Compression bool `mapstructure:"compression"`
+}
+
+// Protects reports whether requests to the given path require the auth
+// token.
+func (c Config) Protects(path string) bool {
+	if c.AuthToken == "" {
+		return false
+	}
+	for _, prefix := range splitList(c.ProtectedPaths) {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList splits a comma separated list, dropping empty entries.
+func splitList(s string) []string {
+	var out []string
+	for _, entry := range strings.Split(s, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			out = append(out, entry)
+		}
+	}
+	return out
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:         false,
+		Address:         defaultAddress,
+		Logging:         false,
+		MaxSyncDistance: defaultMaxSyncDistance,
+		MaxFinalizedAge: defaultMaxFinalizedAge,
+		RateLimit:       0,
+		RateLimitBurst:  defaultRateLimitBurst,
+		AuthToken:       "",
+		ProtectedPaths:  defaultProtectedPaths,
+
+		CORSAllowedOrigins: defaultCORSOrigins,
+		Compression:        true,
 	}
 }
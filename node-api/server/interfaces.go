@@ -23,10 +23,13 @@ package server
 import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/server/middleware"
 )
 
 // Engine is an interface for an API engine.
 type Engine interface {
 	Run(addr string) error
 	RegisterRoutes(*handlers.RouteSet, log.Logger)
+	// UseMiddleware wraps every route with the given middleware.
+	UseMiddleware(middleware.Middleware)
 }
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	encodingGzip    = "gzip"
+	encodingDeflate = "deflate"
+
+	// minCompressSize is the response size below which compressing does not
+	// pay off.
+	minCompressSize = 1024
+)
+
+// Compress compresses responses with gzip or deflate, whichever the client
+// accepts, preferring gzip. Responses smaller than 1KiB are sent as is.
+func Compress(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{
+			ResponseWriter: w,
+			encoding:       encoding,
+			status:         http.StatusOK,
+		}
+		defer cw.Close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateEncoding returns the content coding to use for a request with the
+// given Accept-Encoding header, or "" if none is supported.
+func negotiateEncoding(header string) string {
+	var gzipOK, deflateOK bool
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if q := strings.ReplaceAll(params, " ", ""); q == "q=0" || q == "q=0.0" {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case encodingGzip, "*":
+			gzipOK = true
+		case encodingDeflate:
+			deflateOK = true
+		}
+	}
+	switch {
+	case gzipOK:
+		return encodingGzip
+	case deflateOK:
+		return encodingDeflate
+	default:
+		return ""
+	}
+}
+
+// compressWriter buffers the start of a response, and compresses it once it
+// is large enough to be worth it.
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	status   int
+
+	// buf holds the response until it reaches minCompressSize.
+	buf []byte
+	// cw is the compressing writer, nil until compression starts.
+	cw io.WriteCloser
+	// passthrough is set once the response is sent uncompressed.
+	passthrough bool
+}
+
+// Unwrap returns the underlying writer, so http.ResponseController can reach
+// it.
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// WriteHeader records the status code, which is sent along with the first
+// bytes of the body.
+func (w *compressWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+// Write buffers or compresses p.
+func (w *compressWriter) Write(p []byte) (int, error) {
+	switch {
+	case w.passthrough:
+		return w.ResponseWriter.Write(p)
+	case w.cw != nil:
+		return w.cw.Write(p)
+	}
+
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= minCompressSize {
+		if err := w.start(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush compresses and sends what has been written so far.
+func (w *compressWriter) Flush() {
+	if w.cw == nil && !w.passthrough {
+		if err := w.start(); err != nil {
+			return
+		}
+	}
+	if f, ok := w.cw.(interface{ Flush() error }); ok {
+		//nolint:errcheck // http.Flusher cannot report errors.
+		f.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Close sends the rest of the response.
+func (w *compressWriter) Close() error {
+	switch {
+	case w.cw != nil:
+		return w.cw.Close()
+	case w.passthrough:
+		return nil
+	}
+	return w.sendRaw()
+}
+
+// start starts compressing the response, unless the handler already encoded
+// it.
+func (w *compressWriter) start() error {
+	if w.Header().Get("Content-Encoding") != "" {
+		return w.sendRaw()
+	}
+
+	w.Header().Set("Content-Encoding", w.encoding)
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.encoding == encodingGzip {
+		w.cw = gzip.NewWriter(w.ResponseWriter)
+	} else {
+		w.cw = zlib.NewWriter(w.ResponseWriter)
+	}
+	_, err := w.cw.Write(w.buf)
+	w.buf = nil
+	return err
+}
+
+// sendRaw sends the buffered response uncompressed.
+func (w *compressWriter) sendRaw() error {
+	w.passthrough = true
+	w.ResponseWriter.WriteHeader(w.status)
+	_, err := w.ResponseWriter.Write(w.buf)
+	w.buf = nil
+	return err
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-api/server/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func serve(h http.Handler, remoteAddr, path, auth string) int {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = remoteAddr
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+	h := middleware.NewRateLimiter(0.001, 2).Handler(okHandler)
+
+	require.Equal(t, http.StatusOK, serve(h, "10.0.0.1:1000", "/", ""))
+	require.Equal(t, http.StatusOK, serve(h, "10.0.0.1:1001", "/", ""))
+	require.Equal(t, http.StatusTooManyRequests, serve(h, "10.0.0.1:1002", "/", ""))
+
+	// Other clients have their own bucket.
+	require.Equal(t, http.StatusOK, serve(h, "10.0.0.2:1000", "/", ""))
+}
+
+func TestBearerAuth(t *testing.T) {
+	t.Parallel()
+	h := middleware.BearerAuth("secret", []string{"/eth/v1/debug"})(okHandler)
+
+	require.Equal(t, http.StatusOK, serve(h, "10.0.0.1:1000", "/eth/v1/node/version", ""))
+	require.Equal(t, http.StatusUnauthorized, serve(h, "10.0.0.1:1000", "/eth/v1/debug/fork_choice", ""))
+	require.Equal(t, http.StatusUnauthorized,
+		serve(h, "10.0.0.1:1000", "/eth/v1/debug/fork_choice", "Bearer wrong"))
+	require.Equal(t, http.StatusOK,
+		serve(h, "10.0.0.1:1000", "/eth/v1/debug/fork_choice", "Bearer secret"))
+}
+
+func TestCompress(t *testing.T) {
+	t.Parallel()
+	large := bytes.Repeat([]byte("validator"), 1024)
+	h := middleware.Compress(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/small" {
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+		_, _ = w.Write(large)
+	}))
+
+	get := func(path, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept-Encoding", accept)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("/large", "deflate, gzip")
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	gz, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.Equal(t, large, body)
+
+	rec = get("/large", "gzip;q=0, deflate")
+	require.Equal(t, "deflate", rec.Header().Get("Content-Encoding"))
+	zr, err := zlib.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err = io.ReadAll(zr)
+	require.NoError(t, err)
+	require.Equal(t, large, body)
+
+	rec = get("/small", "gzip")
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Equal(t, "{}", rec.Body.String())
+
+	rec = get("/large", "")
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Equal(t, large, rec.Body.Bytes())
+}
+
+func TestCORS(t *testing.T) {
+	t.Parallel()
+	h := middleware.CORS([]string{"https://dash.example"})(okHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Origin", "https://dash.example")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, "https://dash.example", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	req = httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", "https://dash.example")
+	req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Origin", "https://evil.example")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/noop"
 	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/server/middleware"
 )
 
 // Server is the API Server service.
@@ -48,6 +49,23 @@ func New(
 	if !config.Logging {
 		apiLogger = noop.NewLogger[log.Logger]()
 	}
+	// Middlewares run in the order they are added, so preflight requests are
+	// answered before being rate limited or authenticated.
+	if origins := splitList(config.CORSAllowedOrigins); len(origins) > 0 {
+		engine.UseMiddleware(middleware.CORS(origins))
+	}
+	if config.Compression {
+		engine.UseMiddleware(middleware.Compress)
+	}
+	if config.RateLimit > 0 {
+		limiter := middleware.NewRateLimiter(config.RateLimit, config.RateLimitBurst)
+		engine.UseMiddleware(limiter.Handler)
+	}
+	if config.AuthToken != "" {
+		engine.UseMiddleware(
+			middleware.BearerAuth(config.AuthToken, splitList(config.ProtectedPaths)),
+		)
+	}
 	for _, handler := range handlers {
 		handler.RegisterRoutes(apiLogger)
 		engine.RegisterRoutes(handler.RouteSet(), apiLogger)
@@ -27,10 +27,13 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/cli/commands/server"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -65,8 +68,18 @@ func DefaultServiceOptions(
 		}
 	}
 
+	snapshotStore, err := openSnapshotStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	snapshotOpts := snapshottypes.NewSnapshotOptions(
+		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+	)
+
 	return []func(*cometbft.Service){
 		cometbft.SetPruning(pruningOpts),
+		cometbft.SetSnapshot(snapshotStore, snapshotOpts),
 		cometbft.SetMinRetainBlocks(
 			cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks)),
 		),
@@ -82,6 +95,24 @@ func DefaultServiceOptions(
 	}
 }
 
+// openSnapshotStore opens the state sync snapshot store under the data
+// directory.
+func openSnapshotStore(appOpts config.AppOptions) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(
+		cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots",
+	)
+	//nolint:mnd // standard directory permissions.
+	if err := os.MkdirAll(snapshotDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+	// The CometBFT config is merged into the app options.
+	snapshotDB, err := storagedb.NewDB(snapshotDir, "metadata", cast.ToString(appOpts.Get("db_backend")))
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
 	var (
 		homeDir = cast.ToString(appOpts.Get(flags.FlagHome))
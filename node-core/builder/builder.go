@@ -24,6 +24,7 @@ import (
 	"io"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/config/spec"
@@ -67,6 +68,9 @@ func (nb *NodeBuilder) Build(
 		apiBackend interface {
 			AttachQueryBackend(types.ConsensusService)
 		}
+		chainService interface {
+			AttachProposerSchedule(blockchain.ProposerSchedule)
+		}
 		beaconNode types.Node
 		cmtService types.ConsensusService
 		config     *config.Config
@@ -92,6 +96,7 @@ func (nb *NodeBuilder) Build(
 			),
 		),
 		&apiBackend,
+		&chainService,
 		&beaconNode,
 		&cmtService,
 		&config,
@@ -104,8 +109,12 @@ func (nb *NodeBuilder) Build(
 	if apiBackend == nil {
 		panic("node or api backend is nil")
 	}
+	if chainService == nil {
+		panic("chain service is nil")
+	}
 
 	logger.WithConfig(config.GetLogger())
 	apiBackend.AttachQueryBackend(cmtService)
+	chainService.AttachProposerSchedule(cmtService)
 	return beaconNode
 }
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/services/admin"
+	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// AdminServiceInput is the input for the admin service provider.
+type AdminServiceInput struct {
+	depinject.In
+	AppOpts      config.AppOptions
+	Blocks       admin.BlockPauser
+	Config       *config.Config
+	DBRegistry   *storagedb.Registry
+	EngineClient *client.EngineClient
+	Factory      *attributes.Factory
+	Logger       *phuslu.Logger
+}
+
+// ProvideAdminService provides the loopback-only admin server. The root
+// logger is handed over so that changing the level applies to every service.
+func ProvideAdminService(in AdminServiceInput) *admin.Service {
+	dataDir := filepath.Join(cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data")
+	return admin.NewService(
+		in.Config.Admin,
+		filepath.Join(dataDir, "debug"),
+		in.Logger.With("service", "admin"),
+		in.Logger,
+		in.EngineClient,
+		feeRecipientController{
+			factory: in.Factory,
+			homeDir: cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+		},
+		in.Blocks,
+		in.DBRegistry,
+		filepath.Join(dataDir, "blobs"),
+	)
+}
+
+// feeRecipientController persists the suggested fee recipient to the config
+// file before applying it to the attributes factory, so that it survives a
+// restart.
+type feeRecipientController struct {
+	factory *attributes.Factory
+	homeDir string
+}
+
+func (c feeRecipientController) SuggestedFeeRecipient() common.ExecutionAddress {
+	return c.factory.SuggestedFeeRecipient()
+}
+
+func (c feeRecipientController) SetSuggestedFeeRecipient(
+	addr common.ExecutionAddress,
+) error {
+	if err := config.UpdateConfigFileValue(
+		c.homeDir, "beacon-kit.payload-builder", "suggested-fee-recipient", addr.Hex(),
+	); err != nil {
+		return err
+	}
+	c.factory.SetSuggestedFeeRecipient(addr)
+	return nil
+}
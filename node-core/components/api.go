@@ -21,9 +21,13 @@
 package components
 
 import (
+	"path/filepath"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/backend"
@@ -31,7 +35,11 @@ import (
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	"github.com/berachain/beacon-kit/storage/db"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // TODO: we could make engine type configurable
@@ -45,15 +53,32 @@ type NodeAPIBackendInput struct {
 	ChainSpec      chain.Spec
 	StorageBackend *storage.Backend
 	CometConfig    *cmtcfg.Config
+	StateProcessor *core.StateProcessor
+	EngineClient   *client.EngineClient
+	Optimistic     *engine.OptimisticTracker
+	AppOpts        config.AppOptions
+	DBRegistry     *db.Registry
 }
 
 func ProvideNodeAPIBackend(
 	in NodeAPIBackendInput,
 ) (*backend.Backend, error) {
+	// The operation pools are persisted so that submitted operations survive
+	// restarts.
+	dataDir := filepath.Join(cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data")
+	poolDB, err := db.NewDB(dataDir, "operations", in.CometConfig.DBBackend)
+	if err != nil {
+		return nil, err
+	}
+	in.DBRegistry.Register("operations", poolDB)
 	return backend.New(
 		in.StorageBackend,
 		in.ChainSpec,
 		in.CometConfig,
+		in.StateProcessor,
+		in.EngineClient,
+		in.Optimistic,
+		poolDB,
 	)
 }
 
@@ -75,7 +100,7 @@ func ProvideNodeAPIServer(in NodeAPIServerInput) *server.Server {
 	return server.New(
 		in.Config.NodeAPI,
 		in.Engine,
-		in.Logger.With("service", "node-api-server"),
+		in.Logger.Named("node-api").With("service", "node-api-server"),
 		in.Handlers...,
 	)
 }
@@ -22,36 +22,65 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
+	blocksapi "github.com/berachain/beacon-kit/node-api/handlers/blocks"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/node-api/handlers/config"
 	debugapi "github.com/berachain/beacon-kit/node-api/handlers/debug"
+	depositsapi "github.com/berachain/beacon-kit/node-api/handlers/deposits"
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
+	feesapi "github.com/berachain/beacon-kit/node-api/handlers/fees"
+	healthapi "github.com/berachain/beacon-kit/node-api/handlers/health"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	statsapi "github.com/berachain/beacon-kit/node-api/handlers/stats"
+	validatorapi "github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/node-core/services/depositreconciler"
+	"github.com/berachain/beacon-kit/node-core/services/diskusage"
+	"github.com/berachain/beacon-kit/observability/performance"
+	"github.com/berachain/beacon-kit/observability/slottiming"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/proposals"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
 
 type NodeAPIHandlersInput struct {
 	depinject.In
-	BeaconAPIHandler  *beaconapi.Handler
-	BuilderAPIHandler *builderapi.Handler
-	ConfigAPIHandler  *configapi.Handler
-	DebugAPIHandler   *debugapi.Handler
-	EventsAPIHandler  *eventsapi.Handler
-	NodeAPIHandler    *nodeapi.Handler
-	ProofAPIHandler   *proofapi.Handler
+	BeaconAPIHandler    *beaconapi.Handler
+	BlocksAPIHandler    *blocksapi.Handler
+	BuilderAPIHandler   *builderapi.Handler
+	ConfigAPIHandler    *configapi.Handler
+	DebugAPIHandler     *debugapi.Handler
+	DepositsAPIHandler  *depositsapi.Handler
+	EventsAPIHandler    *eventsapi.Handler
+	FeesAPIHandler      *feesapi.Handler
+	HealthAPIHandler    *healthapi.Handler
+	NodeAPIHandler      *nodeapi.Handler
+	ProofAPIHandler     *proofapi.Handler
+	StatsAPIHandler     *statsapi.Handler
+	ValidatorAPIHandler *validatorapi.Handler
 }
 
 func ProvideNodeAPIHandlers(in NodeAPIHandlersInput) []handlers.Handlers {
 	return []handlers.Handlers{
 		in.BeaconAPIHandler,
+		in.BlocksAPIHandler,
 		in.BuilderAPIHandler,
 		in.ConfigAPIHandler,
 		in.DebugAPIHandler,
+		in.DepositsAPIHandler,
 		in.EventsAPIHandler,
+		in.FeesAPIHandler,
+		in.HealthAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.StatsAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
@@ -59,26 +88,85 @@ func ProvideNodeAPIBeaconHandler(b NodeAPIBackend) *beaconapi.Handler {
 	return beaconapi.NewHandler(b)
 }
 
-func ProvideNodeAPIBuilderHandler() *builderapi.Handler {
-	return builderapi.NewHandler()
+func ProvideNodeAPIBlocksHandler(b NodeAPIBackend) *blocksapi.Handler {
+	return blocksapi.NewHandler(b)
 }
 
-func ProvideNodeAPIConfigHandler(b NodeAPIBackend) *configapi.Handler {
-	return configapi.NewHandler(b)
+func ProvideNodeAPIBuilderHandler(b NodeAPIBackend) *builderapi.Handler {
+	return builderapi.NewHandler(b)
 }
 
-func ProvideNodeAPIDebugHandler(b NodeAPIBackend) *debugapi.Handler {
-	return debugapi.NewHandler(b)
+func ProvideNodeAPIConfigHandler(b NodeAPIBackend, cfg *config.Config) *configapi.Handler {
+	return configapi.NewHandler(b, cfg)
 }
 
-func ProvideNodeAPIEventsHandler() *eventsapi.Handler {
-	return eventsapi.NewHandler()
+// ProvideNodeAPIDebugHandler provides the debug API handler. The signature
+// verification endpoint is only served when protected by the auth token.
+func ProvideNodeAPIDebugHandler(
+	b NodeAPIBackend,
+	timings *slottiming.Recorder,
+	reconciler *depositreconciler.Service,
+	signer crypto.BLSSigner,
+	cfg *config.Config,
+) *debugapi.Handler {
+	var verifier debugapi.SignatureVerifier
+	if cfg.NodeAPI.Protects("/" + debugapi.VerifySignaturePath) {
+		verifier = signer
+	}
+	return debugapi.NewHandler(b, timings, reconciler, verifier)
+}
+
+func ProvideNodeAPIDepositsHandler(
+	b NodeAPIBackend,
+	contract *deposit.WrappedDepositContract,
+) *depositsapi.Handler {
+	return depositsapi.NewHandler(b, contract, depositsapi.DefaultPollInterval)
+}
+
+func ProvideNodeAPIEventsHandler(bus *events.Bus) *eventsapi.Handler {
+	return eventsapi.NewHandler(bus)
+}
+
+func ProvideNodeAPIFeesHandler(
+	b NodeAPIBackend, proposals *proposals.Store,
+) *feesapi.Handler {
+	return feesapi.NewHandler(b, proposals)
 }
 
-func ProvideNodeAPINodeHandler() *nodeapi.Handler {
-	return nodeapi.NewHandler()
+func ProvideNodeAPIHealthHandler(
+	b NodeAPIBackend,
+	engineClient *client.EngineClient,
+	cfg *config.Config,
+) *healthapi.Handler {
+	return healthapi.NewHandler(
+		b,
+		engineClient,
+		cfg.NodeAPI.MaxSyncDistance,
+		cfg.NodeAPI.MaxFinalizedAge,
+	)
+}
+
+func ProvideNodeAPINodeHandler(
+	b NodeAPIBackend,
+	engineClient *client.EngineClient,
+	disk *diskusage.Service,
+) *nodeapi.Handler {
+	return nodeapi.NewHandler(
+		b, engineClient, disk, nodeapi.VersionString(sdkversion.Version),
+	)
 }
 
 func ProvideNodeAPIProofHandler(b NodeAPIBackend) *proofapi.Handler {
 	return proofapi.NewHandler(b)
 }
+
+func ProvideNodeAPIStatsHandler(b NodeAPIBackend) *statsapi.Handler {
+	return statsapi.NewHandler(b)
+}
+
+func ProvideNodeAPIValidatorHandler(
+	b NodeAPIBackend,
+	tracker *performance.Tracker,
+) *validatorapi.Handler {
+	return validatorapi.NewHandler(b, tracker)
+}
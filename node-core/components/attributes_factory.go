@@ -25,15 +25,17 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/payload/attributes"
 )
 
 type AttributesFactoryInput struct {
 	depinject.In
 
-	ChainSpec chain.Spec
-	Config    *config.Config
-	Logger    *phuslu.Logger
+	ChainSpec     chain.Spec
+	Config        *config.Config
+	Logger        *phuslu.Logger
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -42,5 +44,6 @@ func ProvideAttributesFactory(in AttributesFactoryInput) (*attributes.Factory, e
 		in.ChainSpec,
 		in.Logger,
 		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.TelemetrySink,
 	), nil
 }
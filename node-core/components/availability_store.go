@@ -53,10 +53,11 @@ func ProvideAvailabilityStore(in AvailabilityStoreInput) (*dastore.Store, error)
 			filedb.NewDB(
 				filedb.WithRootDirectory(blobsDir),
 				filedb.WithFileExtension("ssz"),
+				filedb.WithSnappyCompression(),
 				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
+				filedb.WithLogger(in.Logger.Named("da")),
 			),
 		),
-		in.Logger.With("service", "da-store"),
+		in.Logger.Named("da").With("service", "da-store"),
 	), nil
 }
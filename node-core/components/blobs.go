@@ -65,7 +65,7 @@ type BlobProcessorIn struct {
 // depinject framework.
 func ProvideBlobProcessor(in BlobProcessorIn) *dablob.Processor {
 	return dablob.NewProcessor(
-		in.Logger.With("service", "blob-processor"),
+		in.Logger.Named("da").With("service", "blob-processor"),
 		in.BlobProofVerifier,
 		in.TelemetrySink,
 	)
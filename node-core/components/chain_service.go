@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/beacon/events"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/execution/deposit"
@@ -40,7 +41,9 @@ type ChainServiceInput struct {
 	ChainSpec             chain.Spec
 	Cfg                   *config.Config
 	ExecutionEngine       *engine.Engine
+	OptimisticTracker     *engine.OptimisticTracker
 	LocalBuilder          LocalBuilder
+	AttributesFactory     AttributesFactory
 	Logger                *phuslu.Logger
 	Signer                crypto.BLSSigner
 	StateProcessor        StateProcessor
@@ -48,21 +51,39 @@ type ChainServiceInput struct {
 	BlobProcessor         BlobProcessor
 	TelemetrySink         *metrics.TelemetrySink
 	BeaconDepositContract deposit.Contract
+	EventBus              *events.Bus
 }
 
 // ProvideChainService is a depinject provider for the blockchain service.
-func ProvideChainService(in ChainServiceInput) *blockchain.Service {
+func ProvideChainService(in ChainServiceInput) (*blockchain.Service, error) {
+	// Blobs are retained for at least the data availability period.
+	minRetentionSlots := in.ChainSpec.MinEpochsForBlobsSidecarsRequest().Unwrap() * in.ChainSpec.SlotsPerEpoch()
+	blobRetentionSlots, err := in.Cfg.DA.BlobRetentionSlots(
+		in.ChainSpec.TargetSecondsPerEth1Block(), minRetentionSlots,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localAddress, err := crypto.GetAddressFromPubKey(in.Signer.PublicKey())
+	if err != nil {
+		return nil, err
+	}
 	return blockchain.NewService(
 		in.StorageBackend,
 		in.BlobProcessor,
 		in.BeaconDepositContract,
-		in.Logger.With("service", "blockchain"),
+		in.Logger.Named("blockchain").With("service", "blockchain"),
 		in.ChainSpec,
 		in.ExecutionEngine,
+		in.OptimisticTracker,
 		in.LocalBuilder,
+		in.AttributesFactory,
 		in.StateProcessor,
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
-	)
+		localAddress,
+		blobRetentionSlots,
+		in.EventBus,
+	), nil
 }
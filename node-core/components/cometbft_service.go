@@ -21,13 +21,24 @@
 package components
 
 import (
+	"path/filepath"
+
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/consensus/upgrade"
+	"github.com/berachain/beacon-kit/consensus/voteext"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-api/backend"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/observability/performance"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/deposit"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -40,8 +51,32 @@ func ProvideCometBFTService(
 	db dbm.DB,
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
+	cfg *config.Config,
 	telemetrySink *metrics.TelemetrySink,
+	dbRegistry *storagedb.Registry,
+	depositStore deposit.StoreManager,
+	voteExtensions *voteext.Manager,
+	storageBackend *storage.Backend,
+	apiBackend *backend.Backend,
+	stateProcessor *core.StateProcessor,
+	tracker *performance.Tracker,
+	cs chain.Spec,
 ) *cometbft.Service {
+	// Leave half of the shutdown timeout to stop the remaining services after
+	// the in-flight block is committed.
+	opts := append(
+		builder.DefaultServiceOptions(appOpts),
+		cometbft.SetDrainTimeout(cfg.ShutdownTimeout/2), //nolint:mnd // half.
+		cometbft.SetDBRegistry(dbRegistry),
+		cometbft.SetSnapshotExtensions(deposit.NewSnapshotExtension(depositStore)),
+		cometbft.SetVoteExtensions(voteExtensions, validatorStore{sb: storageBackend}),
+		cometbft.SetPerformanceTracker(tracker),
+		cometbft.SetUnjails(cs, apiBackend, storageBackend, stateProcessor),
+		cometbft.SetUpgradeCoordinator(upgrade.NewCoordinator(
+			logger.With("service", "upgrade"), cs,
+			filepath.Join(cmtCfg.RootDir, "data"),
+		)),
+	)
 	return cometbft.NewService(
 		logger,
 		db,
@@ -49,6 +84,6 @@ func ProvideCometBFTService(
 		blockBuilder,
 		cmtCfg,
 		telemetrySink,
-		builder.DefaultServiceOptions(appOpts)...,
+		opts...,
 	)
 }
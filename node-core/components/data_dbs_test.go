@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDataDBNames checks that every database the components open under the
+// data directory through db.NewDB is listed in db.DataDBNames, so that it is
+// converted by the db migrate command.
+func TestDataDBNames(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	var opened []string
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, errRead := os.ReadFile(file)
+		require.NoError(t, errRead)
+		f, errParse := parser.ParseFile(fset, file, src, 0)
+		require.NoError(t, errParse)
+		opened = append(opened, newDBNames(t, fset, f)...)
+	}
+
+	require.NotEmpty(t, opened)
+	for _, name := range opened {
+		require.Contains(t, db.DataDBNames, name)
+	}
+}
+
+// newDBNames returns the names of the databases opened by the db.NewDB calls
+// of f. Names are either string literals or identifiers declared with one.
+func newDBNames(t *testing.T, fset *token.FileSet, f *ast.File) []string {
+	t.Helper()
+
+	literals := make(map[string]string)
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		for i, ident := range spec.Names {
+			if i >= len(spec.Values) {
+				break
+			}
+			if lit, isLit := spec.Values[i].(*ast.BasicLit); isLit && lit.Kind == token.STRING {
+				literals[ident.Name] = lit.Value
+			}
+		}
+		return true
+	})
+
+	var names []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "NewDB" {
+			return true
+		}
+		if pkg, isIdent := sel.X.(*ast.Ident); !isIdent || pkg.Name != "db" {
+			return true
+		}
+		require.Len(t, call.Args, 3)
+
+		var quoted string
+		switch arg := call.Args[1].(type) {
+		case *ast.BasicLit:
+			quoted = arg.Value
+		case *ast.Ident:
+			quoted = literals[arg.Name]
+		}
+		name, err := strconv.Unquote(quoted)
+		require.NoError(t, err, "database name of db.NewDB call at %v is not a constant", fset.Position(call.Pos()))
+		names = append(names, name)
+		return true
+	})
+	return names
+}
@@ -26,8 +26,9 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/storage/db"
 	"github.com/berachain/beacon-kit/storage/deposit"
-	dbm "github.com/cosmos/cosmos-db"
+	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
 )
@@ -35,8 +36,10 @@ import (
 // DepositStoreInput is the input for the dep inject framework.
 type DepositStoreInput struct {
 	depinject.In
-	Logger  *phuslu.Logger
-	AppOpts config.AppOptions
+	Logger      *phuslu.Logger
+	AppOpts     config.AppOptions
+	CometConfig *cmtcfg.Config
+	DBRegistry  *db.Registry
 }
 
 // ProvideDepositStore is a function that provides the module to the
@@ -48,10 +51,11 @@ func ProvideDepositStore(in DepositStoreInput) (deposit.StoreManager, error) {
 		nameV1  = "deposits"
 	)
 
-	dbV1, err := dbm.NewDB(nameV1, dbm.PebbleDBBackend, dataDir)
+	dbV1, err := db.NewDB(dataDir, nameV1, in.CometConfig.DBBackend)
 	if err != nil {
 		return nil, err
 	}
+	in.DBRegistry.Register(nameV1, dbV1)
 
 	return deposit.NewStore(
 		dbV1,
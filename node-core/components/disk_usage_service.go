@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/diskusage"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// DiskUsageServiceInput is the input for the disk usage service provider.
+type DiskUsageServiceInput struct {
+	depinject.In
+	AppOpts           config.AppOptions
+	AvailabilityStore *dastore.Store
+	ChainSpec         chain.Spec
+	Config            *config.Config
+	EventBus          *events.Bus
+	Logger            *phuslu.Logger
+	TelemetrySink     *metrics.TelemetrySink
+}
+
+// ProvideDiskUsageService provides the disk usage monitor.
+func ProvideDiskUsageService(in DiskUsageServiceInput) *diskusage.Service {
+	var (
+		rootDir = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		// Blobs are retained for at least the data availability period, even
+		// when over quota.
+		minRetentionSlots = in.ChainSpec.MinEpochsForBlobsSidecarsRequest().Unwrap() *
+			in.ChainSpec.SlotsPerEpoch()
+	)
+	return diskusage.NewService(
+		in.Config.DiskUsage,
+		filepath.Join(rootDir, "data"),
+		minRetentionSlots,
+		in.Logger.With("service", "disk-usage"),
+		in.EventBus,
+		in.AvailabilityStore,
+		in.TelemetrySink,
+	)
+}
@@ -48,27 +48,83 @@ type EngineClientInputs struct {
 func ProvideEngineClient(in EngineClientInputs) *client.EngineClient {
 	return client.New(
 		in.Config.GetEngine(),
-		in.Logger.With("service", "engine.client"),
+		in.Logger.Named("engine-client").With("service", "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		in.ChainSpec,
 	)
 }
 
-// EngineClientInputs is the input for the EngineClient.
-type ExecutionEngineInputs struct {
+// VerifierInputs is the input for the Verifier.
+type VerifierInputs struct {
 	depinject.In
-	EngineClient  *client.EngineClient
+	ChainSpec     chain.Spec
+	Config        *config.Config
+	JWTSecret     *jwt.Secret `optional:"true"`
 	Logger        *phuslu.Logger
 	TelemetrySink *metrics.TelemetrySink
 }
 
+// ProvideVerifier creates the Verifier cross-validating payloads against the
+// verification execution client, if one is configured.
+func ProvideVerifier(in VerifierInputs) (*engine.Verifier, error) {
+	cfg := *in.Config.GetEngine()
+	logger := in.Logger.Named("verification-engine-client").
+		With("service", "engine.verifier")
+	if cfg.VerificationRPCDialURL == nil || cfg.VerificationRPCDialURL.String() == "" {
+		return engine.NewVerifier(nil, false, logger, in.TelemetrySink), nil
+	}
+
+	jwtSecret := in.JWTSecret
+	switch {
+	case cfg.VerificationRPCDialURL.IsIPC():
+		jwtSecret = nil
+	case cfg.VerificationJWTSecretPath != "":
+		var err error
+		if jwtSecret, err = LoadJWTFromFile(cfg.VerificationJWTSecretPath); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg.RPCDialURL = cfg.VerificationRPCDialURL
+	ec := client.New(
+		&cfg,
+		logger,
+		jwtSecret,
+		in.TelemetrySink,
+		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		in.ChainSpec,
+	)
+	return engine.NewVerifier(
+		ec, cfg.HaltOnVerificationMismatch, logger, in.TelemetrySink,
+	), nil
+}
+
+// ProvideOptimisticTracker provides the tracker of the payloads imported
+// optimistically while the execution client is syncing.
+func ProvideOptimisticTracker() *engine.OptimisticTracker {
+	return engine.NewOptimisticTracker()
+}
+
+// ExecutionEngineInputs is the input for the ExecutionEngine.
+type ExecutionEngineInputs struct {
+	depinject.In
+	EngineClient      *client.EngineClient
+	Verifier          *engine.Verifier
+	OptimisticTracker *engine.OptimisticTracker
+	Logger            *phuslu.Logger
+	TelemetrySink     *metrics.TelemetrySink
+}
+
 // ProvideExecutionEngine provides the execution engine to the depinject
 // framework.
 func ProvideExecutionEngine(in ExecutionEngineInputs) *engine.Engine {
 	return engine.New(
 		in.EngineClient,
-		in.Logger.With("service", "execution-engine"),
+		in.Verifier,
+		in.OptimisticTracker,
+		in.Logger.Named("engine-client").With("service", "execution-engine"),
 		in.TelemetrySink,
 	)
 }
@@ -25,12 +25,15 @@ import (
 
 	"github.com/berachain/beacon-kit/chain"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
 	dastore "github.com/berachain/beacon-kit/da/store"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
 	nodecoretypes "github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -53,6 +56,28 @@ type (
 			prevRandao common.Bytes32,
 			prevHeadRoot common.Root,
 		) (*engineprimitives.PayloadAttributes, error)
+		// Validate cross-checks the payload attributes against the parent
+		// payload they build on.
+		Validate(
+			attrs *engineprimitives.PayloadAttributes,
+			slot math.Slot,
+			parentTimestamp math.U64,
+		) error
+		// SuggestedFeeRecipient returns the fee recipient suggested to the
+		// execution client for the payload builds.
+		SuggestedFeeRecipient() common.ExecutionAddress
+		// HeadForkchoiceUpdate returns a forkchoice update that only moves
+		// the head of the execution client, without triggering a payload build.
+		HeadForkchoiceUpdate(
+			state *engineprimitives.ForkchoiceStateV1,
+			headTimestamp math.U64,
+		) *ctypes.ForkchoiceUpdateRequest
+		// BuildForkchoiceUpdate returns a forkchoice update that also starts
+		// building a payload with the given attributes.
+		BuildForkchoiceUpdate(
+			state *engineprimitives.ForkchoiceStateV1,
+			attrs *engineprimitives.PayloadAttributes,
+		) (*ctypes.ForkchoiceUpdateRequest, error)
 	}
 
 	// BlobProcessor is the interface for the blobs processor.
@@ -88,6 +113,12 @@ type (
 			slot math.Slot,
 			parentBlockRoot common.Root,
 		) (ctypes.BuiltExecutionPayloadEnv, error)
+		// RetrieveBuiltPayload returns the payload last built for the given
+		// slot and parent block root, if any.
+		RetrieveBuiltPayload(
+			slot math.Slot,
+			parentBlockRoot common.Root,
+		) (ctypes.BuiltExecutionPayloadEnv, bool)
 		// RequestPayloadSync requests a payload for the given slot and
 		// blocks until the payload is delivered.
 		RequestPayloadSync(
@@ -139,6 +170,12 @@ type (
 			func(blk *ctypes.BeaconBlock, signature crypto.BLSSignature) error,
 			error,
 		)
+		// ValidateVoluntaryExit checks that the signed exit can be applied to
+		// the state.
+		ValidateVoluntaryExit(st *statedb.StateDB, signed *ctypes.SignedVoluntaryExit) error
+		// ValidateBLSToExecutionChange checks that the signed change can be
+		// applied to the state.
+		ValidateBLSToExecutionChange(st *statedb.StateDB, signed *ctypes.SignedBLSToExecutionChange) error
 	}
 
 	SidecarFactory interface {
@@ -498,10 +535,19 @@ type (
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		ExecutionOptimistic(slot math.Slot) (bool, error)
 
 		NodeAPIBeaconBackend
+		NodeAPIBlocksBackend
+		NodeAPIBuilderBackend
 		NodeAPIProofBackend
 		NodeAPIConfigBackend
+		NodeAPIDepositsBackend
+		NodeAPIFeesBackend
+		NodeAPIHealthBackend
+		NodeAPINodeBackend
+		NodeAPIStatsBackend
+		NodeAPIValidatorBackend
 	}
 
 	// NodeAPIBeaconBackend is the interface for backend of the beacon API.
@@ -519,15 +565,71 @@ type (
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	}
 
+	// NodeAPIBlocksBackend is the interface for backend of the blocks API.
+	NodeAPIBlocksBackend interface {
+		GetSlotByExecutionHash(blockHash common.ExecutionHash) (math.Slot, error)
+		GetSlotByExecutionNumber(blockNumber math.U64) (math.Slot, error)
+		PayloadSummaryAtSlot(slot math.Slot) (block.PayloadSummary, error)
+		BlockRootAtSlot(slot math.Slot) (common.Root, error)
+	}
+
+	// NodeAPIBuilderBackend is the interface for backend of the builder API.
+	NodeAPIBuilderBackend interface {
+		ExpectedWithdrawalsAtSlot(slot math.Slot) (engineprimitives.Withdrawals, math.Slot, error)
+	}
+
 	// NodeAPIConfigBackend is the interface for backend of the config API.
 	NodeAPIConfigBackend interface {
 		Spec() (chain.Spec, error)
+		ForkVersionAtSlot(slot math.Slot) (common.Version, error)
+	}
+
+	// NodeAPIDepositsBackend is the interface for backend of the deposits API.
+	NodeAPIDepositsBackend interface {
+		ProcessedDepositCount() (uint64, error)
+		DepositsByIndex(ctx context.Context, startIndex, count uint64) (ctypes.Deposits, error)
+		DepositLocation(ctx context.Context, index uint64) (*ctypes.DepositLocation, error)
+		HeadExecutionNumber() (math.U64, error)
+		SimulateDeposit(ctx context.Context, dep *ctypes.Deposit) (*core.DepositSimulation, error)
+	}
+
+	// NodeAPIFeesBackend is the interface for backend of the fees API.
+	NodeAPIFeesBackend interface {
+		PayloadSummaries(count uint64) []block.PayloadSummary
+		PayloadSummaryAtSlot(slot math.Slot) (block.PayloadSummary, error)
+		FeeHistory(
+			ctx context.Context, blockCount uint64, rewardPercentiles []float64,
+		) (*ethclient.FeeHistory, error)
+	}
+
+	// NodeAPIStatsBackend is the interface for backend of the stats API.
+	NodeAPIStatsBackend interface {
+		PayloadSummaries(count uint64) []block.PayloadSummary
+	}
+
+	// NodeAPIHealthBackend is the interface for backend of the health API.
+	NodeAPIHealthBackend interface {
+		SyncStatus() (int64, bool, error)
+		HeadExecutionTimestamp() (math.Slot, math.U64, error)
+	}
+
+	// NodeAPINodeBackend is the interface for backend of the node API.
+	NodeAPINodeBackend interface {
+		Peers() []*consensustypes.PeerInfo
+	}
+
+	// NodeAPIValidatorBackend is the interface for backend of the validator API.
+	NodeAPIValidatorBackend interface {
+		ProposerDuties(epoch math.Epoch) (common.Root, []*validatortypes.ProposerDutyData, error)
+		CometMapping() ([]*validatortypes.CometMappingData, error)
+		CometMappingByID(id string) (*validatortypes.CometMappingData, error)
 	}
 
 	// NodeAPIProofBackend is the interface for backend of the proof API.
 	NodeAPIProofBackend interface {
 		BlockBackend
 		StateBackend
+		DepositsByIndex(ctx context.Context, startIndex, count uint64) (ctypes.Deposits, error)
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 	}
 
@@ -547,7 +649,7 @@ type (
 
 	BlockBackend interface {
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
-		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
+		BlockRewardsAtSlot(ctx context.Context, slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
 	}
 
@@ -560,6 +662,8 @@ type (
 	}
 
 	ValidatorBackend interface {
+		ActivationQueueAtState(st *statedb.StateDB) ([]*types.QueuedValidatorData, error)
+		JailedValidatorsAtState(st *statedb.StateDB) ([]*types.JailedValidatorData, error)
 		ValidatorByID(
 			slot math.Slot, id string,
 		) (*types.ValidatorData, error)
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/cli/flags"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
@@ -39,7 +40,13 @@ type JWTSecretInput struct {
 }
 
 // ProvideJWTSecret is a function that provides the module to the application.
+// No secret is needed, nor loaded, when the execution client is reached over
+// IPC.
 func ProvideJWTSecret(in JWTSecretInput) (*jwt.Secret, error) {
+	dialURL, err := url.NewFromRaw(cast.ToString(in.AppOpts.Get(flags.RPCDialURL)))
+	if err == nil && dialURL.IsIPC() {
+		return nil, nil //nolint:nilnil // the secret is optional.
+	}
 	return LoadJWTFromFile(cast.ToString(in.AppOpts.Get(flags.JWTSecretPath)))
 }
 
@@ -65,6 +65,20 @@ func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
 	)
 }
 
+// AddSample adds a sample to a histogram metric identified by the provided
+// key.
+func (TelemetrySink) AddSample(key string, value float64, args ...string) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(
+		[]string{key},
+		float32(value),
+		argsToLabels(args...),
+	)
+}
+
 // argsToLabels converts a list of key-value pairs to a list of metrics labels.
 //
 //nolint:mnd // its okay.
@@ -95,3 +109,6 @@ func (NoOpTelemetrySink) SetGauge(string, int64, ...string) {}
 
 // MeasureSince is a no-op implementation of the TelemetrySink interface.
 func (NoOpTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+// AddSample is a no-op implementation of the TelemetrySink interface.
+func (NoOpTelemetrySink) AddSample(string, float64, ...string) {}
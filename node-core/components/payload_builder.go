@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/observability/slottiming"
 	payloadbuilder "github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/payload/cache"
 )
@@ -38,6 +39,7 @@ type LocalBuilderInput struct {
 	ChainSpec         chain.Spec
 	ExecutionEngine   *engine.Engine
 	Logger            *phuslu.Logger
+	SlotTimings       *slottiming.Recorder
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -50,5 +52,6 @@ func ProvideLocalBuilder(in LocalBuilderInput) *payloadbuilder.PayloadBuilder {
 		in.ExecutionEngine,
 		cache.NewPayloadIDCache(),
 		in.AttributesFactory,
+		in.SlotTimings,
 	)
 }
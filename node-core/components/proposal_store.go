@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/proposals"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// ProposalStoreInput is the input for the proposal store provider.
+type ProposalStoreInput struct {
+	depinject.In
+	AppOpts     config.AppOptions
+	CometConfig *cmtcfg.Config
+	DBRegistry  *db.Registry
+}
+
+// ProvideProposalStore provides the audit log of the fee recipients of the
+// blocks proposed by this node.
+func ProvideProposalStore(in ProposalStoreInput) (*proposals.Store, error) {
+	const name = "proposals"
+	dataDir := filepath.Join(cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data")
+	proposalsDB, err := db.NewDB(dataDir, name, in.CometConfig.DBBackend)
+	if err != nil {
+		return nil, err
+	}
+	in.DBRegistry.Register(name, proposalsDB)
+	return proposals.NewStore(proposalsDB), nil
+}
@@ -25,29 +25,43 @@ import (
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/admin"
+	"github.com/berachain/beacon-kit/node-core/services/depositreconciler"
+	"github.com/berachain/beacon-kit/node-core/services/diskusage"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/node-core/services/reload"
 	"github.com/berachain/beacon-kit/node-core/services/shutdown"
 	"github.com/berachain/beacon-kit/node-core/services/version"
+	"github.com/berachain/beacon-kit/node-core/services/watchdog"
 	"github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/observability/tracing"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
 type ServiceRegistryInput struct {
 	depinject.In
-	ChainService     *blockchain.Service
-	EngineClient     *client.EngineClient
-	Logger           *phuslu.Logger
-	NodeAPIServer    *server.Server
-	ReportingService *version.ReportingService
-	TelemetrySink    *metrics.TelemetrySink
-	TelemetryService *telemetry.Service
-	ValidatorService *validator.Service
-	CometBFTService  types.ConsensusService
-	ShutdownService  *shutdown.Service
+	AdminService      *admin.Service
+	ChainService      *blockchain.Service
+	DepositReconciler *depositreconciler.Service
+	DiskUsageService  *diskusage.Service
+	EngineClient      *client.EngineClient
+	Logger            *phuslu.Logger
+	NodeAPIServer     *server.Server
+	ReloadService     *reload.Service
+	ReportingService  *version.ReportingService
+	TelemetrySink     *metrics.TelemetrySink
+	TelemetryService  *telemetry.Service
+	TracingService    *tracing.Service
+	ValidatorService  *validator.Service
+	WatchdogService   *watchdog.Service
+	CometBFTService   types.ConsensusService
+	ShutdownService   *shutdown.Service
+	Verifier          *engine.Verifier
 }
 
 // ProvideServiceRegistry is the depinject provider for the service registry.
@@ -63,6 +77,16 @@ func ProvideServiceRegistry(in ServiceRegistryInput) *service.Registry {
 		service.WithService(in.NodeAPIServer),
 		service.WithService(in.ReportingService),
 		service.WithService(in.TelemetryService),
+		service.WithService(in.TracingService),
+		service.WithService(in.AdminService),
+		service.WithService(in.ReloadService),
+		service.WithService(in.WatchdogService),
+		service.WithService(in.DiskUsageService),
+		service.WithService(in.DepositReconciler),
+
+		// the verification client connects in the background and never
+		// holds up the node
+		service.WithService(in.Verifier),
 
 		// engineClient will block until it connects to the execution layer
 		service.WithService(in.EngineClient),
@@ -21,14 +21,19 @@
 package components
 
 import (
+	"path/filepath"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // StateProcessorInput is the input for the state processor for the depinject
@@ -36,6 +41,7 @@ import (
 type StateProcessorInput struct {
 	depinject.In
 	Logger          *phuslu.Logger
+	AppOpts         config.AppOptions
 	ChainSpec       chain.Spec
 	ExecutionEngine *engine.Engine
 	DepositStore    deposit.StoreManager
@@ -54,5 +60,6 @@ func ProvideStateProcessor(in StateProcessorInput) *core.StateProcessor {
 		in.Signer,
 		crypto.GetAddressFromPubKey,
 		in.TelemetrySink,
+		filepath.Join(cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data", "debug"),
 	)
 }
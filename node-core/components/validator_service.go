@@ -26,9 +26,12 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-api/backend"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/observability/slottiming"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/proposals"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
@@ -43,6 +46,9 @@ type ValidatorServiceInput struct {
 	Signer         crypto.BLSSigner
 	SidecarFactory SidecarFactory
 	TelemetrySink  *metrics.TelemetrySink
+	SlotTimings    *slottiming.Recorder
+	ProposalStore  *proposals.Store
+	OperationPool  *backend.Backend
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -58,5 +64,8 @@ func ProvideValidatorService(in ValidatorServiceInput) (*validator.Service, erro
 		in.SidecarFactory,
 		in.LocalBuilder,
 		in.TelemetrySink,
+		in.SlotTimings,
+		in.ProposalStore,
+		in.OperationPool,
 	), nil
 }
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/consensus/voteext"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// VoteExtensionsInput is the input for the vote extensions provider.
+type VoteExtensionsInput struct {
+	depinject.In
+	Logger *phuslu.Logger
+}
+
+// ProvideVoteExtensions provides the manager of the vote extension providers.
+// It has no providers, so that nodes do not extend their votes. Applications
+// attaching data to votes supply their own manager in its place, and enable
+// vote extensions through the vote_extensions_enable_height consensus
+// parameter.
+func ProvideVoteExtensions(in VoteExtensionsInput) (*voteext.Manager, error) {
+	return voteext.NewManager(in.Logger.With("service", "vote-extensions"))
+}
+
+// validatorStore looks up validators in the beacon state.
+type validatorStore struct {
+	sb *storage.Backend
+}
+
+// PubKeyByCometBFTAddress returns the public key of the validator with the
+// given CometBFT address in the beacon state carried by ctx.
+func (v validatorStore) PubKeyByCometBFTAddress(
+	ctx context.Context, address []byte,
+) (crypto.BLSPubkey, error) {
+	st := v.sb.StateFromContext(ctx)
+	idx, err := st.ValidatorIndexByCometBFTAddress(address)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	return val.GetPubkey(), nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/services/watchdog"
+)
+
+// WatchdogServiceInput is the input for the watchdog service provider.
+type WatchdogServiceInput struct {
+	depinject.In
+	Config        *config.Config
+	EngineClient  *client.EngineClient
+	EventBus      *events.Bus
+	Logger        *phuslu.Logger
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideWatchdogService provides the finality stall watchdog.
+func ProvideWatchdogService(in WatchdogServiceInput) *watchdog.Service {
+	return watchdog.NewService(
+		in.Config.Watchdog,
+		in.Logger.With("service", "watchdog"),
+		in.EventBus,
+		in.EngineClient,
+		in.TelemetrySink,
+	)
+}
@@ -138,3 +138,14 @@ func (n *node) StorageBackend() blockchain.StorageBackend {
 	}
 	return blockchainService.StorageBackend()
 }
+
+// StateProcessor returns the state processor from the blockchain service.
+func (n *node) StateProcessor() blockchain.StateProcessor {
+	var blockchainService *blockchain.Service
+	err := n.registry.FetchService(&blockchainService)
+	if err != nil || blockchainService == nil { // appease nilaway
+		err = fmt.Errorf("failed to fetch blockchain service: %w", err)
+		panic(err)
+	}
+	return blockchainService.StateProcessor()
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+)
+
+type backupRequest struct {
+	Dir string `json:"dir"`
+}
+
+type backupResponse struct {
+	Path      string   `json:"path"`
+	Height    int64    `json:"height"`
+	Databases []string `json:"databases"`
+}
+
+// backup writes a point-in-time copy of the data directory to the requested
+// directory. Block processing is paused after the last committed block while
+// the databases are checkpointed and the blob files hard-linked, so every
+// store is captured at the same height. The node keeps serving queries
+// meanwhile, but falls behind consensus until the copy completes.
+//
+// Only PebbleDB databases can be checkpointed. Nodes running another backend
+// are answered 501 Not Implemented, see storagedb.ErrCheckpointUnsupported.
+func (s *Service) backup(w http.ResponseWriter, r *http.Request) {
+	var req backupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{err.Error()})
+		return
+	}
+	if !filepath.IsAbs(req.Dir) {
+		writeJSON(w, http.StatusBadRequest, errorResponse{errRelativeBackupDir.Error()})
+		return
+	}
+	if _, err := os.Stat(req.Dir); !errors.Is(err, os.ErrNotExist) {
+		writeJSON(w, http.StatusConflict, errorResponse{errBackupDirExists.Error()})
+		return
+	}
+
+	resume, err := s.blocks.PauseBlocks(r.Context())
+	if err != nil {
+		writeJSON(w, http.StatusServiceUnavailable, errorResponse{err.Error()})
+		return
+	}
+	defer resume()
+
+	res := backupResponse{Path: req.Dir, Height: s.blocks.LastBlockHeight()}
+	dataDir := filepath.Join(req.Dir, "data")
+	if res.Databases, err = s.dbs.Checkpoint(dataDir); err == nil {
+		err = s.linkBlobs(filepath.Join(dataDir, "blobs"))
+	}
+	switch {
+	case errors.Is(err, storagedb.ErrCheckpointUnsupported):
+		writeJSON(w, http.StatusNotImplemented, errorResponse{err.Error()})
+		return
+	case err != nil:
+		writeJSON(w, http.StatusInternalServerError, errorResponse{err.Error()})
+		return
+	}
+
+	s.logger.Info("Wrote backup", "path", req.Dir, "height", res.Height)
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Service) linkBlobs(dst string) error {
+	if _, err := os.Stat(s.blobsDir); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return storagedb.LinkFiles(s.blobsDir, dst)
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+const (
+	defaultAddress = "127.0.0.1:6060"
+)
+
+// Config is the configuration for the admin server.
+type Config struct {
+	// Enabled is the flag to enable the admin server.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the loopback address to bind the admin server to.
+	Address string `mapstructure:"address"`
+	// AuthToken is the bearer token required to change the suggested fee
+	// recipient. Empty disables fee recipient changes.
+	AuthToken string `mapstructure:"auth-token" redact:"true"`
+}
+
+// DefaultConfig returns the default configuration for the admin server.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		Address:   defaultAddress,
+		AuthToken: "",
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrNonLoopbackAddress is returned when the admin server is configured
+	// to listen on an address reachable from other hosts.
+	ErrNonLoopbackAddress = errors.New("admin server must listen on a loopback address")
+
+	// errNonLoopbackClient is returned to clients not connecting from a
+	// loopback address.
+	errNonLoopbackClient = errors.New("admin server only accepts loopback clients")
+
+	// errNonLoopbackHost is returned to requests whose Host header does not
+	// name a loopback host, as sent by browsers after DNS rebinding.
+	errNonLoopbackHost = errors.New("admin server only accepts loopback hosts")
+
+	// errUnauthorized is returned to requests without the admin bearer token.
+	errUnauthorized = errors.New("unauthorized")
+
+	// errFeeRecipientChangesDisabled is returned when the fee recipient is
+	// changed while no admin auth token is configured.
+	errFeeRecipientChangesDisabled = errors.New(
+		"fee recipient changes require the admin auth-token to be set",
+	)
+
+	// errRelativeBackupDir is returned when the backup target is not an
+	// absolute path.
+	errRelativeBackupDir = errors.New("backup directory must be an absolute path")
+
+	// errZeroFeeRecipient is returned when the suggested fee recipient is
+	// set to the zero address, whose fees would be burnt.
+	errZeroFeeRecipient = errors.New("fee recipient must not be the zero address")
+
+	// errBackupDirExists is returned when the backup target already exists.
+	errBackupDirExists = errors.New("backup directory already exists")
+)
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// goroutineDumpDebug selects the goroutine dump format with full stacks.
+const goroutineDumpDebug = 2
+
+// dumpResponse is the response to a dump request.
+type dumpResponse struct {
+	Path string `json:"path"`
+}
+
+// logLevel is the body of log level requests. The level of the whole logger
+// is changed unless a module is given.
+type logLevel struct {
+	Module string `json:"module,omitempty"`
+	Level  string `json:"level"`
+}
+
+// logLevels is the body of log level responses.
+type logLevels struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules,omitempty"`
+}
+
+// engineCapture is the body of engine capture requests and responses.
+type engineCapture struct {
+	Enabled bool   `json:"enabled"`
+	Path    string `json:"path,omitempty"`
+}
+
+// feeRecipient is the body of fee recipient requests and responses.
+type feeRecipient struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+}
+
+// errorResponse is the body of failed requests.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler returns the handler serving the admin endpoints.
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	mux.HandleFunc("POST /admin/dump/goroutines", s.dumpGoroutines)
+	mux.HandleFunc("POST /admin/dump/heap", s.dumpHeap)
+	mux.HandleFunc("POST /admin/backup", s.backup)
+	mux.HandleFunc("GET /admin/log-level", s.getLogLevel)
+	mux.HandleFunc("PUT /admin/log-level", s.setLogLevel)
+	mux.HandleFunc("GET /admin/engine-capture", s.getEngineCapture)
+	mux.HandleFunc("PUT /admin/engine-capture", s.setEngineCapture)
+	mux.HandleFunc("GET /admin/fee-recipient", s.getFeeRecipient)
+	mux.HandleFunc("PUT /admin/fee-recipient", s.setFeeRecipient)
+
+	return loopbackOnly(mux)
+}
+
+// loopbackOnly rejects requests that do not originate from a loopback
+// address, or whose Host is not a loopback host. The latter stops pages
+// loaded in a local browser from reaching the server through DNS rebinding.
+func loopbackOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if ip := net.ParseIP(host); err != nil || ip == nil || !ip.IsLoopback() {
+			writeJSON(w, http.StatusForbidden, errorResponse{errNonLoopbackClient.Error()})
+			return
+		}
+		if !isLoopbackHost(r.Host) {
+			writeJSON(w, http.StatusForbidden, errorResponse{errNonLoopbackHost.Error()})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isLoopbackHost reports whether the Host header names a loopback host.
+func isLoopbackHost(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// authorized reports whether the request carries the admin bearer token.
+func (s *Service) authorized(r *http.Request) bool {
+	expected := []byte("Bearer " + s.cfg.AuthToken)
+	got := []byte(r.Header.Get("Authorization"))
+	return subtle.ConstantTimeCompare(got, expected) == 1
+}
+
+// dumpGoroutines writes the stacks of all goroutines to the dump directory.
+func (s *Service) dumpGoroutines(w http.ResponseWriter, _ *http.Request) {
+	s.dump(w, "goroutines", "txt", func(f *os.File) error {
+		return runtimepprof.Lookup("goroutine").WriteTo(f, goroutineDumpDebug)
+	})
+}
+
+// dumpHeap writes a heap profile to the dump directory.
+func (s *Service) dumpHeap(w http.ResponseWriter, _ *http.Request) {
+	s.dump(w, "heap", "pprof", func(f *os.File) error {
+		runtime.GC()
+		return runtimepprof.WriteHeapProfile(f)
+	})
+}
+
+// dump creates a new file in the dump directory, fills it with write and
+// responds with its path.
+func (s *Service) dump(
+	w http.ResponseWriter, name, ext string, write func(*os.File) error,
+) {
+	f, err := s.createDumpFile(name, ext)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, errorResponse{err.Error()})
+		return
+	}
+	defer f.Close()
+
+	if err = write(f); err != nil {
+		writeJSON(w, http.StatusInternalServerError, errorResponse{err.Error()})
+		return
+	}
+	s.logger.Info("Wrote diagnostics dump", "path", f.Name())
+	writeJSON(w, http.StatusOK, dumpResponse{Path: f.Name()})
+}
+
+// createDumpFile creates a uniquely named file in the dump directory.
+func (s *Service) createDumpFile(name, ext string) (*os.File, error) {
+	//nolint:mnd // standard directory permissions.
+	if err := os.MkdirAll(s.dumpDir, 0o755); err != nil {
+		return nil, err
+	}
+	return os.Create(filepath.Join(
+		s.dumpDir, fmt.Sprintf("%s-%d.%s", name, time.Now().UnixNano(), ext),
+	))
+}
+
+func (s *Service) getLogLevel(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.logLevels())
+}
+
+func (s *Service) setLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req logLevel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{err.Error()})
+		return
+	}
+	var err error
+	if req.Module != "" {
+		err = s.levels.SetModuleLevel(req.Module, req.Level)
+	} else {
+		err = s.levels.SetLevel(req.Level)
+	}
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{err.Error()})
+		return
+	}
+	s.logger.Info(
+		"Changed log level", "module", req.Module, "level", req.Level,
+	)
+	writeJSON(w, http.StatusOK, s.logLevels())
+}
+
+// logLevels returns the current level of the logger and of its modules.
+func (s *Service) logLevels() logLevels {
+	return logLevels{
+		Level:   s.levels.Level(),
+		Modules: s.levels.ModuleLevels(),
+	}
+}
+
+func (s *Service) getEngineCapture(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.captureStatus())
+}
+
+func (s *Service) setEngineCapture(w http.ResponseWriter, r *http.Request) {
+	var req engineCapture
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{err.Error()})
+		return
+	}
+
+	if !req.Enabled {
+		s.stopCapture()
+		writeJSON(w, http.StatusOK, s.captureStatus())
+		return
+	}
+	if err := s.startCapture(); err != nil {
+		writeJSON(w, http.StatusInternalServerError, errorResponse{err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, s.captureStatus())
+}
+
+// startCapture starts recording engine calls to a new file, unless capture
+// mode is already on.
+func (s *Service) startCapture() error {
+	s.captureMu.Lock()
+	defer s.captureMu.Unlock()
+	if s.captureFile != nil {
+		return nil
+	}
+
+	f, err := s.createDumpFile("engine-capture", "jsonl")
+	if err != nil {
+		return err
+	}
+	s.captureFile = f
+	s.engine.SetCapture(f)
+	s.logger.Info("Started engine capture", "path", f.Name())
+	return nil
+}
+
+// stopCapture stops recording engine calls and closes the capture file.
+func (s *Service) stopCapture() {
+	s.captureMu.Lock()
+	defer s.captureMu.Unlock()
+	if s.captureFile == nil {
+		return
+	}
+
+	s.engine.SetCapture(nil)
+	if err := s.captureFile.Close(); err != nil {
+		s.logger.Error("Failed to close engine capture", "error", err)
+	}
+	s.logger.Info("Stopped engine capture", "path", s.captureFile.Name())
+	s.captureFile = nil
+}
+
+// captureStatus returns whether capture mode is on, and where calls are
+// recorded.
+func (s *Service) captureStatus() engineCapture {
+	s.captureMu.Lock()
+	defer s.captureMu.Unlock()
+	if s.captureFile == nil {
+		return engineCapture{Enabled: false}
+	}
+	return engineCapture{Enabled: true, Path: s.captureFile.Name()}
+}
+
+func (s *Service) getFeeRecipient(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, feeRecipient{s.fees.SuggestedFeeRecipient()})
+}
+
+// setFeeRecipient changes the suggested fee recipient without a restart, so
+// that rotating it does not cost a missed slot. As it redirects the fees of
+// the blocks proposed, it requires the admin bearer token.
+func (s *Service) setFeeRecipient(w http.ResponseWriter, r *http.Request) {
+	if s.cfg.AuthToken == "" {
+		writeJSON(w, http.StatusForbidden, errorResponse{errFeeRecipientChangesDisabled.Error()})
+		return
+	}
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeJSON(w, http.StatusUnauthorized, errorResponse{errUnauthorized.Error()})
+		return
+	}
+
+	var req feeRecipient
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{err.Error()})
+		return
+	}
+	if req.FeeRecipient == (common.ExecutionAddress{}) {
+		writeJSON(w, http.StatusBadRequest, errorResponse{errZeroFeeRecipient.Error()})
+		return
+	}
+	previous := s.fees.SuggestedFeeRecipient()
+	if err := s.fees.SetSuggestedFeeRecipient(req.FeeRecipient); err != nil {
+		writeJSON(w, http.StatusInternalServerError, errorResponse{err.Error()})
+		return
+	}
+	s.logger.Info(
+		"Changed suggested fee recipient",
+		"previous", previous, "fee_recipient", req.FeeRecipient,
+	)
+	writeJSON(w, http.StatusOK, feeRecipient{s.fees.SuggestedFeeRecipient()})
+}
+
+// writeJSON writes v as the JSON body of a response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	//nolint:errchkjson // the response types always encode.
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"context"
+	"io"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// LogLevelController changes the level of the node logger at runtime.
+type LogLevelController interface {
+	// SetLevel sets the level of the logger.
+	SetLevel(level string) error
+	// Level returns the current level of the logger.
+	Level() string
+	// SetModuleLevel sets the level of a named module. An empty level makes
+	// the module follow the level of the logger again.
+	SetModuleLevel(module, level string) error
+	// ModuleLevels returns the level of every named module.
+	ModuleLevels() map[string]string
+}
+
+// EngineCapturer records the calls made to the execution client.
+type EngineCapturer interface {
+	// SetCapture records every subsequent call to w. A nil w stops
+	// capturing.
+	SetCapture(w io.Writer)
+}
+
+// FeeRecipientController changes the fee recipient suggested for the payloads
+// built by the node at runtime.
+type FeeRecipientController interface {
+	// SuggestedFeeRecipient returns the current suggested fee recipient.
+	SuggestedFeeRecipient() common.ExecutionAddress
+	// SetSuggestedFeeRecipient persists the suggested fee recipient to the
+	// config file and applies it to the subsequent payload builds.
+	SetSuggestedFeeRecipient(addr common.ExecutionAddress) error
+}
+
+// BlockPauser pauses block processing for the duration of a backup.
+type BlockPauser interface {
+	// PauseBlocks waits for the in-flight block to be committed and holds off
+	// further blocks until resume is called.
+	PauseBlocks(ctx context.Context) (resume func(), err error)
+	// LastBlockHeight returns the last committed block height.
+	LastBlockHeight() int64
+}
+
+// Checkpointer checkpoints the open databases of the node. It fails with
+// storagedb.ErrCheckpointUnsupported unless they all run on PebbleDB.
+type Checkpointer interface {
+	// Checkpoint writes a copy of every open database to dir and returns
+	// their names.
+	Checkpoint(dir string) ([]string, error)
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+)
+
+const (
+	// readHeaderTimeout bounds the time to read request headers.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout bounds the time to drain requests on stop.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Service is an admin server exposing pprof, on-demand goroutine and heap
+// dumps, online backups, runtime toggles and the suggested fee recipient. It
+// only listens on, and only serves clients from, loopback addresses.
+type Service struct {
+	cfg     Config
+	dumpDir string
+	logger  log.Logger
+	levels  LogLevelController
+	engine  EngineCapturer
+	fees    FeeRecipientController
+	server  *http.Server
+
+	// blocks, dbs and blobsDir are what backups pause and copy.
+	blocks   BlockPauser
+	dbs      Checkpointer
+	blobsDir string
+
+	// captureMu protects captureFile.
+	captureMu sync.Mutex
+	// captureFile is the file engine calls are recorded to, nil if capture
+	// mode is off.
+	captureFile *os.File
+}
+
+// NewService creates a new admin service. Dumps and engine captures are
+// written to dumpDir. Backups checkpoint dbs and link the blob files under
+// blobsDir while blocks are paused.
+func NewService(
+	cfg Config,
+	dumpDir string,
+	logger log.Logger,
+	levels LogLevelController,
+	engine EngineCapturer,
+	fees FeeRecipientController,
+	blocks BlockPauser,
+	dbs Checkpointer,
+	blobsDir string,
+) *Service {
+	s := &Service{
+		cfg:      cfg,
+		dumpDir:  dumpDir,
+		logger:   logger,
+		levels:   levels,
+		engine:   engine,
+		fees:     fees,
+		blocks:   blocks,
+		dbs:      dbs,
+		blobsDir: blobsDir,
+	}
+	s.server = &http.Server{
+		Addr:              cfg.Address,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	return s
+}
+
+// Name returns the name of the admin service.
+func (s *Service) Name() string {
+	return "admin"
+}
+
+// Start starts serving the admin endpoints if the server is enabled.
+func (s *Service) Start(context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+	if err := validateLoopback(s.cfg.Address); err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if sErr := s.server.Serve(ln); !errors.Is(sErr, http.ErrServerClosed) {
+			s.logger.Error("Admin server stopped", "error", sErr)
+		}
+	}()
+
+	s.logger.Info("Admin server listening", "address", ln.Addr().String())
+	return nil
+}
+
+// Stop shuts the admin server down and stops any engine capture.
+func (s *Service) Stop() error {
+	s.stopCapture()
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// validateLoopback returns an error if address is not a loopback address.
+func validateLoopback(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return ErrNonLoopbackAddress
+	}
+	return nil
+}
@@ -0,0 +1,419 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/admin"
+	"github.com/berachain/beacon-kit/primitives/common"
+	storagedb "github.com/berachain/beacon-kit/storage/db"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errInvalidLevel = errors.New("invalid level")
+	errPersist      = errors.New("persist failed")
+)
+
+const testToken = "s3cret"
+
+type stubLevels struct {
+	mu      sync.Mutex
+	level   string
+	modules map[string]string
+}
+
+func (s *stubLevels) SetLevel(level string) error {
+	if level != "debug" && level != "info" {
+		return errInvalidLevel
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.level = level
+	return nil
+}
+
+func (s *stubLevels) Level() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.level
+}
+
+func (s *stubLevels) SetModuleLevel(module, level string) error {
+	if level != "debug" && level != "info" && level != "" {
+		return errInvalidLevel
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.modules == nil {
+		s.modules = make(map[string]string)
+	}
+	s.modules[module] = level
+	return nil
+}
+
+func (s *stubLevels) ModuleLevels() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]string, len(s.modules))
+	for module, level := range s.modules {
+		out[module] = level
+	}
+	return out
+}
+
+type stubCapturer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *stubCapturer) SetCapture(w io.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.w = w
+}
+
+func (s *stubCapturer) writer() io.Writer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w
+}
+
+// stubFees fails to persist fee recipients ending with 0xff.
+type stubFees struct {
+	mu   sync.Mutex
+	addr common.ExecutionAddress
+}
+
+func (s *stubFees) SuggestedFeeRecipient() common.ExecutionAddress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addr
+}
+
+func (s *stubFees) SetSuggestedFeeRecipient(addr common.ExecutionAddress) error {
+	if addr[len(addr)-1] == 0xff {
+		return errPersist
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addr = addr
+	return nil
+}
+
+type stubBlocks struct {
+	mu     sync.Mutex
+	paused bool
+}
+
+func (s *stubBlocks) PauseBlocks(context.Context) (func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused = true
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.paused = false
+	}, nil
+}
+
+func (s *stubBlocks) LastBlockHeight() int64 {
+	return 42
+}
+
+func (s *stubBlocks) isPaused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.paused
+}
+
+// stubDBs records whether blocks were paused while checkpointing, and fails
+// checkpoints with err if set.
+type stubDBs struct {
+	blocks       *stubBlocks
+	pausedDuring atomic.Bool
+	err          error
+}
+
+func (s *stubDBs) Checkpoint(dir string) ([]string, error) {
+	s.pausedDuring.Store(s.blocks.isPaused())
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []string{"application"}, os.MkdirAll(filepath.Join(dir, "application.db"), 0o755)
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, *stubLevels, *stubCapturer, *stubFees) {
+	t.Helper()
+	levels := &stubLevels{level: "info"}
+	capturer := &stubCapturer{}
+	fees := &stubFees{}
+	blocks := &stubBlocks{}
+	cfg := admin.DefaultConfig()
+	cfg.AuthToken = testToken
+	svc := admin.NewService(
+		cfg, t.TempDir(), noop.NewLogger[any](), levels, capturer,
+		fees, blocks, &stubDBs{blocks: blocks}, t.TempDir(),
+	)
+	srv := httptest.NewServer(svc.Handler())
+	t.Cleanup(func() {
+		srv.Close()
+		require.NoError(t, svc.Stop())
+	})
+	return srv, levels, capturer, fees
+}
+
+// do sends the request with the admin bearer token of the test server.
+func do(t *testing.T, method, url string, body any, out any) int {
+	t.Helper()
+	return doWith(t, method, url, func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+testToken)
+	}, body, out)
+}
+
+func doWith(
+	t *testing.T, method, url string, edit func(*http.Request), body any, out any,
+) int {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, url, &buf)
+	require.NoError(t, err)
+	edit(req)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	if out != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+	}
+	return resp.StatusCode
+}
+
+func TestService_LogLevel(t *testing.T) {
+	t.Parallel()
+	srv, levels, _, _ := newTestServer(t)
+
+	var got struct {
+		Level   string            `json:"level"`
+		Modules map[string]string `json:"modules"`
+	}
+	require.Equal(t, http.StatusOK, do(t, http.MethodGet, srv.URL+"/admin/log-level", nil, &got))
+	require.Equal(t, "info", got.Level)
+
+	body := map[string]string{"level": "debug"}
+	require.Equal(t, http.StatusOK, do(t, http.MethodPut, srv.URL+"/admin/log-level", body, &got))
+	require.Equal(t, "debug", got.Level)
+	require.Equal(t, "debug", levels.Level())
+
+	body = map[string]string{"level": "loud"}
+	require.Equal(t, http.StatusBadRequest, do(t, http.MethodPut, srv.URL+"/admin/log-level", body, nil))
+	require.Equal(t, "debug", levels.Level())
+
+	body = map[string]string{"module": "da", "level": "info"}
+	require.Equal(t, http.StatusOK, do(t, http.MethodPut, srv.URL+"/admin/log-level", body, &got))
+	require.Equal(t, "debug", got.Level)
+	require.Equal(t, map[string]string{"da": "info"}, got.Modules)
+}
+
+func TestService_EngineCapture(t *testing.T) {
+	t.Parallel()
+	srv, _, capturer, _ := newTestServer(t)
+
+	var got struct {
+		Enabled bool   `json:"enabled"`
+		Path    string `json:"path"`
+	}
+	body := map[string]bool{"enabled": true}
+	require.Equal(t, http.StatusOK, do(t, http.MethodPut, srv.URL+"/admin/engine-capture", body, &got))
+	require.True(t, got.Enabled)
+	require.NotNil(t, capturer.writer())
+
+	_, err := capturer.writer().Write([]byte("{}\n"))
+	require.NoError(t, err)
+
+	path := got.Path
+	body = map[string]bool{"enabled": false}
+	require.Equal(t, http.StatusOK, do(t, http.MethodPut, srv.URL+"/admin/engine-capture", body, &got))
+	require.False(t, got.Enabled)
+	require.Nil(t, capturer.writer())
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{}\n", string(bz))
+}
+
+func TestService_FeeRecipient(t *testing.T) {
+	t.Parallel()
+	srv, _, _, fees := newTestServer(t)
+	url := srv.URL + "/admin/fee-recipient"
+
+	addr := common.ExecutionAddress{19: 0xaa}
+	var got map[string]string
+	body := map[string]string{"fee_recipient": "0x00000000000000000000000000000000000000aa"}
+	require.Equal(t, http.StatusOK, do(t, http.MethodPut, url, body, &got))
+	require.Equal(t, addr.Hex(), got["fee_recipient"])
+	require.Equal(t, addr, fees.SuggestedFeeRecipient())
+
+	require.Equal(t, http.StatusOK, do(t, http.MethodGet, url, nil, &got))
+	require.Equal(t, addr.Hex(), got["fee_recipient"])
+
+	// Zero, malformed and unpersisted fee recipients leave it unchanged.
+	body["fee_recipient"] = "0x0000000000000000000000000000000000000000"
+	require.Equal(t, http.StatusBadRequest, do(t, http.MethodPut, url, body, nil))
+	body["fee_recipient"] = "0x01"
+	require.Equal(t, http.StatusBadRequest, do(t, http.MethodPut, url, body, nil))
+	body["fee_recipient"] = "0x00000000000000000000000000000000000000ff"
+	require.Equal(t, http.StatusInternalServerError, do(t, http.MethodPut, url, body, nil))
+	require.Equal(t, addr, fees.SuggestedFeeRecipient())
+
+	// Changes without the bearer token are rejected.
+	body["fee_recipient"] = "0x00000000000000000000000000000000000000bb"
+	noToken := func(*http.Request) {}
+	require.Equal(t, http.StatusUnauthorized, doWith(t, http.MethodPut, url, noToken, body, nil))
+	wrongToken := func(req *http.Request) { req.Header.Set("Authorization", "Bearer guess") }
+	require.Equal(t, http.StatusUnauthorized, doWith(t, http.MethodPut, url, wrongToken, body, nil))
+	require.Equal(t, addr, fees.SuggestedFeeRecipient())
+}
+
+func TestService_FeeRecipientWithoutToken(t *testing.T) {
+	t.Parallel()
+	fees := &stubFees{}
+	svc := admin.NewService(
+		admin.DefaultConfig(), t.TempDir(), noop.NewLogger[any](), &stubLevels{}, &stubCapturer{},
+		fees, &stubBlocks{}, &stubDBs{blocks: &stubBlocks{}}, t.TempDir(),
+	)
+	srv := httptest.NewServer(svc.Handler())
+	t.Cleanup(srv.Close)
+
+	body := map[string]string{"fee_recipient": "0x00000000000000000000000000000000000000aa"}
+	require.Equal(t, http.StatusForbidden, do(t, http.MethodPut, srv.URL+"/admin/fee-recipient", body, nil))
+	require.Equal(t, common.ExecutionAddress{}, fees.SuggestedFeeRecipient())
+}
+
+func TestService_RejectsNonLoopbackHost(t *testing.T) {
+	t.Parallel()
+	srv, _, _, _ := newTestServer(t)
+
+	// A page served from a rebound DNS name reaches the loopback listener
+	// with its own name as Host.
+	rebound := func(req *http.Request) { req.Host = "attacker.example:6060" }
+	require.Equal(t, http.StatusForbidden,
+		doWith(t, http.MethodGet, srv.URL+"/admin/fee-recipient", rebound, nil, nil))
+
+	localhost := func(req *http.Request) { req.Host = "localhost:6060" }
+	require.Equal(t, http.StatusOK,
+		doWith(t, http.MethodGet, srv.URL+"/admin/fee-recipient", localhost, nil, nil))
+}
+
+func TestService_Dumps(t *testing.T) {
+	t.Parallel()
+	srv, _, _, _ := newTestServer(t)
+
+	for _, kind := range []string{"goroutines", "heap"} {
+		var got map[string]string
+		require.Equal(t, http.StatusOK, do(t, http.MethodPost, srv.URL+"/admin/dump/"+kind, nil, &got))
+		info, err := os.Stat(got["path"])
+		require.NoError(t, err)
+		require.Positive(t, info.Size())
+	}
+}
+
+func TestService_RejectsNonLoopbackAddress(t *testing.T) {
+	t.Parallel()
+	svc := admin.NewService(
+		admin.Config{Enabled: true, Address: "0.0.0.0:0"},
+		t.TempDir(), noop.NewLogger[any](), &stubLevels{}, &stubCapturer{},
+		&stubFees{}, &stubBlocks{}, &stubDBs{}, t.TempDir(),
+	)
+	require.ErrorIs(t, svc.Start(context.Background()), admin.ErrNonLoopbackAddress)
+}
+
+func TestService_Backup(t *testing.T) {
+	t.Parallel()
+	blobsDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(blobsDir, "7"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(blobsDir, "7", "blob.ssz"), []byte("blob"), 0o600))
+
+	blocks := &stubBlocks{}
+	dbs := &stubDBs{blocks: blocks}
+	svc := admin.NewService(
+		admin.DefaultConfig(), t.TempDir(), noop.NewLogger[any](), &stubLevels{}, &stubCapturer{},
+		&stubFees{}, blocks, dbs, blobsDir,
+	)
+	srv := httptest.NewServer(svc.Handler())
+	t.Cleanup(srv.Close)
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	var got struct {
+		Path      string   `json:"path"`
+		Height    int64    `json:"height"`
+		Databases []string `json:"databases"`
+	}
+	require.Equal(t, http.StatusOK,
+		do(t, http.MethodPost, srv.URL+"/admin/backup", map[string]string{"dir": dir}, &got))
+	require.Equal(t, dir, got.Path)
+	require.Equal(t, int64(42), got.Height)
+	require.Equal(t, []string{"application"}, got.Databases)
+	require.True(t, dbs.pausedDuring.Load())
+	require.False(t, blocks.isPaused())
+
+	bz, err := os.ReadFile(filepath.Join(dir, "data", "blobs", "7", "blob.ssz"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("blob"), bz)
+
+	// Existing and relative targets are refused.
+	require.Equal(t, http.StatusConflict,
+		do(t, http.MethodPost, srv.URL+"/admin/backup", map[string]string{"dir": dir}, nil))
+	require.Equal(t, http.StatusBadRequest,
+		do(t, http.MethodPost, srv.URL+"/admin/backup", map[string]string{"dir": "backup"}, nil))
+}
+
+func TestService_BackupUnsupportedBackend(t *testing.T) {
+	t.Parallel()
+	blocks := &stubBlocks{}
+	dbs := &stubDBs{blocks: blocks, err: storagedb.ErrCheckpointUnsupported}
+	svc := admin.NewService(
+		admin.DefaultConfig(), t.TempDir(), noop.NewLogger[any](), &stubLevels{}, &stubCapturer{},
+		&stubFees{}, blocks, dbs, t.TempDir(),
+	)
+	srv := httptest.NewServer(svc.Handler())
+	t.Cleanup(srv.Close)
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	require.Equal(t, http.StatusNotImplemented,
+		do(t, http.MethodPost, srv.URL+"/admin/backup", map[string]string{"dir": dir}, nil))
+	require.False(t, blocks.isPaused())
+}
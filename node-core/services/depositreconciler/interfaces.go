@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package depositreconciler
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// DepositStore is the local store of deposits read from the execution layer.
+type DepositStore interface {
+	// GetDepositsByIndex returns up to count deposits starting from the given
+	// index, along with their hash tree root.
+	GetDepositsByIndex(
+		ctx context.Context, startIndex uint64, count uint64,
+	) (ctypes.Deposits, common.Root, error)
+	// GetDepositLocation returns the execution layer location of the deposit
+	// at the given index, or nil if it is unknown (e.g. genesis deposits).
+	GetDepositLocation(
+		ctx context.Context, index uint64,
+	) (*ctypes.DepositLocation, error)
+	// GetLatestDepositLocation returns the execution layer location of the
+	// deposit with the highest index, or nil if none is known.
+	GetLatestDepositLocation(ctx context.Context) (*ctypes.DepositLocation, error)
+}
+
+// DepositContract reads the deposit contract on the execution layer.
+type DepositContract interface {
+	// DepositCount returns the number of deposits made to the contract as of
+	// the latest execution block.
+	DepositCount(ctx context.Context) (uint64, error)
+	// ReadDeposits reads deposits from the deposit contract, along with the
+	// execution layer location of each of them.
+	ReadDeposits(
+		ctx context.Context, fromBlock math.U64, toBlock math.U64,
+	) ([]*ctypes.Deposit, []*ctypes.DepositLocation, error)
+}
+
+// TelemetrySink is an interface for sending telemetry data.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package depositreconciler periodically reconciles the local deposit store
+// against the deposit contract on the execution layer.
+package depositreconciler
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+const (
+	// ReasonMissingDeposit is reported when the local store has a gap.
+	ReasonMissingDeposit = "local store is missing a deposit"
+	// ReasonAheadOfContract is reported when the local store holds more
+	// deposits than the contract has ever received.
+	ReasonAheadOfContract = "local store is ahead of the deposit contract"
+	// ReasonDepositMismatch is reported when a stored deposit differs from
+	// the log the contract emitted for it.
+	ReasonDepositMismatch = "local deposit differs from the deposit contract log"
+
+	// confirmations is how many consecutive checks must find a divergence
+	// before it is alerted on. Deposits from blocks whose logs
+	// failed to be read are retried, so a single check may see a gap that
+	// fills up shortly after.
+	confirmations = 2
+)
+
+// Status is the outcome of a reconciliation.
+type Status struct {
+	// CheckedAt is when the reconciliation ran.
+	CheckedAt time.Time
+	// LocalCount is the number of deposits in the local store, up to the
+	// latest deposit read from the execution layer.
+	LocalCount uint64
+	// LocalRoot is the hash tree root of those deposits.
+	LocalRoot common.Root
+	// ContractCount is the number of deposits the contract has received as
+	// of the latest execution block.
+	ContractCount uint64
+	// Consistent reports whether the local store agrees with the contract.
+	Consistent bool
+	// DivergenceIndex is the first deposit index on which the local store
+	// and the contract disagree, if not Consistent.
+	DivergenceIndex uint64
+	// DivergenceBlock is the execution block the local store recorded for
+	// the deposit at DivergenceIndex, zero if unknown.
+	DivergenceBlock uint64
+	// Reason describes the divergence, if not Consistent.
+	Reason string
+}
+
+// Service reconciles the local deposit store against the deposit contract.
+//
+// The deposit contract keeps no Merkle tree of deposits, so the contract's
+// deposit count bounds the number of deposits held locally, and each stored
+// deposit is compared to the log the contract emitted for it. Logs remain
+// available on non-archive execution clients, unlike historical state.
+type Service struct {
+	cfg      Config
+	logger   log.Logger
+	store    DepositStore
+	contract DepositContract
+	sink     TelemetrySink
+
+	mu     sync.RWMutex
+	status *Status
+}
+
+// NewService creates a new deposit reconciler.
+func NewService(
+	cfg Config,
+	logger log.Logger,
+	store DepositStore,
+	contract DepositContract,
+	sink TelemetrySink,
+) *Service {
+	return &Service{
+		cfg:      cfg,
+		logger:   logger,
+		store:    store,
+		contract: contract,
+		sink:     sink,
+	}
+}
+
+// Name returns the name of the deposit reconciler.
+func (s *Service) Name() string {
+	return "deposit-reconciler"
+}
+
+// Start starts reconciling if enabled.
+func (s *Service) Start(ctx context.Context) error {
+	if s.cfg.Interval <= 0 {
+		return nil
+	}
+	go s.run(ctx)
+	return nil
+}
+
+// Stop is a no-op, reconciliation stops along with the context it was
+// started with.
+func (s *Service) Stop() error {
+	return nil
+}
+
+// Status returns the outcome of the latest reconciliation, or nil if none
+// completed yet.
+func (s *Service) Status() *Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.status == nil {
+		return nil
+	}
+	status := *s.status
+	return &status
+}
+
+// run reconciles every interval until the context is done.
+func (s *Service) run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	var (
+		diverged bool
+		streak   int
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		status, err := s.Reconcile(ctx)
+		if err != nil {
+			s.logger.Warn("Failed to reconcile deposits", "error", err)
+			continue
+		}
+		if status == nil {
+			continue
+		}
+		s.mu.Lock()
+		s.status = status
+		s.mu.Unlock()
+
+		if status.Consistent {
+			streak = 0
+			s.sink.SetGauge("beacon_kit.deposit.reconciliation_diverged", 0)
+			if diverged {
+				diverged = false
+				s.logger.Info("Deposit store agrees with the deposit contract again",
+					"local_count", status.LocalCount,
+					"contract_count", status.ContractCount,
+				)
+			}
+			continue
+		}
+		streak++
+		if streak < confirmations || diverged {
+			continue
+		}
+		diverged = true
+		s.sink.IncrementCounter("beacon_kit.deposit.reconciliation_mismatch")
+		s.sink.SetGauge("beacon_kit.deposit.reconciliation_diverged", 1)
+		s.logger.Error("Deposit store diverges from the deposit contract",
+			"reason", status.Reason,
+			"divergence_index", status.DivergenceIndex,
+			"divergence_block", status.DivergenceBlock,
+			"local_count", status.LocalCount,
+			"local_root", status.LocalRoot,
+			"contract_count", status.ContractCount,
+		)
+	}
+}
+
+// Reconcile compares the local deposit store with the deposit contract. It
+// returns nil if no deposit was read from the execution layer yet. Errors
+// reading either side are returned rather than reported as a divergence.
+func (s *Service) Reconcile(ctx context.Context) (*Status, error) {
+	latest, err := s.store.GetLatestDepositLocation(ctx)
+	if err != nil || latest == nil {
+		return nil, err
+	}
+	localCount := latest.Index.Unwrap() + 1
+	deposits, root, err := s.store.GetDepositsByIndex(ctx, 0, localCount)
+	if err != nil {
+		return nil, err
+	}
+	contractCount, err := s.contract.DepositCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := &Status{
+		CheckedAt:     time.Now(),
+		LocalCount:    localCount,
+		LocalRoot:     root,
+		ContractCount: contractCount,
+		Consistent:    true,
+	}
+
+	//#nosec: G115 // the number of deposits fits in a uint64.
+	if stored := uint64(len(deposits)); stored != localCount {
+		return s.diverged(ctx, status, stored, ReasonMissingDeposit)
+	}
+	if contractCount < localCount {
+		return s.divergence(ctx, status, deposits, ReasonAheadOfContract)
+	}
+	matches, err := s.matches(ctx, deposits, localCount-1)
+	if err != nil {
+		return nil, err
+	}
+	if !matches {
+		return s.divergence(ctx, status, deposits, ReasonDepositMismatch)
+	}
+	return status, nil
+}
+
+// divergence searches for the first stored deposit that differs from the
+// contract log emitted for it, assuming that every deposit after it differs
+// too, as is the case after the local store followed a different history.
+// If all of them match, the local store is only ahead of the contract and
+// diverges at the contract's deposit count.
+func (s *Service) divergence(
+	ctx context.Context, status *Status, deposits ctypes.Deposits, reason string,
+) (*Status, error) {
+	var searchErr error
+	//#nosec: G115 // the number of deposits fits in a uint64.
+	index := uint64(sort.Search(len(deposits), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		//#nosec: G115 // i is a valid deposit index.
+		matches, err := s.matches(ctx, deposits, uint64(i))
+		searchErr = err
+		return !matches
+	}))
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if index == status.LocalCount {
+		index = status.ContractCount
+	}
+	return s.diverged(ctx, status, index, reason)
+}
+
+// diverged records a divergence at the given deposit index.
+func (s *Service) diverged(
+	ctx context.Context, status *Status, index uint64, reason string,
+) (*Status, error) {
+	status.Consistent = false
+	status.DivergenceIndex = index
+	status.Reason = reason
+	location, err := s.store.GetDepositLocation(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	if location != nil {
+		status.DivergenceBlock = location.BlockNumber.Unwrap()
+	}
+	return status, nil
+}
+
+// matches reports whether the stored deposit at the given index is the one
+// the contract emitted a log for at its recorded location. Deposits without
+// a location, i.e. genesis deposits, are not read from the execution layer
+// and always match.
+func (s *Service) matches(
+	ctx context.Context, deposits ctypes.Deposits, index uint64,
+) (bool, error) {
+	location, err := s.store.GetDepositLocation(ctx, index)
+	if err != nil || location == nil {
+		return true, err
+	}
+	logged, locations, err := s.contract.ReadDeposits(
+		ctx, location.BlockNumber, location.BlockNumber,
+	)
+	if err != nil {
+		return false, err
+	}
+	for i, deposit := range logged {
+		if deposit.GetIndex().Unwrap() != index {
+			continue
+		}
+		return deposit.Equals(deposits[index]) &&
+			locations[i].LogIndex == location.LogIndex &&
+			locations[i].TxHash == location.TxHash, nil
+	}
+	return false, nil
+}
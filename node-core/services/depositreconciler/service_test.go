@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package depositreconciler_test
+
+import (
+	"context"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/depositreconciler"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSink struct{}
+
+func (stubSink) IncrementCounter(string, ...string) {}
+
+func (stubSink) SetGauge(string, int64, ...string) {}
+
+// stubStore holds deposits at index i emitted in block i+1.
+type stubStore struct {
+	deposits ctypes.Deposits
+}
+
+func (s *stubStore) GetDepositsByIndex(
+	_ context.Context, start, count uint64,
+) (ctypes.Deposits, common.Root, error) {
+	end := min(start+count, uint64(len(s.deposits)))
+	return s.deposits[start:end], common.Root{1}, nil
+}
+
+func (s *stubStore) GetDepositLocation(
+	_ context.Context, index uint64,
+) (*ctypes.DepositLocation, error) {
+	if index >= uint64(len(s.deposits)) {
+		return nil, nil
+	}
+	return location(index), nil
+}
+
+func (s *stubStore) GetLatestDepositLocation(
+	ctx context.Context,
+) (*ctypes.DepositLocation, error) {
+	if len(s.deposits) == 0 {
+		return nil, nil
+	}
+	return s.GetDepositLocation(ctx, uint64(len(s.deposits)-1))
+}
+
+// stubContract emits the deposit at index i in block i+1.
+type stubContract struct {
+	deposits ctypes.Deposits
+}
+
+func (c *stubContract) DepositCount(context.Context) (uint64, error) {
+	return uint64(len(c.deposits)), nil
+}
+
+func (c *stubContract) ReadDeposits(
+	_ context.Context, from, _ math.U64,
+) ([]*ctypes.Deposit, []*ctypes.DepositLocation, error) {
+	index := from.Unwrap() - 1
+	if index >= uint64(len(c.deposits)) {
+		return nil, nil, nil
+	}
+	return []*ctypes.Deposit{c.deposits[index]},
+		[]*ctypes.DepositLocation{location(index)}, nil
+}
+
+func location(index uint64) *ctypes.DepositLocation {
+	return &ctypes.DepositLocation{
+		Index:       math.U64(index),
+		BlockNumber: math.U64(index + 1),
+	}
+}
+
+func deposits(count int, amount math.Gwei) ctypes.Deposits {
+	out := make(ctypes.Deposits, count)
+	for i := range out {
+		out[i] = &ctypes.Deposit{Amount: amount, Index: uint64(i)}
+	}
+	return out
+}
+
+func newService(
+	store *stubStore, contract *stubContract,
+) *depositreconciler.Service {
+	return depositreconciler.NewService(
+		depositreconciler.DefaultConfig(),
+		noop.NewLogger[any](),
+		store,
+		contract,
+		stubSink{},
+	)
+}
+
+func TestReconcile_Consistent(t *testing.T) {
+	t.Parallel()
+	s := newService(
+		&stubStore{deposits: deposits(5, 1)},
+		&stubContract{deposits: deposits(6, 1)},
+	)
+	status, err := s.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.True(t, status.Consistent)
+	require.Equal(t, uint64(5), status.LocalCount)
+	require.Equal(t, uint64(6), status.ContractCount)
+}
+
+func TestReconcile_FindsDivergence(t *testing.T) {
+	t.Parallel()
+	contract := deposits(8, 1)
+	local := deposits(8, 1)
+	for _, deposit := range local[3:] {
+		deposit.Amount = 2
+	}
+	s := newService(
+		&stubStore{deposits: local}, &stubContract{deposits: contract},
+	)
+	status, err := s.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.False(t, status.Consistent)
+	require.Equal(t, depositreconciler.ReasonDepositMismatch, status.Reason)
+	require.Equal(t, uint64(3), status.DivergenceIndex)
+	require.Equal(t, uint64(4), status.DivergenceBlock)
+}
+
+func TestReconcile_AheadOfContract(t *testing.T) {
+	t.Parallel()
+	s := newService(
+		&stubStore{deposits: deposits(5, 1)},
+		&stubContract{deposits: deposits(3, 1)},
+	)
+	status, err := s.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.False(t, status.Consistent)
+	require.Equal(t, depositreconciler.ReasonAheadOfContract, status.Reason)
+	require.Equal(t, uint64(3), status.DivergenceIndex)
+}
+
+func TestReconcile_NothingRead(t *testing.T) {
+	t.Parallel()
+	s := newService(new(stubStore), new(stubContract))
+	status, err := s.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, status)
+	require.Nil(t, s.Status())
+}
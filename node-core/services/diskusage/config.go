@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diskusage
+
+import "time"
+
+const (
+	defaultInterval = time.Minute
+	bytesPerGiB     = 1 << 30
+)
+
+// Config is the configuration for the disk usage monitor.
+type Config struct {
+	// Interval is how often the disk usage of the stores is measured. Zero
+	// disables the monitor.
+	Interval time.Duration `mapstructure:"interval"`
+	// BlobQuotaGiB is a soft limit on the disk space taken by blob sidecars.
+	// Once crossed, the oldest blob sidecars are pruned ahead of the blob
+	// retention, though never within MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS of
+	// the head. Zero disables the quota.
+	BlobQuotaGiB uint64 `mapstructure:"blob-quota-gib"`
+}
+
+// DefaultConfig returns the default configuration for the disk usage monitor.
+func DefaultConfig() Config {
+	return Config{
+		Interval:     defaultInterval,
+		BlobQuotaGiB: 0,
+	}
+}
+
+// BlobQuotaBytes returns the blob quota in bytes, zero if disabled.
+func (c Config) BlobQuotaBytes() uint64 {
+	return c.BlobQuotaGiB * bytesPerGiB
+}
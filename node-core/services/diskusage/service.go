@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package diskusage reports the disk usage of the node's stores and enforces
+// an optional soft quota on the disk space taken by blob sidecars.
+package diskusage
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/log"
+)
+
+// StoreUsage is the disk usage of a store, named after its entry in the data
+// directory.
+type StoreUsage struct {
+	Name  string
+	Bytes uint64
+}
+
+// Report is the latest disk usage measurement.
+type Report struct {
+	// Stores is the disk usage of each store, sorted by name.
+	Stores []StoreUsage
+	// TotalBytes is the disk usage of the whole data directory.
+	TotalBytes uint64
+	// BlobQuotaBytes is the soft quota on blob sidecars, zero if disabled.
+	BlobQuotaBytes uint64
+	// MeasuredAt is when the measurement was taken, zero if none was yet.
+	MeasuredAt time.Time
+}
+
+// Service periodically measures the disk usage of every store in the data
+// directory, reports it as metrics and through Report, and prunes the oldest
+// blob sidecars when they take more than the configured quota.
+type Service struct {
+	cfg               Config
+	dataDir           string
+	minRetentionSlots uint64
+	logger            log.Logger
+	bus               EventSubscriber
+	blobs             BlobStore
+	sink              TelemetrySink
+
+	mu     sync.RWMutex
+	report Report
+}
+
+// NewService creates a new disk usage service measuring the stores in dataDir.
+// Blob sidecars of the last minRetentionSlots slots are never pruned to
+// enforce the quota.
+func NewService(
+	cfg Config,
+	dataDir string,
+	minRetentionSlots uint64,
+	logger log.Logger,
+	bus EventSubscriber,
+	blobs BlobStore,
+	sink TelemetrySink,
+) *Service {
+	return &Service{
+		cfg:               cfg,
+		dataDir:           dataDir,
+		minRetentionSlots: minRetentionSlots,
+		logger:            logger,
+		bus:               bus,
+		blobs:             blobs,
+		sink:              sink,
+		report:            Report{BlobQuotaBytes: cfg.BlobQuotaBytes()},
+	}
+}
+
+// Name returns the name of the disk usage service.
+func (s *Service) Name() string {
+	return "disk-usage"
+}
+
+// Start starts measuring disk usage if the monitor is enabled.
+func (s *Service) Start(ctx context.Context) error {
+	if s.cfg.Interval <= 0 {
+		return nil
+	}
+	heads, cancel := s.bus.Subscribe(events.TopicHead)
+	go func() {
+		defer cancel()
+		s.monitor(ctx, heads)
+	}()
+	return nil
+}
+
+// Stop is a no-op, the monitor stops along with the context it was started
+// with.
+func (s *Service) Stop() error {
+	return nil
+}
+
+// Report returns the latest disk usage measurement.
+func (s *Service) Report() Report {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	report := s.report
+	report.Stores = slices.Clone(s.report.Stores)
+	return report
+}
+
+// monitor measures disk usage every interval until the context is done.
+func (s *Service) monitor(ctx context.Context, heads <-chan events.Event) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	var (
+		headSlot  uint64
+		overQuota bool
+		check     = func() {
+			overQuota = s.enforceQuota(headSlot, overQuota)
+			s.measure()
+		}
+	)
+	check()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-heads:
+			if head, ok := event.Data.(*events.Head); ok {
+				headSlot = head.Slot.Unwrap()
+			}
+		case <-ticker.C:
+			check()
+		}
+	}
+}
+
+// enforceQuota prunes the oldest blob sidecars outside of the minimum
+// retention while they take more than the quota. It returns whether they
+// still do, warning when that changes from wasOver.
+func (s *Service) enforceQuota(headSlot uint64, wasOver bool) bool {
+	quota := s.cfg.BlobQuotaBytes()
+	if quota == 0 || headSlot <= s.minRetentionSlots {
+		return false
+	}
+	usage, err := s.blobs.PruneToSize(quota, headSlot-s.minRetentionSlots)
+	if err != nil {
+		s.sink.IncrementCounter("beacon_kit.storage.blob_quota_prune_failure")
+		s.logger.Warn("Failed to prune blob sidecars to quota", "error", err)
+		return wasOver
+	}
+	isOver := usage > quota
+	if isOver {
+		s.sink.SetGauge("beacon_kit.storage.blob_quota_exceeded", 1)
+	} else {
+		s.sink.SetGauge("beacon_kit.storage.blob_quota_exceeded", 0)
+	}
+	if isOver && !wasOver {
+		s.logger.Warn(
+			"Blob sidecars exceed the quota within the minimum retention",
+			"usage_bytes", usage, "quota_bytes", quota,
+		)
+	}
+	return isOver
+}
+
+// measure measures the disk usage of each store and records it.
+func (s *Service) measure() {
+	entries, err := os.ReadDir(s.dataDir)
+	if err != nil {
+		s.logger.Warn("Failed to read data directory", "error", err)
+		return
+	}
+	report := Report{
+		Stores:         make([]StoreUsage, 0, len(entries)),
+		BlobQuotaBytes: s.cfg.BlobQuotaBytes(),
+		MeasuredAt:     time.Now(),
+	}
+	for _, entry := range entries {
+		size, sizeErr := dirSize(filepath.Join(s.dataDir, entry.Name()))
+		if sizeErr != nil {
+			s.logger.Warn("Failed to measure store", "store", entry.Name(), "error", sizeErr)
+			continue
+		}
+		report.Stores = append(report.Stores, StoreUsage{Name: entry.Name(), Bytes: size})
+		report.TotalBytes += size
+		//#nosec: G115 // disk usage fits in an int64.
+		s.sink.SetGauge(
+			"beacon_kit.storage.disk_usage_bytes", int64(size), "store", entry.Name(),
+		)
+	}
+
+	s.mu.Lock()
+	s.report = report
+	s.mu.Unlock()
+}
+
+// dirSize returns the number of bytes taken by the files under path, which
+// may also be a single file. Files removed while walking are skipped.
+func dirSize(path string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		//#nosec: G115 // file sizes are never negative.
+		size += uint64(info.Size())
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diskusage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/diskusage"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSink struct{}
+
+func (stubSink) IncrementCounter(string, ...string) {}
+
+func (stubSink) SetGauge(string, int64, ...string) {}
+
+// stubBlobs records the last PruneToSize call.
+type stubBlobs struct {
+	mu       sync.Mutex
+	maxBytes uint64
+	end      uint64
+}
+
+func (b *stubBlobs) PruneToSize(maxBytes, end uint64) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.maxBytes, b.end = maxBytes, end
+	return 0, nil
+}
+
+func (b *stubBlobs) last() (uint64, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.maxBytes, b.end
+}
+
+func TestService_MeasuresAndEnforcesQuota(t *testing.T) {
+	t.Parallel()
+	dataDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "blobs", "1"), 0o700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dataDir, "blobs", "1", "a.ssz"), make([]byte, 100), 0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dataDir, "state.db"), make([]byte, 50), 0o600,
+	))
+
+	var (
+		bus   = events.NewBus()
+		blobs = new(stubBlobs)
+		s     = diskusage.NewService(
+			diskusage.Config{Interval: 10 * time.Millisecond, BlobQuotaGiB: 1},
+			dataDir,
+			10,
+			noop.NewLogger[any](),
+			bus,
+			blobs,
+			stubSink{},
+		)
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx))
+
+	require.Eventually(t, func() bool {
+		return !s.Report().MeasuredAt.IsZero()
+	}, 5*time.Second, 10*time.Millisecond)
+	report := s.Report()
+	require.Equal(t, []diskusage.StoreUsage{
+		{Name: "blobs", Bytes: 100},
+		{Name: "state.db", Bytes: 50},
+	}, report.Stores)
+	require.Equal(t, uint64(150), report.TotalBytes)
+	require.Equal(t, uint64(1<<30), report.BlobQuotaBytes)
+
+	// The quota is only enforced outside of the minimum retention.
+	bus.Publish(events.Event{Topic: events.TopicHead, Data: &events.Head{Slot: 25}})
+	require.Eventually(t, func() bool {
+		maxBytes, end := blobs.last()
+		return maxBytes == 1<<30 && end == 15
+	}, 5*time.Second, 10*time.Millisecond)
+}
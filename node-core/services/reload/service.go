@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reload
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/phuslu"
+)
+
+// LevelApplier applies the log levels of a logger config at runtime.
+type LevelApplier interface {
+	// ApplyLevels sets the level of the logger and of its named modules.
+	ApplyLevels(cfg *phuslu.Config) error
+}
+
+// Service reloads the runtime-tunable parts of the node config, currently the
+// log levels, when the process receives SIGHUP.
+type Service struct {
+	logger  log.Logger
+	applier LevelApplier
+	read    func() (*phuslu.Config, error)
+
+	signals  chan os.Signal
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewService creates a new reload service. read returns the logger config
+// as currently found on disk.
+func NewService(
+	logger log.Logger,
+	applier LevelApplier,
+	read func() (*phuslu.Config, error),
+) *Service {
+	return &Service{
+		logger:  logger,
+		applier: applier,
+		read:    read,
+		signals: make(chan os.Signal, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// Name returns the name of the reload service.
+func (s *Service) Name() string {
+	return "reload"
+}
+
+// Start listens for SIGHUP until the context is canceled or the service is
+// stopped.
+func (s *Service) Start(ctx context.Context) error {
+	signal.Notify(s.signals, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.done:
+				return
+			case <-s.signals:
+				s.Reload()
+			}
+		}
+	}()
+	return nil
+}
+
+// Reload reads the logger config and applies its levels. Errors are logged
+// and leave the current levels in place.
+func (s *Service) Reload() {
+	cfg, err := s.read()
+	if err != nil {
+		s.logger.Error("Failed to reload config", "error", err)
+		return
+	}
+	if err = s.applier.ApplyLevels(cfg); err != nil {
+		s.logger.Error("Failed to apply reloaded log levels", "error", err)
+		return
+	}
+	s.logger.Info(
+		"Reloaded log levels",
+		"log_level", cfg.LogLevel,
+		"module_levels", cfg.ModuleLevels,
+	)
+}
+
+// Stop stops listening for SIGHUP.
+func (s *Service) Stop() error {
+	s.stopOnce.Do(func() {
+		signal.Stop(s.signals)
+		close(s.done)
+	})
+	return nil
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package watchdog
+
+import "time"
+
+const (
+	defaultStallTimeout = time.Minute
+)
+
+// Config is the configuration for the finality stall watchdog.
+type Config struct {
+	// StallTimeout is how long the finalized slot may not advance before an
+	// alert is raised. Zero disables the watchdog.
+	StallTimeout time.Duration `mapstructure:"stall-timeout"`
+	// WebhookURL is where alerts are POSTed as JSON, if set. It is redacted
+	// as such URLs usually embed the token of the receiving channel.
+	WebhookURL string `mapstructure:"webhook-url" redact:"true"`
+}
+
+// DefaultConfig returns the default configuration for the watchdog.
+func DefaultConfig() Config {
+	return Config{
+		StallTimeout: defaultStallTimeout,
+		WebhookURL:   "",
+	}
+}
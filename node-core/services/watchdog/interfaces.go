@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package watchdog
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+)
+
+// EventSubscriber subscribes to chain events.
+type EventSubscriber interface {
+	// Subscribe returns a channel receiving the events of the given topics,
+	// along with a function cancelling the subscription.
+	Subscribe(topics ...string) (<-chan events.Event, func())
+}
+
+// ExecutionClient reports the status of the execution client.
+type ExecutionClient interface {
+	// IsConnected reports whether the execution client is reachable.
+	IsConnected() bool
+	// Syncing reports whether the execution client is syncing.
+	Syncing(ctx context.Context) (bool, error)
+	// LastError returns when the execution client last returned an error
+	// along with that error, which is nil if there was none.
+	LastError() (time.Time, error)
+}
+
+// TelemetrySink is an interface for sending telemetry data.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
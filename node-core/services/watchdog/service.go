@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package watchdog raises alerts when the finalized slot stops advancing.
+package watchdog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/log"
+)
+
+const (
+	// requestTimeout bounds execution client queries and webhook requests.
+	requestTimeout = 5 * time.Second
+	// checksPerTimeout is how many times the finalized slot is checked within
+	// the stall timeout.
+	checksPerTimeout = 4
+)
+
+const (
+	// StatusStalled is the status of an alert raised when finality stalls.
+	StatusStalled = "stalled"
+	// StatusRecovered is the status of an alert raised when finality resumes.
+	StatusRecovered = "recovered"
+)
+
+// Alert is the diagnostic context of a finality stall, as logged and POSTed
+// to the webhook.
+type Alert struct {
+	Status             string     `json:"status"`
+	LastFinalizedSlot  uint64     `json:"last_finalized_slot"`
+	LastFinalizedAt    time.Time  `json:"last_finalized_at"`
+	StalledFor         string     `json:"stalled_for"`
+	ExecutionConnected bool       `json:"execution_connected"`
+	ExecutionSyncing   *bool      `json:"execution_syncing,omitempty"`
+	LastEngineError    string     `json:"last_engine_error,omitempty"`
+	LastEngineErrorAt  *time.Time `json:"last_engine_error_at,omitempty"`
+}
+
+// Service watches head events and raises an alert when no block has been
+// finalized for the configured stall timeout, and again once finality
+// resumes. Since CometBFT finalizes every block it commits, the finalized
+// slot is the head slot.
+type Service struct {
+	cfg    Config
+	logger log.Logger
+	bus    EventSubscriber
+	el     ExecutionClient
+	sink   TelemetrySink
+	client *http.Client
+}
+
+// NewService creates a new watchdog service.
+func NewService(
+	cfg Config,
+	logger log.Logger,
+	bus EventSubscriber,
+	el ExecutionClient,
+	sink TelemetrySink,
+) *Service {
+	return &Service{
+		cfg:    cfg,
+		logger: logger,
+		bus:    bus,
+		el:     el,
+		sink:   sink,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Name returns the name of the watchdog service.
+func (s *Service) Name() string {
+	return "watchdog"
+}
+
+// Start starts watching for finality stalls if the watchdog is enabled.
+func (s *Service) Start(ctx context.Context) error {
+	if s.cfg.StallTimeout <= 0 {
+		return nil
+	}
+	heads, cancel := s.bus.Subscribe(events.TopicHead)
+	go func() {
+		defer cancel()
+		s.watch(ctx, heads)
+	}()
+	return nil
+}
+
+// Stop is a no-op, the watchdog stops along with the context it was started
+// with.
+func (s *Service) Stop() error {
+	return nil
+}
+
+// watch tracks the finalized slot until the context is done.
+func (s *Service) watch(ctx context.Context, heads <-chan events.Event) {
+	ticker := time.NewTicker(s.cfg.StallTimeout / checksPerTimeout)
+	defer ticker.Stop()
+
+	var (
+		lastSlot uint64
+		lastAt   = time.Now()
+		stalled  bool
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-heads:
+			if head, ok := event.Data.(*events.Head); ok {
+				lastSlot = head.Slot.Unwrap()
+			}
+			lastAt = time.Now()
+			if stalled {
+				stalled = false
+				s.sink.SetGauge("beacon_kit.watchdog.finality_stalled", 0)
+				s.alert(ctx, StatusRecovered, lastSlot, lastAt)
+			}
+		case now := <-ticker.C:
+			//#nosec: G115 // the duration is never negative.
+			s.sink.SetGauge(
+				"beacon_kit.watchdog.seconds_since_finalized", int64(now.Sub(lastAt).Seconds()),
+			)
+			if !stalled && now.Sub(lastAt) >= s.cfg.StallTimeout {
+				stalled = true
+				s.sink.IncrementCounter("beacon_kit.watchdog.finality_stall")
+				s.sink.SetGauge("beacon_kit.watchdog.finality_stalled", 1)
+				s.alert(ctx, StatusStalled, lastSlot, lastAt)
+			}
+		}
+	}
+}
+
+// alert logs the alert with its diagnostic context and POSTs it to the
+// webhook, if configured.
+func (s *Service) alert(ctx context.Context, status string, lastSlot uint64, lastAt time.Time) {
+	alert := s.diagnose(ctx, status, lastSlot, lastAt)
+	keyVals := []any{
+		"last_finalized_slot", alert.LastFinalizedSlot,
+		"stalled_for", alert.StalledFor,
+		"execution_connected", alert.ExecutionConnected,
+	}
+	if alert.ExecutionSyncing != nil {
+		keyVals = append(keyVals, "execution_syncing", *alert.ExecutionSyncing)
+	}
+	if alert.LastEngineError != "" {
+		keyVals = append(keyVals,
+			"last_engine_error", alert.LastEngineError,
+			"last_engine_error_at", alert.LastEngineErrorAt,
+		)
+	}
+	if status == StatusStalled {
+		s.logger.Error("Finality stalled", keyVals...)
+	} else {
+		s.logger.Info("Finality recovered", keyVals...)
+	}
+
+	if s.cfg.WebhookURL == "" {
+		return
+	}
+	if err := s.post(ctx, alert); err != nil {
+		s.sink.IncrementCounter("beacon_kit.watchdog.webhook_failure")
+		s.logger.Warn("Failed to post finality alert", "error", err)
+	}
+}
+
+// diagnose gathers the diagnostic context of an alert.
+func (s *Service) diagnose(
+	ctx context.Context, status string, lastSlot uint64, lastAt time.Time,
+) *Alert {
+	alert := &Alert{
+		Status:             status,
+		LastFinalizedSlot:  lastSlot,
+		LastFinalizedAt:    lastAt,
+		StalledFor:         time.Since(lastAt).Round(time.Second).String(),
+		ExecutionConnected: s.el.IsConnected(),
+	}
+	if alert.ExecutionConnected {
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+		if syncing, err := s.el.Syncing(ctx); err == nil {
+			alert.ExecutionSyncing = &syncing
+		}
+	}
+	if at, err := s.el.LastError(); err != nil {
+		alert.LastEngineError = err.Error()
+		alert.LastEngineErrorAt = &at
+	}
+	return alert
+}
+
+// post POSTs the alert to the webhook.
+func (s *Service) post(ctx context.Context, alert *Alert) error {
+	bz, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, s.cfg.WebhookURL, bytes.NewReader(bz),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
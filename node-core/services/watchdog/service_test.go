@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package watchdog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/events"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/services/watchdog"
+	"github.com/stretchr/testify/require"
+)
+
+var errEngine = errors.New("engine unavailable")
+
+type stubEL struct{}
+
+func (stubEL) IsConnected() bool { return true }
+
+func (stubEL) Syncing(context.Context) (bool, error) { return true, nil }
+
+func (stubEL) LastError() (time.Time, error) { return time.Unix(1, 0), errEngine }
+
+type stubSink struct{}
+
+func (stubSink) IncrementCounter(string, ...string) {}
+
+func (stubSink) SetGauge(string, int64, ...string) {}
+
+func TestService_AlertsOnStallAndRecovery(t *testing.T) {
+	t.Parallel()
+	alerts := make(chan *watchdog.Alert, 2)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		alert := new(watchdog.Alert)
+		if err := json.NewDecoder(r.Body).Decode(alert); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		alerts <- alert
+	}))
+	defer webhook.Close()
+
+	bus := events.NewBus()
+	s := watchdog.NewService(
+		watchdog.Config{StallTimeout: 50 * time.Millisecond, WebhookURL: webhook.URL},
+		noop.NewLogger[any](),
+		bus,
+		stubEL{},
+		stubSink{},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx))
+
+	// No head arrives within the stall timeout.
+	var alert *watchdog.Alert
+	select {
+	case alert = <-alerts:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no stall alert")
+	}
+	require.Equal(t, watchdog.StatusStalled, alert.Status)
+	require.True(t, alert.ExecutionConnected)
+	require.NotNil(t, alert.ExecutionSyncing)
+	require.True(t, *alert.ExecutionSyncing)
+	require.Equal(t, errEngine.Error(), alert.LastEngineError)
+
+	// Finality resumes with a new head.
+	bus.Publish(events.Event{Topic: events.TopicHead, Data: &events.Head{Slot: 7}})
+	select {
+	case alert = <-alerts:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no recovery alert")
+	}
+	require.Equal(t, watchdog.StatusRecovered, alert.Status)
+	require.Equal(t, uint64(7), alert.LastFinalizedSlot)
+}
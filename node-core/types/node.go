@@ -25,6 +25,7 @@ import (
 
 	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -34,6 +35,7 @@ import (
 type Node interface {
 	CommitMultistoreAccessor
 	StorageBackendAccessor
+	StateProcessorAccessor
 
 	Start(context.Context) error
 }
@@ -50,6 +52,12 @@ type StorageBackendAccessor interface {
 	StorageBackend() blockchain.StorageBackend
 }
 
+// StateProcessorAccessor allows access to the state processor.
+// This is required by commands like replay.
+type StateProcessorAccessor interface {
+	StateProcessor() blockchain.StateProcessor
+}
+
 // ConsensusService defines everything we utilise externally from CometBFT.
 type ConsensusService interface {
 	service.Basic
@@ -58,4 +66,10 @@ type ConsensusService interface {
 		prove bool,
 	) (sdk.Context, error)
 	LastBlockHeight() int64
+	ExpectedProposerAddresses(count int) ([][]byte, error)
+	// SyncStatus returns the number of blocks the node is behind its peers
+	// and whether it is still catching up.
+	SyncStatus() (int64, bool, error)
+	// Peers returns the peers the node is connected to.
+	Peers() []*consensustypes.PeerInfo
 }
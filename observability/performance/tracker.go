@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// DefaultWindow is the number of heights the performance is tracked over.
+const DefaultWindow = 1000
+
+// TelemetrySink is the subset of the telemetry sink used by the Tracker.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided key.
+	SetGauge(key string, value int64, args ...string)
+}
+
+// Record is the outcome of the proposals of a height.
+type Record struct {
+	Height int64 `json:"height"`
+	// Proposer is the CometBFT address of the proposer of the committed
+	// block.
+	Proposer []byte `json:"proposer"`
+	// Missed are the CometBFT addresses of the proposers of the rounds which
+	// failed to commit a block, once per failed round.
+	Missed [][]byte `json:"missed"`
+	// Latency is the time from the start of the height to the commit of the
+	// block.
+	Latency time.Duration `json:"latency"`
+}
+
+// Stats is the performance of a validator over the tracked window.
+type Stats struct {
+	// ProposalsHit is the number of blocks proposed by the validator.
+	ProposalsHit uint64
+	// ProposalsMissed is the number of rounds proposed by the validator
+	// which failed to commit a block.
+	ProposalsMissed uint64
+	// TotalLatency is the inclusion latency summed over the proposed blocks.
+	TotalLatency time.Duration
+}
+
+// AverageLatency returns the average inclusion latency of the blocks
+// proposed by the validator.
+func (s Stats) AverageLatency() time.Duration {
+	if s.ProposalsHit == 0 {
+		return 0
+	}
+	//#nosec: G115 // the window bounds the number of proposals.
+	return s.TotalLatency / time.Duration(s.ProposalsHit)
+}
+
+// Tracker tracks the proposal hits, misses and inclusion latency of each
+// validator over a sliding window of heights, as observed by this node while
+// following consensus. Heights processed while syncing are not tracked,
+// since their rounds are not observed. The records are persisted so that the
+// window survives restarts. A nil Tracker tracks nothing.
+type Tracker struct {
+	mu     sync.Mutex
+	sink   TelemetrySink
+	db     dbm.DB
+	window int
+
+	// records are the records of the window, by increasing height.
+	records []*Record
+	// stats are the stats of the window, by CometBFT address.
+	stats map[string]*Stats
+
+	// height is the height being decided, started at start, and proposers
+	// are the proposers of its rounds observed so far.
+	height    int64
+	start     time.Time
+	proposers map[int32][]byte
+}
+
+// NewTracker creates a Tracker over window heights, loading the records
+// persisted in db.
+func NewTracker(sink TelemetrySink, db dbm.DB, window int) (*Tracker, error) {
+	t := &Tracker{
+		sink:   sink,
+		db:     db,
+		window: window,
+		stats:  make(map[string]*Stats),
+	}
+	if err := t.load(); err != nil {
+		return nil, fmt.Errorf("failed loading performance records: %w", err)
+	}
+	return t, nil
+}
+
+// RoundStarted marks that the round of the height started at the given time,
+// to be proposed by proposer.
+func (t *Tracker) RoundStarted(height int64, round int32, proposer []byte, at time.Time) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if height != t.height {
+		t.height = height
+		t.proposers = make(map[int32][]byte)
+		// Only heights observed from their first round are tracked.
+		t.start = time.Time{}
+		if round == 0 {
+			t.start = at
+		}
+	}
+	t.proposers[round] = proposer
+}
+
+// BlockCommitted marks that the block proposed by proposer was committed at
+// the given height and time. The proposers of the other rounds of the height
+// are accounted as having missed their proposal.
+func (t *Tracker) BlockCommitted(height int64, proposer []byte, at time.Time) error {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if height != t.height || t.start.IsZero() {
+		return nil
+	}
+
+	record := &Record{
+		Height:   height,
+		Proposer: proposer,
+		Latency:  at.Sub(t.start),
+	}
+	rounds := slices.Sorted(maps.Keys(t.proposers))
+	for _, round := range rounds {
+		if p := t.proposers[round]; !bytes.Equal(p, proposer) {
+			record.Missed = append(record.Missed, p)
+		}
+	}
+	t.start = time.Time{}
+
+	if err := t.persist(record); err != nil {
+		return err
+	}
+	t.add(record)
+	for len(t.records) > t.window {
+		evicted := t.records[0]
+		t.records = t.records[1:]
+		t.remove(evicted)
+		if err := t.db.Delete(heightKey(evicted.Height)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Performance returns the stats of the validator with the given CometBFT
+// address over the window. The boolean is false if the validator neither
+// proposed nor missed a block in the window.
+func (t *Tracker) Performance(address []byte) (Stats, bool) {
+	if t == nil {
+		return Stats{}, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats, ok := t.stats[string(address)]
+	if !ok {
+		return Stats{}, false
+	}
+	return *stats, true
+}
+
+// Heights returns the number of heights currently tracked, up to the window.
+func (t *Tracker) Heights() int {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.records)
+}
+
+// add accounts the record in the stats. The caller must hold t.mu.
+func (t *Tracker) add(record *Record) {
+	t.records = append(t.records, record)
+	hit := t.statsOf(record.Proposer)
+	hit.ProposalsHit++
+	hit.TotalLatency += record.Latency
+	t.emit(record.Proposer, hit)
+	for _, missed := range record.Missed {
+		stats := t.statsOf(missed)
+		stats.ProposalsMissed++
+		t.emit(missed, stats)
+	}
+}
+
+// remove discounts the record from the stats, forgetting the validators
+// left without any proposal in the window. The caller must hold t.mu.
+func (t *Tracker) remove(record *Record) {
+	hit := t.statsOf(record.Proposer)
+	hit.ProposalsHit--
+	hit.TotalLatency -= record.Latency
+	t.emit(record.Proposer, hit)
+	for _, missed := range record.Missed {
+		stats := t.statsOf(missed)
+		stats.ProposalsMissed--
+		t.emit(missed, stats)
+	}
+	for _, address := range append([][]byte{record.Proposer}, record.Missed...) {
+		if stats, ok := t.stats[string(address)]; ok && *stats == (Stats{}) {
+			delete(t.stats, string(address))
+		}
+	}
+}
+
+// statsOf returns the stats of the address, creating them if needed. The
+// caller must hold t.mu.
+func (t *Tracker) statsOf(address []byte) *Stats {
+	stats, ok := t.stats[string(address)]
+	if !ok {
+		stats = &Stats{}
+		t.stats[string(address)] = stats
+	}
+	return stats
+}
+
+// emit sets the gauges of the validator with the given address.
+func (t *Tracker) emit(address []byte, stats *Stats) {
+	label := fmt.Sprintf("%X", address)
+	//#nosec: G115 // the window bounds the number of proposals.
+	t.sink.SetGauge(
+		"beacon_kit.validator.proposals_hit", int64(stats.ProposalsHit),
+		"address", label,
+	)
+	//#nosec: G115 // the window bounds the number of proposals.
+	t.sink.SetGauge(
+		"beacon_kit.validator.proposals_missed", int64(stats.ProposalsMissed),
+		"address", label,
+	)
+	t.sink.SetGauge(
+		"beacon_kit.validator.inclusion_latency_ms", stats.AverageLatency().Milliseconds(),
+		"address", label,
+	)
+}
+
+// persist stores the record.
+func (t *Tracker) persist(record *Record) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return t.db.Set(heightKey(record.Height), bz)
+}
+
+// load rebuilds the window from the persisted records, dropping the ones
+// falling out of it.
+func (t *Tracker) load() error {
+	iter, err := t.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var records []*Record
+	for ; iter.Valid(); iter.Next() {
+		record := &Record{}
+		if err = json.Unmarshal(iter.Value(), record); err != nil {
+			_ = iter.Close()
+			return err
+		}
+		records = append(records, record)
+	}
+	if err = iter.Close(); err != nil {
+		return err
+	}
+
+	for len(records) > t.window {
+		if err = t.db.Delete(heightKey(records[0].Height)); err != nil {
+			return err
+		}
+		records = records[1:]
+	}
+	for _, record := range records {
+		t.add(record)
+	}
+	return nil
+}
+
+// heightKey returns the key of the record of the height, ordered by height.
+func heightKey(height int64) []byte {
+	//#nosec: G115 // heights are never negative.
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/observability/performance"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+type gaugeSink struct {
+	gauges map[string]int64
+}
+
+func (s *gaugeSink) SetGauge(key string, value int64, args ...string) {
+	s.gauges[key+"/"+args[1]] = value
+}
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = []byte{0xaa}
+		bob   = []byte{0xbb}
+		start = time.Unix(1_700_000_000, 0)
+		sink  = &gaugeSink{gauges: make(map[string]int64)}
+		db    = dbm.NewMemDB()
+	)
+	tracker, err := performance.NewTracker(sink, db, 2)
+	require.NoError(t, err)
+
+	// Height 1 is committed by alice in the first round.
+	tracker.RoundStarted(1, 0, alice, start)
+	require.NoError(t, tracker.BlockCommitted(1, alice, start.Add(time.Second)))
+
+	// Height 2 is missed by bob, then committed by alice.
+	tracker.RoundStarted(2, 0, bob, start)
+	tracker.RoundStarted(2, 1, alice, start.Add(3*time.Second))
+	require.NoError(t, tracker.BlockCommitted(2, alice, start.Add(5*time.Second)))
+
+	// Height 3 is only observed from its second round, so it is not tracked.
+	tracker.RoundStarted(3, 1, bob, start)
+	require.NoError(t, tracker.BlockCommitted(3, bob, start.Add(time.Second)))
+
+	stats, found := tracker.Performance(alice)
+	require.True(t, found)
+	require.Equal(t, uint64(2), stats.ProposalsHit)
+	require.Equal(t, uint64(0), stats.ProposalsMissed)
+	require.Equal(t, 3*time.Second, stats.AverageLatency())
+	stats, found = tracker.Performance(bob)
+	require.True(t, found)
+	require.Equal(t, performance.Stats{ProposalsMissed: 1}, stats)
+	require.Equal(t, 2, tracker.Heights())
+	require.Equal(t, int64(2), sink.gauges["beacon_kit.validator.proposals_hit/AA"])
+	require.Equal(t, int64(1), sink.gauges["beacon_kit.validator.proposals_missed/BB"])
+	require.Equal(t, int64(3000), sink.gauges["beacon_kit.validator.inclusion_latency_ms/AA"])
+
+	// The window is reloaded on restart.
+	tracker, err = performance.NewTracker(sink, db, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, tracker.Heights())
+	stats, _ = tracker.Performance(alice)
+	require.Equal(t, uint64(2), stats.ProposalsHit)
+
+	// Height 4 evicts height 1 from the window, then height 5 evicts
+	// bob's miss of height 2.
+	tracker.RoundStarted(4, 0, bob, start)
+	require.NoError(t, tracker.BlockCommitted(4, bob, start.Add(2*time.Second)))
+	tracker.RoundStarted(5, 0, bob, start)
+	require.NoError(t, tracker.BlockCommitted(5, bob, start.Add(2*time.Second)))
+	_, found = tracker.Performance(alice)
+	require.False(t, found)
+	stats, _ = tracker.Performance(bob)
+	require.Equal(t, performance.Stats{ProposalsHit: 2, TotalLatency: 4 * time.Second}, stats)
+	require.Equal(t, int64(0), sink.gauges["beacon_kit.validator.proposals_hit/AA"])
+
+	// Evicted records are deleted from storage.
+	tracker, err = performance.NewTracker(sink, db, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, tracker.Heights())
+}
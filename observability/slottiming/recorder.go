@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slottiming
+
+import (
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// DefaultCapacity is the number of slot timelines kept in memory.
+const DefaultCapacity = 256
+
+// TelemetrySink is the subset of the telemetry sink used by the Recorder.
+type TelemetrySink interface {
+	// AddSample adds a sample to a histogram metric identified by the
+	// provided key.
+	AddSample(key string, value float64, args ...string)
+}
+
+// Timeline is the proposer timeline of a single slot. Zero values mark
+// events that did not happen on this node, e.g. because the payload was
+// built synchronously without an earlier forkchoice update.
+type Timeline struct {
+	Slot math.Slot
+	// ProposalRequested is when consensus asked for a block for the slot.
+	ProposalRequested time.Time
+	// ForkchoiceSent is when the forkchoice update starting the payload
+	// build was sent to the execution client.
+	ForkchoiceSent time.Time
+	// PayloadReady is when the execution client returned the payload.
+	PayloadReady time.Time
+	// GetPayloadLatency is the duration of the engine_getPayload call.
+	GetPayloadLatency time.Duration
+	// BlockBroadcast is when the signed block was handed back to consensus
+	// for broadcast.
+	BlockBroadcast time.Time
+}
+
+// Recorder keeps the proposer timelines of the most recent slots and emits
+// the derived durations as metrics. A nil Recorder records nothing.
+type Recorder struct {
+	mu        sync.Mutex
+	sink      TelemetrySink
+	timelines *lru.Cache[math.Slot, *Timeline]
+}
+
+// NewRecorder creates a Recorder keeping up to capacity slot timelines.
+func NewRecorder(sink TelemetrySink, capacity int) (*Recorder, error) {
+	timelines, err := lru.New[math.Slot, *Timeline](capacity)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		sink:      sink,
+		timelines: timelines,
+	}, nil
+}
+
+// ProposalRequested marks that consensus asked for a block for the slot.
+func (r *Recorder) ProposalRequested(slot math.Slot) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeline(slot).ProposalRequested = time.Now()
+}
+
+// ForkchoiceSent marks that the forkchoice update building the payload for
+// the slot was sent to the execution client.
+func (r *Recorder) ForkchoiceSent(slot math.Slot) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeline(slot).ForkchoiceSent = time.Now()
+}
+
+// PayloadReady marks that the payload for the slot was returned by the
+// execution client after a getPayload call lasting latency.
+func (r *Recorder) PayloadReady(slot math.Slot, latency time.Duration) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tl := r.timeline(slot)
+	tl.PayloadReady = time.Now()
+	tl.GetPayloadLatency = latency
+
+	r.sink.AddSample(
+		"beacon_kit.slot_timing.get_payload_latency_ms", milliseconds(latency),
+	)
+	if !tl.ForkchoiceSent.IsZero() {
+		r.sink.AddSample(
+			"beacon_kit.slot_timing.forkchoice_to_payload_ms",
+			milliseconds(tl.PayloadReady.Sub(tl.ForkchoiceSent)),
+		)
+	}
+}
+
+// BlockBroadcast marks that the signed block for the slot was handed back
+// to consensus.
+func (r *Recorder) BlockBroadcast(slot math.Slot) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tl := r.timeline(slot)
+	tl.BlockBroadcast = time.Now()
+
+	if !tl.PayloadReady.IsZero() {
+		r.sink.AddSample(
+			"beacon_kit.slot_timing.payload_to_broadcast_ms",
+			milliseconds(tl.BlockBroadcast.Sub(tl.PayloadReady)),
+		)
+	}
+	if !tl.ProposalRequested.IsZero() {
+		r.sink.AddSample(
+			"beacon_kit.slot_timing.proposal_to_broadcast_ms",
+			milliseconds(tl.BlockBroadcast.Sub(tl.ProposalRequested)),
+		)
+	}
+}
+
+// Get returns a copy of the timeline recorded for the slot.
+func (r *Recorder) Get(slot math.Slot) (Timeline, bool) {
+	if r == nil {
+		return Timeline{}, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tl, ok := r.timelines.Get(slot)
+	if !ok {
+		return Timeline{}, false
+	}
+	return *tl, true
+}
+
+// timeline returns the timeline for the slot, creating it if needed. The
+// caller must hold r.mu.
+func (r *Recorder) timeline(slot math.Slot) *Timeline {
+	if tl, ok := r.timelines.Get(slot); ok {
+		return tl
+	}
+	tl := &Timeline{Slot: slot}
+	r.timelines.Add(slot, tl)
+	return tl
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
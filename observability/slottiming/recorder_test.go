@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slottiming_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/observability/slottiming"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	samples map[string]int
+}
+
+func (s *recordingSink) AddSample(key string, _ float64, _ ...string) {
+	s.samples[key]++
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	sink := &recordingSink{samples: make(map[string]int)}
+	r, err := slottiming.NewRecorder(sink, 2)
+	require.NoError(t, err)
+
+	_, found := r.Get(1)
+	require.False(t, found)
+
+	// Slot 1 goes through the full optimistic build.
+	r.ForkchoiceSent(1)
+	r.ProposalRequested(1)
+	r.PayloadReady(1, 5*time.Millisecond)
+	r.BlockBroadcast(1)
+
+	tl, found := r.Get(1)
+	require.True(t, found)
+	require.Equal(t, math.Slot(1), tl.Slot)
+	require.Equal(t, 5*time.Millisecond, tl.GetPayloadLatency)
+	require.False(t, tl.PayloadReady.Before(tl.ForkchoiceSent))
+	require.False(t, tl.BlockBroadcast.Before(tl.PayloadReady))
+	require.Equal(t, map[string]int{
+		"beacon_kit.slot_timing.get_payload_latency_ms":   1,
+		"beacon_kit.slot_timing.forkchoice_to_payload_ms": 1,
+		"beacon_kit.slot_timing.payload_to_broadcast_ms":  1,
+		"beacon_kit.slot_timing.proposal_to_broadcast_ms": 1,
+	}, sink.samples)
+
+	// Slot 2 is broadcast without any recorded payload.
+	r.BlockBroadcast(2)
+	tl, found = r.Get(2)
+	require.True(t, found)
+	require.True(t, tl.PayloadReady.IsZero())
+	require.Equal(t, 1, sink.samples["beacon_kit.slot_timing.payload_to_broadcast_ms"])
+
+	// Only the most recent slots are kept.
+	r.ForkchoiceSent(3)
+	_, found = r.Get(1)
+	require.False(t, found)
+}
+
+func TestRecorderNil(t *testing.T) {
+	t.Parallel()
+
+	var r *slottiming.Recorder
+	r.ForkchoiceSent(1)
+	r.PayloadReady(1, time.Second)
+	r.BlockBroadcast(1)
+	_, found := r.Get(1)
+	require.False(t, found)
+}
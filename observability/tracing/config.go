@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+const (
+	defaultEndpoint    = "127.0.0.1:4317"
+	defaultServiceName = "beacond"
+	defaultSampleRatio = 1.0
+)
+
+// Config is the configuration for exporting block lifecycle traces.
+type Config struct {
+	// Enabled is the flag to enable exporting traces.
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint is the host:port of the OTLP gRPC collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS on the connection to the collector.
+	Insecure bool `mapstructure:"insecure"`
+	// ServiceName is the name traces are reported under.
+	ServiceName string `mapstructure:"service-name"`
+	// SampleRatio is the fraction of blocks that are traced, between 0 and 1.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+}
+
+// DefaultConfig returns the default configuration for tracing.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Endpoint:    defaultEndpoint,
+		Insecure:    true,
+		ServiceName: defaultServiceName,
+		SampleRatio: defaultSampleRatio,
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// shutdownTimeout bounds the time spent flushing pending spans on stop.
+const shutdownTimeout = 5 * time.Second
+
+// Service exports the spans of the block lifecycle to an OTLP collector.
+type Service struct {
+	cfg      Config
+	logger   log.Logger
+	provider *sdktrace.TracerProvider
+}
+
+// NewService creates a new tracing service.
+func NewService(cfg Config, logger log.Logger) *Service {
+	return &Service{
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "tracing"
+}
+
+// Start installs the global tracer provider if tracing is enabled. Spans are
+// otherwise started on the no-op provider and are never recorded.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(s.cfg.Endpoint)}
+	if s.cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return err
+	}
+
+	// Block trace IDs are deterministic, so sampling on the trace ID makes
+	// every node trace the same blocks.
+	sampler := sdktrace.TraceIDRatioBased(s.cfg.SampleRatio)
+	s.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sampler, sdktrace.WithRemoteParentNotSampled(sampler),
+		)),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(s.cfg.ServiceName),
+		)),
+	)
+	otel.SetTracerProvider(s.provider)
+
+	s.logger.Info(
+		"Exporting traces",
+		"endpoint", s.cfg.Endpoint,
+		"sample_ratio", s.cfg.SampleRatio,
+	)
+	return nil
+}
+
+// Stop flushes pending spans and shuts down the tracer provider.
+func (s *Service) Stop() error {
+	if s.provider == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.provider.Shutdown(ctx)
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of all beacon-kit spans.
+const tracerName = "github.com/berachain/beacon-kit"
+
+// blockTraceDomain separates block trace IDs from any other use of the hash.
+const blockTraceDomain = "beacon-kit/block-trace"
+
+// BlockTraceID returns the trace ID of the block at the given height. It is
+// derived from the height alone, so every node reports the lifecycle of a
+// block under the same trace and its logs can be joined with the traces.
+func BlockTraceID(height int64) trace.TraceID {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(height)) // #nosec G115
+	h := sha256.Sum256(append([]byte(blockTraceDomain), buf[:]...))
+
+	var id trace.TraceID
+	copy(id[:], h[:len(id)])
+	return id
+}
+
+// ContextWithBlockTrace returns a copy of ctx in which spans are started as
+// part of the trace of the block at the given height.
+func ContextWithBlockTrace(ctx context.Context, height int64) context.Context {
+	traceID := BlockTraceID(height)
+
+	var spanID trace.SpanID
+	copy(spanID[:], traceID[len(traceID)-len(spanID):])
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(
+		trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+			Remote:  true,
+		},
+	))
+}
+
+// TraceID returns the hex encoded trace ID carried by ctx, or an empty string
+// if ctx does not carry one. It is meant to be attached to log lines.
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+// StartSpan starts a span with the given name and attributes as a child of
+// the span in ctx, if any.
+func StartSpan(
+	ctx context.Context, name string, attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(
+		ctx, name, trace.WithAttributes(attrs...),
+	)
+}
+
+// EndSpan records err on span, if not nil, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
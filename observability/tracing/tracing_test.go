@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockTraceID(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, tracing.BlockTraceID(10), tracing.BlockTraceID(10))
+	require.NotEqual(t, tracing.BlockTraceID(10), tracing.BlockTraceID(11))
+	require.True(t, tracing.BlockTraceID(0).IsValid())
+}
+
+func TestContextWithBlockTrace(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, tracing.TraceID(context.Background()))
+
+	ctx := tracing.ContextWithBlockTrace(context.Background(), 42)
+	want := tracing.BlockTraceID(42).String()
+	require.Equal(t, want, tracing.TraceID(ctx))
+
+	// Spans started within the block keep its trace ID.
+	ctx, span := tracing.StartSpan(ctx, "child")
+	defer tracing.EndSpan(span, nil)
+	require.Equal(t, want, tracing.TraceID(ctx))
+}
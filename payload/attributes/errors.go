@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrNilPayloadAttributes is returned when a forkchoice update that
+	// builds a payload is requested without payload attributes.
+	ErrNilPayloadAttributes = errors.New("nil payload attributes")
+
+	// ErrTimestampNotAfterParent is returned when the payload attributes
+	// timestamp does not come after the timestamp of the parent payload.
+	ErrTimestampNotAfterParent = errors.New(
+		"payload attributes timestamp not after parent timestamp",
+	)
+
+	// ErrTooManyWithdrawals is returned when the payload attributes carry more
+	// withdrawals than a payload can include.
+	ErrTooManyWithdrawals = errors.New(
+		"payload attributes withdrawals exceed max withdrawals per payload",
+	)
+)
@@ -21,7 +21,10 @@
 package attributes
 
 import (
+	"sync"
+
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -33,9 +36,13 @@ type Factory struct {
 	chainSpec ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger
+	// sink counts the forkchoice updates produced, by kind.
+	sink TelemetrySink
 	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	// the execution client for the payload build. It can be changed at
+	// runtime.
+	suggestedFeeRecipientMu sync.RWMutex
+	suggestedFeeRecipient   common.ExecutionAddress
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -43,10 +50,12 @@ func NewAttributesFactory(
 	chainSpec ChainSpec,
 	logger log.Logger,
 	suggestedFeeRecipient common.ExecutionAddress,
+	telemetrySink TelemetrySink,
 ) *Factory {
 	return &Factory{
 		chainSpec:             chainSpec,
 		logger:                logger,
+		sink:                  telemetrySink,
 		suggestedFeeRecipient: suggestedFeeRecipient,
 	}
 }
@@ -58,12 +67,64 @@ func (f *Factory) BuildPayloadAttributes(
 	prevRandao common.Bytes32,
 	prevHeadRoot common.Root,
 ) (*engineprimitives.PayloadAttributes, error) {
-	return engineprimitives.NewPayloadAttributes(
+	return f.newBuilder(timestamp).
+		WithTimestamp(timestamp).
+		WithPrevRandao(prevRandao).
+		WithSuggestedFeeRecipient(f.SuggestedFeeRecipient()).
+		WithWithdrawals(payloadWithdrawals).
+		WithParentBeaconBlockRoot(prevHeadRoot).
+		Build()
+}
+
+// SuggestedFeeRecipient returns the fee recipient suggested to the execution
+// client for the payload builds.
+func (f *Factory) SuggestedFeeRecipient() common.ExecutionAddress {
+	f.suggestedFeeRecipientMu.RLock()
+	defer f.suggestedFeeRecipientMu.RUnlock()
+	return f.suggestedFeeRecipient
+}
+
+// SetSuggestedFeeRecipient changes the fee recipient suggested for the
+// subsequent payload builds.
+func (f *Factory) SetSuggestedFeeRecipient(addr common.ExecutionAddress) {
+	f.suggestedFeeRecipientMu.Lock()
+	defer f.suggestedFeeRecipientMu.Unlock()
+	f.suggestedFeeRecipient = addr
+}
+
+// Validate cross-checks the payload attributes against the parent payload they
+// build on, so that invalid attributes are reported before the execution
+// client rejects the forkchoice update.
+func (f *Factory) Validate(
+	attrs *engineprimitives.PayloadAttributes,
+	slot math.Slot,
+	parentTimestamp math.U64,
+) error {
+	if attrs.Timestamp <= parentTimestamp {
+		return errors.Wrapf(
+			ErrTimestampNotAfterParent,
+			"timestamp %d, parent timestamp %d", attrs.Timestamp, parentTimestamp,
+		)
+	}
+	maxWithdrawals := f.chainSpec.MaxWithdrawalsPerPayload()
+	if uint64(len(attrs.Withdrawals)) > maxWithdrawals {
+		return errors.Wrapf(
+			ErrTooManyWithdrawals,
+			"%d withdrawals, max %d", len(attrs.Withdrawals), maxWithdrawals,
+		)
+	}
+	if slot > 0 && attrs.PrevRandao == (common.Bytes32{}) {
+		return engineprimitives.ErrEmptyPrevRandao
+	}
+	return nil
+}
+
+// newBuilder returns a payload attributes builder for the fork active at the
+// given timestamp. Fork-specific attribute fields should be populated here.
+func (f *Factory) newBuilder(
+	timestamp math.U64,
+) *engineprimitives.PayloadAttributesBuilder {
+	return engineprimitives.NewPayloadAttributesBuilder(
 		f.chainSpec.ActiveForkVersionForTimestamp(timestamp),
-		timestamp,
-		prevRandao,
-		f.suggestedFeeRecipient,
-		payloadWithdrawals,
-		prevHeadRoot,
 	)
 }
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/config/spec"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/stretchr/testify/require"
+)
+
+// countingSink counts the increments of each counter, keyed by the counter
+// and its labels.
+type countingSink map[string]int
+
+func (s countingSink) IncrementCounter(key string, args ...string) {
+	s[strings.Join(append([]string{key}, args...), " ")]++
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	f := attributes.NewAttributesFactory(
+		cs, log.NewNopLogger(), common.ExecutionAddress{}, countingSink{},
+	)
+
+	valid := func() *engineprimitives.PayloadAttributes {
+		return &engineprimitives.PayloadAttributes{
+			Timestamp:   11,
+			PrevRandao:  common.Bytes32{0x01},
+			Withdrawals: engineprimitives.Withdrawals{},
+		}
+	}
+	require.NoError(t, f.Validate(valid(), 5, 10))
+
+	attrs := valid()
+	attrs.Timestamp = 10
+	require.ErrorIs(t, f.Validate(attrs, 5, 10), attributes.ErrTimestampNotAfterParent)
+
+	attrs = valid()
+	attrs.Withdrawals = make(
+		engineprimitives.Withdrawals, cs.MaxWithdrawalsPerPayload()+1,
+	)
+	require.ErrorIs(t, f.Validate(attrs, 5, 10), attributes.ErrTooManyWithdrawals)
+
+	attrs = valid()
+	attrs.PrevRandao = common.Bytes32{}
+	require.ErrorIs(t, f.Validate(attrs, 5, 10), engineprimitives.ErrEmptyPrevRandao)
+	require.NoError(t, f.Validate(attrs, 0, 10))
+}
+
+func TestForkchoiceUpdates(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	sink := countingSink{}
+	f := attributes.NewAttributesFactory(
+		cs, log.NewNopLogger(), common.ExecutionAddress{}, sink,
+	)
+	state := &engineprimitives.ForkchoiceStateV1{HeadBlockHash: common.ExecutionHash{0x01}}
+
+	head := f.HeadForkchoiceUpdate(state, 10)
+	require.Nil(t, head.PayloadAttributes)
+	require.Equal(t, state, head.State)
+	require.Equal(t, cs.ActiveForkVersionForTimestamp(10), head.ForkVersion)
+
+	_, err = f.BuildForkchoiceUpdate(state, nil)
+	require.ErrorIs(t, err, attributes.ErrNilPayloadAttributes)
+
+	attrs := &engineprimitives.PayloadAttributes{Timestamp: 11}
+	build, err := f.BuildForkchoiceUpdate(state, attrs)
+	require.NoError(t, err)
+	require.Equal(t, attrs, build.PayloadAttributes)
+	require.Equal(t, cs.ActiveForkVersionForTimestamp(11), build.ForkVersion)
+
+	// Rejected builds are not counted.
+	require.Equal(t, countingSink{
+		"beacon_kit.payload.attributes.forkchoice_update kind head":  1,
+		"beacon_kit.payload.attributes.forkchoice_update kind build": 1,
+	}, sink)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// HeadForkchoiceUpdate returns a forkchoice update that only moves the head
+// of the execution client, without triggering a payload build. The fork is
+// the one active at the timestamp of the head payload.
+func (f *Factory) HeadForkchoiceUpdate(
+	state *engineprimitives.ForkchoiceStateV1,
+	headTimestamp math.U64,
+) *ctypes.ForkchoiceUpdateRequest {
+	f.sink.IncrementCounter(
+		"beacon_kit.payload.attributes.forkchoice_update", "kind", "head",
+	)
+	return ctypes.BuildForkchoiceUpdateRequestNoAttrs(
+		state, f.chainSpec.ActiveForkVersionForTimestamp(headTimestamp),
+	)
+}
+
+// BuildForkchoiceUpdate returns a forkchoice update that also starts building
+// a payload with the given attributes on top of the head. The fork is the one
+// active at the timestamp of the payload to build.
+func (f *Factory) BuildForkchoiceUpdate(
+	state *engineprimitives.ForkchoiceStateV1,
+	attrs *engineprimitives.PayloadAttributes,
+) (*ctypes.ForkchoiceUpdateRequest, error) {
+	if attrs == nil {
+		return nil, ErrNilPayloadAttributes
+	}
+	f.sink.IncrementCounter(
+		"beacon_kit.payload.attributes.forkchoice_update", "kind", "build",
+	)
+	return ctypes.BuildForkchoiceUpdateRequest(
+		state, attrs, f.chainSpec.ActiveForkVersionForTimestamp(attrs.Timestamp),
+	), nil
+}
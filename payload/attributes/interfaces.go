@@ -25,8 +25,16 @@ import (
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
+
 type ChainSpec interface {
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 	EpochsPerHistoricalVector() uint64
+	MaxWithdrawalsPerPayload() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
 }
@@ -21,7 +21,15 @@
 package builder
 
 import (
+	"context"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/slottiming"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // PayloadBuilder is used to build payloads on the
@@ -41,6 +49,23 @@ type PayloadBuilder struct {
 	pc PayloadCache
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory
+	// timings records when payloads are requested and delivered.
+	timings *slottiming.Recorder
+	// delays tunes the wait before fetching a payload when adaptive payload
+	// delay is enabled.
+	delays *DelayTuner
+	// built is the last payload fetched from the execution client, kept so
+	// that a proposal retry in the same slot does not rebuild it.
+	built builtPayload
+}
+
+// builtPayload is a payload fetched from the execution client together with
+// the slot and parent block it was built for.
+type builtPayload struct {
+	mu              sync.Mutex
+	slot            math.Slot
+	parentBlockRoot common.Root
+	envelope        ctypes.BuiltExecutionPayloadEnv
 }
 
 // New creates a new service.
@@ -51,6 +76,7 @@ func New(
 	ee ExecutionEngine,
 	pc PayloadCache,
 	af AttributesFactory,
+	timings *slottiming.Recorder,
 ) *PayloadBuilder {
 	return &PayloadBuilder{
 		cfg:               cfg,
@@ -59,7 +85,22 @@ func New(
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		timings:           timings,
+		delays:            NewDelayTuner(cfg.MinPayloadDelay, cfg.PayloadTimeout),
+	}
+}
+
+// payloadDelay returns how long to let the execution client build a payload
+// before fetching it.
+func (pb *PayloadBuilder) payloadDelay(ctx context.Context) time.Duration {
+	if !pb.cfg.AdaptivePayloadDelay {
+		return pb.cfg.PayloadTimeout
+	}
+	var budget time.Duration
+	if deadline, ok := ctx.Deadline(); ok {
+		budget = time.Until(deadline)
 	}
+	return pb.delays.Delay(budget)
 }
 
 // Enabled returns true if the payload builder is enabled.
@@ -30,6 +30,9 @@ const (
 	// defaultPayloadTimeout is the default value for local build
 	// payload timeout.
 	defaultPayloadTimeout = 850 * time.Millisecond
+	// defaultMinPayloadDelay is the default lower bound of the adaptive
+	// payload delay.
+	defaultMinPayloadDelay = 250 * time.Millisecond
 )
 
 // Config is the configuration for the payload builder.
@@ -44,6 +47,13 @@ type Config struct {
 	// timeout on your execution client. It also must be less than
 	// timeout_proposal in the CometBFT configuration.
 	PayloadTimeout time.Duration `mapstructure:"payload-timeout"`
+	// AdaptivePayloadDelay tunes how long to wait for a locally built
+	// payload from the recent getPayload latencies, instead of always
+	// waiting PayloadTimeout. PayloadTimeout is then the maximum delay.
+	AdaptivePayloadDelay bool `mapstructure:"adaptive-payload-delay"`
+	// MinPayloadDelay is the minimum delay before fetching a locally built
+	// payload when AdaptivePayloadDelay is enabled.
+	MinPayloadDelay time.Duration `mapstructure:"min-payload-delay"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -52,5 +62,7 @@ func DefaultConfig() Config {
 		Enabled:               true,
 		SuggestedFeeRecipient: common.ExecutionAddress{},
 		PayloadTimeout:        defaultPayloadTimeout,
+		AdaptivePayloadDelay:  false,
+		MinPayloadDelay:       defaultMinPayloadDelay,
 	}
 }
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// delayWindow is the number of recent getPayload latencies used to tune
+	// the payload delay.
+	delayWindow = 32
+	// delaySafetyMargin is kept free on top of the expected getPayload
+	// latency so that a slightly slower execution client does not make the
+	// proposal late.
+	delaySafetyMargin = 50 * time.Millisecond
+)
+
+// DelayTuner picks how long to let the execution client build a payload
+// before calling getPayload. It waits as long as the budget allows, minus
+// the 90th percentile of the recent getPayload latencies and a safety
+// margin, so that payloads carry as many transactions as possible without
+// missing the proposal.
+type DelayTuner struct {
+	mu        sync.Mutex
+	minDelay  time.Duration
+	maxDelay  time.Duration
+	latencies []time.Duration
+	next      int
+}
+
+// NewDelayTuner creates a DelayTuner bounded by minDelay and maxDelay.
+func NewDelayTuner(minDelay, maxDelay time.Duration) *DelayTuner {
+	return &DelayTuner{
+		minDelay:  min(minDelay, maxDelay),
+		maxDelay:  maxDelay,
+		latencies: make([]time.Duration, 0, delayWindow),
+	}
+}
+
+// Observe records the latency of a getPayload call.
+func (t *DelayTuner) Observe(latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.latencies) < delayWindow {
+		t.latencies = append(t.latencies, latency)
+		return
+	}
+	t.latencies[t.next] = latency
+	t.next = (t.next + 1) % delayWindow
+}
+
+// Delay returns the payload delay for a build that must be delivered within
+// budget. A non-positive budget means the maximum delay is available.
+func (t *DelayTuner) Delay(budget time.Duration) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if budget <= 0 || budget > t.maxDelay {
+		budget = t.maxDelay
+	}
+	delay := budget - t.expectedLatency() - delaySafetyMargin
+	return min(max(delay, t.minDelay), t.maxDelay)
+}
+
+// expectedLatency returns the 90th percentile of the recorded latencies.
+// The caller must hold t.mu.
+func (t *DelayTuner) expectedLatency() time.Duration {
+	if len(t.latencies) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(t.latencies)
+	slices.Sort(sorted)
+	//nolint:mnd // 90th percentile.
+	return sorted[(len(sorted)-1)*9/10]
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelayTuner(t *testing.T) {
+	t.Parallel()
+
+	const (
+		minDelay = 200 * time.Millisecond
+		maxDelay = 850 * time.Millisecond
+	)
+	tuner := builder.NewDelayTuner(minDelay, maxDelay)
+
+	// Without observations only the safety margin is kept.
+	require.Equal(t, 800*time.Millisecond, tuner.Delay(0))
+
+	// The 90th percentile latency is subtracted from the budget.
+	for i := 0; i < 9; i++ {
+		tuner.Observe(10 * time.Millisecond)
+	}
+	tuner.Observe(500 * time.Millisecond)
+	require.Equal(t, 790*time.Millisecond, tuner.Delay(0))
+
+	// A slow execution client pushes the delay down to the minimum.
+	for i := 0; i < 32; i++ {
+		tuner.Observe(700 * time.Millisecond)
+	}
+	require.Equal(t, minDelay, tuner.Delay(0))
+
+	// A tight budget is honoured, still bounded by the minimum.
+	fast := builder.NewDelayTuner(minDelay, maxDelay)
+	fast.Observe(50 * time.Millisecond)
+	require.Equal(t, 400*time.Millisecond, fast.Delay(500*time.Millisecond))
+	require.Equal(t, minDelay, fast.Delay(100*time.Millisecond))
+	require.Equal(t, 750*time.Millisecond, fast.Delay(time.Hour))
+}
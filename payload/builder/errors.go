@@ -40,4 +40,10 @@ var (
 
 	// ErrNilWithdrawals is returned when nil withdrawals list is received.
 	ErrNilWithdrawals = errors.New("nil withdrawals received from execution client")
+
+	// ErrProposingHalted is returned when block proposals were halted after
+	// the execution clients disagreed on the validity of a payload.
+	ErrProposingHalted = errors.New(
+		"proposing halted after execution clients disagreed on a payload",
+	)
 )
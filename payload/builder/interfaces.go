@@ -43,6 +43,22 @@ type AttributesFactory interface {
 		prevRandao common.Bytes32,
 		prevHeadRoot common.Root,
 	) (*engineprimitives.PayloadAttributes, error)
+	// Validate cross-checks the payload attributes against the parent
+	// payload they build on.
+	Validate(
+		attrs *engineprimitives.PayloadAttributes,
+		slot math.Slot,
+		parentTimestamp math.U64,
+	) error
+	// SuggestedFeeRecipient returns the fee recipient suggested to the
+	// execution client for the payload builds.
+	SuggestedFeeRecipient() common.ExecutionAddress
+	// BuildForkchoiceUpdate returns a forkchoice update that also starts
+	// building a payload with the given attributes.
+	BuildForkchoiceUpdate(
+		state *engineprimitives.ForkchoiceStateV1,
+		attrs *engineprimitives.PayloadAttributes,
+	) (*ctypes.ForkchoiceUpdateRequest, error)
 }
 
 // ExecutionEngine is the interface for the execution engine.
@@ -58,6 +74,12 @@ type ExecutionEngine interface {
 		ctx context.Context,
 		req *ctypes.ForkchoiceUpdateRequest,
 	) (*engineprimitives.PayloadID, error)
+	// ProposingHalted returns true if block proposals were halted after the
+	// execution clients disagreed on the validity of a payload.
+	ProposingHalted() bool
+	// CheckClientVersion returns an error if the execution client runs a
+	// version denied at the given fork.
+	CheckClientVersion(forkVersion common.Version) error
 }
 
 type ChainSpec interface {
@@ -37,6 +37,7 @@ type RequestPayloadData struct {
 	PayloadWithdrawals engineprimitives.Withdrawals
 	PrevRandao         common.Bytes32
 	ParentBlockRoot    common.Root
+	ParentTimestamp    math.U64
 	HeadEth1BlockHash  common.ExecutionHash
 	FinalEth1BlockHash common.ExecutionHash
 }
@@ -70,18 +71,24 @@ func (pb *PayloadBuilder) RequestPayloadAsync(
 	if err != nil {
 		return nil, common.Version{}, err
 	}
+	if err = pb.attributesFactory.Validate(attrs, r.Slot, r.ParentTimestamp); err != nil {
+		return nil, common.Version{}, fmt.Errorf("invalid payload attributes: %w", err)
+	}
 
 	forkVersion := pb.chainSpec.ActiveForkVersionForTimestamp(r.Timestamp)
 	// Submit the forkchoice update to the execution client.
-	req := ctypes.BuildForkchoiceUpdateRequest(
+	req, err := pb.attributesFactory.BuildForkchoiceUpdate(
 		&engineprimitives.ForkchoiceStateV1{
 			HeadBlockHash:      r.HeadEth1BlockHash,
 			SafeBlockHash:      r.FinalEth1BlockHash,
 			FinalizedBlockHash: r.FinalEth1BlockHash,
 		},
 		attrs,
-		forkVersion,
 	)
+	if err != nil {
+		return nil, common.Version{}, err
+	}
+	pb.timings.ForkchoiceSent(r.Slot)
 	payloadID, err := pb.ee.NotifyForkchoiceUpdate(ctx, req)
 	if err != nil {
 		return nil, common.Version{}, fmt.Errorf("RequestPayloadAsync failed sending forkchoice update: %w", err)
@@ -116,12 +123,13 @@ func (pb *PayloadBuilder) RequestPayloadSync(
 	}
 
 	// Wait for the payload to be delivered to the execution client.
+	delay := pb.payloadDelay(ctx)
 	pb.logger.Info(
 		"Waiting for local payload to be delivered to execution client",
-		"for_slot", r.Slot.Base10(), "timeout", pb.cfg.PayloadTimeout.String(),
+		"for_slot", r.Slot.Base10(), "timeout", delay.String(),
 	)
 	select {
-	case <-time.After(pb.cfg.PayloadTimeout):
+	case <-time.After(delay):
 		// We want to trigger delivery of the payload to the execution client
 		// before the timestamp expires.
 		break
@@ -130,7 +138,7 @@ func (pb *PayloadBuilder) RequestPayloadSync(
 	}
 
 	// Get the payload from the execution client.
-	return pb.getPayload(ctx, *payloadID, forkVersion)
+	return pb.getPayload(ctx, r.Slot, r.ParentBlockRoot, *payloadID, forkVersion)
 }
 
 // RetrievePayload attempts to pull a previously built payload
@@ -154,7 +162,9 @@ func (pb *PayloadBuilder) RetrievePayload(
 	}
 
 	// Get the payload from the execution client.
-	envelope, err := pb.getPayload(ctx, payloadID.PayloadID, payloadID.ForkVersion)
+	envelope, err := pb.getPayload(
+		ctx, slot, parentBlockRoot, payloadID.PayloadID, payloadID.ForkVersion,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -162,12 +172,13 @@ func (pb *PayloadBuilder) RetrievePayload(
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
 	payload := envelope.GetExecutionPayload()
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	suggested := pb.attributesFactory.SuggestedFeeRecipient()
+	if payload.GetFeeRecipient() != suggested {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", suggested,
 		)
 	}
 
@@ -186,11 +197,41 @@ func (pb *PayloadBuilder) RetrievePayload(
 	return envelope, err
 }
 
+// RetrieveBuiltPayload returns the payload last fetched from the execution
+// client if it was built for the given slot and parent block root, so that a
+// proposal retried in a later round can reuse it. Callers must still check
+// that the payload is valid for the new round.
+func (pb *PayloadBuilder) RetrieveBuiltPayload(
+	slot math.Slot,
+	parentBlockRoot common.Root,
+) (ctypes.BuiltExecutionPayloadEnv, bool) {
+	if pb.ee.ProposingHalted() {
+		return nil, false
+	}
+	pb.built.mu.Lock()
+	defer pb.built.mu.Unlock()
+	if pb.built.envelope == nil ||
+		pb.built.slot != slot ||
+		pb.built.parentBlockRoot != parentBlockRoot {
+		return nil, false
+	}
+	return pb.built.envelope, true
+}
+
 func (pb *PayloadBuilder) getPayload(
 	ctx context.Context,
+	slot math.Slot,
+	parentBlockRoot common.Root,
 	payloadID engineprimitives.PayloadID,
 	forkVersion common.Version,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
+	if pb.ee.ProposingHalted() {
+		return nil, ErrProposingHalted
+	}
+	if err := pb.ee.CheckClientVersion(forkVersion); err != nil {
+		return nil, err
+	}
+	start := time.Now()
 	envelope, err := pb.ee.GetPayload(
 		ctx,
 		&ctypes.GetPayloadRequest{
@@ -201,11 +242,17 @@ func (pb *PayloadBuilder) getPayload(
 	if err != nil {
 		return nil, err
 	}
+	pb.delays.Observe(time.Since(start))
 	if envelope == nil {
 		return nil, ErrNilPayloadEnvelope
 	}
 	if envelope.GetExecutionPayload().Withdrawals == nil {
 		return nil, ErrNilWithdrawals
 	}
+	pb.timings.PayloadReady(slot, time.Since(start))
+
+	pb.built.mu.Lock()
+	pb.built.slot, pb.built.parentBlockRoot, pb.built.envelope = slot, parentBlockRoot, envelope
+	pb.built.mu.Unlock()
 	return envelope, nil
 }
@@ -87,6 +87,7 @@ func TestRetrievePayloadSunnyPath(t *testing.T) {
 		ee,
 		cache,
 		af,
+		nil,
 	)
 
 	// create inputs and set expectations
@@ -135,6 +136,7 @@ func TestRetrievePayloadNilWithdrawalsListRejected(t *testing.T) {
 		ee,
 		cache,
 		af,
+		nil,
 	)
 
 	// create inputs
@@ -161,6 +163,103 @@ func TestRetrievePayloadNilWithdrawalsListRejected(t *testing.T) {
 	require.ErrorIs(t, builder.ErrNilWithdrawals, err)
 }
 
+func TestRetrieveBuiltPayloadOnRetry(t *testing.T) {
+	t.Parallel()
+
+	chainSpec, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	var (
+		cfg   = &builder.Config{Enabled: true}
+		ee    = &stubExecutionEngine{}
+		cache = cache.NewPayloadIDCache()
+	)
+	pb := builder.New(
+		cfg,
+		chainSpec,
+		noop.NewLogger[any](),
+		ee,
+		cache,
+		&stubAttributesFactory{},
+		nil,
+	)
+
+	var (
+		ctx             = context.TODO()
+		slot            = math.Slot(2025)
+		parentBlockRoot = common.Root{0xff, 0xaa}
+
+		expectedPayload = &mockExecutionPayloadEnvelope[*engineprimitives.BlobsBundleV1]{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				Withdrawals: engineprimitives.Withdrawals{},
+			},
+			BlobsBundle: &engineprimitives.BlobsBundleV1{},
+		}
+	)
+
+	// Nothing has been built yet.
+	_, found := pb.RetrieveBuiltPayload(slot, parentBlockRoot)
+	require.False(t, found)
+
+	cache.Set(slot, parentBlockRoot, engineprimitives.PayloadID{0xab}, version.Deneb())
+	ee.payloadEnvToReturn = expectedPayload
+	_, err = pb.RetrievePayload(ctx, slot, parentBlockRoot)
+	require.NoError(t, err)
+
+	// A retry cannot find the evicted payload ID, but the payload is kept.
+	_, err = pb.RetrievePayload(ctx, slot, parentBlockRoot)
+	require.ErrorIs(t, err, builder.ErrPayloadIDNotFound)
+	payload, found := pb.RetrieveBuiltPayload(slot, parentBlockRoot)
+	require.True(t, found)
+	require.Equal(t, expectedPayload, payload)
+
+	// It is only handed out for the slot and parent it was built for.
+	_, found = pb.RetrieveBuiltPayload(slot+1, parentBlockRoot)
+	require.False(t, found)
+	_, found = pb.RetrieveBuiltPayload(slot, common.Root{0x01})
+	require.False(t, found)
+}
+
+func TestRetrievePayloadProposingHalted(t *testing.T) {
+	t.Parallel()
+
+	chainSpec, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	var (
+		cfg   = &builder.Config{Enabled: true}
+		ee    = &stubExecutionEngine{halted: true}
+		cache = cache.NewPayloadIDCache()
+	)
+	pb := builder.New(
+		cfg,
+		chainSpec,
+		noop.NewLogger[any](),
+		ee,
+		cache,
+		&stubAttributesFactory{},
+		nil,
+	)
+
+	var (
+		ctx             = context.TODO()
+		slot            = math.Slot(2025)
+		parentBlockRoot = common.Root{0xff, 0xaa}
+	)
+	cache.Set(slot, parentBlockRoot, engineprimitives.PayloadID{0xab}, version.Deneb())
+	ee.payloadEnvToReturn = &mockExecutionPayloadEnvelope[*engineprimitives.BlobsBundleV1]{
+		ExecutionPayload: &ctypes.ExecutionPayload{
+			Withdrawals: engineprimitives.Withdrawals{},
+		},
+		BlobsBundle: &engineprimitives.BlobsBundleV1{},
+	}
+
+	_, err = pb.RetrievePayload(ctx, slot, parentBlockRoot)
+	require.ErrorIs(t, err, builder.ErrProposingHalted)
+	_, found := pb.RetrieveBuiltPayload(slot, parentBlockRoot)
+	require.False(t, found)
+}
+
 // HELPERS section
 
 var errStubNotImplemented = errors.New("stub not implemented")
@@ -168,6 +267,7 @@ var errStubNotImplemented = errors.New("stub not implemented")
 type stubExecutionEngine struct {
 	payloadEnvToReturn ctypes.BuiltExecutionPayloadEnv
 	errToReturn        error
+	halted             bool
 }
 
 func (ee *stubExecutionEngine) GetPayload(
@@ -182,8 +282,32 @@ func (ee *stubExecutionEngine) NotifyForkchoiceUpdate(
 	return nil, errStubNotImplemented
 }
 
+func (ee *stubExecutionEngine) ProposingHalted() bool {
+	return ee.halted
+}
+
+func (ee *stubExecutionEngine) CheckClientVersion(common.Version) error {
+	return nil
+}
+
 type stubAttributesFactory struct{}
 
+func (ee *stubAttributesFactory) SuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (ee *stubAttributesFactory) BuildForkchoiceUpdate(
+	*engineprimitives.ForkchoiceStateV1, *engineprimitives.PayloadAttributes,
+) (*ctypes.ForkchoiceUpdateRequest, error) {
+	return nil, errStubNotImplemented
+}
+
+func (ee *stubAttributesFactory) Validate(
+	*engineprimitives.PayloadAttributes, math.Slot, math.U64,
+) error {
+	return nil
+}
+
 func (ee *stubAttributesFactory) BuildPayloadAttributes(
 	math.U64, engineprimitives.Withdrawals, common.Bytes32, common.Root,
 ) (*engineprimitives.PayloadAttributes, error) {
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validation
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrExtraDataTooLong is returned when the extra data of a payload is
+	// longer than allowed.
+	ErrExtraDataTooLong = errors.New("extra data too long")
+
+	// ErrTimestampNotAfterParent is returned when the payload timestamp is
+	// not after the timestamp of its parent.
+	ErrTimestampNotAfterParent = errors.New("timestamp not after parent")
+
+	// ErrNilBaseFee is returned when the payload has no base fee.
+	ErrNilBaseFee = errors.New("nil base fee per gas")
+
+	// ErrGasUsedAboveLimit is returned when the payload uses more gas than
+	// its gas limit.
+	ErrGasUsedAboveLimit = errors.New("gas used above gas limit")
+
+	// ErrInvalidBlobGasUsed is returned when the blob gas used by a payload
+	// does not match the blobs of its block.
+	ErrInvalidBlobGasUsed = errors.New("invalid blob gas used")
+)
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package validation holds the stateless sanity checks an execution payload
+// must pass before it is sent to the execution client.
+package validation
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+)
+
+// GasPerBlob is the blob gas consumed by each blob (EIP-4844).
+const GasPerBlob = 1 << 17
+
+// Spec is the chain spec the checks depend on.
+type Spec interface {
+	// MaxBlobsPerBlock returns the maximum number of blobs per block.
+	MaxBlobsPerBlock() uint64
+}
+
+// Validate runs the sanity checks of a payload against its parent header.
+// numBlobs is the number of blob commitments in the block carrying the
+// payload. These checks are cheap and catch malformed payloads that would
+// otherwise only be rejected by the execution client.
+//
+// The checks are not gated on a fork because none of them tightens
+// consensus: every finalized block already passes them, so replaying the
+// chain is not affected.
+//   - The extra data, timestamp, base fee and gas used rules are part of the
+//     execution header validation the execution client runs on newPayload.
+//   - The execution client checks that the blob gas used matches the blobs of
+//     the payload transactions, whose versioned hashes must match the blob
+//     commitments of the block.
+//   - ProcessProposal already rejects blocks with more than
+//     MaxBlobsPerBlock commitments.
+func Validate(
+	cs Spec,
+	payload *ctypes.ExecutionPayload,
+	parent *ctypes.ExecutionPayloadHeader,
+	numBlobs int,
+) error {
+	if len(payload.GetExtraData()) > ctypes.ExtraDataSize {
+		return fmt.Errorf(
+			"%w: max %d bytes, got %d",
+			ErrExtraDataTooLong, ctypes.ExtraDataSize, len(payload.GetExtraData()),
+		)
+	}
+
+	if payload.GetTimestamp() <= parent.GetTimestamp() {
+		return fmt.Errorf(
+			"%w: parent timestamp %d, got %d",
+			ErrTimestampNotAfterParent, parent.GetTimestamp(), payload.GetTimestamp(),
+		)
+	}
+
+	if payload.GetBaseFeePerGas() == nil {
+		return ErrNilBaseFee
+	}
+
+	if payload.GetGasUsed() > payload.GetGasLimit() {
+		return fmt.Errorf(
+			"%w: gas limit %d, gas used %d",
+			ErrGasUsedAboveLimit, payload.GetGasLimit(), payload.GetGasUsed(),
+		)
+	}
+
+	// Every blob carried by the block consumes a fixed amount of blob gas.
+	blobGasUsed := payload.GetBlobGasUsed().Unwrap()
+	if uint64(numBlobs) > cs.MaxBlobsPerBlock() ||
+		blobGasUsed != uint64(numBlobs)*GasPerBlob {
+		return fmt.Errorf(
+			"%w: %d blobs, blob gas used %d",
+			ErrInvalidBlobGasUsed, numBlobs, blobGasUsed,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validation_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/payload/validation"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+type testSpec struct{}
+
+func (testSpec) MaxBlobsPerBlock() uint64 { return 6 }
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	parent := ctypes.NewEmptyExecutionPayloadHeaderWithVersion(version.Electra())
+	parent.Timestamp = 10
+
+	tests := []struct {
+		name     string
+		modify   func(p *ctypes.ExecutionPayload)
+		numBlobs int
+		wantErr  error
+	}{
+		{
+			name:     "valid",
+			modify:   func(*ctypes.ExecutionPayload) {},
+			numBlobs: 2,
+		},
+		{
+			name: "extra data too long",
+			modify: func(p *ctypes.ExecutionPayload) {
+				p.ExtraData = make([]byte, ctypes.ExtraDataSize+1)
+			},
+			numBlobs: 2,
+			wantErr:  validation.ErrExtraDataTooLong,
+		},
+		{
+			name:     "timestamp equal to parent",
+			modify:   func(p *ctypes.ExecutionPayload) { p.Timestamp = parent.Timestamp },
+			numBlobs: 2,
+			wantErr:  validation.ErrTimestampNotAfterParent,
+		},
+		{
+			name:     "nil base fee",
+			modify:   func(p *ctypes.ExecutionPayload) { p.BaseFeePerGas = nil },
+			numBlobs: 2,
+			wantErr:  validation.ErrNilBaseFee,
+		},
+		{
+			name:     "gas used above limit",
+			modify:   func(p *ctypes.ExecutionPayload) { p.GasUsed = p.GasLimit + 1 },
+			numBlobs: 2,
+			wantErr:  validation.ErrGasUsedAboveLimit,
+		},
+		{
+			name:     "blob gas not matching blobs",
+			modify:   func(*ctypes.ExecutionPayload) {},
+			numBlobs: 3,
+			wantErr:  validation.ErrInvalidBlobGasUsed,
+		},
+		{
+			name: "too many blobs",
+			modify: func(p *ctypes.ExecutionPayload) {
+				p.BlobGasUsed = 7 * validation.GasPerBlob
+			},
+			numBlobs: 7,
+			wantErr:  validation.ErrInvalidBlobGasUsed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			payload := ctypes.NewEmptyExecutionPayloadWithVersion(version.Electra())
+			payload.Timestamp = parent.Timestamp + 1
+			payload.GasLimit = 30_000_000
+			payload.GasUsed = 21_000
+			payload.BlobGasUsed = math.U64(2 * validation.GasPerBlob)
+			tt.modify(payload)
+
+			err := validation.Validate(testSpec{}, payload, parent, tt.numBlobs)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
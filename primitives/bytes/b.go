@@ -31,7 +31,7 @@ type Bytes []byte
 
 // MarshalText implements encoding.TextMarshaler.
 func (b Bytes) MarshalText() ([]byte, error) {
-	return []byte(hex.EncodeBytes(b)), nil
+	return hex.MarshalBytesText(b), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -56,7 +56,7 @@ func ToBytes20(input []byte) (B20, error) {
 
 // MarshalText implements the encoding.TextMarshaler interface for B20.
 func (h B20) MarshalText() ([]byte, error) {
-	return []byte(h.String()), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for B20.
@@ -57,7 +57,7 @@ func ToBytes256(input []byte) (B256, error) {
 
 // MarshalText implements the encoding.TextMarshaler interface for B256.
 func (h B256) MarshalText() ([]byte, error) {
-	return []byte(h.String()), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for B256.
@@ -56,7 +56,7 @@ func ToBytes32(input []byte) (B32, error) {
 
 // MarshalText implements the encoding.TextMarshaler interface for B32.
 func (h B32) MarshalText() ([]byte, error) {
-	return []byte(h.String()), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for B32.
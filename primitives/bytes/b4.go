@@ -57,7 +57,7 @@ func ToBytes4(input []byte) (B4, error) {
 
 // MarshalText implements the encoding.TextMarshaler interface for B4.
 func (h B4) MarshalText() ([]byte, error) {
-	return []byte(h.String()), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for B4.
@@ -57,7 +57,7 @@ func ToBytes48(input []byte) (B48, error) {
 
 // MarshalText implements the encoding.TextMarshaler interface for B48.
 func (h B48) MarshalText() ([]byte, error) {
-	return []byte(h.String()), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for B48.
@@ -56,7 +56,7 @@ func ToBytes8(input []byte) (B8, error) {
 
 // MarshalText implements the encoding.TextMarshaler interface for B8.
 func (h B8) MarshalText() ([]byte, error) {
-	return []byte(h.String()), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for B8.
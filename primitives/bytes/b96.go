@@ -57,7 +57,7 @@ func ToBytes96(input []byte) (B96, error) {
 
 // MarshalText implements the encoding.TextMarshaler interface for B96.
 func (h B96) MarshalText() ([]byte, error) {
-	return []byte(h.String()), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for B96.
@@ -26,7 +26,6 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
-	"github.com/berachain/beacon-kit/primitives/encoding/json"
 )
 
 /* -------------------------------------------------------------------------- */
@@ -103,7 +102,7 @@ func (r Root) String() string {
 
 // MarshalText returns the hex representation of r.
 func (r Root) MarshalText() ([]byte, error) {
-	return []byte(r.Hex()), nil
+	return hex.MarshalBytesText(r[:]), nil
 }
 
 // UnmarshalText parses a root in hex syntax.
@@ -115,7 +114,7 @@ func (r *Root) UnmarshalText(input []byte) error {
 
 // MarshalJSON returns the JSON representation of r.
 func (r Root) MarshalJSON() ([]byte, error) {
-	return json.Marshal(r.Hex())
+	return hex.MarshalBytesJSON(r[:]), nil
 }
 
 // UnmarshalJSON parses a root in hex syntax.
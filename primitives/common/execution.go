@@ -23,6 +23,8 @@ package common
 import (
 	stdbytes "bytes"
 	"encoding"
+	"hash"
+	"sync"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/bytes"
@@ -43,6 +45,14 @@ var (
 	_ json.Unmarshaler         = (*ExecutionAddress)(nil)
 )
 
+// keccakPool recycles the hashers used for address checksums, which are
+// computed for every address serialized by the API.
+//
+//nolint:gochecknoglobals // pool.
+var keccakPool = sync.Pool{
+	New: func() any { return sha3.NewLegacyKeccak256() },
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                ExecutionHash                               */
 /* -------------------------------------------------------------------------- */
@@ -68,7 +78,7 @@ func (h ExecutionHash) String() string {
 
 // MarshalText returns the hex representation of h.
 func (h ExecutionHash) MarshalText() ([]byte, error) {
-	return []byte(hex.EncodeBytes(h[:])), nil
+	return hex.MarshalBytesText(h[:]), nil
 }
 
 // UnmarshalText parses a hash in hex syntax.
@@ -82,7 +92,7 @@ func (h *ExecutionHash) UnmarshalText(input []byte) error {
 
 // MarshalJSON returns the JSON representation of h.
 func (h ExecutionHash) MarshalJSON() ([]byte, error) {
-	return json.Marshal(h.Hex())
+	return hex.MarshalBytesJSON(h[:]), nil
 }
 
 // UnmarshalJSON parses a hash in hex syntax.
@@ -124,7 +134,7 @@ func (a ExecutionAddress) String() string {
 
 // MarshalText returns the hex representation of a.
 func (a ExecutionAddress) MarshalText() ([]byte, error) {
-	return []byte(a.Hex()), nil
+	return a.checksumHex(), nil
 }
 
 // UnmarshalText parses an address in hex syntax.
@@ -138,7 +148,10 @@ func (a *ExecutionAddress) UnmarshalText(input []byte) error {
 
 // MarshalJSON returns the JSON representation of a.
 func (a ExecutionAddress) MarshalJSON() ([]byte, error) {
-	return json.Marshal(a.Hex())
+	buf := make([]byte, 0, hex.EncodedLen(len(a))+2) //nolint:mnd // quotes.
+	buf = append(buf, '"')
+	buf = a.appendChecksumHex(buf)
+	return append(buf, '"'), nil
 }
 
 // UnmarshalJSON parses an address in hex syntax.
@@ -157,15 +170,25 @@ func (a *ExecutionAddress) UnmarshalJSON(input []byte) error {
 
 // checksumHex returns the checksummed hex representation of a.
 func (a *ExecutionAddress) checksumHex() []byte {
-	buf := []byte(hex.EncodeBytes(a[:]))
+	return a.appendChecksumHex(make([]byte, 0, hex.EncodedLen(len(a))))
+}
+
+// appendChecksumHex appends the EIP-55 checksummed hex encoding of a to dst.
+func (a *ExecutionAddress) appendChecksumHex(dst []byte) []byte {
+	start := len(dst)
+	dst = hex.AppendBytes(dst, a[:])
+	buf := dst[start:]
 
 	// compute checksum
-	sha := sha3.NewLegacyKeccak256()
+	sha, _ := keccakPool.Get().(hash.Hash)
+	sha.Reset()
 	sha.Write(buf[2:])
-	hash := sha.Sum(nil)
+	var scratch [32]byte
+	digest := sha.Sum(scratch[:0])
+	keccakPool.Put(sha)
 	for i := 2; i < len(buf); i++ {
 		//nolint:mnd // todo fix.
-		hashByte := hash[(i-2)/2]
+		hashByte := digest[(i-2)/2]
 		if i%2 == 0 {
 			hashByte >>= 4
 		} else {
@@ -175,5 +198,5 @@ func (a *ExecutionAddress) checksumHex() []byte {
 			buf[i] -= 32
 		}
 	}
-	return buf
+	return dst
 }
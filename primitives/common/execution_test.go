@@ -126,3 +126,25 @@ func TestExecutionAddressUnmarshalJSON_Short(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutionAddressMarshal_Checksum(t *testing.T) {
+	t.Parallel()
+	const checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	addr := common.NewExecutionAddressFromHex(checksummed)
+	require.Equal(t, checksummed, addr.Hex())
+
+	text, err := addr.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, checksummed, string(text))
+
+	bz, err := json.Marshal(addr)
+	require.NoError(t, err)
+	require.Equal(t, `"`+checksummed+`"`, string(bz))
+
+	hash := common.NewExecutionHashFromHex(
+		"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+	)
+	bz, err = json.Marshal(hash)
+	require.NoError(t, err)
+	require.Equal(t, `"`+hash.Hex()+`"`, string(bz))
+}
@@ -32,3 +32,12 @@ const (
 	ValidatorStatusWithdrawalPossible = "withdrawal_possible"
 	ValidatorStatusWithdrawalDone     = "withdrawal_done"
 )
+
+// Validator status categories, each matching all of the statuses prefixed by
+// the category name (e.g. "active" matches "active_ongoing").
+const (
+	ValidatorStatusPending    = "pending"
+	ValidatorStatusActive     = "active"
+	ValidatorStatusExited     = "exited"
+	ValidatorStatusWithdrawal = "withdrawal"
+)
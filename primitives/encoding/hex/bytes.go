@@ -22,19 +22,56 @@ package hex
 
 import (
 	"encoding/hex"
+	"slices"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
 )
 
 var ErrInvalidHexStringLength = errors.New("invalid hex string length")
 
+// EncodedLen returns the length of the 0x prefixed hex encoding of n bytes.
+func EncodedLen(n int) int {
+	return prefixLen + n*encDecRatio
+}
+
 // EncodeBytes creates a hex string with 0x prefix.
 // Inverse operation is ToBytes or MustToBytes.
 func EncodeBytes(b []byte) string {
-	hexStr := make([]byte, len(b)*2+prefixLen)
-	copy(hexStr, Prefix)
-	hex.Encode(hexStr[prefixLen:], b)
-	return string(hexStr)
+	// strings.Builder hands over its buffer without copying, so the string
+	// costs a single allocation.
+	var sb strings.Builder
+	sb.Grow(EncodedLen(len(b)))
+	sb.WriteString(Prefix)
+	for _, v := range b {
+		sb.WriteByte(hexDigits[v>>nibbleShift])
+		sb.WriteByte(hexDigits[v&nibbleMask])
+	}
+	return sb.String()
+}
+
+// AppendBytes appends the 0x prefixed hex encoding of src to dst and returns
+// the extended buffer. dst is grown at most once.
+func AppendBytes(dst, src []byte) []byte {
+	dst = slices.Grow(dst, EncodedLen(len(src)))
+	dst = append(dst, Prefix...)
+	return hex.AppendEncode(dst, src)
+}
+
+// MarshalBytesText returns the 0x prefixed hex encoding of b in a freshly
+// allocated slice of exactly the required size. It is meant for
+// encoding.TextMarshaler implementations, whose result is owned by the caller.
+func MarshalBytesText(b []byte) []byte {
+	return AppendBytes(make([]byte, 0, EncodedLen(len(b))), b)
+}
+
+// MarshalBytesJSON returns the 0x prefixed hex encoding of b as a quoted JSON
+// string, in a single allocation. Hex digits never need escaping.
+func MarshalBytesJSON(b []byte) []byte {
+	buf := make([]byte, 0, EncodedLen(len(b))+quotesLen)
+	buf = append(buf, '"')
+	buf = AppendBytes(buf, b)
+	return append(buf, '"')
 }
 
 // MustToBytes returns the bytes represented by the given hex string.
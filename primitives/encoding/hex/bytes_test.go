@@ -78,10 +78,38 @@ func TestEncodeAndDecodeBytes(t *testing.T) {
 				decoded = hex.MustToBytes(result)
 			})
 			require.Equal(t, tt.input, decoded)
+
+			require.Equal(t, tt.expected, string(hex.MarshalBytesText(tt.input)))
+			require.Equal(t,
+				`"`+tt.expected+`"`, string(hex.MarshalBytesJSON(tt.input)),
+			)
+			require.Equal(t,
+				"ab"+tt.expected,
+				string(hex.AppendBytes([]byte("ab"), tt.input)),
+			)
 		})
 	}
 }
 
+//nolint:paralleltest // allocation counts are unreliable in parallel.
+func TestEncodeBytes_Allocations(t *testing.T) {
+	input := make([]byte, 1024)
+	require.InDelta(t, 1, testing.AllocsPerRun(100, func() {
+		_ = hex.EncodeBytes(input)
+	}), 0)
+	require.InDelta(t, 1, testing.AllocsPerRun(100, func() {
+		_ = hex.MarshalBytesText(input)
+	}), 0)
+	require.InDelta(t, 1, testing.AllocsPerRun(100, func() {
+		_ = hex.MarshalBytesJSON(input)
+	}), 0)
+
+	buf := make([]byte, 0, hex.EncodedLen(len(input)))
+	require.Zero(t, testing.AllocsPerRun(100, func() {
+		buf = hex.AppendBytes(buf[:0], input)
+	}))
+}
+
 func TestUnmarshalByteText(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -271,3 +299,16 @@ func BenchmarkDecodeFixedText(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkMarshalBytesText(b *testing.B) {
+	for _, size := range []int{32, 256, 1 << 14} {
+		b.Run("Size"+strconv.Itoa(size), func(b *testing.B) {
+			input := make([]byte, size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				_ = hex.MarshalBytesText(input)
+			}
+		})
+	}
+}
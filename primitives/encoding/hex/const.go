@@ -23,13 +23,15 @@ package hex
 const (
 	Prefix            = "0x"
 	prefixLen         = len(Prefix)
+	quotesLen         = 2
 	badNibble         = ^uint64(0)
 	hexBase           = 16
-	initialCapacity   = 10
 	encDecRatio       = 2
 	nibblesPer64Bits  = 16 // 64/4
 	nibblesPer256Bits = 64 // 256/4
 	nibbleShift       = 4
+	nibbleMask        = 0x0f
+	hexDigits         = "0123456789abcdef"
 
 	// hexadecimal conversion constants.
 	hexBaseOffset       = '0'
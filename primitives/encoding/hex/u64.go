@@ -31,7 +31,7 @@ import (
 // MarshalText returns a byte slice containing the hexadecimal representation
 // of uint64 input.
 func MarshalText(b uint64) ([]byte, error) {
-	buf := make([]byte, prefixLen, initialCapacity)
+	buf := make([]byte, prefixLen, prefixLen+nibblesPer64Bits)
 	copy(buf, Prefix)
 	buf = strconv.AppendUint(buf, b, hexBase)
 	return buf, nil
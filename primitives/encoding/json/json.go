@@ -39,6 +39,8 @@ var MarshalIndent = json.MarshalIndent
 
 var Unmarshal = json.Unmarshal
 
+var Valid = json.Valid
+
 // RawMessage is an alias for json.RawMessage, represensting a raw encoded JSON
 // value. It implements Marshaler and Unmarshaler and can be used to delay JSON
 // decoding or precompute a JSON encoding.
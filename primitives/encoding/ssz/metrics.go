@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/karalabe/ssz"
+)
+
+// Labels identifying the kind of object an SSZ metric was recorded for.
+const (
+	TypeBeaconBlock      = "beacon_block"
+	TypeBeaconBlockBody  = "beacon_block_body"
+	TypeBeaconState      = "beacon_state"
+	TypeExecutionPayload = "execution_payload"
+)
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+	// AddSample adds a sample to the histogram identified by the provided
+	// key.
+	AddSample(key string, value float64, args ...string)
+}
+
+// Metrics records the duration and encoded size of SSZ operations on the
+// hot paths of block processing. A nil *Metrics performs the operations
+// without recording anything.
+type Metrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// NewMetrics creates a new Metrics. It returns nil if sink is nil.
+func NewMetrics(sink TelemetrySink) *Metrics {
+	if sink == nil {
+		return nil
+	}
+	return &Metrics{sink: sink}
+}
+
+// Marshal encodes v, recording the duration and size of the encoding.
+func (m *Metrics) Marshal(typ string, v constraints.SSZMarshaler) ([]byte, error) {
+	if m == nil {
+		return v.MarshalSSZ()
+	}
+
+	start := time.Now()
+	bz, err := v.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	m.sink.MeasureSince("beacon_kit.ssz.marshal_duration", start, "type", typ)
+	m.sink.AddSample("beacon_kit.ssz.marshal_size", float64(len(bz)), "type", typ)
+	return bz, nil
+}
+
+// Unmarshal decodes buf into v, recording the duration of the decoding and
+// the size of buf.
+func (m *Metrics) Unmarshal(typ string, buf []byte, v constraints.SSZUnmarshaler) error {
+	if m == nil {
+		return Unmarshal(buf, v)
+	}
+
+	start := time.Now()
+	if err := Unmarshal(buf, v); err != nil {
+		return err
+	}
+	m.sink.MeasureSince("beacon_kit.ssz.unmarshal_duration", start, "type", typ)
+	m.sink.AddSample("beacon_kit.ssz.unmarshal_size", float64(len(buf)), "type", typ)
+	return nil
+}
+
+// HashTreeRoot computes the hash tree root of v, recording the duration of
+// the computation.
+func (m *Metrics) HashTreeRoot(typ string, v constraints.SSZRootable) common.Root {
+	if m == nil {
+		return v.HashTreeRoot()
+	}
+
+	start := time.Now()
+	root := v.HashTreeRoot()
+	m.sink.MeasureSince("beacon_kit.ssz.hash_tree_root_duration", start, "type", typ)
+	return root
+}
+
+// ObserveSize records the encoded size of v without encoding it. It is used
+// for objects that are encoded or decoded as part of a larger container.
+func (m *Metrics) ObserveSize(typ string, v ssz.Object) {
+	if m == nil {
+		return
+	}
+	m.sink.AddSample("beacon_kit.ssz.size", float64(ssz.Size(v)), "type", typ)
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink records the keys and samples it receives.
+type recordingSink struct {
+	durations []string
+	samples   map[string]float64
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{samples: make(map[string]float64)}
+}
+
+func (r *recordingSink) MeasureSince(key string, _ time.Time, args ...string) {
+	r.durations = append(r.durations, key+"/"+args[1])
+}
+
+func (r *recordingSink) AddSample(key string, value float64, args ...string) {
+	r.samples[key+"/"+args[1]] = value
+}
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+	data := &types.SigningData{ObjectRoot: common.Root{1}}
+
+	sink := newRecordingSink()
+	m := ssz.NewMetrics(sink)
+
+	bz, err := m.Marshal(ssz.TypeBeaconBlock, data)
+	require.NoError(t, err)
+	require.Len(t, bz, 64)
+
+	decoded := &types.SigningData{}
+	require.NoError(t, m.Unmarshal(ssz.TypeBeaconBlock, bz, decoded))
+	require.Equal(t, data, decoded)
+
+	require.Equal(t, data.HashTreeRoot(), m.HashTreeRoot(ssz.TypeBeaconState, data))
+	m.ObserveSize(ssz.TypeExecutionPayload, data)
+
+	require.Equal(t, []string{
+		"beacon_kit.ssz.marshal_duration/beacon_block",
+		"beacon_kit.ssz.unmarshal_duration/beacon_block",
+		"beacon_kit.ssz.hash_tree_root_duration/beacon_state",
+	}, sink.durations)
+	require.Equal(t, map[string]float64{
+		"beacon_kit.ssz.marshal_size/beacon_block":   64,
+		"beacon_kit.ssz.unmarshal_size/beacon_block": 64,
+		"beacon_kit.ssz.size/execution_payload":      64,
+	}, sink.samples)
+}
+
+func TestMetrics_Nil(t *testing.T) {
+	t.Parallel()
+	data := &types.SigningData{ObjectRoot: common.Root{1}}
+
+	var m *ssz.Metrics
+	require.Nil(t, ssz.NewMetrics(nil))
+
+	bz, err := m.Marshal(ssz.TypeBeaconBlock, data)
+	require.NoError(t, err)
+	decoded := &types.SigningData{}
+	require.NoError(t, m.Unmarshal(ssz.TypeBeaconBlock, bz, decoded))
+	require.Equal(t, data, decoded)
+	require.Equal(t, data.HashTreeRoot(), m.HashTreeRoot(ssz.TypeBeaconState, data))
+	m.ObserveSize(ssz.TypeExecutionPayload, data)
+}
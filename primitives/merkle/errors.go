@@ -66,4 +66,16 @@ var (
 	ErrLeavesExceedsLimit = errors.New(
 		"number of leaves exceeds the maximum allowed",
 	)
+
+	// ErrMultiproofLengthMismatch is returned when the number of leaves or
+	// helper nodes of a multiproof does not match its indices.
+	ErrMultiproofLengthMismatch = errors.New(
+		"multiproof leaves or helper nodes do not match its indices",
+	)
+
+	// ErrMultiproofIncomplete is returned when the nodes of a multiproof are
+	// not sufficient to compute the root of the tree.
+	ErrMultiproofIncomplete = errors.New(
+		"multiproof nodes are not sufficient to compute the root",
+	)
 )
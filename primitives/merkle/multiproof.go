@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// maxDescriptorDepth bounds the depth of the nodes of a multiproof, which
+// is that of a generalized index.
+const maxDescriptorDepth = 64
+
+// Multiproof proves multiple leaves of a Merkle tree against its root with a
+// single set of helper nodes, which are shared between the leaves. Inspired by
+// the Ethereum 2.0 spec:
+// https://github.com/ethereum/consensus-specs/blob/dev/ssz/merkle-proofs.md#merkle-multiproofs
+type Multiproof[RootT ~[32]byte] struct {
+	// Indices are the generalized indices of the proven leaves.
+	Indices GeneralizedIndices
+	// Leaves are the proven leaves, in the order of Indices.
+	Leaves []RootT
+	// Hashes are the helper nodes, in the order of
+	// Indices.GetHelperIndices().
+	Hashes []RootT
+}
+
+// ProveMulti generates a multiproof of the nodes at the given generalized
+// indices of a fastssz proof tree.
+func ProveMulti[RootT ~[32]byte](
+	tree *fastssz.Node,
+	indices GeneralizedIndices,
+) (*Multiproof[RootT], error) {
+	if len(indices) == 0 {
+		return nil, ErrEmptyLeaves
+	}
+
+	leaves, err := nodeHashes[RootT](tree, indices)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := nodeHashes[RootT](tree, indices.GetHelperIndices())
+	if err != nil {
+		return nil, err
+	}
+	return &Multiproof[RootT]{
+		Indices: slices.Clone(indices),
+		Leaves:  leaves,
+		Hashes:  hashes,
+	}, nil
+}
+
+// nodeHashes returns the hashes of the nodes at the given generalized indices
+// of a fastssz proof tree.
+func nodeHashes[RootT ~[32]byte](
+	tree *fastssz.Node,
+	indices GeneralizedIndices,
+) ([]RootT, error) {
+	hashes := make([]RootT, len(indices))
+	for i, index := range indices {
+		//#nosec:G115 // generalized indices of a proof tree fit an int.
+		node, err := tree.Get(int(index))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get node %d", index)
+		}
+		copy(hashes[i][:], node.Hash())
+	}
+	return hashes, nil
+}
+
+// Root computes the root of the tree from the multiproof, as per the
+// calculate_multi_merkle_root function of the spec.
+func (p *Multiproof[RootT]) Root() (RootT, error) {
+	var root RootT
+	helperIndices := p.Indices.GetHelperIndices()
+	if len(p.Leaves) != len(p.Indices) || len(p.Hashes) != len(helperIndices) {
+		return root, ErrMultiproofLengthMismatch
+	}
+
+	objects := make(map[GeneralizedIndex]RootT, len(p.Indices)+len(helperIndices))
+	for i, index := range p.Indices {
+		objects[index] = p.Leaves[i]
+	}
+	for i, index := range helperIndices {
+		objects[index] = p.Hashes[i]
+	}
+
+	keys := make(GeneralizedIndices, 0, 2*len(objects)) //nolint:mnd // at most doubles.
+	for index := range objects {
+		keys = append(keys, index)
+	}
+	slices.SortFunc(keys, GeneralizedIndexReverseComparator)
+
+	var input [64]byte
+	for pos := 0; pos < len(keys); pos++ {
+		k := keys[pos]
+		left, hasLeft := objects[k&^1]
+		right, hasRight := objects[k|1]
+		if _, hasParent := objects[k.Parent()]; !hasLeft || !hasRight || hasParent || k <= 1 {
+			continue
+		}
+		copy(input[:32], left[:])
+		copy(input[32:], right[:])
+		objects[k.Parent()] = RootT(sha256.Hash(input[:]))
+		keys = append(keys, k.Parent())
+	}
+
+	root, ok := objects[1]
+	if !ok {
+		return root, ErrMultiproofIncomplete
+	}
+	return root, nil
+}
+
+// Verify returns true if the multiproof verifies against the given root.
+func (p *Multiproof[RootT]) Verify(root RootT) bool {
+	computed, err := p.Root()
+	return err == nil && computed == root
+}
+
+// VerifyMultiproof returns true if the leaves at the given generalized indices
+// verify against the root with the given helper nodes.
+func VerifyMultiproof[RootT ~[32]byte](
+	root RootT,
+	leaves []RootT,
+	indices GeneralizedIndices,
+	hashes []RootT,
+) bool {
+	p := &Multiproof[RootT]{Indices: indices, Leaves: leaves, Hashes: hashes}
+	return p.Verify(root)
+}
+
+// Compact returns the proof descriptor and the nodes of the compact encoding
+// of the multiproof. The descriptor lists the nodes of the pruned tree in
+// depth-first pre-order, packed most significant bit first: a 1 bit for a node
+// included in the proof, which is then the next of the returned nodes, and a
+// 0 bit for an internal node whose children follow. Trailing padding bits are
+// zero.
+func (p *Multiproof[RootT]) Compact() ([]byte, []RootT, error) {
+	helperIndices := p.Indices.GetHelperIndices()
+	if len(p.Leaves) != len(p.Indices) || len(p.Hashes) != len(helperIndices) {
+		return nil, nil, ErrMultiproofLengthMismatch
+	}
+
+	objects := make(map[GeneralizedIndex]RootT, len(p.Indices)+len(helperIndices))
+	for i, index := range p.Indices {
+		objects[index] = p.Leaves[i]
+	}
+	for i, index := range helperIndices {
+		objects[index] = p.Hashes[i]
+	}
+
+	var (
+		bits  []bool
+		nodes = make([]RootT, 0, len(objects))
+		walk  func(index GeneralizedIndex, depth int) error
+	)
+	walk = func(index GeneralizedIndex, depth int) error {
+		if node, ok := objects[index]; ok {
+			bits = append(bits, true)
+			nodes = append(nodes, node)
+			return nil
+		}
+		if depth >= maxDescriptorDepth {
+			return ErrMultiproofIncomplete
+		}
+		bits = append(bits, false)
+		if err := walk(index.LeftChild(), depth+1); err != nil {
+			return err
+		}
+		return walk(index.RightChild(), depth+1)
+	}
+	if err := walk(1, 0); err != nil {
+		return nil, nil, err
+	}
+
+	descriptor := make([]byte, (len(bits)+7)/8) //nolint:mnd // bits per byte.
+	for i, bit := range bits {
+		if bit {
+			descriptor[i/8] |= 0x80 >> (i % 8) //nolint:mnd // bits per byte.
+		}
+	}
+	return descriptor, nodes, nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// buildProofTree builds a fastssz proof tree of depth 3 over the given leaves.
+func buildProofTree(leaves []common.Root) *fastssz.Node {
+	nodes := make([]*fastssz.Node, len(leaves))
+	for i, leaf := range leaves {
+		nodes[i] = fastssz.NewNodeWithValue(leaf[:])
+	}
+	for len(nodes) > 1 {
+		parents := make([]*fastssz.Node, len(nodes)/2)
+		for i := range parents {
+			parents[i] = fastssz.NewNodeWithLR(nodes[2*i], nodes[2*i+1])
+		}
+		nodes = parents
+	}
+	return nodes[0]
+}
+
+func TestMultiproof(t *testing.T) {
+	t.Parallel()
+
+	leaves := make([]common.Root, 8)
+	for i := range leaves {
+		leaves[i] = common.Root{byte(i + 1)}
+	}
+	tree := buildProofTree(leaves)
+	reference, err := merkle.NewTreeFromLeaves(leaves)
+	require.NoError(t, err)
+	root := common.Root(reference.Root())
+	require.Equal(t, root, common.NewRootFromBytes(tree.Hash()))
+
+	indices := merkle.GeneralizedIndices{9, 12, 14}
+	proof, err := merkle.ProveMulti[common.Root](tree, indices)
+	require.NoError(t, err)
+	require.Equal(t, []common.Root{leaves[1], leaves[4], leaves[6]}, proof.Leaves)
+	require.Len(t, proof.Hashes, len(indices.GetHelperIndices()))
+	require.True(t, proof.Verify(root))
+	require.True(t, merkle.VerifyMultiproof(root, proof.Leaves, proof.Indices, proof.Hashes))
+
+	// The compact encoding holds every node of the proof exactly once.
+	descriptor, nodes, err := proof.Compact()
+	require.NoError(t, err)
+	require.NotEmpty(t, descriptor)
+	require.Len(t, nodes, len(proof.Leaves)+len(proof.Hashes))
+
+	// Tampered leaves and truncated proofs fail to verify.
+	tampered := *proof
+	tampered.Leaves = []common.Root{leaves[1], leaves[5], leaves[6]}
+	require.False(t, tampered.Verify(root))
+
+	truncated := *proof
+	truncated.Hashes = proof.Hashes[1:]
+	require.False(t, truncated.Verify(root))
+	_, err = truncated.Root()
+	require.ErrorIs(t, err, merkle.ErrMultiproofLengthMismatch)
+}
+
+func TestMultiproofSingleLeaf(t *testing.T) {
+	t.Parallel()
+
+	leaves := make([]common.Root, 8)
+	for i := range leaves {
+		leaves[i] = common.Root{byte(i + 1)}
+	}
+	tree := buildProofTree(leaves)
+	reference, err := merkle.NewTreeFromLeaves(leaves)
+	require.NoError(t, err)
+
+	// A multiproof of a single leaf is the regular Merkle branch.
+	proof, err := merkle.ProveMulti[common.Root](tree, merkle.GeneralizedIndices{13})
+	require.NoError(t, err)
+	branch, err := reference.MerkleProof(5)
+	require.NoError(t, err)
+	require.Equal(t, branch, proof.Hashes)
+}
@@ -20,7 +20,10 @@
 
 package url
 
-import "net/url"
+import (
+	"net/url"
+	"path/filepath"
+)
 
 // ConnectionURL is a URL struct that is used to dial the execution client.
 type ConnectionURL struct {
@@ -32,11 +35,16 @@ func NewDialURL(u *url.URL) *ConnectionURL {
 	return &ConnectionURL{u}
 }
 
+// NewFromRaw parses a DialURL. An absolute path without a scheme, such as
+// /var/run/geth.ipc, is taken to be an IPC endpoint.
 func NewFromRaw(raw string) (*ConnectionURL, error) {
 	u, err := url.Parse(raw)
 	if err != nil {
 		return nil, err
 	}
+	if u.Scheme == "" && u.Host == "" && filepath.IsAbs(u.Path) {
+		u.Scheme = "ipc"
+	}
 	return NewDialURL(u), nil
 }
 
@@ -37,6 +37,9 @@ type Context struct {
 	consensusTime math.U64
 	// Address of current block proposer
 	proposerAddress []byte
+	// misbehaviors are the validator misbehaviors committed along with the
+	// current block, to be punished by the state transition.
+	misbehaviors []Misbehavior
 
 	// verifyPayload indicates whether to call NewPayload on the
 	// execution client. This can be done when the node is not
@@ -47,6 +50,11 @@ type Context struct {
 	// verifyResult indicates whether to validate the result of
 	// the state transition.
 	verifyResult bool
+	// optimisticPayload indicates whether the payload may be imported
+	// optimistically if the execution client is syncing, rather than
+	// waiting for the execution client to validate it. This is only safe
+	// for finalized blocks.
+	optimisticPayload bool
 
 	// meterGas controls whether gas data related to the execution
 	// layer payload should be meter or not. We currently meter only
@@ -76,6 +84,11 @@ func NewTransitionCtx(
 }
 
 // Setters to control context attributes.
+func (c *Context) WithMisbehaviors(misbehaviors []Misbehavior) *Context {
+	c.misbehaviors = misbehaviors
+	return c
+}
+
 func (c *Context) WithMeterGas(meter bool) *Context {
 	c.meterGas = meter
 	return c
@@ -96,6 +109,11 @@ func (c *Context) WithVerifyResult(verifyResult bool) *Context {
 	return c
 }
 
+func (c *Context) WithOptimisticPayload(optimisticPayload bool) *Context {
+	c.optimisticPayload = optimisticPayload
+	return c
+}
+
 // Getters of context attributes.
 func (c *Context) ConsensusCtx() context.Context {
 	return c.consensusCtx
@@ -109,6 +127,10 @@ func (c *Context) ProposerAddress() []byte {
 	return c.proposerAddress
 }
 
+func (c *Context) Misbehaviors() []Misbehavior {
+	return c.misbehaviors
+}
+
 func (c *Context) VerifyPayload() bool {
 	return c.verifyPayload
 }
@@ -121,6 +143,10 @@ func (c *Context) VerifyResult() bool {
 	return c.verifyResult
 }
 
+func (c *Context) OptimisticPayload() bool {
+	return c.optimisticPayload
+}
+
 func (c *Context) MeterGas() bool {
 	return c.meterGas
 }
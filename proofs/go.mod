@@ -0,0 +1,3 @@
+module github.com/berachain/beacon-kit/proofs
+
+go 1.23.6
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package proofs verifies the Merkle proofs served by the beacon-kit node API
+// under `/bkit/v1/proof`. It only depends on the standard library, so that
+// integrators can verify proofs without importing the node.
+package proofs
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidHex is returned when a value is not valid 0x prefixed hex.
+var ErrInvalidHex = errors.New("invalid hex")
+
+// Root is a 32 byte SSZ chunk, e.g. a node of a Merkle tree.
+type Root [32]byte
+
+// Pubkey is a BLS public key.
+type Pubkey [48]byte
+
+// Signature is a BLS signature.
+type Signature [96]byte
+
+// Quantity is an unsigned integer encoded as 0x prefixed hex.
+type Quantity uint64
+
+// String returns the 0x prefixed hex encoding of the root.
+func (r Root) String() string { return encodeHex(r[:]) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (r Root) MarshalText() ([]byte, error) { return []byte(r.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *Root) UnmarshalText(text []byte) error { return decodeHex(r[:], text) }
+
+// String returns the 0x prefixed hex encoding of the pubkey.
+func (p Pubkey) String() string { return encodeHex(p[:]) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Pubkey) MarshalText() ([]byte, error) { return []byte(p.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Pubkey) UnmarshalText(text []byte) error { return decodeHex(p[:], text) }
+
+// String returns the 0x prefixed hex encoding of the signature.
+func (s Signature) String() string { return encodeHex(s[:]) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Signature) MarshalText() ([]byte, error) { return []byte(s.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Signature) UnmarshalText(text []byte) error { return decodeHex(s[:], text) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (q Quantity) MarshalText() ([]byte, error) {
+	return []byte("0x" + strconv.FormatUint(uint64(q), 16)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (q *Quantity) UnmarshalText(text []byte) error {
+	s, ok := strings.CutPrefix(string(text), "0x")
+	if !ok || s == "" {
+		return fmt.Errorf("%w: quantity %q", ErrInvalidHex, text)
+	}
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return fmt.Errorf("%w: quantity %q: %w", ErrInvalidHex, text, err)
+	}
+	*q = Quantity(v)
+	return nil
+}
+
+func encodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func decodeHex(dst []byte, text []byte) error {
+	s, ok := strings.CutPrefix(string(text), "0x")
+	if !ok || hex.DecodedLen(len(s)) != len(dst) {
+		return fmt.Errorf("%w: expected %d bytes, got %q", ErrInvalidHex, len(dst), text)
+	}
+	if _, err := hex.Decode(dst, []byte(s)); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidHex, err)
+	}
+	return nil
+}
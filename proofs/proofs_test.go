@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proofs_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/proofs"
+)
+
+func hashPair(a, b proofs.Root) proofs.Root {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+func chunk(v uint64) proofs.Root {
+	var r proofs.Root
+	binary.LittleEndian.PutUint64(r[:], v)
+	return r
+}
+
+// headerTree returns the 8 leaves and all nodes of the header tree indexed by
+// generalized index.
+func headerTree(h *proofs.BeaconBlockHeader) map[uint64]proofs.Root {
+	leaves := []proofs.Root{
+		chunk(uint64(h.Slot)),
+		chunk(uint64(h.ProposerIndex)),
+		h.ParentBlockRoot,
+		h.StateRoot,
+		h.BodyRoot,
+		{}, {}, {},
+	}
+	nodes := make(map[uint64]proofs.Root)
+	for i, leaf := range leaves {
+		nodes[8+uint64(i)] = leaf
+	}
+	for i := uint64(7); i >= 1; i-- {
+		nodes[i] = hashPair(nodes[2*i], nodes[2*i+1])
+	}
+	return nodes
+}
+
+func testHeader() *proofs.BeaconBlockHeader {
+	return &proofs.BeaconBlockHeader{
+		Slot:            42,
+		ProposerIndex:   7,
+		ParentBlockRoot: proofs.Root{1},
+		StateRoot:       proofs.Root{2},
+		BodyRoot:        proofs.Root{3},
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	t.Parallel()
+	header := testHeader()
+	nodes := headerTree(header)
+	root := header.HashTreeRoot()
+	if root != nodes[1] {
+		t.Fatalf("header root mismatch: %s != %s", root, nodes[1])
+	}
+
+	proof := []proofs.Root{nodes[8], nodes[5], nodes[3]}
+	if !proofs.VerifyProof(root, chunk(7), proofs.ProposerIndexGIndexBlock, proof) {
+		t.Fatal("valid proposer index proof rejected")
+	}
+	if proofs.VerifyProof(root, chunk(8), proofs.ProposerIndexGIndexBlock, proof) {
+		t.Fatal("proof accepted for wrong leaf")
+	}
+	if proofs.VerifyProof(root, chunk(7), 17, proof) {
+		t.Fatal("proof accepted for wrong depth")
+	}
+}
+
+func TestVerifyMultiproof(t *testing.T) {
+	t.Parallel()
+	header := testHeader()
+	nodes := headerTree(header)
+	root := header.HashTreeRoot()
+
+	indices := []uint64{9, 11, 12}
+	helpers := proofs.HelperIndices(indices)
+	expected := []uint64{13, 10, 8, 7}
+	if len(helpers) != len(expected) {
+		t.Fatalf("unexpected helper indices %v", helpers)
+	}
+	hashes := make([]proofs.Root, len(helpers))
+	for i, index := range helpers {
+		if index != expected[i] {
+			t.Fatalf("unexpected helper indices %v", helpers)
+		}
+		hashes[i] = nodes[index]
+	}
+	leaves := []proofs.Root{nodes[9], nodes[11], nodes[12]}
+
+	ok, err := proofs.VerifyMultiproof(root, leaves, indices, hashes)
+	if err != nil || !ok {
+		t.Fatalf("valid multiproof rejected: %v", err)
+	}
+	leaves[1] = proofs.Root{9}
+	ok, err = proofs.VerifyMultiproof(root, leaves, indices, hashes)
+	if err != nil || ok {
+		t.Fatalf("tampered multiproof accepted: %v", err)
+	}
+	if _, err = proofs.VerifyMultiproof(
+		root, leaves, indices, hashes[:3],
+	); err == nil {
+		t.Fatal("incomplete multiproof accepted")
+	}
+}
+
+func TestBlockProposerResponseJSON(t *testing.T) {
+	t.Parallel()
+	header := testHeader()
+	nodes := headerTree(header)
+	resp := &proofs.BlockProposerResponse{
+		BeaconBlockHeader:  header,
+		BeaconBlockRoot:    header.HashTreeRoot(),
+		ProposerIndexProof: []proofs.Root{nodes[8], nodes[5], nodes[3]},
+	}
+	bz, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded proofs.BlockProposerResponse
+	if err = json.Unmarshal(bz, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded.BeaconBlockHeader != *header {
+		t.Fatalf("header did not round trip: %s", bz)
+	}
+
+	// The validator pubkey proof is empty, so only the proposer index
+	// proof can pass.
+	err = decoded.Verify(1)
+	if err == nil {
+		t.Fatal("empty validator pubkey proof accepted")
+	}
+	decoded.BeaconBlockHeader.Slot++
+	if err = decoded.Verify(1); !errors.Is(err, proofs.ErrRootMismatch) {
+		t.Fatalf("expected root mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proofs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ProposerIndexGIndexBlock is the generalized index of the proposer index in
+// the beacon block header. It is the same for every fork.
+const ProposerIndexGIndexBlock = 9
+
+var (
+	// ErrMissingHeader is returned when a response carries no beacon block
+	// header.
+	ErrMissingHeader = errors.New("missing beacon block header")
+
+	// ErrRootMismatch is returned when the hash tree root of the beacon
+	// block header does not match the beacon block root of a response.
+	ErrRootMismatch = errors.New("beacon block header does not match root")
+
+	// ErrLeafMismatch is returned when the leaf of a field proof does not
+	// match the value of the field.
+	ErrLeafMismatch = errors.New("proof leaf does not match field")
+)
+
+// BlockProposerResponse mirrors the response of the
+// `/proof/block_proposer/{timestamp_id}` endpoint.
+type BlockProposerResponse struct {
+	BeaconBlockHeader    *BeaconBlockHeader `json:"beacon_block_header"`
+	BeaconBlockRoot      Root               `json:"beacon_block_root"`
+	ValidatorPubkey      Pubkey             `json:"validator_pubkey"`
+	ValidatorPubkeyProof []Root             `json:"validator_pubkey_proof"`
+	ProposerIndexProof   []Root             `json:"proposer_index_proof"`
+}
+
+// Verify checks both proofs of the response against its beacon block root.
+// zeroValidatorPubkeyGIndex is the generalized index of the 0 validator pubkey
+// in the beacon block for the fork of the block, as returned by `/proof/gindex`
+// for `BeaconBlockHeader.state_root.validators[0].pubkey`.
+func (r *BlockProposerResponse) Verify(zeroValidatorPubkeyGIndex uint64) error {
+	if err := verifyHeader(r.BeaconBlockHeader, r.BeaconBlockRoot); err != nil {
+		return err
+	}
+	proposerIndex := uint64(r.BeaconBlockHeader.ProposerIndex)
+	if !VerifyProof(
+		r.BeaconBlockRoot,
+		uint64Chunk(proposerIndex),
+		ProposerIndexGIndexBlock,
+		r.ProposerIndexProof,
+	) {
+		return fmt.Errorf("%w: proposer index", ErrInvalidProof)
+	}
+	if !VerifyProof(
+		r.BeaconBlockRoot,
+		bytesRoot(r.ValidatorPubkey[:]),
+		zeroValidatorPubkeyGIndex+8*proposerIndex,
+		r.ValidatorPubkeyProof,
+	) {
+		return fmt.Errorf("%w: validator pubkey", ErrInvalidProof)
+	}
+	return nil
+}
+
+// ValidatorWithdrawalCredentialsResponse mirrors the response of the
+// `/proof/validator_withdrawal_credentials/{timestamp_id}/{validator_index}`
+// endpoint.
+type ValidatorWithdrawalCredentialsResponse struct {
+	BeaconBlockHeader              *BeaconBlockHeader `json:"beacon_block_header"`
+	BeaconBlockRoot                Root               `json:"beacon_block_root"`
+	ValidatorWithdrawalCredentials Root               `json:"validator_withdrawal_credentials"`
+	WithdrawalCredentialsProof     []Root             `json:"withdrawal_credentials_proof"`
+}
+
+// Verify checks the withdrawal credentials of the validator at validatorIndex
+// against the beacon block root of the response. zeroCredentialsGIndex is the
+// generalized index of the 0 validator withdrawal credentials in the beacon
+// block for the fork of the block, as returned by `/proof/gindex` for
+// `BeaconBlockHeader.state_root.validators[0].withdrawal_credentials`.
+func (r *ValidatorWithdrawalCredentialsResponse) Verify(
+	validatorIndex, zeroCredentialsGIndex uint64,
+) error {
+	if err := verifyHeader(r.BeaconBlockHeader, r.BeaconBlockRoot); err != nil {
+		return err
+	}
+	if !VerifyProof(
+		r.BeaconBlockRoot,
+		r.ValidatorWithdrawalCredentials,
+		zeroCredentialsGIndex+8*validatorIndex,
+		r.WithdrawalCredentialsProof,
+	) {
+		return fmt.Errorf("%w: withdrawal credentials", ErrInvalidProof)
+	}
+	return nil
+}
+
+// DepositResponse mirrors the response of the
+// `/proof/deposit/{timestamp_id}/{deposit_index}` endpoint.
+type DepositResponse struct {
+	BeaconBlockHeader *BeaconBlockHeader `json:"beacon_block_header"`
+	BeaconBlockRoot   Root               `json:"beacon_block_root"`
+	Deposit           *Deposit           `json:"deposit"`
+	DepositProof      []Root             `json:"deposit_proof"`
+}
+
+// Verify checks the deposit against the beacon block root of the response.
+// zeroDepositGIndex is the generalized index of the 0 deposit in the beacon
+// block for the fork of the block, as returned by `/proof/gindex` for
+// `BeaconBlockHeader.state_root.eth1_data.deposit_root[0]`.
+func (r *DepositResponse) Verify(zeroDepositGIndex uint64) error {
+	if err := verifyHeader(r.BeaconBlockHeader, r.BeaconBlockRoot); err != nil {
+		return err
+	}
+	if r.Deposit == nil {
+		return fmt.Errorf("%w: missing deposit", ErrInvalidProof)
+	}
+	if !VerifyProof(
+		r.BeaconBlockRoot,
+		r.Deposit.HashTreeRoot(),
+		zeroDepositGIndex+r.Deposit.Index,
+		r.DepositProof,
+	) {
+		return fmt.Errorf("%w: deposit %d", ErrInvalidProof, r.Deposit.Index)
+	}
+	return nil
+}
+
+// FieldProof mirrors a Merkle proof of a single field against the beacon
+// block root.
+type FieldProof struct {
+	GeneralizedIndex uint64 `json:"generalized_index"`
+	Leaf             Root   `json:"leaf"`
+	Proof            []Root `json:"proof"`
+}
+
+// Verify checks the proof against root at its own generalized index.
+func (p *FieldProof) Verify(root Root) error {
+	if !VerifyProof(root, p.Leaf, p.GeneralizedIndex, p.Proof) {
+		return fmt.Errorf(
+			"%w: generalized index %d", ErrInvalidProof, p.GeneralizedIndex,
+		)
+	}
+	return nil
+}
+
+// ExecutionHeaderResponse mirrors the response of the
+// `/proof/execution_header/{timestamp_id}` endpoint.
+type ExecutionHeaderResponse struct {
+	BeaconBlockHeader *BeaconBlockHeader `json:"beacon_block_header"`
+	BeaconBlockRoot   Root               `json:"beacon_block_root"`
+	BlockHash         Root               `json:"block_hash"`
+	BlockHashProof    FieldProof         `json:"block_hash_proof"`
+	BlockNumber       Quantity           `json:"block_number"`
+	BlockNumberProof  FieldProof         `json:"block_number_proof"`
+	Timestamp         Quantity           `json:"timestamp"`
+	TimestampProof    FieldProof         `json:"timestamp_proof"`
+	StateRoot         Root               `json:"state_root"`
+	StateRootProof    FieldProof         `json:"state_root_proof"`
+}
+
+// Verify checks that every field proof verifies against the beacon block root
+// of the response and that its leaf matches the value of the field.
+func (r *ExecutionHeaderResponse) Verify() error {
+	if err := verifyHeader(r.BeaconBlockHeader, r.BeaconBlockRoot); err != nil {
+		return err
+	}
+	fields := []struct {
+		name  string
+		value Root
+		proof *FieldProof
+	}{
+		{"block_hash", r.BlockHash, &r.BlockHashProof},
+		{"block_number", uint64Chunk(uint64(r.BlockNumber)), &r.BlockNumberProof},
+		{"timestamp", uint64Chunk(uint64(r.Timestamp)), &r.TimestampProof},
+		{"state_root", r.StateRoot, &r.StateRootProof},
+	}
+	for _, f := range fields {
+		if f.proof.Leaf != f.value {
+			return fmt.Errorf("%w: %s", ErrLeafMismatch, f.name)
+		}
+		if err := f.proof.Verify(r.BeaconBlockRoot); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// GIndexResponse mirrors the response of the `/proof/gindex` endpoint.
+type GIndexResponse struct {
+	Path             string `json:"path"`
+	Fork             string `json:"fork"`
+	GeneralizedIndex string `json:"generalized_index"`
+}
+
+// Uint64 parses the generalized index of the response. It fails for indices
+// that do not fit a uint64, which cannot be used with VerifyProof anyway.
+func (r *GIndexResponse) Uint64() (uint64, error) {
+	gIndex, err := strconv.ParseUint(r.GeneralizedIndex, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidGeneralizedIndex, err)
+	}
+	if gIndex == 0 {
+		return 0, ErrInvalidGeneralizedIndex
+	}
+	return gIndex, nil
+}
+
+// verifyHeader checks that the hash tree root of header is root.
+func verifyHeader(header *BeaconBlockHeader, root Root) error {
+	if header == nil {
+		return ErrMissingHeader
+	}
+	if header.HashTreeRoot() != root {
+		return ErrRootMismatch
+	}
+	return nil
+}
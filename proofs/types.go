@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proofs
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// BeaconBlockHeader mirrors the beacon block header served by the node API.
+// Its hash tree root is the beacon block root that proofs verify against.
+type BeaconBlockHeader struct {
+	Slot            Quantity `json:"slot"`
+	ProposerIndex   Quantity `json:"proposer_index"`
+	ParentBlockRoot Root     `json:"parent_block_root"`
+	StateRoot       Root     `json:"state_root"`
+	BodyRoot        Root     `json:"body_root"`
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the header.
+func (h *BeaconBlockHeader) HashTreeRoot() Root {
+	return merkleize(
+		uint64Chunk(uint64(h.Slot)),
+		uint64Chunk(uint64(h.ProposerIndex)),
+		h.ParentBlockRoot,
+		h.StateRoot,
+		h.BodyRoot,
+	)
+}
+
+// Deposit mirrors the deposit served by the node API.
+type Deposit struct {
+	Pubkey      Pubkey    `json:"pubkey"`
+	Credentials Root      `json:"credentials"`
+	Amount      Quantity  `json:"amount"`
+	Signature   Signature `json:"signature"`
+	Index       uint64    `json:"index"`
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the deposit, which is the
+// leaf of a deposit proof.
+func (d *Deposit) HashTreeRoot() Root {
+	return merkleize(
+		bytesRoot(d.Pubkey[:]),
+		d.Credentials,
+		uint64Chunk(uint64(d.Amount)),
+		bytesRoot(d.Signature[:]),
+		uint64Chunk(d.Index),
+	)
+}
+
+// uint64Chunk returns the SSZ chunk of a uint64, i.e. its little endian
+// encoding right padded with zeros.
+func uint64Chunk(v uint64) Root {
+	var chunk Root
+	binary.LittleEndian.PutUint64(chunk[:], v)
+	return chunk
+}
+
+// bytesRoot returns the hash tree root of a fixed size byte vector.
+func bytesRoot(b []byte) Root {
+	chunks := make([]Root, (len(b)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], b[i*32:])
+	}
+	return merkleize(chunks...)
+}
+
+// merkleize returns the root of the chunks, padded with zero chunks to the
+// next power of two.
+func merkleize(chunks ...Root) Root {
+	if len(chunks) == 0 {
+		return Root{}
+	}
+	var zero Root
+	layer := slices.Clone(chunks)
+	for len(layer) > 1 {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zero)
+		}
+		next := make([]Root, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+		zero = hashPair(zero, zero)
+	}
+	return layer[0]
+}
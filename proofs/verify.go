@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proofs
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/bits"
+	"slices"
+)
+
+var (
+	// ErrInvalidProof is returned when a proof does not verify against the
+	// expected root.
+	ErrInvalidProof = errors.New("invalid proof")
+
+	// ErrInvalidGeneralizedIndex is returned when a generalized index is 0.
+	ErrInvalidGeneralizedIndex = errors.New("invalid generalized index")
+
+	// ErrMultiproofLengthMismatch is returned when the number of leaves does
+	// not match the number of generalized indices.
+	ErrMultiproofLengthMismatch = errors.New(
+		"multiproof leaves and indices length mismatch",
+	)
+
+	// ErrMultiproofIncomplete is returned when the helper hashes do not
+	// cover every node required to compute the root.
+	ErrMultiproofIncomplete = errors.New("multiproof is missing nodes")
+)
+
+// VerifyProof reports whether proof is a valid Merkle branch for leaf at the
+// generalized index gIndex in the tree with the given root, as per
+// `is_valid_merkle_branch` of the consensus specs. The proof is ordered from
+// the sibling of the leaf up to the child of the root, which is how the node
+// API serves it.
+func VerifyProof(root, leaf Root, gIndex uint64, proof []Root) bool {
+	if gIndex == 0 || len(proof) != bits.Len64(gIndex)-1 {
+		return false
+	}
+	node := leaf
+	for i, sibling := range proof {
+		if (gIndex>>i)&1 == 1 {
+			node = hashPair(sibling, node)
+		} else {
+			node = hashPair(node, sibling)
+		}
+	}
+	return node == root
+}
+
+// VerifyMultiproof reports whether leaves at the generalized indices, together
+// with the helper hashes, verify against root, as per
+// `verify_merkle_multiproof` of the consensus specs. The hashes must be
+// ordered by decreasing generalized index, as returned by HelperIndices.
+func VerifyMultiproof(
+	root Root, leaves []Root, indices []uint64, hashes []Root,
+) (bool, error) {
+	computed, err := MultiproofRoot(leaves, indices, hashes)
+	if err != nil {
+		return false, err
+	}
+	return computed == root, nil
+}
+
+// MultiproofRoot computes the root of a multiproof, as per
+// `calculate_multi_merkle_root` of the consensus specs.
+func MultiproofRoot(
+	leaves []Root, indices []uint64, hashes []Root,
+) (Root, error) {
+	if len(leaves) != len(indices) {
+		return Root{}, ErrMultiproofLengthMismatch
+	}
+	helpers := HelperIndices(indices)
+	if len(hashes) != len(helpers) {
+		return Root{}, ErrMultiproofIncomplete
+	}
+
+	nodes := make(map[uint64]Root, len(leaves)+len(hashes))
+	for i, index := range indices {
+		if index == 0 {
+			return Root{}, ErrInvalidGeneralizedIndex
+		}
+		nodes[index] = leaves[i]
+	}
+	for i, index := range helpers {
+		nodes[index] = hashes[i]
+	}
+
+	keys := make([]uint64, 0, len(nodes))
+	for k := range nodes {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	slices.Reverse(keys)
+
+	// keys grows while it is walked as parents are added, which mirrors the
+	// spec's loop over a list that is appended to.
+	for pos := 0; pos < len(keys); pos++ {
+		k := keys[pos]
+		if k <= 1 {
+			continue
+		}
+		left, hasLeft := nodes[k&^1]
+		right, hasRight := nodes[k|1]
+		if _, done := nodes[k/2]; done || !hasLeft || !hasRight {
+			continue
+		}
+		nodes[k/2] = hashPair(left, right)
+		keys = append(keys, k/2)
+	}
+
+	computed, ok := nodes[1]
+	if !ok {
+		return Root{}, ErrMultiproofIncomplete
+	}
+	return computed, nil
+}
+
+// HelperIndices returns the generalized indices of the nodes, other than the
+// given ones, that are needed to compute the root of a multiproof for the
+// given indices, as per `get_helper_indices` of the consensus specs. They are
+// sorted by decreasing generalized index.
+func HelperIndices(indices []uint64) []uint64 {
+	paths := make(map[uint64]struct{})
+	branches := make(map[uint64]struct{})
+	for _, index := range indices {
+		for i := index; i > 1; i /= 2 {
+			paths[i] = struct{}{}
+			branches[i^1] = struct{}{}
+		}
+	}
+
+	helpers := make([]uint64, 0, len(branches))
+	for index := range branches {
+		if _, ok := paths[index]; !ok {
+			helpers = append(helpers, index)
+		}
+	}
+	slices.Sort(helpers)
+	slices.Reverse(helpers)
+	return helpers
+}
+
+// hashPair returns the SHA-256 hash of the concatenation of a and b.
+func hashPair(a, b Root) Root {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Sum256(buf[:])
+}
@@ -287,11 +287,39 @@ test-simulated: ## run simulation tests
 	$(call FILTER_COVERAGE, temp-test-simulated.txt, test-simulated.txt)
 	@rm temp-test-simulated.txt
 
+SPEC_TESTS_VERSION = v1.5.0
+SPEC_TESTS_DIR = .tmp/consensus-spec-tests
+
+$(SPEC_TESTS_DIR):
+	@mkdir -p $(SPEC_TESTS_DIR)
+	@for preset in mainnet minimal; do \
+		curl -sSfL https://github.com/ethereum/consensus-spec-tests/releases/download/$(SPEC_TESTS_VERSION)/$$preset.tar.gz | \
+			tar -xz -C $(SPEC_TESTS_DIR); \
+	done
+
+test-spectest: $(SPEC_TESTS_DIR) ## run consensus-spec-tests vectors against the shared types
+	@echo "Running consensus spec tests $(SPEC_TESTS_VERSION)..."
+	@go list -f '{{.Dir}}/testing/spectest' -m | SPEC_TESTS_DIR=$(abspath $(SPEC_TESTS_DIR)) xargs \
+		go test -v -tags spectest
+
+test-devnet: ## run in-process multi-node devnet tests
+	@echo "Running devnet tests..."
+	@go list -f '{{.Dir}}/testing/devnet' -m | xargs \
+		go test -tags simulated -v
+
 test-unit-bench: ## run golang unit benchmarks
 	@echo "Running unit tests with benchmarks..."
 	@go list -f '{{.Dir}}/...' -m | xargs \
 		go test -bench=. -run=^$ -benchmem -tags bls12381,test
 
+BENCH_STATE_OUT ?= .tmp/bench-state.txt
+
+test-bench-state: ## run state transition, hashing and proof benchmarks at 1k/10k/100k validators
+	@echo "Running state benchmarks, results in $(BENCH_STATE_OUT)..."
+	@mkdir -p $(dir $(BENCH_STATE_OUT))
+	@go test -run=^$$ -bench='ProcessEpoch|StateHashTreeRoot|ProveWithdrawalCredentials' -benchmem -count=5 -timeout 0 -tags bls12381,test \
+		./testing/benchmarks/ | tee $(BENCH_STATE_OUT)
+
 # On MacOS, if there is a linking issue on the fuzz tests,
 # use the old linker with flags -ldflags=-extldflags=-Wl,-ld_classic
 test-unit-fuzz: ## run fuzz tests
@@ -300,6 +328,10 @@ test-unit-fuzz: ## run fuzz tests
 	go test -run ^FuzzPayloadIDInvalidInput -fuzztime=${SHORT_FUZZ_TIME} github.com/berachain/beacon-kit/payload/cache
 	go test -run ^FuzzPayloadIDCacheConcurrency -fuzztime=${SHORT_FUZZ_TIME} github.com/berachain/beacon-kit/payload/cache
 	go test -run ^FuzzHashTreeRoot -fuzztime=${MEDIUM_FUZZ_TIME} github.com/berachain/beacon-kit/primitives/merkle
+	go test -run ^FuzzExecutionPayload_UnmarshalSSZ -fuzztime=${SHORT_FUZZ_TIME} github.com/berachain/beacon-kit/consensus-types/types
+	go test -run ^FuzzExecutionPayload_UnmarshalJSON -fuzztime=${SHORT_FUZZ_TIME} github.com/berachain/beacon-kit/consensus-types/types
+	go test -run ^FuzzExecutionPayloadHeader_UnmarshalSSZ -fuzztime=${SHORT_FUZZ_TIME} github.com/berachain/beacon-kit/consensus-types/types
+	go test -run ^FuzzSignedBeaconBlock_UnmarshalSSZ -fuzztime=${SHORT_FUZZ_TIME} github.com/berachain/beacon-kit/consensus-types/types
 
 test-e2e: ## run e2e tests
 	@$(MAKE) build-docker VERSION=kurtosis-local test-e2e-no-build
@@ -27,6 +27,14 @@ var (
 	// exceeds the validator set cap.
 	ErrValSetCapExceeded = errors.New("validator set cap exceeded at genesis")
 
+	// ErrInvalidVoluntaryExit is returned when a voluntary exit cannot be
+	// applied to the validator it targets.
+	ErrInvalidVoluntaryExit = errors.New("invalid voluntary exit")
+
+	// ErrInvalidUnjail is returned when an unjail cannot be applied to the
+	// validator it targets.
+	ErrInvalidUnjail = errors.New("invalid unjail")
+
 	// ErrBlockSlotTooLow is returned when the block slot is too low.
 	ErrBlockSlotTooLow = errors.New("block slot too low")
 
@@ -89,6 +97,14 @@ var (
 	// does not match the expected value.
 	ErrStateRootMismatch = errors.New("state root mismatch")
 
+	// ErrExceedMaximumTransactions is returned when the number of transactions
+	// in a payload exceeds the maximum allowed.
+	ErrExceedMaximumTransactions = errors.New("exceeds maximum transactions")
+
+	// ErrExceedMaximumTxSize is returned when a transaction in a payload is
+	// larger than the maximum allowed.
+	ErrExceedMaximumTxSize = errors.New("exceeds maximum transaction size")
+
 	// ErrExceedMaximumWithdrawals is returned when the number of withdrawals
 	// in a block exceeds the maximum allowed.
 	ErrExceedMaximumWithdrawals = errors.New("exceeds maximum withdrawals")
@@ -27,7 +27,9 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
 type ReadOnlyBeaconState interface {
@@ -42,9 +44,11 @@ type ReadOnlyContext interface {
 	ConsensusCtx() context.Context
 	ConsensusTime() math.U64
 	ProposerAddress() []byte
+	Misbehaviors() []transition.Misbehavior
 	VerifyPayload() bool
 	VerifyRandao() bool
 	VerifyResult() bool
+	OptimisticPayload() bool
 	MeterGas() bool
 }
 
@@ -64,6 +68,7 @@ type ExecutionEngine interface {
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	ssz.TelemetrySink
 	SetGauge(key string, value int64, args ...string)
 	// IncrementCounter increments the counter identified by
 	// the provided key.
@@ -76,6 +81,7 @@ type ChainSpec interface {
 	chain.DepositSpec
 	chain.ForkSpec
 	chain.DomainTypeSpec
+	chain.ExecutionPayloadSpec
 	chain.WithdrawalsSpec
 	SlotsPerEpoch() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
@@ -84,5 +90,12 @@ type ChainSpec interface {
 	GenesisForkVersion() common.Version
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 	ValidatorSetCap() uint64
+	MaxValidatorActivationsPerEpoch() uint64
+	MaxValidatorExitsPerEpoch() uint64
+	MinSlashingPenaltyQuotient() uint64
+	EpochsPerSlashingsVector() uint64
+	DowntimeJailThreshold() uint64
+	MinJailDuration() uint64
 	HistoricalRootsLimit() uint64
+	MaxBlobsPerBlock() uint64
 }
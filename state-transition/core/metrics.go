@@ -21,18 +21,22 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 type stateProcessorMetrics struct {
 	// sink is the sink for the metrics.
 	sink TelemetrySink
+	// ssz records the duration of SSZ operations on the hot path.
+	ssz *ssz.Metrics
 }
 
 // newStateProcessorMetrics creates a new stateProcessorMetrics.
 func newStateProcessorMetrics(sink TelemetrySink) *stateProcessorMetrics {
 	return &stateProcessorMetrics{
 		sink: sink,
+		ssz:  ssz.NewMetrics(sink),
 	}
 }
 
@@ -67,6 +71,18 @@ func (s *stateProcessorMetrics) incrementDepositStakeLost() {
 	s.sink.IncrementCounter("beacon_kit.state.deposit_stake_lost")
 }
 
+func (s *stateProcessorMetrics) incrementValidatorSlashed() {
+	s.sink.IncrementCounter("beacon_kit.state.validator_slashed")
+}
+
+func (s *stateProcessorMetrics) incrementValidatorJailed() {
+	s.sink.IncrementCounter("beacon_kit.state.validator_jailed")
+}
+
+func (s *stateProcessorMetrics) incrementValidatorUnjailed() {
+	s.sink.IncrementCounter("beacon_kit.state.validator_unjailed")
+}
+
 func (s *stateProcessorMetrics) incrementPartialWithdrawalRequestDropped() {
 	s.sink.IncrementCounter("beacon_kit.state.partial_withdrawal_request_dropped")
 }
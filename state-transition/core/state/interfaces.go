@@ -23,6 +23,7 @@ package state
 import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -39,5 +40,6 @@ type ChainSpec interface {
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	ssz.TelemetrySink
 	IncrementCounter(key string, args ...string)
 }
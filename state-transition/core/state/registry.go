@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// registry holds the decoded validators and balances of a StateDB. A StateDB
+// and its copies share the same registry until one of them writes to it, at
+// which point the writer clones it (copy-on-write). This spares speculative
+// states, such as those of PrepareProposal and ProcessProposal, from decoding
+// the full validator set again from the underlying store.
+//
+// A registry only reflects the writes made through the StateDBs sharing it.
+// Copy builds the copy over the store of the state, so a shared registry
+// always mirrors the store each holder reads through. Independent StateDBs
+// over the same store, such as two created from the same context, decode
+// their own registries and must not be written to while the other is in use.
+//
+// A registry is never mutated while shared. Validators held by it are never
+// handed out, only copies of them. It relies on validators and balances
+// being stored at contiguous indices starting from 0, as AddValidator does.
+type registry struct {
+	// validators is nil until loaded from the store.
+	validators []*ctypes.Validator
+	// balances is nil until loaded from the store.
+	balances []uint64
+}
+
+// clone returns a registry that shares no slice with r. The validators are
+// not copied as they are never mutated in place.
+func (r *registry) clone() *registry {
+	if r == nil {
+		return &registry{}
+	}
+	return &registry{
+		validators: slices.Clone(r.validators),
+		balances:   slices.Clone(r.balances),
+	}
+}
+
+// mutableRegistry returns the registry of the state, cloning it first if it
+// is shared with a copy of the state.
+func (s *StateDB) mutableRegistry() *registry {
+	if !s.ownsRegistry {
+		s.registry = s.registry.clone()
+		s.ownsRegistry = true
+	}
+	return s.registry
+}
+
+// shareRegistry returns the registry of the state for a copy of it. Neither
+// the state nor the copy may mutate it from then on.
+func (s *StateDB) shareRegistry() *registry {
+	s.ownsRegistry = false
+	return s.registry
+}
+
+// AddValidator registers a new validator in the beacon state.
+func (s *StateDB) AddValidator(val *ctypes.Validator) error {
+	if err := s.KVStore.AddValidator(val); err != nil {
+		return err
+	}
+	if s.registry == nil {
+		return nil
+	}
+	r := s.mutableRegistry()
+	if r.validators != nil {
+		valCopy := *val
+		r.validators = append(r.validators, &valCopy)
+	}
+	if r.balances != nil {
+		r.balances = append(r.balances, 0)
+	}
+	return nil
+}
+
+// UpdateValidatorAtIndex updates a validator at a specific index.
+func (s *StateDB) UpdateValidatorAtIndex(
+	index math.ValidatorIndex, val *ctypes.Validator,
+) error {
+	if err := s.KVStore.UpdateValidatorAtIndex(index, val); err != nil {
+		return err
+	}
+	if s.registry == nil || s.registry.validators == nil {
+		return nil
+	}
+	r := s.mutableRegistry()
+	if index.Unwrap() >= uint64(len(r.validators)) {
+		// Not a known index, reload the validators on the next read.
+		r.validators = nil
+		return nil
+	}
+	valCopy := *val
+	r.validators[index] = &valCopy
+	return nil
+}
+
+// ValidatorByIndex returns the validator at the given index.
+func (s *StateDB) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*ctypes.Validator, error) {
+	if s.registry != nil && index.Unwrap() < uint64(len(s.registry.validators)) {
+		valCopy := *s.registry.validators[index]
+		return &valCopy, nil
+	}
+	return s.KVStore.ValidatorByIndex(index)
+}
+
+// GetValidators retrieves all validators from the beacon state. The caller
+// owns the returned validators.
+func (s *StateDB) GetValidators() (ctypes.Validators, error) {
+	if s.registry == nil || s.registry.validators == nil {
+		vals, err := s.KVStore.GetValidators()
+		if err != nil {
+			return nil, err
+		}
+		s.mutableRegistry().validators = vals
+	}
+
+	vals := make(ctypes.Validators, len(s.registry.validators))
+	for i, val := range s.registry.validators {
+		valCopy := *val
+		vals[i] = &valCopy
+	}
+	return vals, nil
+}
+
+// GetTotalValidators returns the total number of validators.
+func (s *StateDB) GetTotalValidators() (math.U64, error) {
+	if s.registry == nil || s.registry.validators == nil {
+		if _, err := s.GetValidators(); err != nil {
+			return 0, err
+		}
+	}
+	return math.U64(len(s.registry.validators)), nil
+}
+
+// GetBalance returns the balance of a validator.
+func (s *StateDB) GetBalance(idx math.ValidatorIndex) (math.Gwei, error) {
+	if s.registry != nil && idx.Unwrap() < uint64(len(s.registry.balances)) {
+		return math.Gwei(s.registry.balances[idx]), nil
+	}
+	return s.KVStore.GetBalance(idx)
+}
+
+// SetBalance sets the balance of a validator.
+func (s *StateDB) SetBalance(idx math.ValidatorIndex, balance math.Gwei) error {
+	if err := s.KVStore.SetBalance(idx, balance); err != nil {
+		return err
+	}
+	if s.registry == nil || s.registry.balances == nil {
+		return nil
+	}
+	r := s.mutableRegistry()
+	if idx.Unwrap() >= uint64(len(r.balances)) {
+		// Not a known index, reload the balances on the next read.
+		r.balances = nil
+		return nil
+	}
+	r.balances[idx] = balance.Unwrap()
+	return nil
+}
+
+// GetBalances returns the balances of all validators.
+func (s *StateDB) GetBalances() ([]uint64, error) {
+	if s.registry == nil || s.registry.balances == nil {
+		balances, err := s.KVStore.GetBalances()
+		if err != nil {
+			return nil, err
+		}
+		// Balances are only cached if there is one per validator, since the
+		// store returns them without their indices.
+		numValidators, err := s.GetTotalValidators()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(balances)) != numValidators.Unwrap() {
+			return balances, nil
+		}
+		if balances == nil {
+			// Mark the balances as loaded.
+			balances = []uint64{}
+		}
+		s.mutableRegistry().balances = balances
+	}
+	if len(s.registry.balances) == 0 {
+		return nil, nil
+	}
+	return slices.Clone(s.registry.balances), nil
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyOnWriteRegistry(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	_, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+
+	for i := range 3 {
+		require.NoError(t, st.AddValidator(&types.Validator{
+			Pubkey:           crypto.BLSPubkey{byte(i + 1)},
+			EffectiveBalance: math.Gwei(i + 1),
+		}))
+		require.NoError(t, st.SetBalance(math.ValidatorIndex(i), math.Gwei(10*(i+1))))
+	}
+
+	// Load the registry so that the copy shares it.
+	parentVals, err := st.GetValidators()
+	require.NoError(t, err)
+	parentBalances, err := st.GetBalances()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 20, 30}, parentBalances)
+
+	cpy := st.Copy()
+	cpyVals, err := cpy.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, parentVals, cpyVals)
+
+	// Mutating returned validators must not reach the registry.
+	cpyVals[0].EffectiveBalance = 100
+	val, err := cpy.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(1), val.EffectiveBalance)
+
+	// Writes to the copy must not reach the state.
+	val.EffectiveBalance = 100
+	require.NoError(t, cpy.UpdateValidatorAtIndex(0, val))
+	require.NoError(t, cpy.IncreaseBalance(1, 5))
+	require.NoError(t, cpy.AddValidator(&types.Validator{Pubkey: crypto.BLSPubkey{4}}))
+
+	vals, err := st.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, parentVals, vals)
+	balances, err := st.GetBalances()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 20, 30}, balances)
+
+	// The copy must agree with its own store.
+	cachedVals, err := cpy.GetValidators()
+	require.NoError(t, err)
+	storedVals, err := cpy.KVStore.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, storedVals, cachedVals)
+	require.Len(t, cachedVals, 4)
+	cachedBalances, err := cpy.GetBalances()
+	require.NoError(t, err)
+	storedBalances, err := cpy.KVStore.GetBalances()
+	require.NoError(t, err)
+	require.Equal(t, storedBalances, cachedBalances)
+	require.Equal(t, []uint64{10, 25, 30, 0}, cachedBalances)
+}
+
+func TestCopyOnWriteRegistry_SiblingCopies(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	_, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+
+	for i := range 2 {
+		require.NoError(t, st.AddValidator(&types.Validator{
+			Pubkey:           crypto.BLSPubkey{byte(i + 1)},
+			EffectiveBalance: math.Gwei(i + 1),
+		}))
+		require.NoError(t, st.SetBalance(math.ValidatorIndex(i), math.Gwei(10*(i+1))))
+	}
+	parentVals, err := st.GetValidators()
+	require.NoError(t, err)
+	_, err = st.GetBalances()
+	require.NoError(t, err)
+
+	// Both copies share the registry of the state.
+	writer, reader := st.Copy(), st.Copy()
+	_, err = reader.GetValidators()
+	require.NoError(t, err)
+
+	// Writes through one copy are not seen through the other.
+	val, err := writer.ValidatorByIndex(1)
+	require.NoError(t, err)
+	val.EffectiveBalance = 100
+	require.NoError(t, writer.UpdateValidatorAtIndex(1, val))
+	require.NoError(t, writer.SetBalance(0, 7))
+	require.NoError(t, writer.AddValidator(&types.Validator{Pubkey: crypto.BLSPubkey{3}}))
+
+	vals, err := reader.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, parentVals, vals)
+	balances, err := reader.GetBalances()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 20}, balances)
+	storedBalances, err := reader.KVStore.GetBalances()
+	require.NoError(t, err)
+	require.Equal(t, storedBalances, balances)
+
+	// A copy of the writer reads through it, and sees its writes.
+	child := writer.Copy()
+	childVals, err := child.GetValidators()
+	require.NoError(t, err)
+	storedVals, err := child.KVStore.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, storedVals, childVals)
+	require.Len(t, childVals, 3)
+	require.Equal(t, math.Gwei(100), childVals[1].EffectiveBalance)
+	balance, err := child.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(7), balance)
+
+	// And the state is left untouched by all of them.
+	vals, err = st.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, parentVals, vals)
+	balances, err = st.GetBalances()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 20}, balances)
+}
@@ -21,7 +21,6 @@
 package state
 
 import (
-	"context"
 	"fmt"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
@@ -29,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/storage/beacondb"
@@ -43,6 +43,13 @@ type StateDB struct {
 	cs            ChainSpec
 	logger        log.Logger
 	telemetrySink TelemetrySink
+	sszMetrics    *ssz.Metrics
+
+	// registry caches the decoded validators and balances, see registry.
+	registry *registry
+	// ownsRegistry is false while registry is shared with a copy of the
+	// state, or with the state it was copied from.
+	ownsRegistry bool
 }
 
 // NewBeaconStateFromDB creates a new beacon state from an underlying state db.
@@ -54,12 +61,24 @@ func NewBeaconStateFromDB(
 		cs:            cs,
 		logger:        logger,
 		telemetrySink: telemetrySink,
+		sszMetrics:    ssz.NewMetrics(telemetrySink),
+		ownsRegistry:  true,
 	}
 }
 
-// Copy returns a copy of the beacon state.
-func (s *StateDB) Copy(ctx context.Context) *StateDB {
-	return NewBeaconStateFromDB(s.KVStore.Copy(ctx), s.cs, s.logger, s.telemetrySink)
+// Copy returns a copy of the beacon state. Writes to the copy are buffered in
+// a cache on top of the store of the state and never reach it. The copy
+// shares the decoded validators and balances of the state until either of
+// them writes to them, so the copy does not decode the registry again. This
+// only holds because the copy reads through the very store the registry was
+// decoded from, so the state must not be written to while the copy is in use.
+func (s *StateDB) Copy() *StateDB {
+	cpy := NewBeaconStateFromDB(
+		s.KVStore.Copy(s.KVStore.Context()), s.cs, s.logger, s.telemetrySink,
+	)
+	cpy.registry = s.shareRegistry()
+	cpy.ownsRegistry = false
+	return cpy
 }
 
 // GetEpoch returns the current epoch.
@@ -451,5 +470,5 @@ func (s *StateDB) HashTreeRoot() common.Root {
 	if err != nil {
 		panic(err)
 	}
-	return st.HashTreeRoot()
+	return s.sszMetrics.HashTreeRoot(ssz.TypeBeaconState, st)
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
@@ -57,6 +58,9 @@ type StateProcessor struct {
 	metrics *stateProcessorMetrics
 	// logDeneb1Once enforces logging the Deneb1 fork information at most once.
 	logDeneb1Once sync.Once
+	// debugDir is the directory where state root mismatches are dumped. No
+	// dump is written if empty.
+	debugDir string
 }
 
 // NewStateProcessor creates a new state processor.
@@ -68,6 +72,7 @@ func NewStateProcessor(
 	signer crypto.BLSSigner,
 	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
 	telemetrySink TelemetrySink,
+	debugDir string,
 ) *StateProcessor {
 	return &StateProcessor{
 		logger:                logger,
@@ -77,6 +82,7 @@ func NewStateProcessor(
 		fGetAddressFromPubKey: fGetAddressFromPubKey,
 		ds:                    ds,
 		metrics:               newStateProcessorMetrics(telemetrySink),
+		debugDir:              debugDir,
 	}
 }
 
@@ -228,6 +234,10 @@ func (sp *StateProcessor) ProcessBlock(
 		return err
 	}
 
+	if err := sp.processMisbehaviors(ctx, st); err != nil {
+		return err
+	}
+
 	// If we are skipping validate, we can skip calculating the state
 	// root to save compute.
 	if !ctx.VerifyResult() {
@@ -238,6 +248,7 @@ func (sp *StateProcessor) ProcessBlock(
 	// the block.
 	stateRoot := st.HashTreeRoot()
 	if blk.GetStateRoot() != stateRoot {
+		sp.dumpStateRootMismatch(st, blk.GetStateRoot())
 		return errors.Wrapf(
 			ErrStateRootMismatch, "expected %s, got %s",
 			stateRoot, blk.GetStateRoot(),
@@ -248,7 +259,8 @@ func (sp *StateProcessor) ProcessBlock(
 }
 
 // processEpoch processes the epoch and ensures it matches the local state.
-// Currently, beacon-kit does not enforce rewards, penalties, and slashing for validators.
+// Currently, beacon-kit does not enforce rewards and penalties for validators, while slashing
+// is applied along with the block which commits the misbehavior evidence.
 // Extra caution is required when any fork-specific logic is added within the scope of this method
 // as epochs and fork slots may not always neatly overlap.
 func (sp *StateProcessor) processEpoch(st *state.StateDB) (transition.ValidatorUpdates, error) {
@@ -259,7 +271,7 @@ func (sp *StateProcessor) processEpoch(st *state.StateDB) (transition.ValidatorU
 
 	// track validators set before updating it, to be able to
 	// inform consensus of the validators set changes
-	currentActiveVals, err := getActiveVals(st, currentEpoch)
+	currentActiveVals, err := getConsensusVals(st, currentEpoch)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +299,7 @@ func (sp *StateProcessor) processEpoch(st *state.StateDB) (transition.ValidatorU
 
 	// finally compute diffs in validator set to duly update consensus
 	nextEpoch := currentEpoch + 1
-	nextActiveVals, err := getActiveVals(st, nextEpoch)
+	nextActiveVals, err := getConsensusVals(st, nextEpoch)
 	if err != nil {
 		return nil, err
 	}
@@ -356,7 +368,7 @@ func (sp *StateProcessor) processBlockHeader(
 	}
 
 	// Cache current block as the new latest block
-	bodyRoot := blk.GetBody().HashTreeRoot()
+	bodyRoot := sp.metrics.ssz.HashTreeRoot(ssz.TypeBeaconBlockBody, blk.GetBody())
 
 	lbh := &ctypes.BeaconBlockHeader{
 		Slot:            blk.GetSlot(),
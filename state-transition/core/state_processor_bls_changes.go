@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// ValidateBLSToExecutionChange checks that the signed change can be applied to
+// the given state, as per the assertions of process_bls_to_execution_change:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-process_bls_to_execution_change
+// The state is not modified.
+func (sp *StateProcessor) ValidateBLSToExecutionChange(
+	st *statedb.StateDB, signed *ctypes.SignedBLSToExecutionChange,
+) error {
+	_, err := sp.validateBLSToExecutionChange(st, signed)
+	return err
+}
+
+// ProcessBLSToExecutionChange rotates the withdrawal credentials of the
+// validator targeted by the signed change to the 0x01 credentials of its
+// execution address. Changes are processed for blocks from Electra1 on.
+func (sp *StateProcessor) ProcessBLSToExecutionChange(
+	st *statedb.StateDB, signed *ctypes.SignedBLSToExecutionChange,
+) error {
+	validator, err := sp.validateBLSToExecutionChange(st, signed)
+	if err != nil {
+		return err
+	}
+	validator.WithdrawalCredentials = ctypes.NewCredentialsFromExecutionAddress(
+		signed.Message.ToExecutionAddress,
+	)
+	return st.UpdateValidatorAtIndex(signed.Message.ValidatorIndex, validator)
+}
+
+func (sp *StateProcessor) validateBLSToExecutionChange(
+	st *statedb.StateDB, signed *ctypes.SignedBLSToExecutionChange,
+) (*ctypes.Validator, error) {
+	if signed == nil || signed.Message == nil {
+		return nil, ctypes.ErrNilValue
+	}
+	change := signed.Message
+	validator, err := st.ValidatorByIndex(change.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	if err = change.ValidateForValidator(validator); err != nil {
+		return nil, err
+	}
+
+	// The signature is always verified against the genesis fork version.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+	fd := ctypes.NewForkData(sp.cs.GenesisForkVersion(), genesisValidatorsRoot)
+	if err = signed.VerifySignature(fd, sp.signer.VerifySignature); err != nil {
+		return nil, err
+	}
+	return validator, nil
+}
@@ -0,0 +1,106 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionBLSToExecutionChanges checks that changes included in a block
+// rotate the withdrawal credentials, and that a stale change fails the block.
+func TestTransitionBLSToExecutionChanges(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		withdrawalKey = crypto.BLSPubkey{0xaa}
+		hashedKey     = sha256.Hash(withdrawalKey[:])
+		blsCreds      types.WithdrawalCredentials
+		genDeposits   types.Deposits
+	)
+	blsCreds[0] = types.BLSWithdrawalPrefix
+	copy(blsCreds[1:], hashedKey[1:])
+	genDeposits = types.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+			Amount:      cs.MaxEffectiveBalance(),
+			Index:       0,
+		},
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: blsCreds,
+			Amount:      cs.MaxEffectiveBalance(),
+			Index:       1,
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(st, genDeposits, genPayloadHeader, cs.GenesisForkVersion())
+	require.NoError(t, err)
+	_, depRoot, err := ds.GetDepositsByIndex(ctx.ConsensusCtx(), constants.FirstDepositIndex, uint64(len(genDeposits)))
+	require.NoError(t, err)
+
+	toAddress := common.ExecutionAddress{0xbb}
+	change := &types.SignedBLSToExecutionChange{
+		Message: &types.BLSToExecutionChange{
+			ValidatorIndex:     1,
+			FromBLSPubkey:      withdrawalKey,
+			ToExecutionAddress: toAddress,
+		},
+		Signature: crypto.BLSSignature{0x01},
+	}
+	require.NoError(t, sp.ValidateBLSToExecutionChange(st, change))
+
+	blk := buildNextBlock(t, cs, st, types.NewEth1Data(depRoot), 10,
+		types.Deposits{}, &types.ExecutionRequests{}, st.EVMInflationWithdrawal(10),
+	)
+	blk.GetBody().SetBlsToExecutionChanges(types.BlsToExecutionChanges{change})
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	validator, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, types.NewCredentialsFromExecutionAddress(toAddress), validator.GetWithdrawalCredentials())
+
+	// The credentials are rotated already, so the same change fails the block.
+	require.ErrorIs(t, sp.ValidateBLSToExecutionChange(st, change), types.ErrInvalidBLSToExecutionChange)
+	blk = buildNextBlock(t, cs, st, types.NewEth1Data(depRoot), 11,
+		types.Deposits{}, &types.ExecutionRequests{}, st.EVMInflationWithdrawal(11),
+	)
+	blk.GetBody().SetBlsToExecutionChanges(types.BlsToExecutionChanges{change})
+	_, err = sp.Transition(ctx, st, blk)
+	require.ErrorIs(t, err, types.ErrInvalidBLSToExecutionChange)
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// QueuedValidator is a validator waiting in the activation queue.
+type QueuedValidator struct {
+	Index     math.ValidatorIndex
+	Validator *ctypes.Validator
+	// EstimatedActivationEpoch is the epoch the validator will become active
+	// at, assuming no other validator joins the queue ahead of it.
+	EstimatedActivationEpoch math.Epoch
+}
+
+// ActivationQueue returns the validators which are eligible for activation
+// but not activated yet, in the order they will be activated. As in the
+// ETH 2.0 spec, validators are ordered by activation eligibility epoch and
+// then by index. At most MaxValidatorActivationsPerEpoch validators are
+// activated at each epoch, if the limit is set.
+func (sp *StateProcessor) ActivationQueue(st *statedb.StateDB) ([]*QueuedValidator, error) {
+	currEpoch, err := st.GetEpoch()
+	if err != nil {
+		return nil, fmt.Errorf("activation queue, failed loading epoch: %w", err)
+	}
+	vals, err := st.GetValidators()
+	if err != nil {
+		return nil, fmt.Errorf("activation queue, failed listing validators: %w", err)
+	}
+
+	queue := make([]*QueuedValidator, 0)
+	for i, val := range vals {
+		if val.GetActivationEligibilityEpoch() == constants.FarFutureEpoch ||
+			val.GetActivationEpoch() != constants.FarFutureEpoch {
+			continue
+		}
+		queue = append(queue, &QueuedValidator{
+			Index:     math.ValidatorIndex(i),
+			Validator: val,
+		})
+	}
+	// Validators are listed by index, so a stable sort preserves index ordering
+	// among validators sharing the same eligibility epoch.
+	slices.SortStableFunc(queue, func(lhs, rhs *QueuedValidator) int {
+		return cmp.Compare(
+			lhs.Validator.GetActivationEligibilityEpoch(),
+			rhs.Validator.GetActivationEligibilityEpoch(),
+		)
+	})
+
+	// Replay the registry updates of the upcoming epochs: at each epoch
+	// transition validators eligible by then are activated for the next
+	// epoch, up to the churn limit.
+	var (
+		churn     = sp.cs.MaxValidatorActivationsPerEpoch()
+		epoch     = currEpoch
+		activated uint64
+	)
+	for _, qv := range queue {
+		if eligibility := qv.Validator.GetActivationEligibilityEpoch(); eligibility > epoch {
+			epoch = eligibility
+			activated = 0
+		}
+		if churn != 0 && activated == churn {
+			epoch++
+			activated = 0
+		}
+		qv.EstimatedActivationEpoch = epoch + 1
+		activated++
+	}
+	return queue, nil
+}
+
+// exitQueueEpoch returns the epoch a validator initiating its exit in the
+// current epoch is scheduled to exit at. As in the phase0 ETH 2.0 spec, exits
+// are scheduled no earlier than the latest scheduled exit, and at most
+// MaxValidatorExitsPerEpoch validators exit at the same epoch, if the limit is set:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+func (sp *StateProcessor) exitQueueEpoch(st *statedb.StateDB, currEpoch math.Epoch) (math.Epoch, error) {
+	exitEpoch := currEpoch + 1
+	churn := sp.cs.MaxValidatorExitsPerEpoch()
+	if churn == 0 {
+		return exitEpoch, nil
+	}
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return 0, err
+	}
+	for _, val := range vals {
+		if e := val.GetExitEpoch(); e != constants.FarFutureEpoch && e > exitEpoch {
+			exitEpoch = e
+		}
+	}
+	var exiting uint64
+	for _, val := range vals {
+		if val.GetExitEpoch() == exitEpoch {
+			exiting++
+		}
+	}
+	if exiting >= churn {
+		exitEpoch++
+	}
+	return exitEpoch, nil
+}
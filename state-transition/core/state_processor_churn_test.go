@@ -0,0 +1,97 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivationQueueChurn(t *testing.T) {
+	t.Parallel()
+	specData := spec.DevnetChainSpecData()
+	specData.MaxValidatorActivationsPerEpoch = 2
+	cs, err := chain.NewSpec(specData)
+	require.NoError(t, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		minBalance       = cs.MinActivationBalance()
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		genDeposits      = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: emptyCredentials,
+				Amount:      minBalance,
+				Index:       uint64(0),
+			},
+		}
+		genPayloadHeader = &types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st,
+		genDeposits,
+		genPayloadHeader,
+		cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+
+	// Queue validators 1 to 5, with validators 1 and 4 becoming eligible
+	// for activation later than the others.
+	for i, eligibilityEpoch := range []math.Epoch{1, 0, 0, 1, 0} {
+		val := types.NewValidatorFromDeposit(
+			[48]byte{byte(i + 1)},
+			emptyCredentials,
+			minBalance,
+			cs.EffectiveBalanceIncrement(),
+			cs.MaxEffectiveBalance(),
+		)
+		val.SetActivationEligibilityEpoch(eligibilityEpoch)
+		require.NoError(t, st.AddValidator(val))
+	}
+
+	queue, err := sp.ActivationQueue(st)
+	require.NoError(t, err)
+
+	var (
+		indices = make([]math.ValidatorIndex, len(queue))
+		epochs  = make([]math.Epoch, len(queue))
+	)
+	for i, qv := range queue {
+		indices[i] = qv.Index
+		epochs[i] = qv.EstimatedActivationEpoch
+	}
+	require.Equal(t, []math.ValidatorIndex{2, 3, 5, 1, 4}, indices)
+	require.Equal(t, []math.Epoch{1, 1, 2, 2, 3}, epochs)
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// stateRootMismatchFilePrefix is the prefix of the files written by
+// StateRootMismatchDump.Write.
+const stateRootMismatchFilePrefix = "state-root-mismatch"
+
+// StateRootMismatchDump holds the forensic data of a state transition whose
+// resulting state root disagrees with the expected one.
+type StateRootMismatchDump struct {
+	Slot              math.Slot   `json:"slot"`
+	ExpectedStateRoot common.Root `json:"expected_state_root"`
+	ComputedStateRoot common.Root `json:"computed_state_root"`
+	// ComputedFields are the roots of the fields of the computed state.
+	ComputedFields []ctypes.StateFieldRoot `json:"computed_fields"`
+	// ComputedStateFile is the file holding the SSZ encoding of the computed
+	// state, set by Write.
+	ComputedStateFile string `json:"computed_state_file,omitempty"`
+
+	// ReferenceFields are the roots of the fields of the reference state, if
+	// any, and FirstDifferingChunk locates the first chunk where it differs
+	// from the computed state.
+	ReferenceFields     []ctypes.StateFieldRoot `json:"reference_fields,omitempty"`
+	FirstDifferingChunk *ctypes.StateChunkDiff  `json:"first_differing_chunk,omitempty"`
+
+	computed *ctypes.BeaconState
+}
+
+// NewStateRootMismatchDump computes the forensic data of the computed state,
+// whose root should have been expected. When the state the computed one
+// should equal is known, for example when replaying blocks against the states
+// stored by the node, it can be passed as reference to be compared field by
+// field and chunk by chunk. Otherwise reference is nil and the dumps of two
+// nodes can be compared with ctypes.FirstDifferingStateChunk on the SSZ
+// encodings of their computed states.
+func NewStateRootMismatchDump(
+	computed *state.StateDB,
+	reference *state.StateDB,
+	expected common.Root,
+) (*StateRootMismatchDump, error) {
+	computedState, err := computed.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	computedFields, err := computedState.FieldRoots()
+	if err != nil {
+		return nil, err
+	}
+	dump := &StateRootMismatchDump{
+		Slot:              computedState.Slot,
+		ExpectedStateRoot: expected,
+		ComputedStateRoot: computedState.HashTreeRoot(),
+		ComputedFields:    computedFields,
+		computed:          computedState,
+	}
+	if reference == nil {
+		return dump, nil
+	}
+
+	referenceState, err := reference.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	if dump.ReferenceFields, err = referenceState.FieldRoots(); err != nil {
+		return nil, err
+	}
+	if dump.FirstDifferingChunk, err = ctypes.FirstDifferingStateChunk(
+		computedState, referenceState,
+	); err != nil {
+		return nil, err
+	}
+	return dump, nil
+}
+
+// Write writes the dump as JSON to dir, along with the SSZ encoding of the
+// computed state, and returns the path of the JSON file.
+func (d *StateRootMismatchDump) Write(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	base := filepath.Join(dir, fmt.Sprintf(
+		"%s-%d-%d", stateRootMismatchFilePrefix, d.Slot.Unwrap(), time.Now().UnixNano(),
+	))
+
+	stateBz, err := d.computed.MarshalSSZ()
+	if err != nil {
+		return "", err
+	}
+	d.ComputedStateFile = base + ".ssz"
+	if err = os.WriteFile(d.ComputedStateFile, stateBz, 0o600); err != nil {
+		return "", err
+	}
+
+	dumpBz, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err = os.WriteFile(base+".json", dumpBz, 0o600); err != nil {
+		return "", err
+	}
+	return base + ".json", nil
+}
+
+// dumpStateRootMismatch writes the forensic data of a state root mismatch to
+// the debug directory, if configured. Failures are only logged as the
+// mismatch itself is reported by the caller.
+func (sp *StateProcessor) dumpStateRootMismatch(st *state.StateDB, expected common.Root) {
+	if sp.debugDir == "" {
+		return
+	}
+	dump, err := NewStateRootMismatchDump(st, nil, expected)
+	if err != nil {
+		sp.logger.Error("Failed to compute state root mismatch dump", "error", err)
+		return
+	}
+	path, err := dump.Write(sp.debugDir)
+	if err != nil {
+		sp.logger.Error("Failed to write state root mismatch dump", "error", err)
+		return
+	}
+	sp.logger.Warn("Wrote state root mismatch dump", "slot", dump.Slot, "path", path)
+}
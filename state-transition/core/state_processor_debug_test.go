@@ -0,0 +1,101 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateRootMismatchDump(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+
+	// Build two states from the same genesis, then make them diverge.
+	genesisState := func() *statetransition.TestBeaconStateT {
+		sp, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+		deposits := []*types.Deposit{{
+			Pubkey:      [48]byte{0x01},
+			Amount:      cs.MaxEffectiveBalance(),
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01}),
+			Index:       0,
+		}}
+		header := &types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		}
+		_, err := sp.InitializeBeaconStateFromEth1(st, deposits, header, cs.GenesisForkVersion())
+		require.NoError(t, err)
+		return st
+	}
+	computed := genesisState()
+	reference := genesisState()
+	require.NoError(t, reference.IncreaseBalance(0, 1))
+	expected := reference.HashTreeRoot()
+
+	// Without reference only the computed state is described.
+	dump, err := core.NewStateRootMismatchDump(computed, nil, expected)
+	require.NoError(t, err)
+	require.Equal(t, expected, dump.ExpectedStateRoot)
+	require.Equal(t, computed.HashTreeRoot(), dump.ComputedStateRoot)
+	require.NotEmpty(t, dump.ComputedFields)
+	require.Nil(t, dump.ReferenceFields)
+	require.Nil(t, dump.FirstDifferingChunk)
+
+	// With reference the differing field and chunk are located.
+	dump, err = core.NewStateRootMismatchDump(computed, reference, expected)
+	require.NoError(t, err)
+	require.Len(t, dump.ReferenceFields, len(dump.ComputedFields))
+	for i, field := range dump.ComputedFields {
+		if field.Name == "balances" {
+			require.NotEqual(t, field.Root, dump.ReferenceFields[i].Root)
+			continue
+		}
+		require.Equal(t, field, dump.ReferenceFields[i])
+	}
+	require.NotNil(t, dump.FirstDifferingChunk)
+	require.Equal(t, "balances", dump.FirstDifferingChunk.Field)
+
+	// The dump and the computed state are written to the given directory.
+	path, err := dump.Write(t.TempDir())
+	require.NoError(t, err)
+	dumpBz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	written := &core.StateRootMismatchDump{}
+	require.NoError(t, json.Unmarshal(dumpBz, written))
+	require.Equal(t, dump.FirstDifferingChunk, written.FirstDifferingChunk)
+	require.Equal(t, dump.ComputedStateFile, written.ComputedStateFile)
+
+	stateBz, err := os.ReadFile(written.ComputedStateFile)
+	require.NoError(t, err)
+	computedState := types.NewEmptyBeaconStateWithVersion(cs.GenesisForkVersion())
+	require.NoError(t, computedState.UnmarshalSSZ(stateBz))
+	require.Equal(t, dump.ComputedStateRoot, computedState.HashTreeRoot())
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// DepositOutcome is the effect a deposit has once processed.
+type DepositOutcome string
+
+const (
+	// DepositCreatesValidator is the outcome of a deposit for an unknown
+	// pubkey with a valid signature.
+	DepositCreatesValidator DepositOutcome = "create_validator"
+	// DepositTopsUp is the outcome of a deposit for the pubkey of a
+	// validator, whose signature and withdrawal credentials are ignored.
+	DepositTopsUp DepositOutcome = "top_up"
+	// DepositStakeLost is the outcome of a deposit for an unknown pubkey
+	// with an invalid signature. The deposit is ignored and its amount lost.
+	DepositStakeLost DepositOutcome = "stake_lost"
+)
+
+// DepositSimulation is the effect a deposit would have if it was processed.
+type DepositSimulation struct {
+	Outcome DepositOutcome
+	// ValidatorIndex is the validator topped up, if it is in the registry
+	// already.
+	ValidatorIndex *math.ValidatorIndex
+	// CreatingDepositIndex is the pending deposit that creates the validator
+	// topped up, if it is not in the registry yet.
+	CreatingDepositIndex *uint64
+	// SignatureError is why the signature failed verification, if the stake
+	// is lost.
+	SignatureError error
+	// Warnings are the unexpected but valid effects of the deposit.
+	Warnings []string
+}
+
+// SimulateDeposit reports the effect the deposit would have if it was
+// processed on top of the given state, after the pending deposits, i.e. the
+// deposits read from the execution layer but not processed yet. The state is
+// not modified.
+func (sp *StateProcessor) SimulateDeposit(
+	st *state.StateDB, pending ctypes.Deposits, dep *ctypes.Deposit,
+) (*DepositSimulation, error) {
+	// As in applyDeposit, a pubkey that cannot be looked up is a new one.
+	if idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey()); err == nil {
+		return sp.simulateTopUp(st, idx, dep)
+	}
+
+	forkData, err := sp.depositForkData(st)
+	if err != nil {
+		return nil, err
+	}
+	domainType := sp.cs.DomainTypeDeposit()
+
+	// The first pending deposit for the same pubkey with a valid signature
+	// creates the validator, which the deposit then tops up.
+	for _, p := range pending {
+		if p.GetPubkey() != dep.GetPubkey() ||
+			p.VerifySignature(forkData, domainType, sp.signer.VerifySignature) != nil {
+			continue
+		}
+		index := p.GetIndex().Unwrap()
+		sim := &DepositSimulation{Outcome: DepositTopsUp, CreatingDepositIndex: &index}
+		if p.GetWithdrawalCredentials() != dep.GetWithdrawalCredentials() {
+			sim.Warnings = append(sim.Warnings, fmt.Sprintf(
+				"withdrawal credentials differ from those of deposit %d creating the validator, "+
+					"which are kept", index,
+			))
+		}
+		return sim, nil
+	}
+
+	if err = dep.VerifySignature(forkData, domainType, sp.signer.VerifySignature); err != nil {
+		return &DepositSimulation{Outcome: DepositStakeLost, SignatureError: err}, nil
+	}
+	sim := &DepositSimulation{Outcome: DepositCreatesValidator}
+	if !dep.HasEth1WithdrawalCredentials() {
+		sim.Warnings = append(sim.Warnings,
+			"withdrawal credentials are not ETH1 credentials, the stake is not withdrawable as is",
+		)
+	}
+	val := ctypes.NewValidatorFromDeposit(
+		dep.GetPubkey(),
+		dep.GetWithdrawalCredentials(),
+		dep.GetAmount(),
+		sp.cs.EffectiveBalanceIncrement(),
+		sp.cs.MaxEffectiveBalance(),
+	)
+	if minBalance := sp.cs.MinActivationBalance(); !val.IsEligibleForActivationQueue(minBalance) {
+		sim.Warnings = append(sim.Warnings, fmt.Sprintf(
+			"effective balance %d is below the minimum activation balance %d, "+
+				"the validator is not activated until topped up", val.GetEffectiveBalance(), minBalance,
+		))
+	}
+	return sim, nil
+}
+
+// simulateTopUp reports the effect of a deposit topping up the validator at
+// the given index.
+func (sp *StateProcessor) simulateTopUp(
+	st *state.StateDB, idx math.ValidatorIndex, dep *ctypes.Deposit,
+) (*DepositSimulation, error) {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	sim := &DepositSimulation{Outcome: DepositTopsUp, ValidatorIndex: &idx}
+	if val.GetWithdrawalCredentials() != dep.GetWithdrawalCredentials() {
+		sim.Warnings = append(sim.Warnings,
+			"withdrawal credentials differ from the validator's, which are kept",
+		)
+	}
+	if val.GetExitEpoch() != constants.FarFutureEpoch {
+		sim.Warnings = append(sim.Warnings,
+			"validator has exited, the deposit is withdrawn along with its balance",
+		)
+	}
+	return sim, nil
+}
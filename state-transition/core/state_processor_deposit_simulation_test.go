@@ -0,0 +1,102 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateDeposit(t *testing.T) {
+	t.Parallel()
+	cs, err := chain.NewSpec(spec.DevnetChainSpecData())
+	require.NoError(t, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01})
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: credentials,
+				Amount:      cs.MaxEffectiveBalance(),
+				Index:       0,
+			},
+		}
+		genPayloadHeader = &types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+
+	// A deposit for a known pubkey tops the validator up, keeping its
+	// withdrawal credentials.
+	sim, err := sp.SimulateDeposit(st, nil, &types.Deposit{
+		Pubkey:      [48]byte{0x00},
+		Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x02}),
+		Amount:      cs.EffectiveBalanceIncrement(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, core.DepositTopsUp, sim.Outcome)
+	require.Equal(t, math.ValidatorIndex(0), *sim.ValidatorIndex)
+	require.Len(t, sim.Warnings, 1)
+
+	// A deposit for a new pubkey creates a validator, which is not activated
+	// below the minimum activation balance.
+	newDeposit := &types.Deposit{
+		Pubkey:      [48]byte{0x01},
+		Credentials: credentials,
+		Amount:      cs.MinActivationBalance() - cs.EffectiveBalanceIncrement(),
+	}
+	sim, err = sp.SimulateDeposit(st, nil, newDeposit)
+	require.NoError(t, err)
+	require.Equal(t, core.DepositCreatesValidator, sim.Outcome)
+	require.Nil(t, sim.ValidatorIndex)
+	require.Len(t, sim.Warnings, 1)
+
+	// Once a pending deposit creates the validator, the deposit tops it up.
+	pending := types.Deposits{{
+		Pubkey:      [48]byte{0x01},
+		Credentials: credentials,
+		Amount:      cs.MinActivationBalance(),
+		Index:       1,
+	}}
+	sim, err = sp.SimulateDeposit(st, pending, newDeposit)
+	require.NoError(t, err)
+	require.Equal(t, core.DepositTopsUp, sim.Outcome)
+	require.Equal(t, uint64(1), *sim.CreatingDepositIndex)
+	require.Empty(t, sim.Warnings)
+}
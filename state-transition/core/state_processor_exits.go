@@ -21,6 +21,10 @@
 package core
 
 import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
@@ -31,6 +35,15 @@ import (
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate-validator-exit
 // to handle pre-Electra validator exit logic.
 func (sp *StateProcessor) InitiateValidatorExit(st *statedb.StateDB, idx math.ValidatorIndex) error {
+	return sp.initiateValidatorExit(st, idx, true)
+}
+
+// initiateValidatorExit initiates the exit of the validator with index `idx`.
+// If applyChurn is false the exit churn limit is not enforced, which is used
+// to eject validators exceeding the validator set cap.
+func (sp *StateProcessor) initiateValidatorExit(
+	st *statedb.StateDB, idx math.ValidatorIndex, applyChurn bool,
+) error {
 	validator, err := st.ValidatorByIndex(idx)
 	if err != nil {
 		return err
@@ -46,8 +59,9 @@ func (sp *StateProcessor) InitiateValidatorExit(st *statedb.StateDB, idx math.Va
 		return err
 	}
 
-	// We still have no cap on validator churn, choosing not to adopt any churn-related
-	// Electra changes, so exit epoch is at the next epoch.
+	// Exits happen at the next epoch unless the exit churn limit is reached.
+	// We choose not to adopt the balance based churn of Electra, and only
+	// limit the number of validators exiting per epoch.
 	exitEpoch := currentEpoch + 1
 
 	// The withdrawable epoch is `MinValidatorWithdrawabilityDelay` epoch's after `exitEpoch`.
@@ -63,6 +77,13 @@ func (sp *StateProcessor) InitiateValidatorExit(st *statedb.StateDB, idx math.Va
 			return nil
 		}
 
+		if applyChurn {
+			exitEpoch, err = sp.exitQueueEpoch(st, currentEpoch)
+			if err != nil {
+				return err
+			}
+		}
+
 		// The withdrawable Epoch is `MinValidatorWithdrawabilityDelay` epoch's after `exitEpoch`.
 		withdrawableEpoch = exitEpoch + sp.cs.MinValidatorWithdrawabilityDelay()
 	}
@@ -72,3 +93,70 @@ func (sp *StateProcessor) InitiateValidatorExit(st *statedb.StateDB, idx math.Va
 	validator.SetWithdrawableEpoch(withdrawableEpoch)
 	return st.UpdateValidatorAtIndex(idx, validator)
 }
+
+// ValidateVoluntaryExit checks that the signed exit can be applied to the given
+// state, as per the assertions of process_voluntary_exit:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-process_voluntary_exit
+// The state is not modified.
+func (sp *StateProcessor) ValidateVoluntaryExit(st *statedb.StateDB, signed *ctypes.SignedVoluntaryExit) error {
+	if signed == nil || signed.Message == nil {
+		return ctypes.ErrNilValue
+	}
+	exit := signed.Message
+	validator, err := st.ValidatorByIndex(exit.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	// Checks that the validator is active and has not initiated an exit yet.
+	if err = verifyWithdrawalConditions(st, validator); err != nil {
+		return errors.Join(ErrInvalidVoluntaryExit, err)
+	}
+	currentEpoch, err := st.GetEpoch()
+	if err != nil {
+		return err
+	}
+	if currentEpoch < exit.Epoch {
+		return fmt.Errorf("%w: exit epoch %d is in the future, current epoch %d",
+			ErrInvalidVoluntaryExit, exit.Epoch, currentEpoch,
+		)
+	}
+
+	// Only exit validators without pending partial withdrawals, as done for
+	// full exit requests.
+	fork, err := st.GetFork()
+	if err != nil {
+		return err
+	}
+	if version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		var pendingPartialWithdrawals ctypes.PendingPartialWithdrawals
+		pendingPartialWithdrawals, err = st.GetPendingPartialWithdrawals()
+		if err != nil {
+			return err
+		}
+		if pending := pendingPartialWithdrawals.PendingBalanceToWithdraw(exit.ValidatorIndex); pending != 0 {
+			return fmt.Errorf("%w: validator %d has %d gwei pending to withdraw",
+				ErrInvalidVoluntaryExit, exit.ValidatorIndex, pending,
+			)
+		}
+	}
+
+	// Like EIP-7044 pins exits to the Capella fork version, exits are signed over
+	// the genesis fork version so that they remain valid perpetually.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	fd := ctypes.NewForkData(sp.cs.GenesisForkVersion(), genesisValidatorsRoot)
+	return signed.VerifySignature(
+		fd, sp.cs.DomainTypeVoluntaryExit(), validator.GetPubkey(), sp.signer.VerifySignature,
+	)
+}
+
+// ProcessVoluntaryExit validates the signed exit and initiates the exit of the
+// validator. Exits are processed for blocks from Electra1 on.
+func (sp *StateProcessor) ProcessVoluntaryExit(st *statedb.StateDB, signed *ctypes.SignedVoluntaryExit) error {
+	if err := sp.ValidateVoluntaryExit(st, signed); err != nil {
+		return err
+	}
+	return sp.InitiateValidatorExit(st, signed.Message.ValidatorIndex)
+}
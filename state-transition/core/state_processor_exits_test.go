@@ -0,0 +1,197 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// setupExitState initializes a genesis state with two active validators.
+func setupExitState(t *testing.T, cs chain.Spec) (
+	*statetransition.TestStateProcessorT,
+	*statetransition.TestBeaconStateT,
+	core.ReadOnlyContext,
+	common.Root,
+) {
+	t.Helper()
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: credentials,
+				Amount:      cs.MaxEffectiveBalance(),
+				Index:       0,
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: credentials,
+				Amount:      cs.MaxEffectiveBalance(),
+				Index:       1,
+			},
+		}
+		genPayloadHeader = &types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(st, genDeposits, genPayloadHeader, cs.GenesisForkVersion())
+	require.NoError(t, err)
+
+	_, depRoot, err := ds.GetDepositsByIndex(ctx.ConsensusCtx(), constants.FirstDepositIndex, uint64(len(genDeposits)))
+	require.NoError(t, err)
+	return sp, st, ctx, depRoot
+}
+
+func newSignedExit(epoch math.Epoch, idx math.ValidatorIndex) *types.SignedVoluntaryExit {
+	return &types.SignedVoluntaryExit{
+		Message:   &types.VoluntaryExitMessage{Epoch: epoch, ValidatorIndex: idx},
+		Signature: [96]byte{0x01},
+	}
+}
+
+func TestValidateVoluntaryExit(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, sp *core.StateProcessor, st *statetransition.TestBeaconStateT)
+		exit    *types.SignedVoluntaryExit
+		wantErr error
+	}{
+		{
+			name: "valid exit",
+			exit: newSignedExit(0, 1),
+		},
+		{
+			name:    "nil exit",
+			exit:    &types.SignedVoluntaryExit{},
+			wantErr: types.ErrNilValue,
+		},
+		{
+			name:    "exit epoch in the future",
+			exit:    newSignedExit(1, 1),
+			wantErr: core.ErrInvalidVoluntaryExit,
+		},
+		{
+			name: "validator already exiting",
+			setup: func(t *testing.T, sp *core.StateProcessor, st *statetransition.TestBeaconStateT) {
+				t.Helper()
+				require.NoError(t, sp.InitiateValidatorExit(st, 1))
+			},
+			exit:    newSignedExit(0, 1),
+			wantErr: core.ErrInvalidVoluntaryExit,
+		},
+		{
+			name: "pending partial withdrawal",
+			setup: func(t *testing.T, _ *core.StateProcessor, st *statetransition.TestBeaconStateT) {
+				t.Helper()
+				require.NoError(t, st.SetPendingPartialWithdrawals([]*types.PendingPartialWithdrawal{
+					{ValidatorIndex: 1, Amount: 1, WithdrawableEpoch: 1},
+				}))
+			},
+			exit:    newSignedExit(0, 1),
+			wantErr: core.ErrInvalidVoluntaryExit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sp, st, _, _ := setupExitState(t, cs)
+			if tt.setup != nil {
+				tt.setup(t, sp, st)
+			}
+			err := sp.ValidateVoluntaryExit(st, tt.exit)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Exits for validators missing from the state are rejected.
+	sp, st, _, _ := setupExitState(t, cs)
+	require.Error(t, sp.ValidateVoluntaryExit(st, newSignedExit(0, 2)))
+}
+
+func TestProcessVoluntaryExit(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, _, _ := setupExitState(t, cs)
+
+	require.NoError(t, sp.ProcessVoluntaryExit(st, newSignedExit(0, 1)))
+	validator, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.NotEqual(t, constants.FarFutureEpoch, validator.GetExitEpoch())
+	require.Equal(t,
+		validator.GetExitEpoch()+cs.MinValidatorWithdrawabilityDelay(),
+		validator.GetWithdrawableEpoch(),
+	)
+
+	// The other validator is untouched and the exit cannot be applied twice.
+	validator, err = st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, constants.FarFutureEpoch, validator.GetExitEpoch())
+	require.ErrorIs(t, sp.ProcessVoluntaryExit(st, newSignedExit(0, 1)), core.ErrInvalidVoluntaryExit)
+}
+
+// TestTransitionVoluntaryExits checks that exits included in a block are
+// applied by the state transition, and that an invalid exit fails the block.
+func TestTransitionVoluntaryExits(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ctx, depRoot := setupExitState(t, cs)
+
+	blk := buildNextBlock(t, cs, st, types.NewEth1Data(depRoot), 10,
+		types.Deposits{}, &types.ExecutionRequests{}, st.EVMInflationWithdrawal(10),
+	)
+	blk.GetBody().SetVoluntaryExits(types.VoluntaryExits{newSignedExit(0, 1)})
+	_, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	validator, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.NotEqual(t, constants.FarFutureEpoch, validator.GetExitEpoch())
+
+	// Including the same exit again fails the block.
+	blk = buildNextBlock(t, cs, st, types.NewEth1Data(depRoot), 11,
+		types.Deposits{}, &types.ExecutionRequests{}, st.EVMInflationWithdrawal(11),
+	)
+	blk.GetBody().SetVoluntaryExits(types.VoluntaryExits{newSignedExit(0, 1)})
+	_, err = sp.Transition(ctx, st, blk)
+	require.ErrorIs(t, err, core.ErrInvalidVoluntaryExit)
+}
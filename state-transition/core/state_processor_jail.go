@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// CommitVote is the participation of a validator in the commit of the
+// previous block.
+type CommitVote struct {
+	// ValidatorAddress is the CometBFT address of the validator.
+	ValidatorAddress []byte
+	// Absent is true if the commit carries no vote of the validator.
+	Absent bool
+}
+
+// ProcessJailing releases the validators unjailing, then jails the
+// validators which missed signing DowntimeJailThreshold blocks in a row.
+// Jailed validators stay active but are removed from the consensus validator
+// set, hence from the proposer rotation, until they unjail.
+//
+// Missed proposals cannot be attributed deterministically, so downtime is
+// tracked from the votes of the previous block commit. Jailing does not
+// change the beacon state root and must be processed after the state
+// transition of finalized blocks only. The returned updates apply to the
+// consensus validator set.
+func (sp *StateProcessor) ProcessJailing(
+	st *statedb.StateDB, votes []CommitVote, unjails []*ctypes.SignedUnjail,
+) (transition.ValidatorUpdates, error) {
+	threshold := sp.cs.DowntimeJailThreshold()
+	if threshold == 0 {
+		return nil, nil
+	}
+
+	var updates transition.ValidatorUpdates
+	for _, unjail := range unjails {
+		update, err := sp.processUnjail(st, unjail)
+		if err != nil {
+			return nil, err
+		}
+		if update != nil {
+			updates = append(updates, update)
+		}
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range votes {
+		var update *transition.ValidatorUpdate
+		update, err = sp.processCommitVote(st, vote, slot, threshold)
+		if err != nil {
+			return nil, err
+		}
+		if update != nil {
+			updates = append(updates, update)
+		}
+	}
+	return updates, nil
+}
+
+// processCommitVote tracks the blocks the validator missed signing in a row,
+// and jails it once they reach the threshold.
+func (sp *StateProcessor) processCommitVote(
+	st *statedb.StateDB, vote CommitVote, slot math.Slot, threshold uint64,
+) (*transition.ValidatorUpdate, error) {
+	idx, err := st.ValidatorIndexByCometBFTAddress(vote.ValidatorAddress)
+	if errors.Is(err, collections.ErrNotFound) {
+		// Validators are never removed from the registry, so this should
+		// never happen. Log the issue rather than halting the chain.
+		sp.logger.Error(
+			"voting validator not found in registry",
+			"address", fmt.Sprintf("%X", vote.ValidatorAddress),
+		)
+		return nil, nil //nolint:nilnil // no update.
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed loading voting validator index: %w", err)
+	}
+
+	// Consensus removes jailed validators from its set with a delay, in the
+	// meantime they keep missing blocks.
+	_, jailed, err := st.GetJailedUntil(idx)
+	if err != nil {
+		return nil, err
+	}
+	if jailed {
+		return nil, nil //nolint:nilnil // no update.
+	}
+
+	missed, err := st.GetMissedBlocks(idx)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !vote.Absent && missed == 0:
+		return nil, nil //nolint:nilnil // no update.
+	case !vote.Absent:
+		return nil, st.SetMissedBlocks(idx, 0)
+	case missed+1 < threshold:
+		return nil, st.SetMissedBlocks(idx, missed+1)
+	}
+
+	validator, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	jailedUntil := slot + math.Slot(sp.cs.MinJailDuration())
+	if err = st.SetJailedUntil(idx, jailedUntil); err != nil {
+		return nil, err
+	}
+	if err = st.SetMissedBlocks(idx, 0); err != nil {
+		return nil, err
+	}
+	sp.logger.Info(
+		"Jailed validator for downtime",
+		"index", idx, "missed_blocks", threshold, "jailed_until", jailedUntil,
+	)
+	sp.metrics.incrementValidatorJailed()
+	return &transition.ValidatorUpdate{
+		Pubkey:           validator.GetPubkey(),
+		EffectiveBalance: 0, // signal val eviction to consensus
+	}, nil
+}
+
+// processUnjail releases the validator from jail, restoring its voting power.
+// Invalid unjails are skipped: they are rejected when verifying proposals,
+// but blocks are not verified while syncing.
+func (sp *StateProcessor) processUnjail(
+	st *statedb.StateDB, signed *ctypes.SignedUnjail,
+) (*transition.ValidatorUpdate, error) {
+	if err := sp.ValidateUnjail(st, signed); err != nil {
+		sp.logger.Warn("Skipping invalid unjail", "error", err)
+		return nil, nil //nolint:nilnil // no update.
+	}
+	idx := signed.Message.ValidatorIndex
+	validator, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	if err = st.RemoveJail(idx); err != nil {
+		return nil, err
+	}
+	sp.logger.Info("Unjailed validator", "index", idx)
+	sp.metrics.incrementValidatorUnjailed()
+	return &transition.ValidatorUpdate{
+		Pubkey:           validator.GetPubkey(),
+		EffectiveBalance: validator.GetEffectiveBalance(),
+	}, nil
+}
+
+// ValidateUnjail checks that the validator is jailed until the slot the
+// unjail was signed for, that its jail time is served and that it can rejoin
+// the consensus validator set. Slashed validators are tombstoned: they stay
+// jailed until they exit.
+func (sp *StateProcessor) ValidateUnjail(st *statedb.StateDB, signed *ctypes.SignedUnjail) error {
+	if signed == nil || signed.Message == nil {
+		return ctypes.ErrNilValue
+	}
+	unjail := signed.Message
+	jailedUntil, jailed, err := st.GetJailedUntil(unjail.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	if !jailed {
+		return fmt.Errorf("%w: validator %d is not jailed",
+			ErrInvalidUnjail, unjail.ValidatorIndex,
+		)
+	}
+	if jailedUntil != unjail.JailedUntil {
+		return fmt.Errorf("%w: validator %d is jailed until slot %d, not %d",
+			ErrInvalidUnjail, unjail.ValidatorIndex, jailedUntil, unjail.JailedUntil,
+		)
+	}
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if slot < jailedUntil {
+		return fmt.Errorf("%w: validator %d is jailed until slot %d, current slot %d",
+			ErrInvalidUnjail, unjail.ValidatorIndex, jailedUntil, slot,
+		)
+	}
+
+	validator, err := st.ValidatorByIndex(unjail.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	if validator.IsSlashed() {
+		return fmt.Errorf("%w: validator %d is tombstoned",
+			ErrInvalidUnjail, unjail.ValidatorIndex,
+		)
+	}
+	epoch, err := st.GetEpoch()
+	if err != nil {
+		return err
+	}
+	if !validator.IsActive(epoch) {
+		return fmt.Errorf("%w: validator %d is not active",
+			ErrInvalidUnjail, unjail.ValidatorIndex,
+		)
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	fd := ctypes.NewForkData(sp.cs.GenesisForkVersion(), genesisValidatorsRoot)
+	return signed.VerifySignature(fd, validator.GetPubkey(), sp.signer.VerifySignature)
+}
@@ -0,0 +1,129 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessJailingAndUnjail(t *testing.T) {
+	t.Parallel()
+	specData := spec.DevnetChainSpecData()
+	specData.DowntimeJailThreshold = 2
+	specData.MinJailDuration = 5
+	cs, err := chain.NewSpec(specData)
+	require.NoError(t, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance       = cs.MaxEffectiveBalance()
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		genDeposits      = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(1),
+			},
+		}
+		genPayloadHeader = &types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st,
+		genDeposits,
+		genPayloadHeader,
+		cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+
+	var (
+		pk0   = genDeposits[0].Pubkey
+		pk1   = genDeposits[1].Pubkey
+		votes = []core.CommitVote{
+			{ValidatorAddress: cmtcrypto.AddressHash(pk0[:]).Bytes()},
+			{ValidatorAddress: cmtcrypto.AddressHash(pk1[:]).Bytes(), Absent: true},
+		}
+	)
+
+	// The validator is jailed once it misses the threshold of blocks in a
+	// row, and ignored afterwards.
+	updates, err := sp.ProcessJailing(st, votes, nil)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	updates, err = sp.ProcessJailing(st, votes, nil)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{{Pubkey: pk1, EffectiveBalance: 0}}, updates)
+	jailedUntil, jailed, err := st.GetJailedUntil(1)
+	require.NoError(t, err)
+	require.True(t, jailed)
+	require.Equal(t, math.Slot(5), jailedUntil)
+	updates, err = sp.ProcessJailing(st, votes, nil)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	_, jailed, err = st.GetJailedUntil(0)
+	require.NoError(t, err)
+	require.False(t, jailed)
+
+	// The validator cannot unjail before its jail time is served.
+	unjail := &types.SignedUnjail{
+		Message: &types.UnjailMessage{ValidatorIndex: 1, JailedUntil: jailedUntil},
+	}
+	require.ErrorIs(t, sp.ValidateUnjail(st, unjail), core.ErrInvalidUnjail)
+	updates, err = sp.ProcessJailing(st, nil, []*types.SignedUnjail{unjail})
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// Once served, the unjail must match the jailing it was signed for.
+	require.NoError(t, st.SetSlot(jailedUntil))
+	wrongUnjail := &types.SignedUnjail{
+		Message: &types.UnjailMessage{ValidatorIndex: 1, JailedUntil: jailedUntil - 1},
+	}
+	require.ErrorIs(t, sp.ValidateUnjail(st, wrongUnjail), core.ErrInvalidUnjail)
+	updates, err = sp.ProcessJailing(st, nil, []*types.SignedUnjail{unjail})
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{{Pubkey: pk1, EffectiveBalance: maxBalance}}, updates)
+	_, jailed, err = st.GetJailedUntil(1)
+	require.NoError(t, err)
+	require.False(t, jailed)
+}
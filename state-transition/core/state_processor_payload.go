@@ -26,6 +26,7 @@ import (
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/payload/validation"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -75,7 +76,9 @@ func (sp *StateProcessor) processExecutionPayload(
 	// Perform payload verification only if the context is configured as such.
 	if txCtx.VerifyPayload() {
 		g.Go(func() error {
-			return sp.validateExecutionPayload(ctx, txCtx.ConsensusTime(), st, blk)
+			return sp.validateExecutionPayload(
+				ctx, txCtx.ConsensusTime(), txCtx.OptimisticPayload(), st, blk,
+			)
 		})
 	}
 
@@ -105,13 +108,14 @@ func (sp *StateProcessor) processExecutionPayload(
 func (sp *StateProcessor) validateExecutionPayload(
 	ctx context.Context,
 	consensusTime math.U64,
+	optimistic bool,
 	st ReadOnlyBeaconState,
 	blk *ctypes.BeaconBlock,
 ) error {
 	if err := sp.validateStatelessPayload(blk); err != nil {
 		return err
 	}
-	return sp.validateStatefulPayload(ctx, consensusTime, st, blk)
+	return sp.validateStatefulPayload(ctx, consensusTime, optimistic, st, blk)
 }
 
 // validateStatelessPayload performs stateless checks on the execution payload.
@@ -119,6 +123,26 @@ func (sp *StateProcessor) validateStatelessPayload(blk *ctypes.BeaconBlock) erro
 	body := blk.GetBody()
 	payload := body.GetExecutionPayload()
 
+	// Verify the number and size of transactions. The SSZ limits are those of
+	// the consensus specs, the chain may accept less.
+	txs := payload.GetTransactions()
+	if uint64(len(txs)) > sp.cs.MaxTxsPerPayload() {
+		return errors.Wrapf(
+			ErrExceedMaximumTransactions,
+			"too many transactions, expected at most: %d, got: %d",
+			sp.cs.MaxTxsPerPayload(), len(txs),
+		)
+	}
+	for i, tx := range txs {
+		if uint64(len(tx)) > sp.cs.MaxBytesPerTx() {
+			return errors.Wrapf(
+				ErrExceedMaximumTxSize,
+				"transaction %d is %d bytes, expected at most: %d",
+				i, len(tx), sp.cs.MaxBytesPerTx(),
+			)
+		}
+	}
+
 	// Verify the number of withdrawals.
 	withdrawals := payload.GetWithdrawals()
 	if uint64(len(withdrawals)) > sp.cs.MaxWithdrawalsPerPayload() {
@@ -138,6 +162,7 @@ func (sp *StateProcessor) validateStatelessPayload(blk *ctypes.BeaconBlock) erro
 func (sp *StateProcessor) validateStatefulPayload(
 	ctx context.Context,
 	consensusTime math.U64,
+	optimistic bool,
 	st ReadOnlyBeaconState,
 	blk *ctypes.BeaconBlock,
 ) error {
@@ -169,6 +194,13 @@ func (sp *StateProcessor) validateStatefulPayload(
 		return err
 	}
 
+	// Run the cheap sanity checks before the engine call.
+	if err = validation.Validate(
+		sp.cs, payload, lph, len(body.GetBlobKzgCommitments()),
+	); err != nil {
+		return err
+	}
+
 	payloadReq, err := ctypes.BuildNewPayloadRequestFromFork(blk)
 	if err != nil {
 		return err
@@ -181,9 +213,9 @@ func (sp *StateProcessor) validateStatefulPayload(
 		return err
 	}
 
-	// TODO: set retryOnSyncingStatus to false if we are in FinalizeBlock.
-	// Otherwise leave as true. This is ok to leave this way for now.
-	if err = sp.executionEngine.NotifyNewPayload(ctx, payloadReq, true); err != nil {
+	// Payloads which may be imported optimistically are not retried while
+	// the execution client is syncing.
+	if err = sp.executionEngine.NotifyNewPayload(ctx, payloadReq, !optimistic); err != nil {
 		return err
 	}
 
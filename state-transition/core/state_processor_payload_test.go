@@ -32,6 +32,7 @@ import (
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/payload/validation"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -80,7 +81,9 @@ func TestPayloadTimestampVerification(t *testing.T) {
 	ctx.ConsensusCtx().(sdk.Context).MultiStore().(storetypes.CacheMultiStore).Write()
 
 	// Test cases
-	consensusBlkTime := genesisTime.Add(time.Second)
+	// Consensus time is far enough from genesis that a payload may be
+	// before it while still being after its parent.
+	consensusBlkTime := genesisTime.Add(10 * time.Second)
 	tests := []struct {
 		name        string
 		setupMocksF func()
@@ -92,9 +95,17 @@ func TestPayloadTimestampVerification(t *testing.T) {
 			setupMocksF: func() {
 				mockEngine.EXPECT().NotifyNewPayload(mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
-			payloadTime: consensusBlkTime.Add(-10 * time.Second),
+			payloadTime: consensusBlkTime.Add(-5 * time.Second),
 			expectedErr: nil,
 		},
+		{
+			name: "Payload timestamp <= parent timestamp",
+			setupMocksF: func() {
+				// no mock here, since the payload sanity checks fail
+			},
+			payloadTime: genesisTime,
+			expectedErr: validation.ErrTimestampNotAfterParent,
+		},
 		{
 			name: "Payload timestamp == consensus timestamp",
 			setupMocksF: func() {
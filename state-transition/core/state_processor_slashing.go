@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// processMisbehaviors slashes the validators which misbehaviors were committed
+// by consensus along with the block. Misbehaviors are processed after the block
+// operations, so that the payload of the block, notably its withdrawals, does
+// not depend on them.
+func (sp *StateProcessor) processMisbehaviors(ctx ReadOnlyContext, st *statedb.StateDB) error {
+	if sp.cs.MinSlashingPenaltyQuotient() == 0 {
+		return nil
+	}
+	for _, m := range ctx.Misbehaviors() {
+		idx, err := st.ValidatorIndexByCometBFTAddress(m.ValidatorAddress)
+		if errors.Is(err, collections.ErrNotFound) {
+			// Validators are never removed from the registry, so this should
+			// never happen. Log the issue rather than halting the chain.
+			sp.logger.Error(
+				"misbehaving validator not found in registry",
+				"address", fmt.Sprintf("%X", m.ValidatorAddress),
+				"height", m.Height,
+			)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed loading misbehaving validator index: %w", err)
+		}
+		if err = sp.slashValidator(st, idx); err != nil {
+			return fmt.Errorf("failed slashing validator idx %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// slashValidator is modified from the ETH 2.0 spec:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-slash_validator
+// There is no whistleblower nor proposer reward: the penalty is simply burned.
+func (sp *StateProcessor) slashValidator(st *statedb.StateDB, idx math.ValidatorIndex) error {
+	validator, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	// Evidence of several misbehaviors may be committed for the same
+	// validator, slash it only once.
+	if validator.IsSlashed() {
+		return nil
+	}
+	epoch, err := st.GetEpoch()
+	if err != nil {
+		return err
+	}
+
+	if validator.GetExitEpoch() == constants.FarFutureEpoch {
+		if err = sp.InitiateValidatorExit(st, idx); err != nil {
+			return err
+		}
+		if validator, err = st.ValidatorByIndex(idx); err != nil {
+			return err
+		}
+	}
+	validator.SetSlashed()
+	vectorLength := sp.cs.EpochsPerSlashingsVector()
+	validator.SetWithdrawableEpoch(
+		max(validator.GetWithdrawableEpoch(), epoch+math.Epoch(vectorLength)),
+	)
+	if err = st.UpdateValidatorAtIndex(idx, validator); err != nil {
+		return err
+	}
+
+	effectiveBalance := validator.GetEffectiveBalance()
+	slashingIdx := epoch.Unwrap() % vectorLength
+	slashing, err := st.GetSlashingAtIndex(slashingIdx)
+	if err != nil {
+		return err
+	}
+	if err = st.SetSlashingAtIndex(slashingIdx, slashing+effectiveBalance); err != nil {
+		return err
+	}
+	totalSlashing, err := st.GetTotalSlashing()
+	if err != nil {
+		return err
+	}
+	if err = st.SetTotalSlashing(totalSlashing + effectiveBalance); err != nil {
+		return err
+	}
+
+	penalty := effectiveBalance / math.Gwei(sp.cs.MinSlashingPenaltyQuotient())
+	sp.logger.Info(
+		"Slashing misbehaving validator",
+		"index", idx.Base10(),
+		"pubkey", validator.GetPubkey().String(),
+		"penalty", penalty.Base10(),
+	)
+	sp.metrics.incrementValidatorSlashed()
+	return st.DecreaseBalance(idx, penalty)
+}
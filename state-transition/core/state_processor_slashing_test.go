@@ -0,0 +1,135 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionSlashesMisbehavingValidator(t *testing.T) {
+	t.Parallel()
+	specData := spec.DevnetChainSpecData()
+	specData.MinSlashingPenaltyQuotient = 32
+	cs, err := chain.NewSpec(specData)
+	require.NoError(t, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance       = cs.MaxEffectiveBalance()
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		genDeposits      = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x00},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(1),
+			},
+		}
+		genPayloadHeader = &types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		}
+	)
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st,
+		genDeposits,
+		genPayloadHeader,
+		cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+
+	_, depRoot, err := ds.GetDepositsByIndex(
+		ctx.ConsensusCtx(), constants.FirstDepositIndex, uint64(len(genDeposits)),
+	)
+	require.NoError(t, err)
+	blk := buildNextBlock(
+		t,
+		cs,
+		st,
+		types.NewEth1Data(depRoot),
+		10,
+		[]*types.Deposit{},
+		&types.ExecutionRequests{},
+		st.EVMInflationWithdrawal(10),
+	)
+
+	// Evidence of two misbehaviors of the same validator is committed with
+	// the block, the validator must be slashed only once.
+	pk := genDeposits[1].Pubkey
+	misbehavior := transition.Misbehavior{
+		ValidatorAddress: cmtcrypto.AddressHash(pk[:]).Bytes(),
+		Height:           1,
+	}
+	txCtx := transition.NewTransitionCtx(
+		ctx.ConsensusCtx(),
+		ctx.ConsensusTime(),
+		ctx.ProposerAddress(),
+	).
+		WithMisbehaviors([]transition.Misbehavior{misbehavior, misbehavior}).
+		WithVerifyPayload(false).
+		WithVerifyRandao(false).
+		WithVerifyResult(false).
+		WithMeterGas(false)
+	_, err = sp.Transition(txCtx, st, blk)
+	require.NoError(t, err)
+
+	// The misbehaving validator is slashed, exits and is penalized.
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.True(t, val.IsSlashed())
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.GreaterOrEqual(t, val.GetWithdrawableEpoch(), math.Epoch(cs.EpochsPerSlashingsVector()))
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-maxBalance/32, balance)
+	totalSlashing, err := st.GetTotalSlashing()
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, totalSlashing)
+
+	// The other validator is untouched.
+	val, err = st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.False(t, val.IsSlashed())
+	require.Equal(t, constants.FarFutureEpoch, val.GetExitEpoch())
+	balance, err = st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, balance)
+}
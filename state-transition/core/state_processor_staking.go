@@ -67,6 +67,21 @@ func (sp *StateProcessor) processOperations(
 		}
 	}
 
+	// After Electra1, validators can exit and rotate their BLS withdrawal
+	// credentials by including signed operations in the block.
+	if version.EqualsOrIsAfter(blk.GetForkVersion(), version.Electra1()) {
+		for _, exit := range blk.GetBody().GetVoluntaryExits() {
+			if err := sp.ProcessVoluntaryExit(st, exit); err != nil {
+				return err
+			}
+		}
+		for _, change := range blk.GetBody().GetBlsToExecutionChanges() {
+			if err := sp.ProcessBLSToExecutionChange(st, change); err != nil {
+				return err
+			}
+		}
+	}
+
 	if version.EqualsOrIsAfter(blk.GetForkVersion(), version.Electra()) {
 		// After Electra, validators can request withdrawals through execution requests which must be handled.
 		requests, err := blk.GetBody().GetExecutionRequests()
@@ -131,22 +146,11 @@ func (sp *StateProcessor) applyDeposit(st *state.StateDB, dep *ctypes.Deposit) e
 
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor) createValidator(st *state.StateDB, dep *ctypes.Deposit) error {
-	// Get the current slot.
-	slot, err := st.GetSlot()
+	forkData, err := sp.depositForkData(st)
 	if err != nil {
 		return err
 	}
 
-	// At genesis, the validators sign over an empty root.
-	genesisValidatorsRoot := common.Root{}
-	if slot != 0 {
-		// Get the genesis validators root to be used to find fork data later.
-		genesisValidatorsRoot, err = st.GetGenesisValidatorsRoot()
-		if err != nil {
-			return err
-		}
-	}
-
 	// Check that the deposit has the ETH1 withdrawal credentials.
 	if !dep.HasEth1WithdrawalCredentials() {
 		sp.logger.Warn(
@@ -159,15 +163,7 @@ func (sp *StateProcessor) createValidator(st *state.StateDB, dep *ctypes.Deposit
 	}
 
 	// Verify that the message was signed correctly.
-	err = dep.VerifySignature(
-		ctypes.NewForkData(
-			// Deposits must be signed with GENESIS_FORK_VERSION.
-			sp.cs.GenesisForkVersion(),
-			genesisValidatorsRoot,
-		),
-		sp.cs.DomainTypeDeposit(),
-		sp.signer.VerifySignature,
-	)
+	err = dep.VerifySignature(forkData, sp.cs.DomainTypeDeposit(), sp.signer.VerifySignature)
 	if err != nil {
 		// Ignore deposits that fail the signature check.
 		sp.logger.Warn(
@@ -185,6 +181,29 @@ func (sp *StateProcessor) createValidator(st *state.StateDB, dep *ctypes.Deposit
 	return sp.addValidatorToRegistry(st, dep)
 }
 
+// depositForkData returns the fork data deposits creating a validator are
+// signed over.
+func (sp *StateProcessor) depositForkData(st *state.StateDB) (*ctypes.ForkData, error) {
+	// Get the current slot.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	// At genesis, the validators sign over an empty root.
+	genesisValidatorsRoot := common.Root{}
+	if slot != 0 {
+		// Get the genesis validators root to be used to find fork data later.
+		genesisValidatorsRoot, err = st.GetGenesisValidatorsRoot()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Deposits must be signed with GENESIS_FORK_VERSION.
+	return ctypes.NewForkData(sp.cs.GenesisForkVersion(), genesisValidatorsRoot), nil
+}
+
 // addValidatorToRegistry adds a validator to the registry.
 func (sp *StateProcessor) addValidatorToRegistry(st *state.StateDB, dep *ctypes.Deposit) error {
 	val := ctypes.NewValidatorFromDeposit(
@@ -46,8 +46,8 @@ func (sp *StateProcessor) processRegistryUpdates(st *statedb.StateDB) error {
 		return fmt.Errorf("registry update, failed listing validators: %w", err)
 	}
 
-	// We do not currently have a cap on validator churn,
-	// so we can process validators activations in a single loop
+	// Activations are processed once the activation queue has been updated,
+	// so that validators are activated in queue order up to the churn limit.
 	var idx math.ValidatorIndex
 	for si, val := range vals {
 		valModified := false
@@ -76,11 +76,6 @@ func (sp *StateProcessor) processRegistryUpdates(st *statedb.StateDB) error {
 			)
 		}
 
-		if val.IsEligibleForActivation(currEpoch) {
-			val.SetActivationEpoch(activationEpoch)
-			valModified = true
-		}
-
 		if valModified {
 			idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
 			if err != nil {
@@ -100,6 +95,25 @@ func (sp *StateProcessor) processRegistryUpdates(st *statedb.StateDB) error {
 		}
 	}
 
+	queue, err := sp.ActivationQueue(st)
+	if err != nil {
+		return fmt.Errorf("registry update, failed loading activation queue: %w", err)
+	}
+	for _, qv := range queue {
+		if qv.EstimatedActivationEpoch != activationEpoch {
+			// The queue is ordered, so no further validator is activated.
+			break
+		}
+		qv.Validator.SetActivationEpoch(activationEpoch)
+		if err = st.UpdateValidatorAtIndex(qv.Index, qv.Validator); err != nil {
+			return fmt.Errorf(
+				"registry update, failed activating validator idx %d: %w",
+				qv.Index,
+				err,
+			)
+		}
+	}
+
 	// validators registry will be possibly further modified in order to enforce
 	// validators set cap. We will do that at the end of processEpoch, once all
 	// Eth 2.0 like transitions has been done (notable EffectiveBalances
@@ -152,8 +166,8 @@ func (sp *StateProcessor) processValidatorSetCap(st *statedb.StateDB) error {
 		}
 	})
 
-	// We do not currently have a cap on validators churn, so we exit
-	// validators in the next epoch, and we withdraw them after a delay depending on the fork.
+	// Ejected validators bypass the exit churn, so that they exit in the next
+	// epoch, and we withdraw them after a delay depending on the fork.
 	var idx math.ValidatorIndex
 	for li := range uint64(len(nextEpochVals)) - validatorSetCap {
 		valToEject := nextEpochVals[li]
@@ -164,7 +178,7 @@ func (sp *StateProcessor) processValidatorSetCap(st *statedb.StateDB) error {
 				err,
 			)
 		}
-		if exitErr := sp.InitiateValidatorExit(st, idx); exitErr != nil {
+		if exitErr := sp.initiateValidatorExit(st, idx, false); exitErr != nil {
 			return fmt.Errorf(
 				"validator cap, failed ejecting validator idx %d: %w",
 				li,
@@ -245,3 +259,27 @@ func getActiveVals(st *statedb.StateDB, epoch math.Epoch) ([]*ctypes.Validator,
 	}
 	return activeVals, nil
 }
+
+// getConsensusVals returns the validators making up the consensus validator
+// set at the given epoch, i.e. the active validators which are not jailed.
+func getConsensusVals(st *statedb.StateDB, epoch math.Epoch) ([]*ctypes.Validator, error) {
+	vals, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	jailed, err := st.GetJailedValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	consensusVals := make([]*ctypes.Validator, 0, len(vals))
+	for i, val := range vals {
+		if _, isJailed := jailed[math.ValidatorIndex(i)]; isJailed {
+			continue
+		}
+		if val.IsActive(epoch) {
+			consensusVals = append(consensusVals, val)
+		}
+	}
+	return consensusVals, nil
+}
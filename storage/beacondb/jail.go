@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetMissedBlocks retrieves the number of blocks in a row the validator at
+// the given index missed signing.
+func (kv *KVStore) GetMissedBlocks(index math.ValidatorIndex) (uint64, error) {
+	missed, err := kv.missedBlocks.Get(kv.ctx, index.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return missed, err
+}
+
+// SetMissedBlocks sets the number of blocks in a row the validator at the
+// given index missed signing. Validators which did not miss any are not
+// stored.
+func (kv *KVStore) SetMissedBlocks(index math.ValidatorIndex, missed uint64) error {
+	if missed == 0 {
+		return kv.missedBlocks.Remove(kv.ctx, index.Unwrap())
+	}
+	return kv.missedBlocks.Set(kv.ctx, index.Unwrap(), missed)
+}
+
+// GetJailedUntil retrieves the slot the validator at the given index is
+// jailed until. The boolean is false if the validator is not jailed.
+func (kv *KVStore) GetJailedUntil(index math.ValidatorIndex) (math.Slot, bool, error) {
+	slot, err := kv.jailedUntil.Get(kv.ctx, index.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	return math.Slot(slot), true, nil
+}
+
+// SetJailedUntil jails the validator at the given index until the given slot.
+func (kv *KVStore) SetJailedUntil(index math.ValidatorIndex, slot math.Slot) error {
+	return kv.jailedUntil.Set(kv.ctx, index.Unwrap(), slot.Unwrap())
+}
+
+// RemoveJail releases the validator at the given index from jail.
+func (kv *KVStore) RemoveJail(index math.ValidatorIndex) error {
+	return kv.jailedUntil.Remove(kv.ctx, index.Unwrap())
+}
+
+// GetJailedValidators retrieves the slot each jailed validator is jailed
+// until, by validator index.
+func (kv *KVStore) GetJailedValidators() (map[math.ValidatorIndex]math.Slot, error) {
+	iter, err := kv.jailedUntil.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	jailed := make(map[math.ValidatorIndex]math.Slot)
+	for ; iter.Valid(); iter.Next() {
+		var entry collections.KeyValue[uint64, uint64]
+		entry, err = iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		jailed[math.ValidatorIndex(entry.Key)] = math.Slot(entry.Value)
+	}
+	return jailed, err
+}
@@ -45,6 +45,8 @@ const (
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
 	PendingPartialWithdrawalsPrefix
+	MissedBlocksPrefix
+	JailedUntilPrefix
 )
 
 const (
@@ -72,4 +74,6 @@ const (
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
 	PendingPartialWithdrawalsPrefixHumanReadable        = "PendingPartialWithdrawalsPrefix"
+	MissedBlocksPrefixHumanReadable                     = "MissedBlocksPrefix"
+	JailedUntilPrefixHumanReadable                      = "JailedUntilPrefix"
 )
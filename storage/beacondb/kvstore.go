@@ -97,6 +97,13 @@ type KVStore struct {
 	// We must use `*ctypes.PendingPartialWithdrawals` instead of `ctypes.PendingPartialWithdrawals` as marshalling
 	// methods require a pointer receiver.
 	pendingPartialWithdrawals sdkcollections.Item[*ctypes.PendingPartialWithdrawals]
+	// missedBlocks stores, by validator index, the number of blocks in a row
+	// the validator missed signing. Like jailedUntil, it is not part of the
+	// beacon state root.
+	missedBlocks sdkcollections.Map[uint64, uint64]
+	// jailedUntil stores, by validator index, the slot jailed validators can
+	// unjail from.
+	jailedUntil sdkcollections.Map[uint64, uint64]
 }
 
 // New creates a new instance of Store.
@@ -251,6 +258,20 @@ func New(kss store.KVStoreService) *KVStore {
 				NewEmptyF: ctypes.NewEmptyPendingPartialWithdrawals,
 			},
 		),
+		missedBlocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.MissedBlocksPrefix}),
+			keys.MissedBlocksPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		jailedUntil: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.JailedUntilPrefix}),
+			keys.JailedUntilPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 	}
 	if _, err := schemaBuilder.Build(); err != nil {
 		panic(fmt.Errorf("failed building KVStore schema: %w", err))
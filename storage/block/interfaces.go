@@ -21,15 +21,17 @@
 package block
 
 import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), timestamp, and state root.
+// tree root), timestamp, state root and body.
 type BeaconBlock interface {
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
 	GetTimestamp() math.U64
 	GetStateRoot() common.Root
+	GetBody() *ctypes.BeaconBlockBody
 }
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// PayloadSummary is the load of the execution payload of a block.
+type PayloadSummary struct {
+	Slot             math.Slot
+	BlockHash        common.ExecutionHash
+	BlockNumber      math.U64
+	GasUsed          math.U64
+	GasLimit         math.U64
+	BaseFeePerGas    *math.U256
+	TransactionCount uint64
+	BlobCount        uint64
+}
+
+// summarizePayload returns the summary of the execution payload in the given
+// body, or false if the body carries no payload.
+func summarizePayload(slot math.Slot, body *ctypes.BeaconBlockBody) (PayloadSummary, bool) {
+	if body == nil {
+		return PayloadSummary{}, false
+	}
+	payload := body.GetExecutionPayload()
+	if payload == nil {
+		return PayloadSummary{}, false
+	}
+	return PayloadSummary{
+		Slot:             slot,
+		BlockHash:        payload.GetBlockHash(),
+		BlockNumber:      payload.GetNumber(),
+		GasUsed:          payload.GetGasUsed(),
+		GasLimit:         payload.GetGasLimit(),
+		BaseFeePerGas:    payload.GetBaseFeePerGas(),
+		TransactionCount: uint64(len(payload.GetTransactions())),
+		BlobCount:        uint64(len(body.GetBlobKzgCommitments())),
+	}, true
+}
@@ -44,6 +44,14 @@ type KVStore[BeaconBlockT BeaconBlock] struct {
 	// Beacon state root to slot mapping is injective for finalized blocks.
 	stateRoots *lru.Cache[common.Root, math.Slot]
 
+	// Slot to execution payload summary mapping, in the order blocks are set.
+	payloads *lru.Cache[math.Slot, PayloadSummary]
+
+	// Execution block hash and number to slot mappings are injective for
+	// finalized blocks, as each block carries a distinct execution payload.
+	executionHashes  *lru.Cache[common.ExecutionHash, math.Slot]
+	executionNumbers *lru.Cache[math.U64, math.Slot]
+
 	// Logger for the store.
 	logger log.Logger
 }
@@ -65,25 +73,56 @@ func NewStore[BeaconBlockT BeaconBlock](
 	if err != nil {
 		panic(err)
 	}
+	payloads, err := lru.New[math.Slot, PayloadSummary](availabilityWindow)
+	if err != nil {
+		panic(err)
+	}
+	executionHashes, err := lru.New[common.ExecutionHash, math.Slot](availabilityWindow)
+	if err != nil {
+		panic(err)
+	}
+	executionNumbers, err := lru.New[math.U64, math.Slot](availabilityWindow)
+	if err != nil {
+		panic(err)
+	}
 	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+		blockRoots:       blockRoots,
+		timestamps:       timestamps,
+		stateRoots:       stateRoots,
+		payloads:         payloads,
+		executionHashes:  executionHashes,
+		executionNumbers: executionNumbers,
+		logger:           logger,
 	}
 }
 
 // Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
+// timestamp, state root and execution payload summary. Only this function may potentially evict
 // entries from the store if the availability window is reached.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
 	slot := blk.GetSlot()
 	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
 	kv.timestamps.Add(blk.GetTimestamp(), slot)
 	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	if summary, ok := summarizePayload(slot, blk.GetBody()); ok {
+		kv.payloads.Add(slot, summary)
+		kv.executionHashes.Add(summary.BlockHash, slot)
+		kv.executionNumbers.Add(summary.BlockNumber, slot)
+	}
 	return nil
 }
 
+// GetPayloadSummaries returns the execution payload summaries of up to the
+// last count blocks set in the store, in ascending slot order.
+func (kv *KVStore[BeaconBlockT]) GetPayloadSummaries(count uint64) []PayloadSummary {
+	// Values are ordered from the least to the most recently added.
+	summaries := kv.payloads.Values()
+	if uint64(len(summaries)) > count {
+		summaries = summaries[uint64(len(summaries))-count:]
+	}
+	return summaries
+}
+
 // GetSlotByBlockRoot retrieves the slot by a given block root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
@@ -121,3 +160,37 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	}
 	return slot, nil
 }
+
+// GetSlotByExecutionHash retrieves the slot of the block whose execution
+// payload has the given block hash from the store.
+func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionHash(
+	blockHash common.ExecutionHash,
+) (math.Slot, error) {
+	slot, ok := kv.executionHashes.Peek(blockHash)
+	if !ok {
+		return 0, fmt.Errorf("slot not found at execution block hash: %s", blockHash)
+	}
+	return slot, nil
+}
+
+// GetSlotByExecutionNumber retrieves the slot of the block whose execution
+// payload has the given block number from the store.
+func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
+	blockNumber math.U64,
+) (math.Slot, error) {
+	slot, ok := kv.executionNumbers.Peek(blockNumber)
+	if !ok {
+		return 0, fmt.Errorf("slot not found at execution block number: %d", blockNumber)
+	}
+	return slot, nil
+}
+
+// GetPayloadSummary retrieves the execution payload summary of the block at
+// the given slot from the store.
+func (kv *KVStore[BeaconBlockT]) GetPayloadSummary(slot math.Slot) (PayloadSummary, error) {
+	summary, ok := kv.payloads.Peek(slot)
+	if !ok {
+		return PayloadSummary{}, fmt.Errorf("payload summary not found at slot: %d", slot)
+	}
+	return summary, nil
+}
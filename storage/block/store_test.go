@@ -23,6 +23,7 @@ package block_test
 import (
 	"testing"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log/noop"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -32,6 +33,7 @@ import (
 
 type MockBeaconBlock struct {
 	slot math.Slot
+	body *ctypes.BeaconBlockBody
 }
 
 func (m MockBeaconBlock) GetSlot() math.Slot {
@@ -50,6 +52,10 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
+func (m MockBeaconBlock) GetBody() *ctypes.BeaconBlockBody {
+	return m.body
+}
+
 func TestBlockStore(t *testing.T) {
 	t.Parallel()
 	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
@@ -87,3 +93,56 @@ func TestBlockStore(t *testing.T) {
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
 }
+
+func TestBlockStorePayloadSummaries(t *testing.T) {
+	t.Parallel()
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 3)
+
+	// Blocks without a payload are not summarized.
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 1}))
+	require.Empty(t, blockStore.GetPayloadSummaries(10))
+
+	for i := 2; i <= 5; i++ {
+		body := &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				Number:        math.U64(100 + i),
+				BlockHash:     common.ExecutionHash{byte(i)},
+				GasUsed:       math.U64(i * 1000),
+				GasLimit:      30_000_000,
+				BaseFeePerGas: math.NewU256(uint64(i)),
+				Transactions:  make([][]byte, i),
+			},
+		}
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i), body: body}))
+	}
+
+	// Only the last 3 blocks are kept, in ascending slot order.
+	summaries := blockStore.GetPayloadSummaries(10)
+	require.Len(t, summaries, 3)
+	for i, summary := range summaries {
+		slot := math.Slot(i + 3)
+		require.Equal(t, slot, summary.Slot)
+		require.Equal(t, slot*1000, summary.GasUsed)
+		require.Equal(t, slot.Unwrap(), summary.TransactionCount)
+		require.Zero(t, summary.BlobCount)
+	}
+
+	// Slots are resolved by execution block hash and number.
+	slot, err := blockStore.GetSlotByExecutionHash(common.ExecutionHash{4})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(4), slot)
+	slot, err = blockStore.GetSlotByExecutionNumber(105)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(5), slot)
+	summary, err := blockStore.GetPayloadSummary(5)
+	require.NoError(t, err)
+	require.Equal(t, common.ExecutionHash{5}, summary.BlockHash)
+	_, err = blockStore.GetSlotByExecutionNumber(102)
+	require.ErrorContains(t, err, "not found")
+
+	// The most recent blocks are returned when fewer are requested.
+	summaries = blockStore.GetPayloadSummaries(2)
+	require.Len(t, summaries, 2)
+	require.Equal(t, math.Slot(4), summaries[0].Slot)
+	require.Equal(t, math.Slot(5), summaries[1].Slot)
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/cockroachdb/pebble"
+)
+
+// ErrCheckpointUnsupported is returned when checkpointing a database whose
+// backend cannot take consistent online copies.
+var ErrCheckpointUnsupported = errors.New("database backend does not support checkpoints")
+
+// pebbleBacked is implemented by the PebbleDB wrappers of both cosmos-db and
+// cometbft-db.
+type pebbleBacked interface {
+	DB() *pebble.DB
+}
+
+// Checkpoint writes a consistent copy of an open database to dir, hard-linking
+// immutable files where possible. Only PebbleDB is supported.
+func Checkpoint(db any, dir string) error {
+	pdb, ok := db.(pebbleBacked)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrCheckpointUnsupported, db)
+	}
+	return pdb.DB().Checkpoint(dir, pebble.WithFlushedWAL())
+}
+
+// Registry tracks the open databases of the node by name, so that they can be
+// checkpointed together.
+type Registry struct {
+	mu  sync.Mutex
+	dbs map[string]any
+}
+
+// NewRegistry creates an empty database registry.
+func NewRegistry() *Registry {
+	return &Registry{dbs: make(map[string]any)}
+}
+
+// Register records db under name, replacing any database registered under
+// the same name.
+func (r *Registry) Register(name string, db any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dbs[name] = db
+}
+
+// Checkpoint checkpoints every registered database to dir/<name>.db, the
+// layout of the data directory. Callers must stop writes across databases
+// for the checkpoints to be consistent with each other.
+func (r *Registry) Checkpoint(dir string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.dbs))
+	for name := range r.dbs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if err := Checkpoint(r.dbs[name], filepath.Join(dir, name+".db")); err != nil {
+			return nil, fmt.Errorf("failed checkpointing %s: %w", name, err)
+		}
+	}
+	return names, nil
+}
+
+// LinkFiles recreates the directory tree under src at dst, hard-linking every
+// file. It suits stores such as the blob store whose files are never modified
+// in place once written.
+func LinkFiles(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			//nolint:mnd // standard directory permissions.
+			return os.MkdirAll(target, 0o755)
+		}
+		return os.Link(path, target)
+	})
+}
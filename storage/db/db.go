@@ -21,13 +21,166 @@
 package db
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/berachain/beacon-kit/errors"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
+// copyBatchSize is the number of entries written per batch by Copy.
+const copyBatchSize = 10_000
+
+// ErrUnsupportedBackend is returned for database backends BeaconKit does not
+// run on.
+var ErrUnsupportedBackend = errors.New("unsupported database backend")
+
+// DataDBNames are the databases kept under the data directory, including the
+// ones owned by CometBFT. Each may use a different backend, see
+// DetectBackend.
+//
+//nolint:gochecknoglobals // fixed list of database names.
+var DataDBNames = []string{
+	"application", "deposits", "operations", "proposals", "performance",
+	"blockstore", "state", "evidence", "tx_index",
+}
+
+// ParseBackend returns the backend named by the CometBFT db_backend setting.
+// PebbleDB is used if none is set.
+func ParseBackend(name string) (dbm.BackendType, error) {
+	switch backend := dbm.BackendType(name); backend {
+	case "":
+		return dbm.PebbleDBBackend, nil
+	case dbm.PebbleDBBackend, dbm.GoLevelDBBackend:
+		return backend, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedBackend, name)
+	}
+}
+
 // OpenDB opens the application database using the appropriate driver.
 func OpenDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
 }
+
+// OpenAppDB opens the application database, see NewDB.
+func OpenAppDB(rootDir string, backendName string) (dbm.DB, error) {
+	return NewDB(filepath.Join(rootDir, "data"), "application", backendName)
+}
+
+// NewDB opens the database name under dataDir with the backend it was
+// created with. New databases use the backend named by the CometBFT
+// db_backend setting.
+func NewDB(dataDir, name, backendName string) (dbm.DB, error) {
+	configured, err := ParseBackend(backendName)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := DetectBackend(dataDir, name, configured)
+	if err != nil {
+		return nil, err
+	}
+	return dbm.NewDB(name, backend, dataDir)
+}
+
+// DetectBackend returns the backend the database name under dataDir was
+// created with, or configured if it does not exist yet. The BeaconKit
+// databases were always created with PebbleDB before db_backend applied to
+// them, so the backend of an existing database cannot be taken from the
+// setting. PebbleDB is recognized by the OPTIONS files it writes, which
+// GoLevelDB never does.
+func DetectBackend(dataDir, name string, configured dbm.BackendType) (dbm.BackendType, error) {
+	entries, err := os.ReadDir(filepath.Join(dataDir, name+".db"))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return configured, nil
+	case err != nil:
+		return "", err
+	case len(entries) == 0:
+		return configured, nil
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "OPTIONS-") {
+			return dbm.PebbleDBBackend, nil
+		}
+	}
+	return dbm.GoLevelDBBackend, nil
+}
+
+// Copy writes every entry of src into dst and returns the number of entries
+// copied.
+func Copy(src, dst dbm.DB) (uint64, error) {
+	it, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var count uint64
+	batch := dst.NewBatch()
+	for ; it.Valid(); it.Next() {
+		if err = batch.Set(it.Key(), it.Value()); err != nil {
+			return count, errors.Join(err, batch.Close())
+		}
+		count++
+		if count%copyBatchSize != 0 {
+			continue
+		}
+		if err = batch.Write(); err != nil {
+			return count, errors.Join(err, batch.Close())
+		}
+		if err = batch.Close(); err != nil {
+			return count, err
+		}
+		batch = dst.NewBatch()
+	}
+	if err = it.Error(); err != nil {
+		return count, errors.Join(err, batch.Close())
+	}
+	if err = batch.WriteSync(); err != nil {
+		return count, errors.Join(err, batch.Close())
+	}
+	return count, batch.Close()
+}
+
+// Migrate converts the database name under dataDir from one backend to
+// another. The original is kept next to it with a ".<from>.bak" suffix. It
+// reports false if the database does not exist.
+func Migrate(dataDir, name string, from, to dbm.BackendType) (uint64, bool, error) {
+	path := filepath.Join(dataDir, name+".db")
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+
+	tmpDir := filepath.Join(dataDir, "migrate-"+string(to))
+	count, err := copyDB(dataDir, tmpDir, name, from, to)
+	if err != nil {
+		return count, true, fmt.Errorf("failed migrating %s: %w", name, err)
+	}
+
+	if err = os.Rename(path, path+"."+string(from)+".bak"); err != nil {
+		return count, true, err
+	}
+	if err = os.Rename(filepath.Join(tmpDir, name+".db"), path); err != nil {
+		return count, true, err
+	}
+	return count, true, os.RemoveAll(tmpDir)
+}
+
+func copyDB(srcDir, dstDir, name string, from, to dbm.BackendType) (uint64, error) {
+	src, err := dbm.NewDB(name, from, srcDir)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	dst, err := dbm.NewDB(name, to, dstDir)
+	if err != nil {
+		return 0, err
+	}
+	count, err := Copy(src, dst)
+	return count, errors.Join(err, dst.Close())
+}
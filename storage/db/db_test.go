@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBackend(t *testing.T) {
+	t.Parallel()
+
+	backend, err := db.ParseBackend("")
+	require.NoError(t, err)
+	require.Equal(t, dbm.PebbleDBBackend, backend)
+
+	backend, err = db.ParseBackend("goleveldb")
+	require.NoError(t, err)
+	require.Equal(t, dbm.GoLevelDBBackend, backend)
+
+	_, err = db.ParseBackend("rocksdb")
+	require.ErrorIs(t, err, db.ErrUnsupportedBackend)
+}
+
+func TestDetectBackend(t *testing.T) {
+	t.Parallel()
+	dataDir := t.TempDir()
+
+	for name, backend := range map[string]dbm.BackendType{
+		"pebble":  dbm.PebbleDBBackend,
+		"leveldb": dbm.GoLevelDBBackend,
+	} {
+		created, err := dbm.NewDB(name, backend, dataDir)
+		require.NoError(t, err)
+		require.NoError(t, created.Set([]byte("key"), []byte("value")))
+		require.NoError(t, created.Close())
+
+		// Existing databases keep their backend, whatever is configured.
+		for _, configured := range []dbm.BackendType{dbm.PebbleDBBackend, dbm.GoLevelDBBackend} {
+			detected, errDetect := db.DetectBackend(dataDir, name, configured)
+			require.NoError(t, errDetect)
+			require.Equal(t, backend, detected)
+		}
+	}
+
+	detected, err := db.DetectBackend(dataDir, "missing", dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	require.Equal(t, dbm.GoLevelDBBackend, detected)
+}
+
+// A node whose BeaconKit databases were created with PebbleDB keeps using
+// them after db_backend is set to GoLevelDB.
+func TestNewDB_ExistingPebble(t *testing.T) {
+	t.Parallel()
+	dataDir := t.TempDir()
+
+	created, err := dbm.NewDB("deposits", dbm.PebbleDBBackend, dataDir)
+	require.NoError(t, err)
+	require.NoError(t, created.Set([]byte("key"), []byte("value")))
+	require.NoError(t, created.Close())
+
+	opened, err := db.NewDB(dataDir, "deposits", "goleveldb")
+	require.NoError(t, err)
+	defer opened.Close()
+	value, err := opened.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+	dataDir := t.TempDir()
+
+	src, err := dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+	for i := range 25 {
+		key := []byte(fmt.Sprintf("key-%02d", i))
+		require.NoError(t, src.Set(key, []byte{byte(i)}))
+	}
+	require.NoError(t, src.Close())
+
+	count, found, err := db.Migrate(dataDir, "application", dbm.GoLevelDBBackend, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(25), count)
+
+	// The original is kept as a backup and the temporary directory is gone.
+	_, err = os.Stat(filepath.Join(dataDir, "application.db.goleveldb.bak"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dataDir, "migrate-pebbledb"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	dst, err := dbm.NewDB("application", dbm.PebbleDBBackend, dataDir)
+	require.NoError(t, err)
+	defer dst.Close()
+	value, err := dst.Get([]byte("key-24"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{24}, value)
+
+	_, found, err = db.Migrate(dataDir, "deposits", dbm.GoLevelDBBackend, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/encoding"
+)
+
+const (
+	// snapshotFormat is the format of the deposit snapshot payloads: one SSZ
+	// encoded deposit per payload, in index order.
+	snapshotFormat uint32 = 1
+	// snapshotPageSize is the number of deposits read from the store at once.
+	snapshotPageSize uint64 = 1024
+)
+
+// SnapshotExtension adds the deposit store to state sync snapshots. Nodes
+// joining through state sync need every deposit up to the snapshot height to
+// validate the deposits of later blocks, and those are not part of the beacon
+// state.
+type SnapshotExtension struct {
+	store Store
+	codec encoding.SSZValueCodec[*ctypes.Deposit]
+}
+
+// NewSnapshotExtension creates a snapshot extension backed by store.
+func NewSnapshotExtension(store Store) *SnapshotExtension {
+	return &SnapshotExtension{
+		store: store,
+		codec: encoding.SSZValueCodec[*ctypes.Deposit]{
+			NewEmptyF: ctypes.NewEmptyDeposit,
+		},
+	}
+}
+
+// SnapshotName returns the name of the extension.
+func (*SnapshotExtension) SnapshotName() string {
+	return "deposits"
+}
+
+// SnapshotFormat returns the format written by SnapshotExtension.
+func (*SnapshotExtension) SnapshotFormat() uint32 {
+	return snapshotFormat
+}
+
+// SupportedFormats returns the formats RestoreExtension can read.
+func (*SnapshotExtension) SupportedFormats() []uint32 {
+	return []uint32{snapshotFormat}
+}
+
+// SnapshotExtension writes every stored deposit. Deposits are read from the
+// execution layer ahead of inclusion, so this may include deposits past the
+// snapshot height, which are equally valid.
+func (e *SnapshotExtension) SnapshotExtension(
+	_ uint64, write snapshottypes.ExtensionPayloadWriter,
+) error {
+	ctx := context.Background()
+	for start := uint64(0); ; start += snapshotPageSize {
+		deposits, _, err := e.store.GetDepositsByIndex(ctx, start, snapshotPageSize)
+		if err != nil {
+			return err
+		}
+		for _, deposit := range deposits {
+			bz, encErr := e.codec.Encode(deposit)
+			if encErr != nil {
+				return encErr
+			}
+			if err = write(bz); err != nil {
+				return err
+			}
+		}
+		if uint64(len(deposits)) < snapshotPageSize {
+			return nil
+		}
+	}
+}
+
+// RestoreExtension enqueues the deposits of a snapshot.
+func (e *SnapshotExtension) RestoreExtension(
+	_ uint64, format uint32, read snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != snapshotFormat {
+		return fmt.Errorf("%w: deposits format %d", snapshottypes.ErrUnknownFormat, format)
+	}
+
+	ctx := context.Background()
+	for {
+		bz, err := read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		deposit, err := e.codec.Decode(bz)
+		if err != nil {
+			return err
+		}
+		if err = e.store.EnqueueDeposits(ctx, []*ctypes.Deposit{deposit}); err != nil {
+			return err
+		}
+	}
+}
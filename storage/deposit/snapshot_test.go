@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotExtension_RoundTrip(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	src := deposit.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	deposits := make([]*types.Deposit, 0, 3)
+	for i := range uint64(3) {
+		deposits = append(deposits, &types.Deposit{
+			Pubkey: [48]byte{byte(i)},
+			Amount: 32_000_000_000,
+			Index:  i,
+		})
+	}
+	require.NoError(t, src.EnqueueDeposits(ctx, deposits))
+
+	var payloads [][]byte
+	require.NoError(t, deposit.NewSnapshotExtension(src).SnapshotExtension(
+		1, func(bz []byte) error {
+			payloads = append(payloads, bz)
+			return nil
+		},
+	))
+	require.Len(t, payloads, len(deposits))
+
+	dst := deposit.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	ext := deposit.NewSnapshotExtension(dst)
+	require.NoError(t, ext.RestoreExtension(1, ext.SnapshotFormat(), func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		bz := payloads[0]
+		payloads = payloads[1:]
+		return bz, nil
+	}))
+
+	wantDeposits, wantRoot, err := src.GetDepositsByIndex(ctx, 0, 10)
+	require.NoError(t, err)
+	gotDeposits, gotRoot, err := dst.GetDepositsByIndex(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, wantDeposits, gotDeposits)
+	require.Equal(t, wantRoot, gotRoot)
+}
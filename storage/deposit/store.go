@@ -41,6 +41,9 @@ const (
 type Store interface {
 	GetDepositsByIndex(ctx context.Context, startIndex uint64, depRange uint64) (ctypes.Deposits, common.Root, error)
 	EnqueueDeposits(ctx context.Context, deposits []*ctypes.Deposit) error
+	EnqueueDepositLocations(ctx context.Context, locations []*ctypes.DepositLocation) error
+	GetDepositLocation(ctx context.Context, index uint64) (*ctypes.DepositLocation, error)
+	GetLatestDepositLocation(ctx context.Context) (*ctypes.DepositLocation, error)
 	Prune(ctx context.Context, start, end uint64) error
 	Close() error
 }
@@ -105,6 +108,50 @@ func (gs *generalStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.
 	}
 }
 
+func (gs *generalStore) EnqueueDepositLocations(
+	ctx context.Context,
+	locations []*ctypes.DepositLocation,
+) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.EnqueueDepositLocations(ctx, locations)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) GetDepositLocation(
+	ctx context.Context,
+	index uint64,
+) (*ctypes.DepositLocation, error) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.GetDepositLocation(ctx, index)
+	default:
+		return nil, fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) GetLatestDepositLocation(
+	ctx context.Context,
+) (*ctypes.DepositLocation, error) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.GetLatestDepositLocation(ctx)
+	default:
+		return nil, fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
 func (gs *generalStore) Close() error {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
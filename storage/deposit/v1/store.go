@@ -35,13 +35,20 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix         = "deposit"
+	KeyDepositLocationPrefix = "deposit_location"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore struct {
 	store sdkcollections.Map[uint64, *ctypes.Deposit]
 
+	// locations tracks where on the execution layer each deposit was read
+	// from. Genesis deposits have no location.
+	locations sdkcollections.Map[uint64, *ctypes.DepositLocation]
+
 	// closeFunc is a closure that closes the underlying database
 	// used by store to ensure that all writes are flushed to disk.
 	// We guarantee that closeFunc is called at maximum only once.
@@ -75,6 +82,17 @@ func NewStore(
 				NewEmptyF: ctypes.NewEmptyDeposit,
 			},
 		),
+		locations: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyDepositLocationPrefix)),
+			KeyDepositLocationPrefix,
+			sdkcollections.Uint64Key,
+			encoding.BinaryValueCodec[*ctypes.DepositLocation]{
+				NewEmptyF: func() *ctypes.DepositLocation {
+					return &ctypes.DepositLocation{}
+				},
+			},
+		),
 		closeFunc: closeFunc,
 		logger:    logger,
 	}
@@ -144,6 +162,59 @@ func (kv *KVStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.Depos
 	return nil
 }
 
+// EnqueueDepositLocations records the execution layer location of deposits.
+func (kv *KVStore) EnqueueDepositLocations(
+	ctx context.Context,
+	locations []*ctypes.DepositLocation,
+) error {
+	for _, location := range locations {
+		idx := location.Index.Unwrap()
+		if err := kv.locations.Set(ctx, idx, location); err != nil {
+			return errors.Wrapf(err, "failed to store location of deposit %d", idx)
+		}
+	}
+	return nil
+}
+
+// GetDepositLocation returns the execution layer location of the deposit at
+// the given index, or nil if the location is unknown (e.g. genesis deposits).
+func (kv *KVStore) GetDepositLocation(
+	ctx context.Context,
+	index uint64,
+) (*ctypes.DepositLocation, error) {
+	location, err := kv.locations.Get(ctx, index)
+	switch {
+	case err == nil:
+		return location, nil
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return nil, nil //nolint:nilnil // unknown location is not an error.
+	default:
+		return nil, errors.Wrapf(err, "failed to get location of deposit %d", index)
+	}
+}
+
+// GetLatestDepositLocation returns the execution layer location of the
+// deposit with the highest index, or nil if no deposit has a known location.
+func (kv *KVStore) GetLatestDepositLocation(
+	ctx context.Context,
+) (*ctypes.DepositLocation, error) {
+	iter, err := kv.locations.Iterate(
+		ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate deposit locations")
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil //nolint:nilnil // no known location is not an error.
+	}
+	location, err := iter.Value()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest deposit location")
+	}
+	return location, nil
+}
+
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 	if start > end {
@@ -156,6 +227,9 @@ func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 		if err := kv.store.Remove(ctx, start+i); err != nil {
 			return errors.Wrapf(err, "failed to prune deposit %d", start+i)
 		}
+		if err := kv.locations.Remove(ctx, start+i); err != nil {
+			return errors.Wrapf(err, "failed to prune location of deposit %d", start+i)
+		}
 	}
 
 	kv.logger.Debug("Pruned deposits", "start", start, "end", end)
@@ -78,3 +78,44 @@ func BenchmarkDepositsInsertion(b *testing.B) {
 		}
 	}
 }
+
+func TestDepositLocations(t *testing.T) {
+	t.Parallel()
+
+	baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	store := deposit.NewStore(baseDB, log.NewNopLogger())
+	ctx := context.Background()
+
+	latest, err := store.GetLatestDepositLocation(ctx)
+	require.NoError(t, err)
+	require.Nil(t, latest)
+
+	location := &types.DepositLocation{
+		Index:       3,
+		BlockNumber: 1_234,
+		LogIndex:    7,
+		TxHash:      common.ExecutionHash{0xab},
+	}
+	require.NoError(t, store.EnqueueDepositLocations(ctx, []*types.DepositLocation{location}))
+
+	got, err := store.GetDepositLocation(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, location, got)
+
+	earlier := &types.DepositLocation{Index: 2, BlockNumber: 1_000}
+	require.NoError(t, store.EnqueueDepositLocations(ctx, []*types.DepositLocation{earlier}))
+	latest, err = store.GetLatestDepositLocation(ctx)
+	require.NoError(t, err)
+	require.Equal(t, location, latest)
+
+	// Unknown locations, e.g. genesis deposits, are reported as nil.
+	got, err = store.GetDepositLocation(ctx, 0)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.NoError(t, store.Prune(ctx, 0, 4))
+	got, err = store.GetDepositLocation(ctx, 3)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"encoding"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// BinaryMarshallable is a value that can be round-tripped through its binary
+// encoding.
+type BinaryMarshallable interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// BinaryValueCodec provides methods to encode and decode values through their
+// MarshalBinary and UnmarshalBinary methods.
+type BinaryValueCodec[T BinaryMarshallable] struct {
+	NewEmptyF func() T // constructor
+}
+
+// Encode marshals the provided value into its binary encoding.
+func (BinaryValueCodec[T]) Encode(value T) ([]byte, error) {
+	return value.MarshalBinary()
+}
+
+// Decode unmarshals the provided bytes into a value of type T.
+func (bc BinaryValueCodec[T]) Decode(bz []byte) (T, error) {
+	dest := bc.NewEmptyF()
+	return dest, dest.UnmarshalBinary(bz)
+}
+
+// EncodeJSON is not implemented and will panic if called.
+func (BinaryValueCodec[T]) EncodeJSON(_ T) ([]byte, error) {
+	panic("not implemented")
+}
+
+// DecodeJSON is not implemented and will panic if called.
+func (BinaryValueCodec[T]) DecodeJSON(_ []byte) (T, error) {
+	panic("not implemented")
+}
+
+// Stringify returns the string representation of the provided value.
+func (BinaryValueCodec[T]) Stringify(value T) string {
+	return spew.Sdump(value)
+}
+
+// ValueType returns the name of the interface that this codec is intended for.
+func (BinaryValueCodec[T]) ValueType() string {
+	return "BinaryMarshallable"
+}
@@ -21,14 +21,23 @@
 package filedb
 
 import (
+	"bufio"
+	stdbytes "bytes"
+	"io"
 	"os"
 	"path/filepath"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/golang/snappy"
 	"github.com/spf13/afero"
 )
 
+// snappyMagic is the stream identifier chunk every snappy framed stream
+// starts with. Values stored uncompressed never start with it, since its
+// first byte marks a chunk type no SSZ value we store begins with.
+const snappyMagic = "\xff\x06\x00\x00sNaPpY"
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
@@ -38,6 +47,7 @@ type DB struct {
 	rootDir   string
 	extension string
 	dirPerms  os.FileMode
+	compress  bool
 }
 
 // NewDB creates a new instance of the DB.
@@ -53,9 +63,22 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
-// Get retrieves the value for a key.
+// Get retrieves the value for a key. Values are decompressed transparently.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	path := db.pathForKey(key)
+	file, err := db.fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	r, compressed := db.newReader(file)
+	value, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	db.migrate(path, value, compressed)
+	return value, nil
 }
 
 // Has returns true if the key exists in the database.
@@ -87,7 +110,7 @@ func (db *DB) Set(key []byte, value []byte) error {
 	}
 	defer file.Close()
 
-	n, err := file.Write(value)
+	n, err := file.Write(db.encode(value))
 	if err != nil {
 		return errors.Wrap(err, "failed to write to file")
 	}
@@ -101,6 +124,67 @@ func (db *DB) Delete(key []byte) error {
 	return db.fs.RemoveAll(db.pathForKey(key))
 }
 
+// newReader returns a reader over the value stored in file, decompressing it
+// if it was stored snappy framed, and whether it was.
+func (db *DB) newReader(file io.Reader) (io.Reader, bool) {
+	br := bufio.NewReader(file)
+	if head, err := br.Peek(len(snappyMagic)); err == nil &&
+		string(head) == snappyMagic {
+		return snappy.NewReader(br), true
+	}
+	return br, false
+}
+
+// encode returns value as it should be written to disk.
+func (db *DB) encode(value []byte) []byte {
+	if !db.compress {
+		return value
+	}
+	var buf stdbytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	// Writes to a bytes.Buffer cannot fail.
+	_, _ = w.Write(value)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+// migrate lazily rewrites a value read uncompressed from path in compressed
+// form, so that data written before compression was enabled shrinks as it is
+// read. The rewrite goes through a temporary file and a rename so concurrent
+// readers never observe a partial file. Failures are logged and otherwise
+// ignored, as the value stays readable in its original form.
+func (db *DB) migrate(path string, value []byte, compressed bool) {
+	if !db.compress || compressed {
+		return
+	}
+	err := db.rewrite(path, db.encode(value))
+	if err != nil {
+		db.logger.Warn("Failed to compress stored value", "path", path, "err", err)
+	}
+}
+
+// rewrite atomically replaces the file at path with data.
+func (db *DB) rewrite(path string, data []byte) error {
+	tmp, err := afero.TempFile(
+		db.fs, filepath.Dir(path), filepath.Base(path)+".*.tmp",
+	)
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Close()
+	} else {
+		_ = tmp.Close()
+	}
+	if err == nil {
+		err = db.fs.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = db.fs.Remove(tmp.Name())
+	}
+	return err
+}
+
 // pathForKey returns the path for a key.
 // TODO: for efficient storage we should expand this path.
 func (db *DB) pathForKey(key []byte) string {
@@ -62,6 +62,16 @@ func WithLogger(logger log.Logger) Option {
 	}
 }
 
+// WithSnappyCompression makes the database store values snappy framed.
+// Values stored uncompressed are still read, and are compressed the first
+// time they are read.
+func WithSnappyCompression() Option {
+	return func(db *DB) error {
+		db.compress = true
+		return nil
+	}
+}
+
 // WithRootDirectory sets the root directory for the database.
 func WithRootDirectory(rootDir string) Option {
 	return func(db *DB) error {
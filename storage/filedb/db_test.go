@@ -21,6 +21,9 @@
 package filedb_test
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -300,3 +303,47 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_SnappyCompression(t *testing.T) {
+	t.Parallel()
+	var (
+		rootDir = t.TempDir()
+		value   = bytes.Repeat([]byte("value"), 1024)
+	)
+	newDB := func(opts ...file.Option) *file.DB {
+		return file.NewDB(append([]file.Option{
+			file.WithRootDirectory(rootDir),
+			file.WithFileExtension("ssz"),
+			file.WithDirectoryPermissions(0700),
+			file.WithLogger(log.NewNopLogger()),
+		}, opts...)...)
+	}
+	readRaw := func(key string) []byte {
+		raw, err := os.ReadFile(filepath.Join(rootDir, key+".ssz"))
+		require.NoError(t, err)
+		return raw
+	}
+
+	// Values written before compression was enabled stay readable and are
+	// compressed the first time they are read.
+	require.NoError(t, newDB().Set([]byte("legacy"), value))
+	require.Equal(t, value, readRaw("legacy"))
+
+	db := newDB(file.WithSnappyCompression())
+	got, err := db.Get([]byte("legacy"))
+	require.NoError(t, err)
+	require.Equal(t, value, got)
+	require.Less(t, len(readRaw("legacy")), len(value))
+
+	got, err = db.Get([]byte("legacy"))
+	require.NoError(t, err)
+	require.Equal(t, value, got)
+
+	// New values are written compressed, and remain readable by a database
+	// with compression disabled.
+	require.NoError(t, db.Set([]byte("fresh"), value))
+	require.Less(t, len(readRaw("fresh")), len(value))
+	got, err = newDB().Get([]byte("fresh"))
+	require.NoError(t, err)
+	require.Equal(t, value, got)
+}
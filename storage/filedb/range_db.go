@@ -22,9 +22,12 @@ package filedb
 
 import (
 	"bytes"
+	"cmp"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
@@ -110,6 +113,14 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 	return db.coreDB.Delete(prefix(index, key))
 }
 
+// DeleteIndex removes all values stored under the given index. Unlike Prune,
+// it does not move the lower bound, so the index can be written again.
+func (db *RangeDB) DeleteIndex(index uint64) error {
+	db.rwMu.Lock()
+	defer db.rwMu.Unlock()
+	return db.deleteRange(index, index+1)
+}
+
 // deleteRange removes all values associated with the given index from the
 // filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
 // the `to“ index.
@@ -133,16 +144,26 @@ func (db *RangeDB) deleteRange(from, to uint64) error {
 }
 
 // Prune removes all values in the given range [start, end) from the db.
+// Indexes already pruned are skipped.
 func (db *RangeDB) Prune(start, end uint64) error {
 	db.rwMu.Lock()
 	defer db.rwMu.Unlock()
-	start = max(start, db.lowerBoundIndex)
+	return db.prune(start, end)
+}
+
+// prune implements Prune, with the lock held.
+func (db *RangeDB) prune(start, end uint64) error {
 	if start > end {
 		return fmt.Errorf(
 			"RangeDB Prune start: %d, end: %d: %w",
 			start, end, storage.ErrInvalidRange,
 		)
 	}
+	start = max(start, db.lowerBoundIndex)
+	if start > end {
+		// The range was already pruned, e.g. ahead of time by PruneToSize.
+		return nil
+	}
 
 	// DeleteRange may fail and so some files to be pruned may have not
 	// been removed. We *do not* retry to prune those files to avoid getting
@@ -153,11 +174,103 @@ func (db *RangeDB) Prune(start, end uint64) error {
 	return err
 }
 
+// DiskUsage returns the number of bytes taken by the values stored in the db.
+func (db *RangeDB) DiskUsage() (uint64, error) {
+	db.rwMu.RLock()
+	defer db.rwMu.RUnlock()
+	usage, err := db.indexUsage()
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for _, u := range usage {
+		total += u.bytes
+	}
+	return total, nil
+}
+
+// PruneToSize prunes the lowest indexes until the values stored in the db
+// take at most maxBytes, without pruning index end or any index above it.
+// It returns the number of bytes the db takes afterwards.
+func (db *RangeDB) PruneToSize(maxBytes, end uint64) (uint64, error) {
+	db.rwMu.Lock()
+	defer db.rwMu.Unlock()
+	usage, err := db.indexUsage()
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for _, u := range usage {
+		total += u.bytes
+	}
+
+	cutoff := db.lowerBoundIndex
+	for _, u := range usage {
+		if total <= maxBytes || u.index >= end {
+			break
+		}
+		total -= u.bytes
+		cutoff = u.index + 1
+	}
+	return total, db.prune(db.lowerBoundIndex, cutoff)
+}
+
+// indexSize is the number of bytes stored under an index.
+type indexSize struct {
+	index uint64
+	bytes uint64
+}
+
+// indexUsage returns the number of bytes stored under each index, in
+// ascending index order.
+func (db *RangeDB) indexUsage() ([]indexSize, error) {
+	dirs, err := afero.ReadDir(db.coreDB.fs, ".")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	usage := make([]indexSize, 0, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		index, err := math.U64FromString(dir.Name())
+		if err != nil {
+			continue
+		}
+		entries, err := afero.ReadDir(db.coreDB.fs, dir.Name())
+		if err != nil {
+			return nil, err
+		}
+		u := indexSize{index: index.Unwrap()}
+		for _, entry := range entries {
+			//#nosec: G115 // file sizes are never negative.
+			u.bytes += uint64(entry.Size())
+		}
+		usage = append(usage, u)
+	}
+	slices.SortFunc(usage, func(a, b indexSize) int {
+		return cmp.Compare(a.index, b.index)
+	})
+	return usage, nil
+}
+
 // GetByIndex takes the database index and returns all associated entries,
 // expecting database keys to follow the prefix() format. If index does not
 // exist in the DB for any reason (pruned, invalid index), an empty list is
 // returned with no error.
 func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
+	return db.GetByIndexMatching(index, 0, func([]byte) bool { return true })
+}
+
+// GetByIndexMatching behaves like GetByIndex, but only returns the entries
+// whose first prefixLen bytes satisfy match. The remainder of the entries that
+// do not match is never read. Entries shorter than prefixLen are skipped.
+func (db *RangeDB) GetByIndexMatching(
+	index uint64, prefixLen int, match func(prefix []byte) bool,
+) ([][]byte, error) {
 	db.rwMu.RLock()
 	defer db.rwMu.RUnlock()
 	indexDir := fmt.Sprintf(pathFormat, index)
@@ -177,16 +290,52 @@ func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
 		if !strings.HasSuffix(filename, db.coreDB.extension) {
 			continue
 		}
-		var sidecarBz []byte
-		sidecarBz, err = afero.ReadFile(db.coreDB.fs, filepath.Join(indexDir, filename))
+		var (
+			value   []byte
+			matched bool
+		)
+		value, matched, err = db.readMatching(filepath.Join(indexDir, filename), prefixLen, match)
 		if err != nil {
 			return keys, err
 		}
-		keys = append(keys, sidecarBz)
+		if matched {
+			keys = append(keys, value)
+		}
 	}
 	return keys, nil
 }
 
+// readMatching reads the file at path if its first prefixLen bytes satisfy
+// match.
+func (db *RangeDB) readMatching(
+	path string, prefixLen int, match func(prefix []byte) bool,
+) ([]byte, bool, error) {
+	file, err := db.coreDB.fs.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer file.Close()
+
+	r, compressed := db.coreDB.newReader(file)
+	head := make([]byte, prefixLen)
+	if _, err = io.ReadFull(r, head); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if !match(head) {
+		return nil, false, nil
+	}
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		return nil, false, err
+	}
+	value := append(head, rest...)
+	db.coreDB.migrate(path, value, compressed)
+	return value, true, nil
+}
+
 // prefix prefixes the given key with the index and a slash.
 func prefix(index uint64, key []byte) []byte {
 	return []byte(fmt.Sprintf(keyFormat, index, hex.EncodeBytes(key)))
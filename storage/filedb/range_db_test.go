@@ -101,6 +101,23 @@ func TestRangeDB(t *testing.T) {
 				require.False(t, exists)
 			},
 		},
+		{
+			name: "DeleteIndex",
+			setupFunc: func(rdb *file.RangeDB) error {
+				return populateTestDB(rdb, 1, 3)
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				require.NoError(t, rdb.DeleteIndex(2))
+				requireNotExist(t, rdb, 2, 2)
+				requireExist(t, rdb, 1, 1)
+				requireExist(t, rdb, 3, 3)
+
+				// The index stays writable after deletion.
+				require.NoError(t, rdb.Set(2, []byte("key"), []byte("value")))
+				requireExist(t, rdb, 2, 2)
+			},
+		},
 		{
 			name: "Prune",
 			setupFunc: func(rdb *file.RangeDB) error {
@@ -190,8 +207,59 @@ func TestExtractIndex(t *testing.T) {
 	}
 }
 
+func TestRangeDB_GetByIndexMatching_Compressed(t *testing.T) {
+	t.Parallel()
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithSnappyCompression(),
+	))
+	require.NoError(t, rdb.Set(1, []byte("a"), []byte("keep-me")))
+	require.NoError(t, rdb.Set(1, []byte("b"), []byte("drop-me")))
+
+	values, err := rdb.GetByIndexMatching(1, len("keep"),
+		func(prefix []byte) bool { return string(prefix) == "keep" },
+	)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("keep-me")}, values)
+}
+
 // =========================== PRUNING =====================================
 
+func TestRangeDB_PruneToSize(t *testing.T) {
+	t.Parallel()
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	))
+	for i := range uint64(10) {
+		require.NoError(t, rdb.Set(i, []byte("key"), make([]byte, 100)))
+	}
+	usage, err := rdb.DiskUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), usage)
+
+	// Pruning stops at end even if the db is still over the limit.
+	usage, err = rdb.PruneToSize(100, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), usage)
+	requireNotExist(t, rdb, 0, 2)
+	requireExist(t, rdb, 3, 9)
+
+	usage, err = rdb.PruneToSize(450, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), usage)
+	requireExist(t, rdb, 6, 9)
+
+	// Regular pruning of an already pruned range is a no-op.
+	require.NoError(t, rdb.Prune(0, 4))
+	requireExist(t, rdb, 6, 9)
+}
+
 func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package proposals keeps an audit log of the blocks proposed by this node,
+// recording which fee recipient captured the fees of each of them.
+package proposals
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	// keyLength is the length of the keys, i.e. the big endian encoded slot.
+	keyLength = 8
+	// valueLength is the length of the values, i.e. the fee recipient
+	// followed by the payload block hash.
+	valueLength = 20 + 32
+)
+
+var (
+	// ErrNotFound is returned when no proposal is recorded at a slot.
+	ErrNotFound = errors.New("proposal not found")
+
+	// ErrCorruptedEntry is returned when a recorded proposal cannot be loaded.
+	ErrCorruptedEntry = errors.New("corrupted proposal entry")
+)
+
+// Record is a block proposed by this node.
+type Record struct {
+	// Slot is the slot of the block.
+	Slot math.Slot
+	// FeeRecipient is the fee recipient of the execution payload, which
+	// captured the fees of the block.
+	FeeRecipient common.ExecutionAddress
+	// BlockHash is the hash of the execution payload.
+	BlockHash common.ExecutionHash
+}
+
+// Store persists the proposals of this node, keyed by slot. A proposal built
+// again for the same slot replaces the previous one. Records may exist for
+// slots whose block was never committed, such as proposals of failed rounds
+// or a slot rolled back after a crash.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore creates a new store of proposals backed by db.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Set records the proposal.
+func (s *Store) Set(r Record) error {
+	value := make([]byte, 0, valueLength)
+	value = append(value, r.FeeRecipient[:]...)
+	value = append(value, r.BlockHash[:]...)
+	return s.db.SetSync(key(r.Slot), value)
+}
+
+// Get returns the proposal recorded at the slot.
+func (s *Store) Get(slot math.Slot) (Record, error) {
+	value, err := s.db.Get(key(slot))
+	if err != nil {
+		return Record{}, err
+	}
+	if value == nil {
+		return Record{}, errors.Wrapf(ErrNotFound, "slot %d", slot)
+	}
+	return decode(key(slot), value)
+}
+
+// Range returns up to limit proposals recorded from the slot onwards, in
+// ascending slot order.
+func (s *Store) Range(from math.Slot, limit int) ([]Record, error) {
+	it, err := s.db.Iterator(key(from), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	records := make([]Record, 0)
+	for ; it.Valid() && len(records) < limit; it.Next() {
+		r, dErr := decode(it.Key(), it.Value())
+		if dErr != nil {
+			return nil, dErr
+		}
+		records = append(records, r)
+	}
+	return records, it.Error()
+}
+
+// key returns the key of the proposal at the slot.
+func key(slot math.Slot) []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, keyLength), slot.Unwrap())
+}
+
+// decode returns the proposal stored under k.
+func decode(k, value []byte) (Record, error) {
+	if len(k) != keyLength || len(value) != valueLength {
+		return Record{}, ErrCorruptedEntry
+	}
+	var r Record
+	r.Slot = math.Slot(binary.BigEndian.Uint64(k))
+	copy(r.FeeRecipient[:], value[:20])
+	copy(r.BlockHash[:], value[20:])
+	return r, nil
+}
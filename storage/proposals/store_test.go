@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposals_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/proposals"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func record(slot math.Slot) proposals.Record {
+	return proposals.Record{
+		Slot:         slot,
+		FeeRecipient: common.ExecutionAddress{byte(slot)},
+		BlockHash:    common.ExecutionHash{byte(slot), 0xff},
+	}
+}
+
+func TestStore_SetGet(t *testing.T) {
+	t.Parallel()
+	store := proposals.NewStore(dbm.NewMemDB())
+
+	_, err := store.Get(1)
+	require.ErrorIs(t, err, proposals.ErrNotFound)
+
+	require.NoError(t, store.Set(record(1)))
+	got, err := store.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, record(1), got)
+
+	// A proposal built again for the same slot replaces the previous one.
+	replaced := record(1)
+	replaced.BlockHash = common.ExecutionHash{0xaa}
+	require.NoError(t, store.Set(replaced))
+	got, err = store.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, replaced, got)
+}
+
+func TestStore_Range(t *testing.T) {
+	t.Parallel()
+	store := proposals.NewStore(dbm.NewMemDB())
+	for _, slot := range []math.Slot{3, 7, 256, 9} {
+		require.NoError(t, store.Set(record(slot)))
+	}
+
+	got, err := store.Range(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []proposals.Record{
+		record(3), record(7), record(9), record(256),
+	}, got)
+
+	got, err = store.Range(7, 2)
+	require.NoError(t, err)
+	require.Equal(t, []proposals.Record{record(7), record(9)}, got)
+
+	got, err = store.Range(257, 10)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
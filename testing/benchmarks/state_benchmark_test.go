@@ -0,0 +1,148 @@
+//go:build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package benchmarks_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// validatorCounts are the validator set sizes every state benchmark runs at.
+//
+//nolint:gochecknoglobals // benchmark table.
+var validatorCounts = []int{1_000, 10_000, 100_000}
+
+// benchFixture is a genesis state with a given number of active validators.
+// Fixtures are deterministic: validator i always has the same pubkey,
+// credentials and balance, so results are comparable across runs.
+type benchFixture struct {
+	cs chain.Spec
+	sp *statetransition.TestStateProcessorT
+	st *statetransition.TestBeaconStateT
+}
+
+func newBenchFixture(b *testing.B, numValidators int) *benchFixture {
+	b.Helper()
+	specData := spec.DevnetChainSpecData()
+	specData.ValidatorSetCap = uint64(numValidators)
+	cs, err := chain.NewSpec(specData)
+	require.NoError(b, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(b, cs)
+
+	deposits := make(types.Deposits, numValidators)
+	for i := range deposits {
+		var pubkey [48]byte
+		binary.BigEndian.PutUint64(pubkey[:], uint64(i)+1)
+		var addr common.ExecutionAddress
+		binary.BigEndian.PutUint64(addr[:], uint64(i)+1)
+		deposits[i] = &types.Deposit{
+			Pubkey:      pubkey,
+			Credentials: types.NewCredentialsFromExecutionAddress(addr),
+			Amount:      cs.MaxEffectiveBalance(),
+			Index:       uint64(i),
+		}
+	}
+	require.NoError(b, ds.EnqueueDeposits(ctx.ConsensusCtx(), deposits))
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st,
+		deposits,
+		&types.ExecutionPayloadHeader{
+			Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+		},
+		cs.GenesisForkVersion(),
+	)
+	require.NoError(b, err)
+	return &benchFixture{cs: cs, sp: sp, st: st}
+}
+
+// runForValidatorCounts runs the benchmark once per validator set size,
+// building the fixture outside of the timed section.
+func runForValidatorCounts(b *testing.B, fn func(b *testing.B, f *benchFixture)) {
+	b.Helper()
+	for _, n := range validatorCounts {
+		b.Run(fmt.Sprintf("validators=%d", n), func(b *testing.B) {
+			f := newBenchFixture(b, n)
+			b.ReportAllocs()
+			b.ResetTimer()
+			fn(b, f)
+			b.ReportMetric(float64(n), "validators")
+		})
+	}
+}
+
+// BenchmarkProcessEpoch measures the slot processing that crosses an epoch
+// boundary, which is where the validator set is iterated.
+func BenchmarkProcessEpoch(b *testing.B) {
+	runForValidatorCounts(b, func(b *testing.B, f *benchFixture) {
+		lastSlot := math.Slot(f.cs.SlotsPerEpoch() - 1)
+		require.NoError(b, f.st.SetSlot(lastSlot))
+		for range b.N {
+			b.StopTimer()
+			st := f.st.Copy()
+			b.StartTimer()
+			_, err := f.sp.ProcessSlots(st, lastSlot+1)
+			require.NoError(b, err)
+		}
+	})
+}
+
+// BenchmarkStateHashTreeRoot measures hashing the full beacon state.
+func BenchmarkStateHashTreeRoot(b *testing.B) {
+	runForValidatorCounts(b, func(b *testing.B, f *benchFixture) {
+		b.StopTimer()
+		bsm, err := f.st.GetMarshallable()
+		require.NoError(b, err)
+		b.StartTimer()
+		for range b.N {
+			_ = bsm.HashTreeRoot()
+		}
+	})
+}
+
+// BenchmarkProveWithdrawalCredentials measures building the proof of the
+// last validator's withdrawal credentials in the beacon state.
+func BenchmarkProveWithdrawalCredentials(b *testing.B) {
+	runForValidatorCounts(b, func(b *testing.B, f *benchFixture) {
+		b.StopTimer()
+		bsm, err := f.st.GetMarshallable()
+		require.NoError(b, err)
+		offset := merkle.ValidatorGIndexOffset * math.U64(len(bsm.Validators)-1)
+		b.StartTimer()
+		for range b.N {
+			_, _, err = merkle.ProveWithdrawalCredentialsInState(
+				bsm.GetForkVersion(), bsm, offset,
+			)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -0,0 +1,396 @@
+//go:build simulated
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package devnet runs a network of BeaconKit nodes in-process, without
+// Kurtosis nor Docker. Every node is backed by its own in-process execution
+// client and the CometBFT consensus rounds are driven by the Network itself,
+// which makes it possible to inject faults deterministically.
+package devnet
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/testing/simulated"
+	"github.com/berachain/beacon-kit/testing/simulated/execution"
+	"github.com/cometbft/cometbft/abci/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// defaultELGenesisPath is the EL genesis used when none is configured,
+	// relative to this package.
+	defaultELGenesisPath = "../simulated/el-genesis-files/eth-genesis.json"
+
+	// defaultMaxRounds is the number of rounds after which ProduceBlock gives
+	// up on a height when none is configured.
+	defaultMaxRounds = 10
+
+	servicesStartTimeout  = 10 * time.Second
+	servicesStartInterval = 50 * time.Millisecond
+)
+
+// Config configures a Network.
+type Config struct {
+	// NumValidators is the number of nodes of the network, each running one
+	// of the genesis validators.
+	NumValidators int
+	// ChainSpec creates the chain spec of the network. Defaults to
+	// simulated.ProvideSimulationChainSpec.
+	ChainSpec func() (chain.Spec, error)
+	// ELGenesisPath is the path to the EL genesis file. Defaults to the one
+	// used by the simulated tests.
+	ELGenesisPath string
+	// StartTime is the time of the first block. Defaults to the current time.
+	StartTime time.Time
+	// MaxRounds is the number of rounds after which ProduceBlock fails the
+	// test if no block could be finalized. Defaults to 10.
+	MaxRounds int32
+}
+
+// DropProposalFunc reports whether the proposal of the given height and round
+// is withheld from the node of the given index, which then votes against it.
+type DropProposalFunc func(height int64, round int32, node int) bool
+
+// Node is a node of the network.
+type Node struct {
+	simulated.TestNode
+
+	// Index is the index of the node in the network.
+	Index int
+	// HomeDir is the home directory of the node.
+	HomeDir string
+	// Signer signs with the validator key of the node.
+	Signer *signer.BLSSigner
+	// EL is the execution client of the node.
+	EL *execution.InProcessEL
+	// LogBuffer holds the logs of the node.
+	LogBuffer *bytes.Buffer
+}
+
+// Block is a block finalized by the network.
+type Block struct {
+	Height int64
+	// Round is the round in which the block was finalized.
+	Round int32
+	// Proposer is the index of the node which proposed the block.
+	Proposer int
+	// AppHash is the app hash all nodes agreed on after the block.
+	AppHash []byte
+}
+
+// Network is a network of in-process BeaconKit nodes. It plays the role of
+// CometBFT: at every height the proposer of the round prepares a proposal,
+// every node processes it and the block is finalized on all nodes once more
+// than two thirds of them accepted it. Otherwise the next round starts with
+// the next proposer, in round-robin order.
+type Network struct {
+	t         *testing.T
+	ctx       context.Context
+	chainSpec chain.Spec
+	nodes     []*Node
+	maxRounds int32
+
+	height       int64
+	time         time.Time
+	dropProposal DropProposalFunc
+}
+
+// New creates the genesis of the network, then builds, starts and
+// initializes all of its nodes. The nodes are stopped when the test ends.
+func New(t *testing.T, cfg Config) *Network {
+	t.Helper()
+	require.Positive(t, cfg.NumValidators, "number of validators must be positive")
+	if cfg.ChainSpec == nil {
+		cfg.ChainSpec = simulated.ProvideSimulationChainSpec
+	}
+	if cfg.ELGenesisPath == "" {
+		cfg.ELGenesisPath = defaultELGenesisPath
+	}
+	if cfg.StartTime.IsZero() {
+		cfg.StartTime = time.Now()
+	}
+	if cfg.MaxRounds == 0 {
+		cfg.MaxRounds = defaultMaxRounds
+	}
+
+	chainSpec, err := cfg.ChainSpec()
+	require.NoError(t, err)
+
+	// The first node holds the genesis and the keys of all validators.
+	rootDir := t.TempDir()
+	rootCometConfig, _ := simulated.InitializeMultiValidatorHomeDir(
+		t, chainSpec, rootDir, cfg.ELGenesisPath, cfg.NumValidators,
+	)
+	genesisBz, err := os.ReadFile(rootCometConfig.GenesisFile())
+	require.NoError(t, err)
+	appGenesis, err := genutiltypes.AppGenesisFromFile(rootCometConfig.GenesisFile())
+	require.NoError(t, err)
+	genesisState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	require.NoError(t, err)
+	beaconGenesis := &ctypes.Genesis{}
+	require.NoError(t, json.Unmarshal(genesisState["beacon"], beaconGenesis))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &Network{
+		t:         t,
+		ctx:       ctx,
+		chainSpec: chainSpec,
+		maxRounds: cfg.MaxRounds,
+		time:      cfg.StartTime,
+	}
+	t.Cleanup(func() {
+		if t.Failed() {
+			for _, node := range n.nodes {
+				t.Logf("node %d logs:\n%s", node.Index, node.LogBuffer.String())
+			}
+		}
+		cancel()
+		for _, node := range n.nodes {
+			node.ServiceRegistry.StopAll()
+		}
+	})
+
+	for i := range cfg.NumValidators {
+		homeDir := rootDir
+		if i > 0 {
+			homeDir = genesis.ValidatorHomeDir(rootDir, i)
+			//#nosec:G306 // genesis is not sensitive
+			require.NoError(t, os.WriteFile(
+				filepath.Join(homeDir, "config", "genesis.json"), genesisBz, 0o644,
+			))
+		}
+		n.nodes = append(n.nodes, n.startNode(
+			i, homeDir, chainSpec, cfg.ChainSpec, beaconGenesis.GetExecutionPayloadHeader(),
+		))
+	}
+
+	for _, node := range n.nodes {
+		resp, err := node.SimComet.Comet.InitChain(n.ctx, &types.InitChainRequest{
+			ChainId:       simulated.TestnetBeaconChainID,
+			AppStateBytes: appGenesis.AppState,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Validators, cfg.NumValidators)
+	}
+	return n
+}
+
+// startNode builds and starts the node of the given index.
+func (n *Network) startNode(
+	index int,
+	homeDir string,
+	chainSpec chain.Spec,
+	chainSpecFunc func() (chain.Spec, error),
+	elGenesis *ctypes.ExecutionPayloadHeader,
+) *Node {
+	n.t.Helper()
+
+	cometConfig := cometbft.DefaultConfig()
+	cometConfig.RootDir = homeDir
+
+	el := execution.NewInProcessEL(chainSpec.DepositEth1ChainID(), elGenesis)
+	elURL := el.Start(n.t)
+
+	logBuffer := new(bytes.Buffer)
+	components := simulated.FixedComponents(n.t)
+	components = append(components, simulated.ProvideSimComet)
+	components = append(components, chainSpecFunc)
+	testNode := simulated.NewTestNode(n.t, simulated.TestNodeInput{
+		TempHomeDir: homeDir,
+		CometConfig: cometConfig,
+		AuthRPC:     elURL,
+		ClientRPC:   elURL,
+		Logger:      phuslu.NewLogger(logBuffer, nil),
+		AppOpts:     viper.New(),
+		Components:  components,
+	})
+	go func() {
+		_ = testNode.Start(n.ctx)
+	}()
+	require.NoError(n.t, simulated.WaitTillServicesStarted(
+		logBuffer, servicesStartTimeout, servicesStartInterval,
+	), "node %d did not start", index)
+
+	return &Node{
+		TestNode:  testNode,
+		Index:     index,
+		HomeDir:   homeDir,
+		Signer:    simulated.GetBlsSigner(homeDir),
+		EL:        el,
+		LogBuffer: logBuffer,
+	}
+}
+
+// Nodes returns the nodes of the network, ordered by index.
+func (n *Network) Nodes() []*Node {
+	return n.nodes
+}
+
+// ChainSpec returns the chain spec of the network.
+func (n *Network) ChainSpec() chain.Spec {
+	return n.chainSpec
+}
+
+// Height returns the height of the last finalized block.
+func (n *Network) Height() int64 {
+	return n.height
+}
+
+// DropProposals withholds proposals from nodes as decided by f, which is
+// consulted for every node other than the proposer. A nil f delivers all
+// proposals.
+func (n *Network) DropProposals(f DropProposalFunc) {
+	n.dropProposal = f
+}
+
+// DelayEngine delays the responses of the execution client of the given node
+// to the given Engine API method, or to every method if method is empty. A
+// zero d removes the delay.
+func (n *Network) DelayEngine(node int, method string, d time.Duration) {
+	n.nodes[node].EL.SetResponseDelay(method, d)
+}
+
+// ProduceBlocks finalizes count blocks and returns them.
+func (n *Network) ProduceBlocks(count int) []*Block {
+	n.t.Helper()
+	blocks := make([]*Block, 0, count)
+	for range count {
+		blocks = append(blocks, n.ProduceBlock())
+	}
+	return blocks
+}
+
+// ProduceBlock runs rounds at the next height until a block is finalized,
+// and fails the test if none is after the configured maximum number of
+// rounds or if the nodes disagree on the resulting app hash.
+func (n *Network) ProduceBlock() *Block {
+	n.t.Helper()
+	height := n.height + 1
+	for round := range n.maxRounds {
+		proposer := n.nodes[(int(height-1)+int(round))%len(n.nodes)]
+		txs, ok := n.runRound(height, round, proposer)
+		if !ok {
+			continue
+		}
+
+		appHash := n.finalize(height, proposer, txs)
+		n.height = height
+		n.time = n.time.Add(
+			time.Duration(n.chainSpec.TargetSecondsPerEth1Block()) * time.Second,
+		)
+		return &Block{
+			Height:   height,
+			Round:    round,
+			Proposer: proposer.Index,
+			AppHash:  appHash,
+		}
+	}
+	n.t.Fatalf("no block finalized at height %d after %d rounds", height, n.maxRounds)
+	return nil
+}
+
+// runRound has the proposer prepare a proposal and all nodes process it. It
+// returns the proposed transactions and whether more than two thirds of the
+// nodes accepted them.
+func (n *Network) runRound(
+	height int64, round int32, proposer *Node,
+) ([][]byte, bool) {
+	n.t.Helper()
+	proposerAddress := n.address(proposer)
+	proposal, err := proposer.SimComet.Comet.PrepareProposal(n.ctx, &types.PrepareProposalRequest{
+		Height:          height,
+		Time:            n.time,
+		ProposerAddress: proposerAddress,
+	})
+	if err != nil {
+		n.t.Logf("height %d round %d: node %d failed to propose: %v", height, round, proposer.Index, err)
+		return nil, false
+	}
+
+	var accepted int
+	for _, node := range n.nodes {
+		if node != proposer && n.dropProposal != nil && n.dropProposal(height, round, node.Index) {
+			continue
+		}
+		resp, err := node.SimComet.Comet.ProcessProposal(n.ctx, &types.ProcessProposalRequest{
+			Txs:             proposal.Txs,
+			Height:          height,
+			ProposerAddress: proposerAddress,
+			Time:            n.time,
+		})
+		if err != nil {
+			n.t.Logf("height %d round %d: node %d failed to process proposal: %v", height, round, node.Index, err)
+			continue
+		}
+		if resp.Status == types.PROCESS_PROPOSAL_STATUS_ACCEPT {
+			accepted++
+		}
+	}
+	return proposal.Txs, 3*accepted > 2*len(n.nodes)
+}
+
+// finalize finalizes and commits the block on all nodes and returns the app
+// hash they agreed on.
+func (n *Network) finalize(height int64, proposer *Node, txs [][]byte) []byte {
+	n.t.Helper()
+	var appHash []byte
+	for _, node := range n.nodes {
+		resp, err := node.SimComet.Comet.FinalizeBlock(n.ctx, &types.FinalizeBlockRequest{
+			Txs:             txs,
+			Height:          height,
+			ProposerAddress: n.address(proposer),
+			Time:            n.time,
+		})
+		require.NoError(n.t, err, "node %d failed to finalize height %d", node.Index, height)
+		if appHash == nil {
+			appHash = resp.AppHash
+		}
+		require.Equal(n.t, appHash, resp.AppHash,
+			"node %d diverged at height %d", node.Index, height)
+
+		_, err = node.SimComet.Comet.Commit(n.ctx, &types.CommitRequest{})
+		require.NoError(n.t, err, "node %d failed to commit height %d", node.Index, height)
+	}
+	return appHash
+}
+
+// address returns the consensus address of the node.
+func (n *Network) address(node *Node) []byte {
+	n.t.Helper()
+	pubkey, err := node.Signer.GetPubKey()
+	require.NoError(n.t, err)
+	return pubkey.Address()
+}
@@ -0,0 +1,88 @@
+//go:build simulated
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/testing/devnet"
+	"github.com/stretchr/testify/require"
+)
+
+const numValidators = 4
+
+func TestNetworkFinalizesBlocks(t *testing.T) {
+	n := devnet.New(t, devnet.Config{NumValidators: numValidators})
+
+	blocks := n.ProduceBlocks(2 * numValidators)
+	for i, block := range blocks {
+		require.Equal(t, int64(i+1), block.Height)
+		require.Zero(t, block.Round)
+		require.Equal(t, i%numValidators, block.Proposer)
+	}
+
+	// All execution clients followed the same chain.
+	head := n.Nodes()[0].EL.Head()
+	for _, node := range n.Nodes()[1:] {
+		require.Equal(t, head, node.EL.Head())
+	}
+}
+
+func TestNetworkDroppedProposal(t *testing.T) {
+	n := devnet.New(t, devnet.Config{NumValidators: numValidators})
+
+	// Withholding the first proposal of height 2 from two nodes out of four
+	// prevents the quorum, so the next proposer finalizes it in round 1.
+	n.DropProposals(func(height int64, round int32, node int) bool {
+		return height == 2 && round == 0 && node >= 2
+	})
+	blocks := n.ProduceBlocks(3)
+	require.Zero(t, blocks[0].Round)
+	require.Equal(t, int32(1), blocks[1].Round)
+	require.Equal(t, 2, blocks[1].Proposer)
+	require.Zero(t, blocks[2].Round)
+
+	// Withholding it from a single node does not.
+	n.DropProposals(func(_ int64, _ int32, node int) bool { return node == 3 })
+	require.Zero(t, n.ProduceBlock().Round)
+}
+
+func TestNetworkDelayedEngine(t *testing.T) {
+	n := devnet.New(t, devnet.Config{NumValidators: numValidators})
+	n.ProduceBlock()
+
+	// The proposer of height 2 cannot get its payload built in time, so the
+	// next proposer finalizes the block in round 1.
+	n.DelayEngine(1, ethclient.ForkchoiceUpdatedMethodV3, time.Minute)
+	n.DelayEngine(1, ethclient.GetPayloadMethodV3, time.Minute)
+	block := n.ProduceBlock()
+	require.Equal(t, int32(1), block.Round)
+	require.Equal(t, 2, block.Proposer)
+
+	// Once the delays are removed the node catches up with the others.
+	n.DelayEngine(1, ethclient.ForkchoiceUpdatedMethodV3, 0)
+	n.DelayEngine(1, ethclient.GetPayloadMethodV3, 0)
+	n.ProduceBlocks(numValidators)
+}
@@ -91,11 +91,9 @@ if [[ $overwrite == "y" || $overwrite == "Y" ]]; then
 		cp -f $network_dir/*.toml $network_dir/genesis.json ${HOMEDIR}/config
     	KZG_PATH=$network_dir/kzg-trusted-setup.json
 	else
-		./build/bin/beacond genesis add-premined-deposit --home $HOMEDIR \
-			32000000000 0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4 $CHAIN_SPEC_ARG
-		./build/bin/beacond genesis collect-premined-deposits --home $HOMEDIR $CHAIN_SPEC_ARG
-		./build/bin/beacond genesis set-deposit-storage "$ETH_GENESIS" --home $HOMEDIR $CHAIN_SPEC_ARG
-		./build/bin/beacond genesis execution-payload "$HOMEDIR/eth-genesis.json" --home $HOMEDIR $CHAIN_SPEC_ARG
+		./build/bin/beacond genesis generate "$ETH_GENESIS" --home $HOMEDIR \
+			--validators 1 --deposit-amount 32000000000 \
+			--withdrawal-address 0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4 $CHAIN_SPEC_ARG
 	fi
 fi
 
@@ -0,0 +1,138 @@
+//go:build quick
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package compare_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// dualHashable is a type that is hashed both through its karalabe/ssz
+// definition and through its hand-written fastssz HashTreeRootWith. The two
+// must never disagree: the first roots blocks and states, the second builds
+// the proofs served by the node API.
+type dualHashable interface {
+	HashTreeRoot() common.Root
+	HashTreeRootWith(hh fastssz.HashWalker) error
+}
+
+// requireHashersAgree generates random objects for every supported fork and
+// checks that both hashing paths return the same root.
+func requireHashersAgree[T dualHashable](
+	t *testing.T,
+	generate func(r *rand.Rand, v common.Version) T,
+) {
+	t.Helper()
+	for _, v := range version.GetSupportedVersions() {
+		f := func(seed int64) bool {
+			obj := generate(rand.New(rand.NewSource(seed)), v)
+			hh := fastssz.NewHasher()
+			if err := obj.HashTreeRootWith(hh); err != nil {
+				t.Logf("fastssz failed for %s with seed %d: %v", version.Name(v), seed, err)
+				return false
+			}
+			fastRoot, err := hh.HashRoot()
+			if err != nil {
+				t.Logf("fastssz failed for %s with seed %d: %v", version.Name(v), seed, err)
+				return false
+			}
+			root := obj.HashTreeRoot()
+			if root != common.Root(fastRoot) {
+				t.Logf(
+					"%T roots diverge for %s with seed %d: karalabe %s, fastssz %s",
+					obj, version.Name(v), seed, root, common.Root(fastRoot),
+				)
+				return false
+			}
+			return true
+		}
+		require.NoError(t, quick.Check(f, &quick.Config{MaxCount: 1_000}), version.Name(v))
+	}
+}
+
+func generateExecutionPayload(r *rand.Rand, v common.Version) *ctypes.ExecutionPayload {
+	payload := (*ctypes.ExecutionPayload)(
+		(&TestExecPayload{}).Generate(r, 0).Interface().(*TestExecPayload),
+	)
+	payload.Versionable = ctypes.NewVersionable(v)
+	if len(payload.ExtraData) > ctypes.ExtraDataSize {
+		payload.ExtraData = payload.ExtraData[:ctypes.ExtraDataSize]
+	}
+	return payload
+}
+
+func generateValue[T any](r *rand.Rand) *T {
+	var obj T
+	val, ok := quick.Value(reflect.TypeOf(obj), r)
+	if !ok {
+		panic("failed to generate value")
+	}
+	obj = val.Interface().(T)
+	return &obj
+}
+
+func TestExecutionPayloadHashersAgree(t *testing.T) {
+	t.Parallel()
+	requireHashersAgree(t, generateExecutionPayload)
+}
+
+func TestExecutionPayloadHeaderHashersAgree(t *testing.T) {
+	t.Parallel()
+	requireHashersAgree(t, func(r *rand.Rand, v common.Version) *ctypes.ExecutionPayloadHeader {
+		header, err := generateExecutionPayload(r, v).ToHeader()
+		if err != nil {
+			panic(err)
+		}
+		return header
+	})
+}
+
+func TestWithdrawalHashersAgree(t *testing.T) {
+	t.Parallel()
+	requireHashersAgree(t, func(r *rand.Rand, _ common.Version) *engineprimitives.Withdrawal {
+		return generateValue[engineprimitives.Withdrawal](r)
+	})
+}
+
+func TestValidatorHashersAgree(t *testing.T) {
+	t.Parallel()
+	requireHashersAgree(t, func(r *rand.Rand, _ common.Version) *ctypes.Validator {
+		return generateValue[ctypes.Validator](r)
+	})
+}
+
+func TestDepositHashersAgree(t *testing.T) {
+	t.Parallel()
+	requireHashersAgree(t, func(r *rand.Rand, _ common.Version) *ctypes.Deposit {
+		return generateValue[ctypes.Deposit](r)
+	})
+}
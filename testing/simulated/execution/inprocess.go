@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package execution
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/stretchr/testify/require"
+)
+
+// JSON-RPC error codes returned by the in-process EL, as per the Engine API
+// specification.
+const (
+	errCodeMethodNotFound           = -32601
+	errCodeInvalidParams            = -32602
+	errCodeUnknownPayload           = -38001
+	errCodeInvalidPayloadAttributes = -38003
+)
+
+// inProcessBlock is a block of the deterministic fake chain.
+type inProcessBlock struct {
+	number    math.U64
+	timestamp math.U64
+}
+
+// InProcessEL is an in-process execution client implementing the subset of the
+// Engine API used by BeaconKit (newPayload, forkchoiceUpdated, getPayload and
+// the handshake methods) on top of a deterministic fake chain. Transactions
+// are never executed: a payload is valid whenever it extends a known block
+// and its block hash matches the hash the fake chain derives from its fields.
+// Since the fake chain is deterministic, several InProcessEL started from the
+// same genesis accept the same payloads. The deposit contract never emits
+// logs.
+//
+// NOTE: JWT authentication is not enforced.
+type InProcessEL struct {
+	chainID uint64
+
+	mu       sync.Mutex
+	blocks   map[common.ExecutionHash]inProcessBlock
+	payloads map[engineprimitives.PayloadID]*ctypes.ExecutionPayload
+	head     common.ExecutionHash
+	delays   map[string]time.Duration
+}
+
+// NewInProcessEL returns an in-process execution client whose chain starts at
+// the given genesis execution payload header, as found in the beacon genesis.
+func NewInProcessEL(chainID uint64, genesis *ctypes.ExecutionPayloadHeader) *InProcessEL {
+	return &InProcessEL{
+		chainID: chainID,
+		blocks: map[common.ExecutionHash]inProcessBlock{
+			genesis.GetBlockHash(): {
+				number:    genesis.GetNumber(),
+				timestamp: genesis.GetTimestamp(),
+			},
+		},
+		payloads: make(map[engineprimitives.PayloadID]*ctypes.ExecutionPayload),
+		head:     genesis.GetBlockHash(),
+		delays:   make(map[string]time.Duration),
+	}
+}
+
+// SetResponseDelay delays the responses to the given JSON-RPC method by d,
+// or the responses to every method if method is empty. A zero d removes the
+// delay.
+func (e *InProcessEL) SetResponseDelay(method string, d time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if d == 0 {
+		delete(e.delays, method)
+		return
+	}
+	e.delays[method] = d
+}
+
+// responseDelay returns the delay to apply to the responses to method.
+func (e *InProcessEL) responseDelay(method string) time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if d, ok := e.delays[method]; ok {
+		return d
+	}
+	return e.delays[""]
+}
+
+// Start serves the Engine API on a local port until the test ends and returns
+// the URL to configure as the engine RPC dial URL.
+func (e *InProcessEL) Start(t *testing.T) *url.ConnectionURL {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &http.Server{Handler: e, ReadHeaderTimeout: time.Second}
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() { _ = server.Close() })
+
+	connURL, err := url.NewFromRaw("http://" + listener.Addr().String())
+	require.NoError(t, err)
+	return connURL
+}
+
+// Head returns the hash of the current head of the fake chain.
+func (e *InProcessEL) Head() common.ExecutionHash {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.head
+}
+
+// ServeHTTP implements http.Handler, serving JSON-RPC requests.
+func (e *InProcessEL) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if d := e.responseDelay(req.Method); d > 0 {
+		select {
+		case <-time.After(d):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	resp := &rpc.Response{ID: req.ID, JSONRPC: "2.0"}
+	result, rpcErr := e.handle(req.Method, req.Params)
+	if rpcErr != nil {
+		resp.Error = rpcErr
+	} else {
+		bz, err := json.Marshal(result)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Result = bz
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (e *InProcessEL) handle(method string, params []json.RawMessage) (any, *rpc.Error) {
+	switch method {
+	case "eth_chainId":
+		return math.U64(e.chainID), nil
+	case "eth_getLogs":
+		return []struct{}{}, nil
+	case ethclient.ExchangeCapabilities:
+		var capabilities []string
+		if err := decodeParam(params, 0, &capabilities); err != nil {
+			return nil, err
+		}
+		return capabilities, nil
+	case ethclient.GetClientVersionV1:
+		return []engineprimitives.ClientVersionV1{{
+			Code: "BK", Name: "inprocess-el", Version: "v0.0.0", Commit: "00000000",
+		}}, nil
+	case ethclient.NewPayloadMethodV3, ethclient.NewPayloadMethodV4:
+		return e.newPayload(params)
+	case ethclient.ForkchoiceUpdatedMethodV3:
+		return e.forkchoiceUpdated(params)
+	case ethclient.GetPayloadMethodV3, ethclient.GetPayloadMethodV4:
+		return e.getPayload(method, params)
+	default:
+		return nil, &rpc.Error{
+			Code:    errCodeMethodNotFound,
+			Message: fmt.Sprintf("method %s not supported", method),
+		}
+	}
+}
+
+func (e *InProcessEL) newPayload(params []json.RawMessage) (any, *rpc.Error) {
+	payload := &ctypes.ExecutionPayload{}
+	if err := decodeParam(params, 0, payload); err != nil {
+		return nil, err
+	}
+	var parentBeaconBlockRoot common.Root
+	if err := decodeParam(params, 2, &parentBeaconBlockRoot); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	parent, ok := e.blocks[payload.GetParentHash()]
+	if !ok {
+		return &engineprimitives.PayloadStatusV1{Status: engineprimitives.PayloadStatusSyncing}, nil
+	}
+	if payload.GetNumber() != parent.number+1 ||
+		payload.GetTimestamp() <= parent.timestamp ||
+		payload.GetBlockHash() != fakeBlockHash(payload, parentBeaconBlockRoot) {
+		latestValidHash := payload.GetParentHash()
+		return &engineprimitives.PayloadStatusV1{
+			Status:          engineprimitives.PayloadStatusInvalid,
+			LatestValidHash: &latestValidHash,
+		}, nil
+	}
+
+	blockHash := payload.GetBlockHash()
+	e.blocks[blockHash] = inProcessBlock{
+		number:    payload.GetNumber(),
+		timestamp: payload.GetTimestamp(),
+	}
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusValid,
+		LatestValidHash: &blockHash,
+	}, nil
+}
+
+func (e *InProcessEL) forkchoiceUpdated(params []json.RawMessage) (any, *rpc.Error) {
+	state := &engineprimitives.ForkchoiceStateV1{}
+	if err := decodeParam(params, 0, state); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	head, ok := e.blocks[state.HeadBlockHash]
+	if !ok {
+		return &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{Status: engineprimitives.PayloadStatusSyncing},
+		}, nil
+	}
+	e.head = state.HeadBlockHash
+	headHash := state.HeadBlockHash
+	resp := &engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: engineprimitives.PayloadStatusV1{
+			Status:          engineprimitives.PayloadStatusValid,
+			LatestValidHash: &headHash,
+		},
+	}
+
+	// Build a payload on top of the new head if attributes are given.
+	if len(params) < 2 || string(params[1]) == "null" {
+		return resp, nil
+	}
+	attrs := &engineprimitives.PayloadAttributes{}
+	if err := decodeParam(params, 1, attrs); err != nil {
+		return nil, err
+	}
+	if attrs.Timestamp <= head.timestamp {
+		return nil, &rpc.Error{
+			Code:    errCodeInvalidPayloadAttributes,
+			Message: "payload timestamp must be greater than the parent timestamp",
+		}
+	}
+
+	payload := &ctypes.ExecutionPayload{
+		ParentHash:    headHash,
+		FeeRecipient:  attrs.SuggestedFeeRecipient,
+		Random:        attrs.PrevRandao,
+		Number:        head.number + 1,
+		GasLimit:      30_000_000, //nolint:mnd // arbitrary.
+		Timestamp:     attrs.Timestamp,
+		ExtraData:     []byte{},
+		BaseFeePerGas: &math.U256{},
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   attrs.Withdrawals,
+	}
+	if payload.Withdrawals == nil {
+		payload.Withdrawals = engineprimitives.Withdrawals{}
+	}
+	payload.BlockHash = fakeBlockHash(payload, attrs.ParentBeaconBlockRoot)
+
+	var payloadID engineprimitives.PayloadID
+	copy(payloadID[:], payload.BlockHash[:])
+	e.payloads[payloadID] = payload
+	resp.PayloadID = &payloadID
+	return resp, nil
+}
+
+func (e *InProcessEL) getPayload(method string, params []json.RawMessage) (any, *rpc.Error) {
+	var payloadID engineprimitives.PayloadID
+	if err := decodeParam(params, 0, &payloadID); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	payload, ok := e.payloads[payloadID]
+	if !ok {
+		return nil, &rpc.Error{Code: errCodeUnknownPayload, Message: "unknown payload"}
+	}
+
+	envelope := map[string]any{
+		"executionPayload":      payload,
+		"blockValue":            "0x0",
+		"blobsBundle":           &engineprimitives.BlobsBundleV1{},
+		"shouldOverrideBuilder": false,
+	}
+	if method == ethclient.GetPayloadMethodV4 {
+		envelope["executionRequests"] = []ctypes.EncodedExecutionRequest{}
+	}
+	return envelope, nil
+}
+
+// fakeBlockHash deterministically derives the block hash of a payload of the
+// fake chain from the fields the consensus layer sets.
+func fakeBlockHash(payload *ctypes.ExecutionPayload, parentBeaconBlockRoot common.Root) common.ExecutionHash {
+	h := sha256.New()
+	h.Write(payload.ParentHash[:])
+	h.Write(binary.BigEndian.AppendUint64(nil, payload.Number.Unwrap()))
+	h.Write(binary.BigEndian.AppendUint64(nil, payload.Timestamp.Unwrap()))
+	h.Write(payload.Random[:])
+	h.Write(payload.FeeRecipient[:])
+	withdrawalsRoot := engineprimitives.Withdrawals(payload.Withdrawals).HashTreeRoot()
+	h.Write(withdrawalsRoot[:])
+	h.Write(parentBeaconBlockRoot[:])
+	return common.ExecutionHash(h.Sum(nil))
+}
+
+func decodeParam(params []json.RawMessage, i int, target any) *rpc.Error {
+	if i >= len(params) {
+		return &rpc.Error{Code: errCodeInvalidParams, Message: fmt.Sprintf("missing param %d", i)}
+	}
+	if err := json.Unmarshal(params[i], target); err != nil {
+		return &rpc.Error{Code: errCodeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package execution_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/simulated/execution"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInProcessEL(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	forkVersion := version.Deneb1()
+
+	genesis, err := ctypes.DefaultGenesisExecutionPayloadHeader(forkVersion)
+	require.NoError(t, err)
+	el := execution.NewInProcessEL(80087, genesis)
+	connURL := el.Start(t)
+	c := ethclient.New(rpc.NewClient(connURL.String(), nil, time.Minute))
+
+	chainID, err := c.ChainID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(80087), chainID)
+
+	// Build a payload on top of genesis.
+	parentBeaconBlockRoot := common.Root{0x01}
+	attrs, err := engineprimitives.NewPayloadAttributes(
+		forkVersion,
+		genesis.GetTimestamp()+1,
+		common.Bytes32{0x02},
+		common.ExecutionAddress{0x03},
+		engineprimitives.Withdrawals{},
+		parentBeaconBlockRoot,
+	)
+	require.NoError(t, err)
+	fcuResp, err := c.ForkchoiceUpdatedV3(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      genesis.GetBlockHash(),
+		SafeBlockHash:      genesis.GetBlockHash(),
+		FinalizedBlockHash: genesis.GetBlockHash(),
+	}, attrs)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusValid, fcuResp.PayloadStatus.Status)
+	require.NotNil(t, fcuResp.PayloadID)
+
+	env, err := c.GetPayloadV3(ctx, *fcuResp.PayloadID, forkVersion)
+	require.NoError(t, err)
+	payload := env.GetExecutionPayload()
+	require.Equal(t, genesis.GetBlockHash(), payload.GetParentHash())
+	require.Equal(t, genesis.GetNumber()+1, payload.GetNumber())
+
+	// The built payload is valid, a tampered one is not.
+	status, err := c.NewPayloadV3(ctx, payload, nil, &parentBeaconBlockRoot)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+
+	tampered := *payload
+	tampered.FeeRecipient = common.ExecutionAddress{0x04}
+	status, err = c.NewPayloadV3(ctx, &tampered, nil, &parentBeaconBlockRoot)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusInvalid, status.Status)
+
+	// Payloads on top of unknown blocks cannot be validated.
+	orphan := *payload
+	orphan.ParentHash = common.ExecutionHash{0x05}
+	status, err = c.NewPayloadV3(ctx, &orphan, nil, &parentBeaconBlockRoot)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusSyncing, status.Status)
+
+	// The new block can become the head.
+	fcuResp, err = c.ForkchoiceUpdatedV3(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      payload.GetBlockHash(),
+		SafeBlockHash:      payload.GetBlockHash(),
+		FinalizedBlockHash: payload.GetBlockHash(),
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusValid, fcuResp.PayloadStatus.Status)
+	require.Nil(t, fcuResp.PayloadID)
+	require.Equal(t, payload.GetBlockHash(), el.Head())
+
+	// Unknown payload IDs are rejected.
+	_, err = c.GetPayloadV3(ctx, engineprimitives.PayloadID{0xff}, forkVersion)
+	require.ErrorContains(t, err, "-38001")
+}
+
+func TestInProcessEL_ResponseDelay(t *testing.T) {
+	t.Parallel()
+	genesis, err := ctypes.DefaultGenesisExecutionPayloadHeader(version.Deneb1())
+	require.NoError(t, err)
+	el := execution.NewInProcessEL(80087, genesis)
+	connURL := el.Start(t)
+	c := ethclient.New(rpc.NewClient(connURL.String(), nil, time.Minute))
+
+	const delay = 200 * time.Millisecond
+	el.SetResponseDelay("eth_chainId", delay)
+	start := time.Now()
+	_, err = c.ChainID(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), delay)
+
+	// A request timing out on the client side fails.
+	ctx, cancel := context.WithTimeout(context.Background(), delay/4)
+	defer cancel()
+	_, err = c.ChainID(ctx)
+	require.Error(t, err)
+
+	// Removing the delay restores immediate responses.
+	el.SetResponseDelay("eth_chainId", 0)
+	_, err = c.ChainID(context.Background())
+	require.NoError(t, err)
+}
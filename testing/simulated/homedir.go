@@ -94,6 +94,43 @@ func InitializeHomeDir(t *testing.T, chainSpec chain.Spec, tempHomeDir string, e
 	return cometConfig, genesisValidatorsRoot
 }
 
+// InitializeMultiValidatorHomeDir sets up a temporary home directory with a genesis
+// holding numValidators validators, as done by 'beacond genesis generate'. The keys of
+// the validators other than the first one are written to genesis.ValidatorHomeDir.
+// It returns the configured CometBFT config along with the computed genesis validators root.
+func InitializeMultiValidatorHomeDir(
+	t *testing.T,
+	chainSpec chain.Spec,
+	tempHomeDir string,
+	elGenesisPath string,
+	numValidators int,
+) (*cmtcfg.Config, common.Root) {
+	t.Helper()
+
+	t.Logf("Initializing home directory with %d validators: %s", numValidators, tempHomeDir)
+	cometConfig := createCometConfig(t, tempHomeDir)
+	initCommand(t, chainSpec, cometConfig.RootDir)
+
+	_, err := genesis.Generate(
+		chainSpec,
+		cometConfig,
+		elGenesisPath,
+		numValidators,
+		chainSpec.MaxEffectiveBalance(),
+		common.NewExecutionAddressFromHex(WithdrawalExecutionAddress),
+		nil,
+	)
+	require.NoError(t, err, "failed to generate genesis")
+
+	genesisValidatorsRoot, err := genesisutils.ComputeValidatorsRootFromFile(
+		path.Join(cometConfig.RootDir, "config/genesis.json"),
+		chainSpec,
+	)
+	require.NoError(t, err, "failed to compute validators root")
+
+	return cometConfig, genesisValidatorsRoot
+}
+
 func CopyHomeDir(t *testing.T, sourceHomeDir, targetHomeDir string) {
 	t.Logf("Copying home directory to: %s", targetHomeDir)
 	srcPath := filepath.Join(filepath.Clean(sourceHomeDir), ".")
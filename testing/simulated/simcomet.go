@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	consensustypes "github.com/berachain/beacon-kit/consensus/types"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
@@ -81,6 +82,22 @@ func (s *SimComet) CreateQueryContext(height int64, prove bool) (sdk.Context, er
 	return s.Comet.CreateQueryContext(height, prove)
 }
 
+func (s *SimComet) PauseBlocks(ctx context.Context) (func(), error) {
+	return s.Comet.PauseBlocks(ctx)
+}
+
 func (s *SimComet) LastBlockHeight() int64 {
 	panic("unimplemented")
 }
+
+func (s *SimComet) ExpectedProposerAddresses(int) ([][]byte, error) {
+	return nil, cometbft.ErrNodeNotStarted
+}
+
+func (s *SimComet) SyncStatus() (int64, bool, error) {
+	return 0, false, nil
+}
+
+func (s *SimComet) Peers() []*consensustypes.PeerInfo {
+	return nil
+}
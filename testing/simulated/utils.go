@@ -328,7 +328,7 @@ func ComputeAndSetStateRoot(
 ) (*ctypes.BeaconBlock, error) {
 
 	// Copy the current state from the storage backend.
-	stateDBCopy := storageBackend.StateFromContext(queryCtx).Copy(queryCtx)
+	stateDBCopy := storageBackend.StateFromContext(queryCtx).Copy()
 
 	// Create a transition context with the provided consensus time and proposer address.
 	txCtx := transition.NewTransitionCtx(
@@ -0,0 +1,137 @@
+//go:build spectest
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spectest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+// sszObject is a type shared with upstream whose vectors we check.
+type sszObject interface {
+	constraints.SSZUnmarshaler
+	MarshalSSZ() ([]byte, error)
+	HashTreeRoot() common.Root
+}
+
+// sszStaticType is a container whose ssz_static vectors are checked. Presets
+// lists the presets whose limits match ours: the minimal preset lowers e.g.
+// MAX_WITHDRAWALS_PER_PAYLOAD, which changes the root of a payload.
+type sszStaticType struct {
+	name    string
+	presets []string
+	newFn   func(forkVersion common.Version) sszObject
+}
+
+//nolint:gochecknoglobals // test table.
+var (
+	forks = map[string]common.Version{
+		"deneb":   version.Deneb(),
+		"electra": version.Electra(),
+	}
+	sszStaticTypes = []sszStaticType{
+		{
+			name:    "ExecutionPayload",
+			presets: []string{"mainnet"},
+			newFn: func(v common.Version) sszObject {
+				return types.NewEmptyExecutionPayloadWithVersion(v)
+			},
+		},
+		{
+			name:    "Withdrawal",
+			presets: []string{"mainnet", "minimal"},
+			newFn: func(common.Version) sszObject {
+				return &engineprimitives.Withdrawal{}
+			},
+		},
+		{
+			name:    "BeaconBlockHeader",
+			presets: []string{"mainnet", "minimal"},
+			newFn: func(common.Version) sszObject {
+				return types.NewEmptyBeaconBlockHeader()
+			},
+		},
+	}
+)
+
+// TestSSZStatic decodes the ssz_static vectors of consensus-spec-tests and
+// checks that they re-encode to the same bytes and hash to the same root.
+// The vectors are read from $SPEC_TESTS_DIR, see `make test-spectest`.
+func TestSSZStatic(t *testing.T) {
+	dir := os.Getenv("SPEC_TESTS_DIR")
+	require.NotEmpty(t, dir, "SPEC_TESTS_DIR must point to consensus-spec-tests")
+
+	for _, typ := range sszStaticTypes {
+		for _, preset := range typ.presets {
+			for fork, forkVersion := range forks {
+				cases, err := filepath.Glob(filepath.Join(
+					dir, "tests", preset, fork, "ssz_static", typ.name, "*", "case_*",
+				))
+				require.NoError(t, err)
+				require.NotEmpty(t, cases, "no vectors for %s/%s/%s", preset, fork, typ.name)
+
+				for _, c := range cases {
+					rel, _ := filepath.Rel(dir, c)
+					t.Run(rel, func(t *testing.T) {
+						t.Parallel()
+						runSSZStatic(t, c, typ.newFn(forkVersion))
+					})
+				}
+			}
+		}
+	}
+}
+
+func runSSZStatic(t *testing.T, dir string, obj sszObject) {
+	t.Helper()
+	compressed, err := os.ReadFile(filepath.Join(dir, "serialized.ssz_snappy"))
+	require.NoError(t, err)
+	serialized, err := snappy.Decode(nil, compressed)
+	require.NoError(t, err)
+
+	rootsYAML, err := os.ReadFile(filepath.Join(dir, "roots.yaml"))
+	require.NoError(t, err)
+	var roots struct {
+		Root string `json:"root"`
+	}
+	require.NoError(t, yaml.Unmarshal(rootsYAML, &roots))
+	root, err := common.NewRootFromHex(roots.Root)
+	require.NoError(t, err)
+
+	require.NoError(t, ssz.Unmarshal(serialized, obj))
+	encoded, err := obj.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, serialized, encoded)
+	require.Equal(t, root, obj.HashTreeRoot())
+}
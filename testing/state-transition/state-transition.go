@@ -111,7 +111,7 @@ func BuildTestStores() (
 		nil
 }
 
-func SetupTestState(t *testing.T, cs chain.Spec) (
+func SetupTestState(t testing.TB, cs chain.Spec) (
 	*TestStateProcessorT,
 	*TestBeaconStateT,
 	deposit.StoreManager,
@@ -147,6 +147,7 @@ func SetupTestState(t *testing.T, cs chain.Spec) (
 			return DummyProposerAddr, nil
 		},
 		nodemetrics.NewNoOpTelemetrySink(),
+		"",
 	)
 
 	// by default we keep checks at minimum. It is up